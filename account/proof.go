@@ -0,0 +1,81 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package account
+
+//账户的默克尔证明:
+//节点返回账户在状态树中的值以及证明, 轻客户端只需要一个可信的区块头(stateHash)就可以校验账户的余额
+
+import (
+	"bytes"
+
+	"github.com/33cn/chain33/client"
+	mavl "github.com/33cn/chain33/system/store/mavl/db"
+	"github.com/33cn/chain33/types"
+)
+
+//execer 为空的时候是主币(coins bty)的账户
+func newProofAccountDB(execer, symbol string) (*DB, error) {
+	if execer == "" {
+		return NewCoinsAccount(), nil
+	}
+	if symbol == "" {
+		return nil, types.ErrInvalidParam
+	}
+	return NewAccountDB(execer, symbol, nil)
+}
+
+//GetAccountProof 获取账户在 stateHash 对应的状态中的值以及默克尔证明, stateHash 为空的时候使用最新的区块
+func GetAccountProof(api client.QueueProtocolAPI, req *types.ReqAccountProof) (*types.AccountProof, error) {
+	if req == nil || req.Addr == "" {
+		return nil, types.ErrInvalidParam
+	}
+	acc, err := newProofAccountDB(req.Execer, req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	stateHash := req.StateHash
+	if len(stateHash) == 0 {
+		header, err := api.GetLastHeader()
+		if err != nil {
+			return nil, err
+		}
+		stateHash = header.StateHash
+	}
+	proof, err := api.StoreGetProof(&types.ReqStateProof{StateHash: stateHash, Key: acc.AccountKey(req.Addr)})
+	if err == types.ErrNotFound {
+		return nil, types.ErrAccountNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	var account types.Account
+	err = types.Decode(proof.Value, &account)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountProof{Account: &account, Proof: proof}, nil
+}
+
+//VerifyAccountProof 校验账户的默克尔证明, 返回证明中的账户
+//stateHash 必须来自已经校验过的区块头
+func VerifyAccountProof(stateHash []byte, addr, execer, symbol string, proof *types.AccountProof) (*types.Account, error) {
+	acc, err := newProofAccountDB(execer, symbol)
+	if err != nil {
+		return nil, err
+	}
+	if proof == nil || proof.Proof == nil || !bytes.Equal(proof.Proof.Key, acc.AccountKey(addr)) {
+		return nil, types.ErrStateProof
+	}
+	err = mavl.VerifyStateProof(stateHash, proof.Proof)
+	if err != nil {
+		return nil, err
+	}
+	var account types.Account
+	err = types.Decode(proof.Proof.Value, &account)
+	if err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
@@ -0,0 +1,66 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package account
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/db"
+	mavl "github.com/33cn/chain33/system/store/mavl/db"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountProof(t *testing.T) {
+	memdb, err := db.NewGoMemDB("gomemdb", "test", 128)
+	require.NoError(t, err)
+	accCoin := NewCoinsAccount()
+	accToken, err := NewAccountDB("token", "test", nil)
+	require.NoError(t, err)
+
+	tree := mavl.NewTree(memdb, true)
+	coin := &types.Account{Balance: 1000 * 1e8, Frozen: 10, Addr: addr1}
+	for _, kv := range accCoin.GetKVSet(coin) {
+		tree.Set(kv.Key, kv.Value)
+	}
+	for _, kv := range accToken.GetKVSet(&types.Account{Balance: 20, Addr: addr1}) {
+		tree.Set(kv.Key, kv.Value)
+	}
+	stateHash := tree.Save()
+	key := accCoin.AccountKey(addr1)
+	value, proofbyte, exists := tree.Proof(key)
+	require.True(t, exists)
+	proof := &types.StateProof{StateHash: stateHash, Key: key, Value: value, Proof: proofbyte}
+
+	//stateHash 为空的时候使用最新的区块
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetLastHeader").Return(&types.Header{StateHash: stateHash}, nil)
+	api.On("StoreGetProof", &types.ReqStateProof{StateHash: stateHash, Key: key}).Return(proof, nil)
+	api.On("StoreGetProof", &types.ReqStateProof{StateHash: stateHash, Key: accCoin.AccountKey(addr2)}).Return(nil, types.ErrNotFound)
+	reply, err := GetAccountProof(api, &types.ReqAccountProof{Addr: addr1})
+	require.NoError(t, err)
+	assert.Equal(t, coin, reply.Account)
+	_, err = GetAccountProof(api, &types.ReqAccountProof{Addr: addr2, StateHash: stateHash})
+	assert.Equal(t, types.ErrAccountNotExist, err)
+	_, err = GetAccountProof(api, &types.ReqAccountProof{Addr: addr1, Execer: "token"})
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	acc, err := VerifyAccountProof(stateHash, addr1, "", "", reply)
+	require.NoError(t, err)
+	assert.Equal(t, coin, acc)
+
+	//证明和地址, 资产或者 stateHash 不一致的时候校验失败
+	_, err = VerifyAccountProof(stateHash, addr2, "", "", reply)
+	assert.Equal(t, types.ErrStateProof, err)
+	_, err = VerifyAccountProof(stateHash, addr1, "token", "test", reply)
+	assert.Equal(t, types.ErrStateProof, err)
+	_, err = VerifyAccountProof(tree.Hash()[1:], addr1, "", "", reply)
+	assert.Equal(t, types.ErrStateProof, err)
+	reply.Proof.Value = types.Encode(&types.Account{Balance: 2000 * 1e8, Addr: addr1})
+	_, err = VerifyAccountProof(stateHash, addr1, "", "", reply)
+	assert.Equal(t, types.ErrStateProof, err)
+}
@@ -304,6 +304,7 @@ func (bs *BlockStore) LastHeader() *types.Header {
 		blockheader.BlockTime = bs.lastBlock.BlockTime
 		blockheader.Signature = bs.lastBlock.Signature
 		blockheader.Difficulty = bs.lastBlock.Difficulty
+		blockheader.ReceiptHash = bs.lastBlock.ReceiptHash
 
 		blockheader.Hash = bs.lastBlock.Hash()
 		blockheader.TxCount = int64(len(bs.lastBlock.Txs))
@@ -404,6 +405,7 @@ func (bs *BlockStore) LoadBlockByHash(hash []byte) (*types.BlockDetail, error) {
 	block.BlockTime = blockheader.BlockTime
 	block.Signature = blockheader.Signature
 	block.Difficulty = blockheader.Difficulty
+	block.ReceiptHash = blockheader.ReceiptHash
 	block.Txs = blockbody.Txs
 
 	blockdetail.Receipts = blockbody.Receipts
@@ -416,12 +418,30 @@ func (bs *BlockStore) LoadBlockByHash(hash []byte) (*types.BlockDetail, error) {
 
 //  批量保存blocks信息到db数据库中
 func (bs *BlockStore) SaveBlock(storeBatch dbm.Batch, blockdetail *types.BlockDetail, sequence int64) error {
+	height := blockdetail.Block.Height
+	hash := blockdetail.Block.Hash()
+	err := bs.saveBlockData(storeBatch, blockdetail, hash)
+	if err != nil {
+		return err
+	}
+	if isRecordBlockSequence || isParaChain {
+		//存储记录block序列执行的type add
+		err = bs.SaveBlockSequence(storeBatch, hash, height, AddBlock, sequence)
+		if err != nil {
+			storeLog.Error("SaveBlock SaveBlockSequence", "height", height, "hash", common.ToHex(hash), "error", err)
+			return err
+		}
+	}
+	storeLog.Debug("SaveBlock success", "blockheight", height, "hash", common.ToHex(hash))
+	return nil
+}
 
+//保存区块的数据, 不包括 sequence, 导入快照的时候 sequence 直接从快照中导入
+func (bs *BlockStore) saveBlockData(storeBatch dbm.Batch, blockdetail *types.BlockDetail, hash []byte) error {
 	height := blockdetail.Block.Height
 	if len(blockdetail.Receipts) == 0 && len(blockdetail.Block.Txs) != 0 {
 		storeLog.Error("SaveBlock Receipts is nil ", "height", height)
 	}
-	hash := blockdetail.Block.Hash()
 
 	// Save blockbody通过block hash
 	var blockbody types.BlockBody
@@ -445,6 +465,7 @@ func (bs *BlockStore) SaveBlock(storeBatch dbm.Batch, blockdetail *types.BlockDe
 	blockheader.BlockTime = blockdetail.Block.BlockTime
 	blockheader.Signature = blockdetail.Block.Signature
 	blockheader.Difficulty = blockdetail.Block.Difficulty
+	blockheader.ReceiptHash = blockdetail.Block.ReceiptHash
 
 	blockheader.Hash = hash
 	blockheader.TxCount = int64(len(blockdetail.Block.Txs))
@@ -467,16 +488,6 @@ func (bs *BlockStore) SaveBlock(storeBatch dbm.Batch, blockdetail *types.BlockDe
 
 	//存储block height和block hash的对应关系，便于通过height查询block
 	storeBatch.Set(calcHeightToHashKey(height), hash)
-
-	if isRecordBlockSequence || isParaChain {
-		//存储记录block序列执行的type add
-		err = bs.SaveBlockSequence(storeBatch, hash, height, AddBlock, sequence)
-		if err != nil {
-			storeLog.Error("SaveBlock SaveBlockSequence", "height", height, "hash", common.ToHex(hash), "error", err)
-			return err
-		}
-	}
-	storeLog.Debug("SaveBlock success", "blockheight", height, "hash", common.ToHex(hash))
 	return nil
 }
 
@@ -763,6 +774,7 @@ func (bs *BlockStore) dbMaybeStoreBlock(blockdetail *types.BlockDetail, sync boo
 	blockheader.BlockTime = blockdetail.Block.BlockTime
 	blockheader.Signature = blockdetail.Block.Signature
 	blockheader.Difficulty = blockdetail.Block.Difficulty
+	blockheader.ReceiptHash = blockdetail.Block.ReceiptHash
 	blockheader.Hash = hash
 	blockheader.TxCount = int64(len(blockdetail.Block.Txs))
 
@@ -513,6 +513,8 @@ func (chain *BlockChain) UpdateFaultPeer(pid string, reqFlag bool) {
 func (chain *BlockChain) RecordFaultPeer(pid string, height int64, hash []byte, err error) {
 
 	var faultnode FaultPeerInfo
+	//通知p2p模块降低节点的信誉分数
+	chain.reportMisbehave(pid, types.MisbehaveInvalidBlock, err)
 
 	//通过pid获取peerinfo
 	peerinfo := chain.GetPeerInfo(pid)
@@ -528,6 +530,21 @@ func (chain *BlockChain) RecordFaultPeer(pid string, height int64, hash []byte,
 	chain.AddFaultPeer(&faultnode)
 }
 
+//reportMisbehave 通知p2p模块节点的不良行为, p2p模块通过节点名称查找节点地址
+func (chain *BlockChain) reportMisbehave(pid string, reason int32, err error) {
+	if pid == "self" || chain.client == nil {
+		return
+	}
+	var info string
+	if err != nil {
+		info = err.Error()
+	}
+	msg := chain.client.NewMessage("p2p", types.EventPeerMisbehave, &types.PeerMisbehave{Pid: pid, Reason: reason, Info: info})
+	if err := chain.client.SendTimeout(msg, false, time.Second); err != nil {
+		synlog.Error("reportMisbehave", "pid", pid, "err", err)
+	}
+}
+
 func (chain *BlockChain) PrintFaultPeer() {
 	faultpeerlock.Lock()
 	defer faultpeerlock.Unlock()
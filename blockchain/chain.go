@@ -15,6 +15,7 @@ import (
 
 	"github.com/hashicorp/golang-lru"
 	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
 	dbm "github.com/33cn/chain33/common/db"
 	log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/queue"
@@ -97,6 +98,9 @@ type BlockChain struct {
 	//fork block req
 	forkInfo *ForkInfo
 	forklock sync.Mutex
+
+	//对本节点产生的区块签名, 轻节点只接受 lightSigners 签名的区块头
+	blockSigner crypto.PrivKey
 }
 
 func New(cfg *types.BlockChain) *BlockChain {
@@ -128,10 +132,28 @@ func New(cfg *types.BlockChain) *BlockChain {
 		futureBlocks:        futureBlocks,
 		forkInfo:            &ForkInfo{},
 	}
-
+	if cfg.BlockSignKey != "" {
+		blockchain.blockSigner = newBlockSigner(cfg.BlockSignKey)
+	}
 	return blockchain
 }
 
+func newBlockSigner(key string) crypto.PrivKey {
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		panic(err)
+	}
+	keybytes, err := common.FromHex(key)
+	if err != nil {
+		panic("blockSignKey config error")
+	}
+	priv, err := cr.PrivKeyFromBytes(keybytes)
+	if err != nil {
+		panic("blockSignKey config error")
+	}
+	return priv
+}
+
 func initConfig(cfg *types.BlockChain) {
 	if cfg.DefCacheSize > 0 {
 		DefCacheSize = cfg.DefCacheSize
@@ -274,9 +296,22 @@ func (chain *BlockChain) SendAddBlockEvent(block *types.BlockDetail) (err error)
 	msg = chain.client.NewMessage("wallet", types.EventAddBlock, block)
 	chain.client.Send(msg, false)
 
+	chain.sendPushEvent(types.EventAddBlock, block)
 	return nil
 }
 
+//通知rpc模块推送给订阅者, 消息队列满的时候直接丢弃, 不阻塞区块的处理
+func (chain *BlockChain) sendPushEvent(ty int64, block *types.BlockDetail) {
+	if !types.IsEnable("subscribe") {
+		return
+	}
+	msg := chain.client.NewMessage("rpc", ty, block)
+	err := chain.client.SendTimeout(msg, false, 0)
+	if err != nil {
+		chainlog.Debug("sendPushEvent", "height", block.GetBlock().GetHeight(), "err", err)
+	}
+}
+
 //blockchain模块广播此block到网络中
 func (chain *BlockChain) SendBlockBroadcast(block *types.BlockDetail) {
 	if chain.client == nil {
@@ -341,6 +376,7 @@ func (chain *BlockChain) SendDelBlockEvent(block *types.BlockDetail) (err error)
 	msg = chain.client.NewMessage("wallet", types.EventDelBlock, block)
 	chain.client.Send(msg, false)
 
+	chain.sendPushEvent(types.EventDelBlock, block)
 	return nil
 }
 
@@ -388,6 +424,10 @@ func (chain *BlockChain) InitIndexAndBestView() {
 		for ; height <= curheight; height++ {
 			header, _ := chain.blockStore.GetBlockHeaderByHeight(height)
 			if header == nil {
+				//从快照启动的节点没有快照高度之前的区块
+				if !initflag {
+					continue
+				}
 				return
 			}
 
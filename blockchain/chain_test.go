@@ -77,6 +77,8 @@ func TestBlockChain(t *testing.T) {
 
 	testProcQueryTxMsg(t, blockchain)
 
+	testProcGetReceiptProof(t, blockchain)
+
 	testGetBlocksMsg(t, blockchain)
 
 	testProcGetHeadersMsg(t, blockchain)
@@ -484,6 +486,32 @@ func testProcQueryTxMsg(t *testing.T, blockchain *blockchain.BlockChain) {
 	chainlog.Info("TestProcQueryTxMsg end --------------------")
 }
 
+func testProcGetReceiptProof(t *testing.T, blockchain *blockchain.BlockChain) {
+	chainlog.Info("TestProcGetReceiptProof begin --------------------")
+	block, err := blockchain.GetBlock(blockchain.GetBlockHeight())
+	require.NoError(t, err)
+	require.NotEmpty(t, block.Block.Txs)
+	txhash := block.Block.Txs[len(block.Block.Txs)-1].Hash()
+
+	proof, err := blockchain.ProcGetReceiptProof(txhash)
+	require.NoError(t, err)
+	assert.Equal(t, block.Block.Hash(), proof.Header.Hash)
+	assert.Equal(t, block.Block.ReceiptHash, proof.Header.ReceiptHash)
+	assert.Equal(t, block.Receipts[proof.Index], proof.Receipt)
+
+	//证明receipt proof的正确性
+	roothash := merkle.GetMerkleRootFromBranch(proof.Proofs, proof.Receipt.Hash(), uint32(proof.Index))
+	assert.Equal(t, proof.Header.ReceiptHash, roothash)
+	receipt := *proof.Receipt
+	receipt.Ty = types.ExecPack
+	roothash = merkle.GetMerkleRootFromBranch(proof.Proofs, receipt.Hash(), uint32(proof.Index))
+	assert.NotEqual(t, proof.Header.ReceiptHash, roothash)
+
+	_, err = blockchain.ProcGetReceiptProof([]byte("txhash"))
+	assert.NotNil(t, err)
+	chainlog.Info("TestProcGetReceiptProof end --------------------")
+}
+
 func testGetBlocksMsg(t *testing.T, blockchain *blockchain.BlockChain) {
 	chainlog.Info("TestGetBlocksMsg begin --------------------")
 	curheight := blockchain.GetBlockHeight()
@@ -91,6 +91,14 @@ func execBlock(client queue.Client, prevStateRoot []byte, block *types.Block, er
 		block.Txs = newtx
 		block.TxHash = merkle.CalcMerkleRoot(block.Txs)
 	}
+	//check ReceiptHash, 写入状态之前检查, 不需要回滚
+	if types.IsFork(block.Height, "ForkReceiptHash") {
+		receiptHash := merkle.CalcReceiptMerkleRoot(rdata)
+		if errReturn && !bytes.Equal(receiptHash, block.ReceiptHash) {
+			return nil, nil, types.ErrCheckReceiptHash
+		}
+		block.ReceiptHash = receiptHash
+	}
 
 	var detail types.BlockDetail
 
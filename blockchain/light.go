@@ -0,0 +1,734 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+//轻节点模式:
+//1. 只同步区块头, 校验区块头的hash, 签名以及和父区块的连接, 按照总难度选择最优链(blockNode/chainView),
+//   轻节点没有办法校验共识, 只信任配置的创世区块和出块节点(lightSigners)签名的区块头,
+//   出块节点通过 blockSignKey 对自己产生的区块签名
+//2. 区块的交易在查询的时候从其他节点获取, 用区块头中的 TxHash 校验
+//3. 不执行区块, 状态数据通过 store.LightStore 从其他节点获取默克尔证明
+//数据库中区块头的存储格式和全节点一致, 只是没有区块体
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/golang-lru"
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	lightlog = chainlog.New("submodule", "light")
+	//缓存从其他节点获取的区块个数
+	lightBodyCacheSize = 1024
+	//从其他节点获取区块的超时时间
+	lightFetchTimeout = 30 * time.Second
+)
+
+type LightChain struct {
+	client     queue.Client
+	cfg        *types.BlockChain
+	blockStore *BlockStore
+	index      *blockIndex
+	bestChain  *chainView
+	chainLock  sync.Mutex
+
+	//从其他节点获取的区块, 已经用区块头校验过
+	bodies   *lru.Cache
+	waitLock sync.Mutex
+	waiters  map[string][]chan *types.Block
+	peerList PeerInfoList
+	peerLock sync.Mutex
+	backNum  int64
+	caughtUp int32
+	genesis  []byte
+	signers  map[string]bool
+	root     *blockNode
+	quit     chan struct{}
+	wg       sync.WaitGroup
+	runcount int32
+}
+
+func NewLight(cfg *types.BlockChain) *LightChain {
+	initConfig(cfg)
+	types.S("lightMode", true)
+	bodies, _ := lru.New(lightBodyCacheSize)
+	chain := &LightChain{
+		cfg:     cfg,
+		index:   newBlockIndex(),
+		bodies:  bodies,
+		waiters: make(map[string][]chan *types.Block),
+		quit:    make(chan struct{}),
+	}
+	//没有信任的创世区块, 没有办法判断同步的区块头是不是同一条链
+	if cfg.LightGenesisHash == "" {
+		panic("lightGenesisHash must be config in light mode")
+	}
+	hash, err := common.FromHex(cfg.LightGenesisHash)
+	if err != nil || len(hash) == 0 {
+		panic("lightGenesisHash config error")
+	}
+	chain.genesis = hash
+	//没有信任的出块节点, 任何节点都可以伪造区块头
+	if len(cfg.LightSigners) == 0 {
+		panic("lightSigners must be config in light mode")
+	}
+	chain.signers = make(map[string]bool)
+	for _, signer := range cfg.LightSigners {
+		pubkey, err := common.FromHex(signer)
+		if err != nil || len(pubkey) == 0 {
+			panic("lightSigners config error: " + signer)
+		}
+		chain.signers[string(pubkey)] = true
+	}
+	return chain
+}
+
+func (chain *LightChain) SetQueueClient(client queue.Client) {
+	chain.client = client
+	chain.client.Sub("blockchain")
+	db := dbm.NewDB("blockchain", chain.cfg.Driver, chain.cfg.DbPath, chain.cfg.DbCache)
+	height, err := LoadBlockStoreHeight(db)
+	if err != nil && err != types.ErrHeightNotExist {
+		panic(err)
+	}
+	chain.blockStore = &BlockStore{db: db, client: client, height: height}
+	chain.initIndex()
+
+	chain.wg.Add(2)
+	go chain.procRecvMsg()
+	go chain.synRoutine()
+}
+
+func (chain *LightChain) Close() {
+	close(chain.quit)
+	for atomic.LoadInt32(&chain.runcount) > 0 {
+		time.Sleep(time.Microsecond)
+	}
+	chain.client.Close()
+	chain.wg.Wait()
+	chain.blockStore.db.Close()
+	lightlog.Info("light blockchain module closed")
+}
+
+//从数据库中加载最新的 InitBlockNum 个区块头到 index 和 bestChain 中
+func (chain *LightChain) initIndex() {
+	chain.root = newPreGenBlockNode()
+	chain.index.AddNode(chain.root)
+	curheight := chain.blockStore.Height()
+	if curheight == -1 {
+		chain.bestChain = newChainView(chain.root)
+		return
+	}
+	height := curheight - InitBlockNum
+	if height < 0 {
+		height = 0
+	}
+	var prevNode *blockNode
+	if height == 0 {
+		prevNode = chain.root
+	}
+	for ; height <= curheight; height++ {
+		header, err := chain.blockStore.GetBlockHeaderByHeight(height)
+		if err != nil {
+			panic(err)
+		}
+		node := newBlockNodeByHeader(false, header, "self", -1)
+		node.parent = prevNode
+		prevNode = node
+		chain.index.AddNode(node)
+		if chain.bestChain == nil {
+			chain.bestChain = newChainView(node)
+		} else {
+			chain.bestChain.SetTip(node)
+		}
+	}
+}
+
+func (chain *LightChain) GetBlockHeight() int64 {
+	return chain.blockStore.Height()
+}
+
+//IsCaughtUp 本节点的区块头高度已经追上了其他节点
+func (chain *LightChain) IsCaughtUp() bool {
+	return atomic.LoadInt32(&chain.caughtUp) == 1
+}
+
+//blockchain模块的消息接收处理, 只处理区块头相关的消息以及按需获取区块的查询
+func (chain *LightChain) procRecvMsg() {
+	defer chain.wg.Done()
+	reqnum := make(chan struct{}, 1000)
+	for msg := range chain.client.Recv() {
+		lightlog.Debug("light blockchain recv", "msg", types.GetEventName(int(msg.Ty)), "id", msg.Id)
+		reqnum <- struct{}{}
+		atomic.AddInt32(&chain.runcount, 1)
+		switch msg.Ty {
+		case types.EventGetBlockHeight:
+			go chain.processMsg(msg, reqnum, chain.getBlockHeight)
+		case types.EventGetLastHeader:
+			go chain.processMsg(msg, reqnum, chain.getLastHeader)
+		case types.EventGetHeaders:
+			go chain.processMsg(msg, reqnum, chain.getHeaders)
+		case types.EventGetBlockHash:
+			go chain.processMsg(msg, reqnum, chain.getBlockHash)
+		case types.EventIsSync:
+			go chain.processMsg(msg, reqnum, chain.isSync)
+		case types.EventIsNtpClockSync:
+			go chain.processMsg(msg, reqnum, chain.isNtpClockSync)
+		case types.EventAddBlockHeaders:
+			go chain.processMsg(msg, reqnum, chain.addBlockHeaders)
+		case types.EventSyncBlock:
+			go chain.processMsg(msg, reqnum, chain.syncBlock)
+		case types.EventBroadcastAddBlock:
+			go chain.processMsg(msg, reqnum, chain.broadcastAddBlock)
+		case types.EventGetBlocks:
+			go chain.processMsg(msg, reqnum, chain.getBlocks)
+		case types.EventGetBlockOverview:
+			go chain.processMsg(msg, reqnum, chain.getBlockOverview)
+		default:
+			go chain.processMsg(msg, reqnum, chain.unknowMsg)
+		}
+	}
+}
+
+func (chain *LightChain) processMsg(msg queue.Message, reqnum chan struct{}, cb funcProcess) {
+	defer func() {
+		<-reqnum
+		atomic.AddInt32(&chain.runcount, -1)
+	}()
+	cb(msg)
+}
+
+func (chain *LightChain) unknowMsg(msg queue.Message) {
+	lightlog.Debug("light blockchain not support", "msg", types.GetEventName(int(msg.Ty)))
+	msg.Reply(chain.client.NewMessage("", msg.Ty, types.ErrNotSupport))
+}
+
+func (chain *LightChain) getBlockHeight(msg queue.Message) {
+	msg.Reply(chain.client.NewMessage("", types.EventReplyBlockHeight, &types.ReplyBlockHeight{Height: chain.GetBlockHeight()}))
+}
+
+func (chain *LightChain) getLastHeader(msg queue.Message) {
+	msg.Reply(chain.client.NewMessage("", types.EventHeader, chain.LastHeader()))
+}
+
+func (chain *LightChain) getHeaders(msg queue.Message) {
+	headers, err := chain.GetHeaders(msg.Data.(*types.ReqBlocks))
+	if err != nil {
+		msg.Reply(chain.client.NewMessage("", types.EventHeaders, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("", types.EventHeaders, headers))
+}
+
+func (chain *LightChain) getBlockHash(msg queue.Message) {
+	height := msg.Data.(*types.ReqInt)
+	hash, err := chain.blockStore.GetBlockHashByHeight(height.GetHeight())
+	if err != nil {
+		msg.Reply(chain.client.NewMessage("", types.EventBlockHash, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("", types.EventBlockHash, &types.ReplyHash{Hash: hash}))
+}
+
+func (chain *LightChain) isSync(msg queue.Message) {
+	msg.Reply(chain.client.NewMessage("", types.EventReplyIsSync, &types.IsCaughtUp{Iscaughtup: chain.IsCaughtUp()}))
+}
+
+func (chain *LightChain) isNtpClockSync(msg queue.Message) {
+	msg.Reply(chain.client.NewMessage("", types.EventReplyIsNtpClockSync, &types.IsNtpClockSync{Isntpclocksync: GetNtpClockSyncStatus()}))
+}
+
+func (chain *LightChain) addBlockHeaders(msg queue.Message) {
+	headerspid := msg.Data.(*types.HeadersPid)
+	err := chain.AddHeaders(headerspid.Headers.GetItems(), headerspid.Pid)
+	msg.ReplyErr("addBlockHeaders", err)
+}
+
+//p2p 模块下载的区块, 只缓存区块头已经存在的区块
+func (chain *LightChain) syncBlock(msg queue.Message) {
+	blockpid := msg.Data.(*types.BlockPid)
+	err := chain.AddBlockBody(blockpid.Block)
+	msg.ReplyErr("syncBlock", err)
+}
+
+//其他节点广播的区块, 先把区块头加入到链中, 然后缓存区块
+//同步阶段收到的广播区块接不上本地的链, 直接忽略, 不影响区块头的同步
+func (chain *LightChain) broadcastAddBlock(msg queue.Message) {
+	blockpid := msg.Data.(*types.BlockPid)
+	block := blockpid.Block
+	header := &types.Header{
+		Version:     block.Version,
+		ParentHash:  block.ParentHash,
+		TxHash:      block.TxHash,
+		StateHash:   block.StateHash,
+		Height:      block.Height,
+		BlockTime:   block.BlockTime,
+		TxCount:     int64(len(block.Txs)),
+		Hash:        block.Hash(),
+		Difficulty:  block.Difficulty,
+		Signature:   block.Signature,
+		ReceiptHash: block.ReceiptHash,
+	}
+	chain.chainLock.Lock()
+	err := chain.addHeader(header, blockpid.Pid)
+	chain.chainLock.Unlock()
+	if err == nil {
+		err = chain.AddBlockBody(block)
+	}
+	msg.ReplyErr("broadcastAddBlock", err)
+}
+
+func (chain *LightChain) getBlocks(msg queue.Message) {
+	blocks, err := chain.GetBlocks(msg.Data.(*types.ReqBlocks))
+	if err != nil {
+		msg.Reply(chain.client.NewMessage("", types.EventBlocks, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("", types.EventBlocks, blocks))
+}
+
+func (chain *LightChain) getBlockOverview(msg queue.Message) {
+	req := msg.Data.(*types.ReqHash)
+	header, err := chain.blockStore.GetBlockHeaderByHash(req.Hash)
+	if err != nil {
+		msg.Reply(chain.client.NewMessage("", types.EventReplyBlockOverview, err))
+		return
+	}
+	blocks, err := chain.fetchBlocks([]*types.Header{header})
+	if err != nil {
+		msg.Reply(chain.client.NewMessage("", types.EventReplyBlockOverview, err))
+		return
+	}
+	overview := &types.BlockOverview{Head: header, TxCount: int64(len(blocks[0].Txs))}
+	for _, tx := range blocks[0].Txs {
+		overview.TxHashes = append(overview.TxHashes, tx.Hash())
+	}
+	msg.Reply(chain.client.NewMessage("", types.EventReplyBlockOverview, overview))
+}
+
+//LastHeader 最优链的最新区块头, 还没有区块头的时候返回空的区块头
+func (chain *LightChain) LastHeader() *types.Header {
+	header, err := chain.blockStore.GetBlockHeaderByHeight(chain.GetBlockHeight())
+	if err != nil {
+		return &types.Header{}
+	}
+	return header
+}
+
+//GetHeaders 获取最优链上指定高度区间的区块头
+func (chain *LightChain) GetHeaders(req *types.ReqBlocks) (*types.Headers, error) {
+	height := chain.GetBlockHeight()
+	if req.Start > req.End {
+		return nil, types.ErrEndLessThanStartHeight
+	}
+	if req.Start > height {
+		return nil, types.ErrStartHeight
+	}
+	end := req.End
+	if end > height {
+		end = height
+	}
+	var headers types.Headers
+	for i := req.Start; i <= end; i++ {
+		header, err := chain.blockStore.GetBlockHeaderByHeight(i)
+		if err != nil {
+			return nil, err
+		}
+		headers.Items = append(headers.Items, header)
+	}
+	return &headers, nil
+}
+
+//checkHeader 校验区块头本身: hash 是否正确, 是否是配置的创世区块, 以及是否由配置的出块节点签名
+//难度和最优链的选择只在出块节点签名的区块头之间比较, 其他节点不能伪造区块头抢占最优链
+func (chain *LightChain) checkHeader(header *types.Header) error {
+	if header == nil || header.Height < 0 {
+		return types.ErrInvalidParam
+	}
+	if !bytes.Equal(header.CalcHash(), header.Hash) {
+		return types.ErrBlockHashNoMatch
+	}
+	if header.Height == 0 {
+		if !bytes.Equal(header.Hash, chain.genesis) {
+			return types.ErrBlockHashNoMatch
+		}
+		return nil
+	}
+	if header.Signature == nil || !chain.signers[string(header.Signature.Pubkey)] {
+		return types.ErrSign
+	}
+	if !types.CheckSign(header.Hash, "", header.Signature) {
+		return types.ErrSign
+	}
+	return nil
+}
+
+//AddHeaders 按照高度顺序添加区块头, 总难度比当前最优链大的时候切换最优链
+func (chain *LightChain) AddHeaders(headers []*types.Header, pid string) error {
+	chain.chainLock.Lock()
+	defer chain.chainLock.Unlock()
+	for i, header := range headers {
+		err := chain.addHeader(header, pid)
+		if err == types.ErrParentBlockNoExist && i == 0 {
+			//和当前的链接不上, 下一次同步的时候向后多取一些区块头寻找分叉点
+			chain.backNum = chain.backNum*2 + 1
+			if chain.backNum > MaxRollBlockNum {
+				chain.backNum = MaxRollBlockNum
+			}
+			lightlog.Debug("AddHeaders parent not exist", "height", header.Height, "backNum", chain.backNum, "pid", pid)
+			return err
+		}
+		if err != nil {
+			lightlog.Error("AddHeaders", "height", header.GetHeight(), "pid", pid, "err", err)
+			return err
+		}
+	}
+	chain.backNum = 0
+	return nil
+}
+
+func (chain *LightChain) addHeader(header *types.Header, pid string) error {
+	err := chain.checkHeader(header)
+	if err != nil {
+		return err
+	}
+	if chain.index.HaveBlock(header.Hash) {
+		return nil
+	}
+	parent := chain.root
+	parenttd := big.NewInt(0)
+	if header.Height != 0 {
+		parent = chain.index.LookupNode(header.ParentHash)
+		if parent == nil {
+			return types.ErrParentBlockNoExist
+		}
+		parenttd, err = chain.blockStore.GetTdByBlockHash(parent.hash)
+		if err != nil {
+			return types.ErrParentTdNoExist
+		}
+	}
+	if parent.height+1 != header.Height {
+		return types.ErrBlockHeightNoMatch
+	}
+	node := newBlockNodeByHeader(false, header, pid, -1)
+	node.parent = parent
+	td := new(big.Int).Add(node.Difficulty, parenttd)
+
+	batch := chain.blockStore.NewBatch(true)
+	batch.Set(calcHashToBlockHeaderKey(header.Hash), types.Encode(header))
+	chain.blockStore.SaveTdByBlockHash(batch, header.Hash, td)
+	err = batch.Write()
+	if err != nil {
+		return err
+	}
+	chain.index.AddNode(node)
+
+	//总难度不大于当前最优链的时候作为侧链保存
+	tip := chain.bestChain.Tip()
+	if tip.height >= 0 {
+		tiptd, err := chain.blockStore.GetTdByBlockHash(tip.hash)
+		if err != nil {
+			return err
+		}
+		if td.Cmp(tiptd) <= 0 {
+			lightlog.Debug("addHeader side chain", "height", node.height, "hash", common.ToHex(node.hash), "pid", pid)
+			return nil
+		}
+	}
+	return chain.setBestChain(node)
+}
+
+//setBestChain 把 node 所在的链设置成最优链, 重新写入 node 到分叉点之间的高度索引
+func (chain *LightChain) setBestChain(node *blockNode) error {
+	tip := chain.bestChain.Tip()
+	forkheight := int64(-1)
+	fork := chain.bestChain.FindFork(node)
+	if fork != nil {
+		forkheight = fork.height
+	} else if tip.height >= 0 {
+		//创世区块不同, 或者分叉点已经不在内存的索引中
+		return types.ErrForkTooDeep
+	}
+	if tip.height-forkheight > MaxRollBlockNum {
+		return types.ErrForkTooDeep
+	}
+	batch := chain.blockStore.NewBatch(true)
+	var detached, attached []*blockNode
+	for n := tip; n != nil && n.height > forkheight; n = n.parent {
+		batch.Delete(calcHashToHeightKey(n.hash))
+		if n.height > node.height {
+			batch.Delete(calcHeightToHashKey(n.height))
+			batch.Delete(calcHeightToBlockHeaderKey(n.height))
+		}
+		detached = append(detached, n)
+	}
+	for n := node; n != nil && n.height > forkheight; n = n.parent {
+		header, err := chain.blockStore.GetBlockHeaderByHash(n.hash)
+		if err != nil {
+			return err
+		}
+		heightbytes := types.Encode(&types.Int64{Data: n.height})
+		batch.Set(calcHashToHeightKey(n.hash), heightbytes)
+		batch.Set(calcHeightToHashKey(n.height), n.hash)
+		batch.Set(calcHeightToBlockHeaderKey(n.height), types.Encode(header))
+		attached = append(attached, n)
+	}
+	batch.Set(blockLastHeight, types.Encode(&types.Int64{Data: node.height}))
+	err := batch.Write()
+	if err != nil {
+		return err
+	}
+	for _, n := range detached {
+		chain.bestChain.DelTip(n)
+	}
+	for i := len(attached) - 1; i >= 0; i-- {
+		chain.bestChain.SetTip(attached[i])
+	}
+	chain.blockStore.UpdateHeight2(node.height)
+	if len(detached) > 0 {
+		lightlog.Info("setBestChain reorganize", "forkheight", forkheight, "detached", len(detached), "attached", len(attached))
+	}
+	lightlog.Debug("setBestChain", "height", node.height, "hash", common.ToHex(node.hash), "pid", node.pid)
+	return nil
+}
+
+//AddBlockBody 缓存从其他节点获取的区块, 区块的hash必须是已经存在的区块头,
+//并且交易的默克尔根和区块头中的 TxHash 一致
+func (chain *LightChain) AddBlockBody(block *types.Block) error {
+	if block == nil {
+		return types.ErrInvalidParam
+	}
+	hash := block.Hash()
+	if _, err := chain.blockStore.GetBlockHeaderByHash(hash); err != nil {
+		return err
+	}
+	if !bytes.Equal(merkle.CalcMerkleRoot(block.Txs), block.TxHash) {
+		return types.ErrCheckTxHash
+	}
+	chain.bodies.Add(string(hash), block)
+
+	chain.waitLock.Lock()
+	waiters := chain.waiters[string(hash)]
+	delete(chain.waiters, string(hash))
+	chain.waitLock.Unlock()
+	for _, ch := range waiters {
+		ch <- block
+	}
+	return nil
+}
+
+//GetBlocks 获取最优链上指定高度区间的区块, 本地没有缓存的区块从其他节点获取
+//一次最多获取 MaxFetchBlockNum 个区块
+func (chain *LightChain) GetBlocks(req *types.ReqBlocks) (*types.BlockDetails, error) {
+	if req.End-req.Start >= MaxFetchBlockNum {
+		return nil, types.ErrInvalidParam
+	}
+	headers, err := chain.GetHeaders(req)
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := chain.fetchBlocks(headers.Items)
+	if err != nil {
+		return nil, err
+	}
+	var details types.BlockDetails
+	for _, block := range blocks {
+		details.Items = append(details.Items, &types.BlockDetail{Block: block})
+	}
+	return &details, nil
+}
+
+//fetchBlocks 获取区块头对应的区块, 区块头必须是连续的高度
+func (chain *LightChain) fetchBlocks(headers []*types.Header) ([]*types.Block, error) {
+	blocks := make([]*types.Block, len(headers))
+	chans := make(map[int]chan *types.Block)
+	var start, end int64 = -1, -1
+	chain.waitLock.Lock()
+	for i, header := range headers {
+		if block, ok := chain.bodies.Get(string(header.Hash)); ok {
+			blocks[i] = block.(*types.Block)
+			continue
+		}
+		ch := make(chan *types.Block, 1)
+		chain.waiters[string(header.Hash)] = append(chain.waiters[string(header.Hash)], ch)
+		chans[i] = ch
+		if start == -1 {
+			start = header.Height
+		}
+		end = header.Height
+	}
+	chain.waitLock.Unlock()
+	if len(chans) == 0 {
+		return blocks, nil
+	}
+	defer chain.removeWaiters(headers, chans)
+	err := chain.fetchFromPeers(start, end)
+	if err != nil {
+		return nil, err
+	}
+	timeout := time.NewTimer(lightFetchTimeout)
+	defer timeout.Stop()
+	for i, ch := range chans {
+		select {
+		case block := <-ch:
+			blocks[i] = block
+		case <-timeout.C:
+			lightlog.Error("fetchBlocks timeout", "start", start, "end", end)
+			return nil, types.ErrTimeout
+		case <-chain.quit:
+			return nil, types.ErrIsClosed
+		}
+	}
+	return blocks, nil
+}
+
+//删除还没有收到区块的等待, 超时或者出错以后不会再读取
+func (chain *LightChain) removeWaiters(headers []*types.Header, chans map[int]chan *types.Block) {
+	chain.waitLock.Lock()
+	defer chain.waitLock.Unlock()
+	for i, ch := range chans {
+		hash := string(headers[i].Hash)
+		waiters := chain.waiters[hash]
+		for j, waiter := range waiters {
+			if waiter == ch {
+				waiters = append(waiters[:j], waiters[j+1:]...)
+				break
+			}
+		}
+		if len(waiters) == 0 {
+			delete(chain.waiters, hash)
+		} else {
+			chain.waiters[hash] = waiters
+		}
+	}
+}
+
+//通过 p2p 模块下载区块, 下载的区块通过 EventSyncBlock 消息返回
+func (chain *LightChain) fetchFromPeers(start, end int64) error {
+	var pids []string
+	for _, peer := range chain.getPeers() {
+		if peer.Height >= end {
+			pids = append(pids, peer.Name)
+		}
+	}
+	req := &types.ReqBlocks{Start: start, End: end, IsDetail: false, Pid: pids}
+	msg := chain.client.NewMessage("p2p", types.EventFetchBlocks, req)
+	err := chain.client.SendTimeout(msg, true, lightFetchTimeout)
+	if err != nil {
+		return err
+	}
+	resp, err := chain.client.WaitTimeout(msg, lightFetchTimeout)
+	if err != nil {
+		return err
+	}
+	reply := resp.GetData().(*types.Reply)
+	if !reply.IsOk {
+		return errors.New(string(reply.Msg))
+	}
+	return nil
+}
+
+func (chain *LightChain) getPeers() PeerInfoList {
+	chain.peerLock.Lock()
+	defer chain.peerLock.Unlock()
+	return chain.peerList
+}
+
+//定时从 p2p 获取 peer 列表, 并且从最高的 peer 同步区块头
+func (chain *LightChain) synRoutine() {
+	defer chain.wg.Done()
+	ticker := time.NewTicker(time.Duration(fetchPeerListSeconds) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-chain.quit:
+			return
+		case <-ticker.C:
+			if err := chain.fetchPeerList(); err != nil {
+				continue
+			}
+			chain.synHeaders()
+		}
+	}
+}
+
+func (chain *LightChain) fetchPeerList() error {
+	msg := chain.client.NewMessage("p2p", types.EventPeerInfo, nil)
+	err := chain.client.SendTimeout(msg, true, 30*time.Second)
+	if err != nil {
+		lightlog.Error("fetchPeerList", "client.Send err:", err)
+		return err
+	}
+	resp, err := chain.client.WaitTimeout(msg, 60*time.Second)
+	if err != nil {
+		lightlog.Error("fetchPeerList", "client.Wait err:", err)
+		return err
+	}
+	peerlist := resp.GetData().(*types.PeerList)
+	var peers PeerInfoList
+	for _, peer := range peerlist.GetPeers() {
+		if peer.Self || peer.Header == nil {
+			continue
+		}
+		peers = append(peers, &PeerInfo{Name: peer.Name, ParentHash: peer.Header.ParentHash, Height: peer.Header.Height, Hash: peer.Header.Hash})
+	}
+	sort.Sort(peers)
+	chain.peerLock.Lock()
+	chain.peerList = peers
+	chain.peerLock.Unlock()
+	return nil
+}
+
+//synHeaders 从最高的 peer 请求区块头, 和本地的链接不上的时候向后多请求一些区块头寻找分叉点
+func (chain *LightChain) synHeaders() {
+	peers := chain.getPeers()
+	if len(peers) == 0 {
+		return
+	}
+	maxpeer := peers[len(peers)-1]
+	chain.chainLock.Lock()
+	tip := chain.bestChain.Tip()
+	backNum := chain.backNum
+	chain.chainLock.Unlock()
+	if maxpeer.Height < tip.height || (maxpeer.Height == tip.height && bytes.Equal(maxpeer.Hash, tip.hash)) {
+		atomic.StoreInt32(&chain.caughtUp, 1)
+		return
+	}
+	atomic.StoreInt32(&chain.caughtUp, 0)
+	if maxpeer.Height == tip.height && backNum == 0 {
+		backNum = 1
+	}
+	start := tip.height + 1 - backNum
+	if start < 0 {
+		start = 0
+	}
+	end := start + MaxFetchBlockNum - 1
+	if end > maxpeer.Height {
+		end = maxpeer.Height
+	}
+	lightlog.Debug("synHeaders", "start", start, "end", end, "pid", maxpeer.Name)
+	req := &types.ReqBlocks{Start: start, End: end, IsDetail: false, Pid: []string{maxpeer.Name}}
+	msg := chain.client.NewMessage("p2p", types.EventFetchBlockHeaders, req)
+	err := chain.client.SendTimeout(msg, true, 30*time.Second)
+	if err != nil {
+		lightlog.Error("synHeaders", "client.Send err:", err)
+		return
+	}
+	_, err = chain.client.WaitTimeout(msg, 60*time.Second)
+	if err != nil {
+		lightlog.Error("synHeaders", "client.Wait err:", err)
+	}
+}
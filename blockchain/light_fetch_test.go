@@ -0,0 +1,43 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/golang-lru"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLightFetchTimeout(t *testing.T) {
+	q := queue.New("channel")
+	p2p := q.Client()
+	p2p.Sub("p2p")
+	go func() {
+		for msg := range p2p.Recv() {
+			msg.Reply(p2p.NewMessage("", types.EventReply, &types.Reply{IsOk: true}))
+		}
+	}()
+	defer p2p.Close()
+
+	old := lightFetchTimeout
+	lightFetchTimeout = 100 * time.Millisecond
+	defer func() { lightFetchTimeout = old }()
+	bodies, _ := lru.New(lightBodyCacheSize)
+	chain := &LightChain{
+		client:  q.Client(),
+		bodies:  bodies,
+		waiters: make(map[string][]chan *types.Block),
+		quit:    make(chan struct{}),
+	}
+	headers := []*types.Header{{Height: 1, Hash: []byte("hash1")}, {Height: 2, Hash: []byte("hash2")}}
+	_, err := chain.fetchBlocks(headers)
+	assert.Equal(t, types.ErrTimeout, err)
+	//超时以后不再等待区块
+	assert.Equal(t, 0, len(chain.waiters))
+}
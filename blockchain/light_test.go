@@ -0,0 +1,182 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/blockchain"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func genSignKey(t *testing.T) crypto.PrivKey {
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	require.NoError(t, err)
+	priv, err := cr.GenKey()
+	require.NoError(t, err)
+	return priv
+}
+
+func signHeader(header *types.Header, priv crypto.PrivKey) {
+	header.Signature = &types.Signature{Ty: types.SECP256K1, Pubkey: priv.PubKey().Bytes(), Signature: priv.Sign(header.Hash).Bytes()}
+}
+
+//从 preHeader 开始构造 n 个没有交易的分叉区块头, priv 不是 nil 的时候对区块头签名
+func genForkHeaders(preHeader *types.Header, n int, priv crypto.PrivKey) []*types.Header {
+	var headers []*types.Header
+	for i := 0; i < n; i++ {
+		header := &types.Header{
+			Version:    preHeader.Version,
+			ParentHash: preHeader.Hash,
+			TxHash:     preHeader.TxHash,
+			StateHash:  preHeader.StateHash,
+			Height:     preHeader.Height + 1,
+			BlockTime:  preHeader.BlockTime + 100,
+			Difficulty: preHeader.Difficulty,
+		}
+		header.Hash = header.CalcHash()
+		if priv != nil {
+			signHeader(header, priv)
+		}
+		headers = append(headers, header)
+		preHeader = header
+	}
+	return headers
+}
+
+func TestLightChain(t *testing.T) {
+	priv := genSignKey(t)
+	fullcfg, sub := testnode.GetDefaultConfig()
+	fullcfg.BlockChain.BlockSignKey = common.ToHex(priv.Bytes())
+	mock33 := testnode.NewWithConfig(fullcfg, sub, nil)
+	defer mock33.Close()
+	mock33.Listen()
+	require.NoError(t, mock33.SendHot())
+	last := mock33.GetLastBlock()
+	headers, err := mock33.GetAPI().GetHeaders(&types.ReqBlocks{Start: 0, End: last.Height})
+	require.NoError(t, err)
+	//全节点对自己产生的区块签名
+	for _, header := range headers.Items[1:] {
+		require.NotNil(t, header.Signature)
+		assert.Equal(t, priv.PubKey().Bytes(), header.Signature.Pubkey)
+		assert.True(t, types.CheckSign(header.Hash, "", header.Signature))
+	}
+
+	cfg, _ := testnode.GetDefaultConfig()
+	cfg.BlockChain.LightGenesisHash = common.ToHex(headers.Items[0].Hash)
+	cfg.BlockChain.LightSigners = []string{common.ToHex(priv.PubKey().Bytes())}
+	q := queue.New("channel")
+	chain := blockchain.NewLight(cfg.BlockChain)
+	defer types.S("lightMode", false)
+	chain.SetQueueClient(q.Client())
+	defer chain.Close()
+	assert.Equal(t, int64(-1), chain.GetBlockHeight())
+
+	//hash 不正确或者接不上本地链的区块头不能添加
+	bad := *headers.Items[0]
+	bad.BlockTime++
+	assert.Equal(t, types.ErrBlockHashNoMatch, chain.AddHeaders([]*types.Header{&bad}, "self"))
+	assert.Equal(t, types.ErrParentBlockNoExist, chain.AddHeaders(headers.Items[1:], "self"))
+	//不是配置的创世区块
+	fakeGenesis := *headers.Items[0]
+	fakeGenesis.BlockTime++
+	fakeGenesis.Hash = fakeGenesis.CalcHash()
+	assert.Equal(t, types.ErrBlockHashNoMatch, chain.AddHeaders([]*types.Header{&fakeGenesis}, "self"))
+
+	require.NoError(t, chain.AddHeaders(headers.Items, "self"))
+	assert.Equal(t, last.Height, chain.GetBlockHeight())
+	assert.Equal(t, last.Hash(), chain.LastHeader().Hash)
+	reply, err := chain.GetHeaders(&types.ReqBlocks{Start: 0, End: last.Height})
+	require.NoError(t, err)
+	assert.Equal(t, headers.Items, reply.Items)
+
+	//区块体必须和区块头中的交易默克尔根一致
+	block := mock33.GetBlock(last.Height)
+	badblock := *block
+	badtx := *block.Txs[0]
+	badtx.Fee++
+	badblock.Txs = []*types.Transaction{&badtx}
+	assert.Equal(t, types.ErrCheckTxHash, chain.AddBlockBody(&badblock))
+	require.NoError(t, chain.AddBlockBody(block))
+	blocks, err := chain.GetBlocks(&types.ReqBlocks{Start: last.Height, End: last.Height})
+	require.NoError(t, err)
+	assert.Equal(t, block, blocks.Items[0].Block)
+
+	//总难度小的分叉只保存, 不切换最优链
+	fork := genForkHeaders(headers.Items[0], int(last.Height), priv)
+	require.NoError(t, chain.AddHeaders(fork, "self"))
+	assert.Equal(t, last.Hash(), chain.LastHeader().Hash)
+
+	//攻击者伪造的更长, 难度更大的链, 没有签名或者自己签名, 都不能切换最优链
+	attacker := genSignKey(t)
+	for _, key := range []crypto.PrivKey{nil, attacker} {
+		forged := genForkHeaders(headers.Items[0], int(last.Height)+10, key)
+		for _, header := range forged {
+			header.Difficulty--
+			header.Hash = header.CalcHash()
+			if key != nil {
+				signHeader(header, key)
+			}
+		}
+		assert.Equal(t, types.ErrSign, chain.AddHeaders(forged, "attacker"))
+		assert.Equal(t, last.Hash(), chain.LastHeader().Hash)
+	}
+	//复制出块节点的签名也不行
+	forged := genForkHeaders(headers.Items[0], 1, nil)[0]
+	forged.BlockTime++
+	forged.Hash = forged.CalcHash()
+	forged.Signature = fork[0].Signature
+	assert.Equal(t, types.ErrSign, chain.AddHeaders([]*types.Header{forged}, "attacker"))
+
+	//出块节点签名的总难度更大的分叉切换最优链
+	fork = append(fork, genForkHeaders(fork[len(fork)-1], 2, priv)...)
+	require.NoError(t, chain.AddHeaders(fork[len(fork)-2:], "self"))
+	assert.Equal(t, fork[len(fork)-1].Hash, chain.LastHeader().Hash)
+	assert.Equal(t, fork[len(fork)-1].Height, chain.GetBlockHeight())
+	reply, err = chain.GetHeaders(&types.ReqBlocks{Start: 1, End: 1})
+	require.NoError(t, err)
+	assert.Equal(t, fork[0].Hash, reply.Items[0].Hash)
+}
+
+func TestLightChainSigners(t *testing.T) {
+	mock33 := testnode.New("", nil)
+	defer mock33.Close()
+	headers, err := mock33.GetAPI().GetHeaders(&types.ReqBlocks{Start: 0, End: 0})
+	require.NoError(t, err)
+	genesisHeader := headers.Items[0]
+
+	priv := genSignKey(t)
+	other := genSignKey(t)
+
+	cfg, _ := testnode.GetDefaultConfig()
+	cfg.BlockChain.LightGenesisHash = common.ToHex(genesisHeader.Hash)
+	//没有配置信任的出块节点不能启动
+	assert.Panics(t, func() { blockchain.NewLight(cfg.BlockChain) })
+	types.S("lightMode", false)
+	cfg.BlockChain.LightSigners = []string{common.ToHex(priv.PubKey().Bytes())}
+	q := queue.New("channel")
+	chain := blockchain.NewLight(cfg.BlockChain)
+	defer types.S("lightMode", false)
+	chain.SetQueueClient(q.Client())
+	defer chain.Close()
+
+	require.NoError(t, chain.AddHeaders([]*types.Header{genesisHeader}, "self"))
+	header := genForkHeaders(genesisHeader, 1, nil)[0]
+	//没有签名或者不是配置的出块节点签名的区块头不能添加
+	assert.Equal(t, types.ErrSign, chain.AddHeaders([]*types.Header{header}, "self"))
+	header.Signature = &types.Signature{Ty: types.SECP256K1, Pubkey: other.PubKey().Bytes(), Signature: other.Sign(header.Hash).Bytes()}
+	assert.Equal(t, types.ErrSign, chain.AddHeaders([]*types.Header{header}, "self"))
+	header.Signature = &types.Signature{Ty: types.SECP256K1, Pubkey: priv.PubKey().Bytes(), Signature: other.Sign(header.Hash).Bytes()}
+	assert.Equal(t, types.ErrSign, chain.AddHeaders([]*types.Header{header}, "self"))
+	header.Signature = &types.Signature{Ty: types.SECP256K1, Pubkey: priv.PubKey().Bytes(), Signature: priv.Sign(header.Hash).Bytes()}
+	require.NoError(t, chain.AddHeaders([]*types.Header{header}, "self"))
+	assert.Equal(t, int64(1), chain.GetBlockHeight())
+}
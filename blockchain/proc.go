@@ -83,6 +83,8 @@ func (chain *BlockChain) ProcRecvMsg() {
 			go chain.processMsg(msg, reqnum, chain.getSeqByHash)
 		case types.EventLocalPrefixCount:
 			go chain.processMsg(msg, reqnum, chain.localPrefixCount)
+		case types.EventGetReceiptProof:
+			go chain.processMsg(msg, reqnum, chain.getReceiptProof)
 		default:
 			go chain.processMsg(msg, reqnum, chain.unknowMsg)
 		}
@@ -105,6 +107,17 @@ func (chain *BlockChain) queryTx(msg queue.Message) {
 	}
 }
 
+func (chain *BlockChain) getReceiptProof(msg queue.Message) {
+	txhash := (msg.Data).(*types.ReqHash)
+	proof, err := chain.ProcGetReceiptProof(txhash.Hash)
+	if err != nil {
+		chainlog.Error("ProcGetReceiptProof", "err", err.Error())
+		msg.Reply(chain.client.NewMessage("rpc", types.EventReplyReceiptProof, err))
+	} else {
+		msg.Reply(chain.client.NewMessage("rpc", types.EventReplyReceiptProof, proof))
+	}
+}
+
 func (chain *BlockChain) getBlocks(msg queue.Message) {
 	requestblocks := (msg.Data).(*types.ReqBlocks)
 	blocks, err := chain.ProcGetBlockDetailsMsg(requestblocks)
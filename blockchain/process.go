@@ -313,6 +313,14 @@ func (b *BlockChain) connectBlock(node *blockNode, blockdetail *types.BlockDetai
 		node.statehash = blockdetail.Block.GetStateHash()
 		node.hash = blockdetail.Block.Hash()
 		b.index.UpdateNode(prevhash, node)
+		//执行以后区块的hash才确定, 签名不影响区块的hash
+		if b.blockSigner != nil && blockdetail.Block.Signature == nil {
+			blockdetail.Block.Signature = &types.Signature{
+				Ty:        types.SECP256K1,
+				Pubkey:    b.blockSigner.PubKey().Bytes(),
+				Signature: b.blockSigner.Sign(node.hash).Bytes(),
+			}
+		}
 	}
 	beg := types.Now()
 	// 写入磁盘
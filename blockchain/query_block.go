@@ -72,6 +72,7 @@ func (chain *BlockChain) ProcGetBlockOverview(ReqHash *types.ReqHash) (*types.Bl
 	header.Hash = block.Block.Hash()
 	header.TxCount = int64(len(block.Block.GetTxs()))
 	header.Difficulty = block.Block.Difficulty
+	header.ReceiptHash = block.Block.ReceiptHash
 	header.Signature = block.Block.Signature
 
 	blockOverview.Head = &header
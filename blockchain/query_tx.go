@@ -212,6 +212,45 @@ func (chain *BlockChain) ProcQueryTxMsg(txhash []byte) (proof *types.Transaction
 	return &TransactionDetail, nil
 }
 
+//获取指定 index 的交易执行结果在 receipts 中的 proof, 注释：index从0开始
+func GetReceiptProofs(receipts []*types.ReceiptData, index int32) [][]byte {
+	leaves := make([][]byte, len(receipts))
+	for i, receipt := range receipts {
+		leaves[i] = receipt.Hash()
+	}
+	return merkle.GetMerkleBranch(leaves, uint32(index))
+}
+
+//ProcGetReceiptProof 查询交易执行结果的默克尔证明, 以及交易所在区块的区块头
+//只有 ForkReceiptHash 以后的区块头中才有 receiptHash
+func (chain *BlockChain) ProcGetReceiptProof(txhash []byte) (*types.ReceiptProof, error) {
+	txresult, err := chain.GetTxResultFromDb(txhash)
+	if err != nil {
+		return nil, err
+	}
+	if !types.IsFork(txresult.Height, "ForkReceiptHash") {
+		return nil, types.ErrNotSupport
+	}
+	block, err := chain.GetBlock(txresult.Height)
+	if err != nil {
+		return nil, err
+	}
+	if int(txresult.Index) >= len(block.Receipts) {
+		return nil, types.ErrNotFound
+	}
+	header, err := chain.blockStore.GetBlockHeaderByHeight(txresult.Height)
+	if err != nil {
+		return nil, err
+	}
+	proof := &types.ReceiptProof{
+		Receipt: block.Receipts[txresult.Index],
+		Index:   int64(txresult.Index),
+		Proofs:  GetReceiptProofs(block.Receipts, txresult.Index),
+		Header:  header,
+	}
+	return proof, nil
+}
+
 //type  AddrOverview {
 //	int64 reciver = 1;
 //	int64 balance = 2;
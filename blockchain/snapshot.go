@@ -0,0 +1,372 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math/big"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/merkle"
+	drivers "github.com/33cn/chain33/system/store"
+	"github.com/33cn/chain33/types"
+)
+
+/*
+状态快照:
+新节点不需要从创世区块开始执行全部的区块, 直接导入快照以后从快照的高度开始同步
+
+快照文件的格式:
+magic | meta | block ... | state ... | local/seq ... | end
+每个记录的格式是 类型(1字节) + 长度(uvarint) + protobuf 数据
+1. meta: 快照的版本, 链的 title, 以及最新区块的高度、hash、stateHash
+2. block: 最新的 DefCacheSize 个区块以及区块的总难度, 用于初始化区块缓存和 bestchain
+3. state: store 导出的状态数据
+4. local: blockchain 数据库中除了区块以外的全部数据, 包括执行器的 localdb 以及交易的索引
+5. seq: 区块的 sequence 记录, 开启了 isRecordBlockSequence 的节点导入的时候需要,
+   sequence 的编号和导出快照的节点一致, 快照之前的区块不能再通过 sequence 获取
+6. end: 记录的数量, 用于检查文件是否完整
+
+导出只能在节点停止的时候进行, 导出的是当前最新的高度(localdb 只保存了最新高度的数据)
+导入的时候不信任快照文件, 快照中最新区块的 hash 必须和配置的 snapshotHash 一致,
+区块之间通过 ParentHash 连接, 状态树的根必须和区块的 StateHash 一致
+*/
+
+const (
+	snapshotVersion   = 1
+	snapshotMagic     = "CHAIN33-SNAPSHOT"
+	maxSnapshotRecord = 64 * 1024 * 1024
+	snapshotBatchSize = 10000
+)
+
+const (
+	snapshotTyMeta = iota + 1
+	snapshotTyBlock
+	snapshotTyState
+	snapshotTyLocal
+	snapshotTyEnd
+	snapshotTySeq
+)
+
+type snapshotWriter struct {
+	w     *bufio.Writer
+	count int64
+}
+
+func newSnapshotWriter(w io.Writer) (*snapshotWriter, error) {
+	sw := &snapshotWriter{w: bufio.NewWriter(w)}
+	_, err := sw.w.WriteString(snapshotMagic)
+	if err != nil {
+		return nil, err
+	}
+	return sw, nil
+}
+
+func (sw *snapshotWriter) write(ty byte, data []byte) error {
+	var head [1 + binary.MaxVarintLen64]byte
+	head[0] = ty
+	n := binary.PutUvarint(head[1:], uint64(len(data)))
+	_, err := sw.w.Write(head[:1+n])
+	if err != nil {
+		return err
+	}
+	_, err = sw.w.Write(data)
+	if err != nil {
+		return err
+	}
+	if ty != snapshotTyMeta && ty != snapshotTyEnd {
+		sw.count++
+	}
+	return nil
+}
+
+func (sw *snapshotWriter) writeKV(ty byte, key, value []byte) error {
+	return sw.write(ty, types.Encode(&types.KeyValue{Key: key, Value: value}))
+}
+
+type snapshotReader struct {
+	r *bufio.Reader
+}
+
+func newSnapshotReader(r io.Reader) (*snapshotReader, error) {
+	sr := &snapshotReader{r: bufio.NewReader(r)}
+	magic := make([]byte, len(snapshotMagic))
+	_, err := io.ReadFull(sr.r, magic)
+	if err != nil || string(magic) != snapshotMagic {
+		return nil, types.ErrSnapshotFormat
+	}
+	return sr, nil
+}
+
+func (sr *snapshotReader) read() (byte, []byte, error) {
+	ty, err := sr.r.ReadByte()
+	if err != nil {
+		return 0, nil, types.ErrSnapshotFormat
+	}
+	size, err := binary.ReadUvarint(sr.r)
+	if err != nil || size > maxSnapshotRecord {
+		return 0, nil, types.ErrSnapshotFormat
+	}
+	data := make([]byte, size)
+	_, err = io.ReadFull(sr.r, data)
+	if err != nil {
+		return 0, nil, types.ErrSnapshotFormat
+	}
+	return ty, data, nil
+}
+
+//区块相关的数据不属于 localdb, 导入的时候通过 SaveBlock 重新生成
+func isBlockStoreKey(key []byte) bool {
+	for _, prefix := range GetLocalDBKeyList() {
+		if bytes.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSequenceKey(key []byte) bool {
+	return bytes.Equal(key, LastSequence) || bytes.HasPrefix(key, seqToHashKey) || bytes.HasPrefix(key, HashToSeqPerfix)
+}
+
+//ExportSnapshot 导出最新高度的状态快照, 需要在节点停止的时候调用
+func ExportSnapshot(cfg *types.BlockChain, st drivers.SnapshotStore, w io.Writer) (*types.SnapshotMeta, error) {
+	initConfig(cfg)
+	db := dbm.NewDB("blockchain", cfg.Driver, cfg.DbPath, cfg.DbCache)
+	defer db.Close()
+	bs := NewBlockStore(db, nil)
+	height := bs.Height()
+	if height < 0 {
+		return nil, types.ErrHeightNotExist
+	}
+	last := bs.LastBlock()
+	meta := &types.SnapshotMeta{
+		Version:   snapshotVersion,
+		Title:     types.GetTitle(),
+		Height:    height,
+		Hash:      last.Hash(),
+		StateHash: last.StateHash,
+	}
+	sw, err := newSnapshotWriter(w)
+	if err != nil {
+		return nil, err
+	}
+	err = sw.write(snapshotTyMeta, types.Encode(meta))
+	if err != nil {
+		return nil, err
+	}
+	start := height - DefCacheSize
+	if start < 0 {
+		start = 0
+	}
+	for i := start; i <= height; i++ {
+		detail, err := bs.LoadBlockByHeight(i)
+		if err != nil {
+			return nil, err
+		}
+		td, err := bs.GetTdByBlockHash(detail.Block.Hash())
+		if err != nil {
+			return nil, err
+		}
+		err = sw.write(snapshotTyBlock, types.Encode(&types.SnapshotBlock{Detail: detail, Td: td.Bytes()}))
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = st.ExportState(meta.StateHash, func(key, value []byte) error {
+		return sw.writeKV(snapshotTyState, key, value)
+	})
+	if err != nil {
+		return nil, err
+	}
+	it := db.Iterator(nil, types.EmptyValue, false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		ty := byte(snapshotTyLocal)
+		if isSequenceKey(it.Key()) {
+			ty = snapshotTySeq
+		} else if isBlockStoreKey(it.Key()) {
+			continue
+		}
+		err = sw.writeKV(ty, it.Key(), it.Value())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if it.Error() != nil {
+		return nil, it.Error()
+	}
+	err = sw.write(snapshotTyEnd, types.Encode(&types.Int64{Data: sw.count}))
+	if err != nil {
+		return nil, err
+	}
+	chainlog.Info("ExportSnapshot", "height", height, "hash", common.ToHex(meta.Hash), "records", sw.count)
+	return meta, sw.w.Flush()
+}
+
+//ImportSnapshot 把快照导入到空的数据库中, 之后节点从快照的高度开始同步
+//导入的时候检查每个状态树节点的 hash, 状态树的根必须和最新区块的 StateHash 一致
+func ImportSnapshot(cfg *types.BlockChain, st drivers.SnapshotStore, r io.Reader) (*types.SnapshotMeta, error) {
+	initConfig(cfg)
+	//平行链的 sequence 来自主链, 需要从 0 开始同步
+	if isParaChain {
+		return nil, types.ErrNotSupport
+	}
+	trusted, err := common.FromHex(cfg.SnapshotHash)
+	if err != nil || len(trusted) == 0 {
+		return nil, types.ErrSnapshotHash
+	}
+	db := dbm.NewDB("blockchain", cfg.Driver, cfg.DbPath, cfg.DbCache)
+	defer db.Close()
+	height, _ := LoadBlockStoreHeight(db)
+	if height >= 0 {
+		return nil, types.ErrSnapshotDBNotEmpty
+	}
+	sr, err := newSnapshotReader(r)
+	if err != nil {
+		return nil, err
+	}
+	ty, data, err := sr.read()
+	if err != nil {
+		return nil, err
+	}
+	var meta types.SnapshotMeta
+	if ty != snapshotTyMeta || types.Decode(data, &meta) != nil {
+		return nil, types.ErrSnapshotFormat
+	}
+	if meta.Version != snapshotVersion {
+		return nil, types.ErrSnapshotVersion
+	}
+	if meta.Title != types.GetTitle() {
+		return nil, types.ErrSnapshotTitle
+	}
+	if !bytes.Equal(meta.Hash, trusted) {
+		return nil, types.ErrSnapshotHash
+	}
+	importer := st.NewStateImporter(meta.StateHash)
+	batch := db.NewBatch(true)
+	var blocks []*types.SnapshotBlock
+	var count, local int64
+	for {
+		ty, data, err := sr.read()
+		if err != nil {
+			return nil, err
+		}
+		if ty == snapshotTyEnd {
+			var end types.Int64
+			if types.Decode(data, &end) != nil || end.Data != count {
+				return nil, types.ErrSnapshotFormat
+			}
+			break
+		}
+		count++
+		switch ty {
+		case snapshotTyBlock:
+			var block types.SnapshotBlock
+			if types.Decode(data, &block) != nil || block.Detail.GetBlock() == nil {
+				return nil, types.ErrSnapshotFormat
+			}
+			if !bytes.Equal(merkle.CalcMerkleRoot(block.Detail.Block.Txs), block.Detail.Block.TxHash) {
+				return nil, types.ErrCheckTxHash
+			}
+			if len(blocks) > 0 {
+				prev := blocks[len(blocks)-1].Detail.Block
+				if block.Detail.Block.Height != prev.Height+1 || !bytes.Equal(block.Detail.Block.ParentHash, prev.Hash()) {
+					return nil, types.ErrBlockHashNoMatch
+				}
+			}
+			blocks = append(blocks, &block)
+		case snapshotTyState:
+			var kv types.KeyValue
+			if types.Decode(data, &kv) != nil {
+				return nil, types.ErrSnapshotFormat
+			}
+			err = importer.Add(kv.Key, kv.Value)
+			if err != nil {
+				return nil, err
+			}
+		case snapshotTyLocal, snapshotTySeq:
+			var kv types.KeyValue
+			if types.Decode(data, &kv) != nil {
+				return nil, types.ErrSnapshotFormat
+			}
+			if (ty == snapshotTyLocal && isBlockStoreKey(kv.Key)) || (ty == snapshotTySeq && !isSequenceKey(kv.Key)) {
+				return nil, types.ErrSnapshotFormat
+			}
+			//没有开启 isRecordBlockSequence 不需要 sequence
+			if ty == snapshotTySeq && !isRecordBlockSequence {
+				continue
+			}
+			batch.Set(kv.Key, kv.Value)
+			local++
+			if local%snapshotBatchSize == 0 {
+				err = batch.Write()
+				if err != nil {
+					return nil, err
+				}
+				batch = db.NewBatch(true)
+			}
+		default:
+			return nil, types.ErrSnapshotFormat
+		}
+	}
+	if len(blocks) == 0 {
+		return nil, types.ErrSnapshotFormat
+	}
+	last := blocks[len(blocks)-1].Detail.Block
+	if last.Height != meta.Height || !bytes.Equal(last.Hash(), meta.Hash) || !bytes.Equal(last.StateHash, meta.StateHash) {
+		return nil, types.ErrBlockHashNoMatch
+	}
+	err = importer.Commit()
+	if err != nil {
+		return nil, err
+	}
+	err = batch.Write()
+	if err != nil {
+		return nil, err
+	}
+	bs := &BlockStore{db: db, height: -1}
+	if isRecordBlockSequence {
+		err = checkSnapshotSequence(bs, meta.Hash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	//最后写入区块, 写入以后数据库的高度才是快照的高度
+	batch = db.NewBatch(true)
+	for _, block := range blocks {
+		err = bs.saveBlockData(batch, block.Detail, block.Detail.Block.Hash())
+		if err != nil {
+			return nil, err
+		}
+		err = bs.SaveTdByBlockHash(batch, block.Detail.Block.Hash(), new(big.Int).SetBytes(block.Td))
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = batch.Write()
+	if err != nil {
+		return nil, err
+	}
+	chainlog.Info("ImportSnapshot", "height", meta.Height, "hash", common.ToHex(meta.Hash), "stateHash", common.ToHex(meta.StateHash))
+	return &meta, nil
+}
+
+//最新的 sequence 必须是添加快照中最新的区块
+func checkSnapshotSequence(bs *BlockStore, hash []byte) error {
+	sequence, err := bs.LoadBlockLastSequence()
+	if err != nil {
+		return types.ErrSnapshotSequence
+	}
+	seq, err := bs.GetBlockSequence(sequence)
+	if err != nil || seq.Type != AddBlock || !bytes.Equal(seq.Hash, hash) {
+		return types.ErrSnapshotSequence
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/33cn/chain33/blockchain"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/store"
+	drivers "github.com/33cn/chain33/system/store"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func snapshotConfig(dir string) (*types.Config, *types.ConfigSubModule) {
+	cfg, sub := testnode.GetDefaultConfig()
+	cfg.BlockChain.Driver = "leveldb"
+	cfg.BlockChain.DbPath = filepath.Join(dir, "blockchain")
+	cfg.Store.Driver = "leveldb"
+	cfg.Store.DbPath = filepath.Join(dir, "mavltree")
+	return cfg, sub
+}
+
+func importSnapshot(cfg *types.Config, sub *types.ConfigSubModule, data []byte) (*types.SnapshotMeta, error) {
+	s := store.New(cfg.Store, sub.Store)
+	defer s.Close()
+	return blockchain.ImportSnapshot(cfg.BlockChain, s.(drivers.SnapshotStore), bytes.NewReader(data))
+}
+
+func TestSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg, sub := snapshotConfig(filepath.Join(dir, "src"))
+	mock33 := testnode.NewWithConfig(cfg, sub, nil)
+	mock33.Listen()
+	require.NoError(t, mock33.SendHot())
+	last := mock33.GetLastBlock()
+	hot := mock33.GetAccount(last.StateHash, mock33.GetHotAddress())
+	assert.Equal(t, 10000*types.Coin, hot.Balance)
+	seq, err := mock33.GetAPI().GetLastBlockSequence()
+	require.NoError(t, err)
+	mock33.Close()
+
+	s := store.New(cfg.Store, sub.Store)
+	var buf bytes.Buffer
+	meta, err := blockchain.ExportSnapshot(cfg.BlockChain, s.(drivers.SnapshotStore), &buf)
+	s.Close()
+	require.NoError(t, err)
+	assert.Equal(t, last.Height, meta.Height)
+	assert.Equal(t, last.Hash(), meta.Hash)
+	assert.Equal(t, last.StateHash, meta.StateHash)
+	data := buf.Bytes()
+
+	//没有配置信任的hash, 或者快照和信任的hash不一致, 不能导入
+	cfgbad, _ := snapshotConfig(filepath.Join(dir, "bad"))
+	_, err = importSnapshot(cfgbad, sub, data)
+	assert.Equal(t, types.ErrSnapshotHash, err)
+	cfgbad.BlockChain.SnapshotHash = common.ToHex(last.ParentHash)
+	_, err = importSnapshot(cfgbad, sub, data)
+	assert.Equal(t, types.ErrSnapshotHash, err)
+
+	//不完整或者被修改的快照不能导入
+	cfgbad.BlockChain.SnapshotHash = common.ToHex(last.Hash())
+	_, err = importSnapshot(cfgbad, sub, data[:len(data)-1])
+	assert.Equal(t, types.ErrSnapshotFormat, err)
+	_, err = importSnapshot(cfgbad, sub, data[1:])
+	assert.Equal(t, types.ErrSnapshotFormat, err)
+
+	cfg2, sub2 := snapshotConfig(filepath.Join(dir, "dst"))
+	cfg2.BlockChain.SnapshotHash = common.ToHex(last.Hash())
+	meta2, err := importSnapshot(cfg2, sub2, data)
+	require.NoError(t, err)
+	assert.Equal(t, meta, meta2)
+	_, err = importSnapshot(cfg2, sub2, data)
+	assert.Equal(t, types.ErrSnapshotDBNotEmpty, err)
+
+	//导入以后从快照的高度继续出块
+	mock2 := testnode.NewWithConfig(cfg2, sub2, nil)
+	defer mock2.Close()
+	mock2.Listen()
+	header, err := mock2.GetAPI().GetLastHeader()
+	require.NoError(t, err)
+	assert.Equal(t, last.Height, header.Height)
+	assert.Equal(t, last.Hash(), header.Hash)
+	assert.Equal(t, hot, mock2.GetAccount(last.StateHash, mock2.GetHotAddress()))
+	//sequence 和导出快照的节点一致
+	seq2, err := mock2.GetAPI().GetLastBlockSequence()
+	require.NoError(t, err)
+	assert.Equal(t, seq.Data, seq2.Data)
+
+	tx := util.CreateCoinsTx(mock2.GetHotKey(), mock2.GetGenesisAddress(), types.Coin)
+	mock2.SendTx(tx)
+	require.NoError(t, mock2.Wait())
+	newlast := mock2.GetLastBlock()
+	assert.True(t, newlast.Height > last.Height)
+	hot = mock2.GetAccount(newlast.StateHash, mock2.GetHotAddress())
+	assert.True(t, hot.Balance < 9999*types.Coin)
+	seq2, err = mock2.GetAPI().GetLastBlockSequence()
+	require.NoError(t, err)
+	assert.Equal(t, seq.Data+newlast.Height-last.Height, seq2.Data)
+}
+
+func TestSnapshotSequence(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	//没有记录 sequence 的节点导出的快照, 不能导入到需要记录 sequence 的节点
+	cfg, sub := snapshotConfig(filepath.Join(dir, "src"))
+	cfg.BlockChain.IsRecordBlockSequence = false
+	mock33 := testnode.NewWithConfig(cfg, sub, nil)
+	mock33.Listen()
+	last := mock33.GetLastBlock()
+	mock33.Close()
+
+	s := store.New(cfg.Store, sub.Store)
+	var buf bytes.Buffer
+	_, err = blockchain.ExportSnapshot(cfg.BlockChain, s.(drivers.SnapshotStore), &buf)
+	s.Close()
+	require.NoError(t, err)
+
+	cfg2, sub2 := snapshotConfig(filepath.Join(dir, "dst"))
+	cfg2.BlockChain.SnapshotHash = common.ToHex(last.Hash())
+	_, err = importSnapshot(cfg2, sub2, buf.Bytes())
+	assert.Equal(t, types.ErrSnapshotSequence, err)
+
+	cfg3, sub3 := snapshotConfig(filepath.Join(dir, "noseq"))
+	cfg3.BlockChain.IsRecordBlockSequence = false
+	cfg3.BlockChain.SnapshotHash = common.ToHex(last.Hash())
+	_, err = importSnapshot(cfg3, sub3, buf.Bytes())
+	assert.Nil(t, err)
+}
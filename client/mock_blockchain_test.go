@@ -51,6 +51,16 @@ func (m *mockBlockChain) SetQueueClient(q queue.Queue) {
 				} else {
 					msg.ReplyErr("Do not support", types.ErrInvalidParam)
 				}
+			case types.EventGetReceiptProof:
+				if req, ok := msg.GetData().(*types.ReqHash); ok {
+					if bytes.Equal(req.Hash, []byte("case1")) {
+						msg.Reply(client.NewMessage(blockchainKey, types.EventReplyReceiptProof, &types.Transaction{}))
+					} else {
+						msg.Reply(client.NewMessage(blockchainKey, types.EventReplyReceiptProof, &types.ReceiptProof{}))
+					}
+				} else {
+					msg.ReplyErr("Do not support", types.ErrInvalidParam)
+				}
 			case types.EventGetTransactionByHash:
 				if req, ok := msg.GetData().(*types.ReqHashes); ok {
 					if len(req.GetHashes()) > 0 && bytes.Equal(req.Hashes[0], []byte("case1")) {
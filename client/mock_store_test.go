@@ -30,6 +30,12 @@ func (m *mockStore) SetQueueClient(q queue.Queue) {
 				} else {
 					msg.ReplyErr("Do not support", types.ErrInvalidParam)
 				}
+			case types.EventStoreGetProof:
+				if req, ok := msg.GetData().(*types.ReqStateProof); ok && string(req.Key) == "key" {
+					msg.Reply(client.NewMessage("store", types.EventStoreGetProofReply, &types.StateProof{Key: req.Key}))
+				} else {
+					msg.Reply(client.NewMessage("store", types.EventStoreGetProofReply, types.ErrNotFound))
+				}
 			default:
 				msg.ReplyErr("Do not support", types.ErrNotSupport)
 			}
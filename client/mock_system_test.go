@@ -92,6 +92,10 @@ func (mock *mockClient) CloseQueue() (*types.Reply, error) {
 	return &types.Reply{IsOk: true, Msg: []byte("Ok")}, nil
 }
 
+func (mock *mockClient) QueueStats() (*types.QueueStats, error) {
+	return mock.c.QueueStats()
+}
+
 func (mock *mockClient) NewMessage(topic string, ty int64, data interface{}) queue.Message {
 	return mock.c.NewMessage(topic, ty, data)
 }
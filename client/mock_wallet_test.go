@@ -133,6 +133,20 @@ func (m *mockWallet) SetQueueClient(q queue.Queue) {
 				} else {
 					msg.ReplyErr("Do not support", types.ErrInvalidParam)
 				}
+			case types.EventDeriveAccount:
+				msg.Reply(client.NewMessage(walletKey, types.EventDeriveAccount, &types.WalletAccount{}))
+			case types.EventGetExtendedPubKey:
+				msg.Reply(client.NewMessage(walletKey, types.EventGetExtendedPubKey, &types.ReplyString{Data: "xpub"}))
+			case types.EventDiscoverAccounts:
+				msg.Reply(client.NewMessage(walletKey, types.EventDiscoverAccounts, &types.WalletAccounts{}))
+			case types.EventWalletImportWatchOnly:
+				msg.Reply(client.NewMessage(walletKey, types.EventWalletImportWatchOnly, &types.WalletAccount{WatchOnly: true}))
+			case types.EventWalletExport:
+				msg.Reply(client.NewMessage(walletKey, types.EventWalletExport, &types.ReplyString{Data: "backup"}))
+			case types.EventWalletImport:
+				msg.Reply(client.NewMessage(walletKey, types.EventWalletImport, &types.WalletAccounts{Wallets: []*types.WalletAccount{{Label: "restored"}}}))
+			case types.EventWalletCreateOfflineTx:
+				msg.Reply(client.NewMessage(walletKey, types.EventWalletCreateOfflineTx, &types.OfflineTx{TxHex: "0x00"}))
 			case types.EventGetSeed:
 				if req, ok := msg.GetData().(*types.GetSeedByPw); ok {
 					if req.Passwd == "case1" {
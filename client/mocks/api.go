@@ -14,6 +14,29 @@ type QueueProtocolAPI struct {
 	mock.Mock
 }
 
+// BanPeer provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) BanPeer(param *types.ReqBanPeer) (*types.Reply, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.ReqBanPeer) *types.Reply); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqBanPeer) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields:
 func (_m *QueueProtocolAPI) Close() {
 	_m.Called()
@@ -42,6 +65,52 @@ func (_m *QueueProtocolAPI) CloseQueue() (*types.Reply, error) {
 	return r0, r1
 }
 
+// DeriveAccount provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) DeriveAccount(param *types.ReqDeriveAccount) (*types.WalletAccount, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.WalletAccount
+	if rf, ok := ret.Get(0).(func(*types.ReqDeriveAccount) *types.WalletAccount); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.WalletAccount)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqDeriveAccount) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscoverAccounts provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) DiscoverAccounts(param *types.ReqDiscoverAccounts) (*types.WalletAccounts, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.WalletAccounts
+	if rf, ok := ret.Get(0).(func(*types.ReqDiscoverAccounts) *types.WalletAccounts); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.WalletAccounts)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqDiscoverAccounts) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DumpPrivkey provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) DumpPrivkey(param *types.ReqString) (*types.ReplyString, error) {
 	ret := _m.Called(param)
@@ -272,6 +341,29 @@ func (_m *QueueProtocolAPI) GetBlocks(param *types.ReqBlocks) (*types.BlockDetai
 	return r0, r1
 }
 
+// GetExtendedPubKey provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) GetExtendedPubKey(param *types.ReqExtendedPubKey) (*types.ReplyString, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ReplyString
+	if rf, ok := ret.Get(0).(func(*types.ReqExtendedPubKey) *types.ReplyString); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyString)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqExtendedPubKey) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetFatalFailure provides a mock function with given fields:
 func (_m *QueueProtocolAPI) GetFatalFailure() (*types.Int32, error) {
 	ret := _m.Called()
@@ -433,6 +525,52 @@ func (_m *QueueProtocolAPI) GetNetInfo() (*types.NodeNetInfo, error) {
 	return r0, r1
 }
 
+// GetPeerScores provides a mock function with given fields:
+func (_m *QueueProtocolAPI) GetPeerScores() (*types.PeerScoreList, error) {
+	ret := _m.Called()
+
+	var r0 *types.PeerScoreList
+	if rf, ok := ret.Get(0).(func() *types.PeerScoreList); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.PeerScoreList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReceiptProof provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) GetReceiptProof(param *types.ReqHash) (*types.ReceiptProof, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ReceiptProof
+	if rf, ok := ret.Get(0).(func(*types.ReqHash) *types.ReceiptProof); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReceiptProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqHash) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSeed provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) GetSeed(param *types.GetSeedByPw) (*types.ReplySeed, error) {
 	ret := _m.Called(param)
@@ -836,6 +974,29 @@ func (_m *QueueProtocolAPI) QueryTx(param *types.ReqHash) (*types.TransactionDet
 	return r0, r1
 }
 
+// QueueStats provides a mock function with given fields:
+func (_m *QueueProtocolAPI) QueueStats() (*types.QueueStats, error) {
+	ret := _m.Called()
+
+	var r0 *types.QueueStats
+	if rf, ok := ret.Get(0).(func() *types.QueueStats); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueueStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SaveSeed provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) SaveSeed(param *types.SaveSeedByPw) (*types.Reply, error) {
 	ret := _m.Called(param)
@@ -905,6 +1066,29 @@ func (_m *QueueProtocolAPI) SignRawTx(param *types.ReqSignRawTx) (*types.ReplySi
 	return r0, r1
 }
 
+// SignVerifyStats provides a mock function with given fields:
+func (_m *QueueProtocolAPI) SignVerifyStats() (*types.SignVerifyStats, error) {
+	ret := _m.Called()
+
+	var r0 *types.SignVerifyStats
+	if rf, ok := ret.Get(0).(func() *types.SignVerifyStats); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SignVerifyStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StoreGet provides a mock function with given fields: _a0
 func (_m *QueueProtocolAPI) StoreGet(_a0 *types.StoreGet) (*types.StoreReplyValue, error) {
 	ret := _m.Called(_a0)
@@ -928,6 +1112,29 @@ func (_m *QueueProtocolAPI) StoreGet(_a0 *types.StoreGet) (*types.StoreReplyValu
 	return r0, r1
 }
 
+// StoreGetProof provides a mock function with given fields: _a0
+func (_m *QueueProtocolAPI) StoreGetProof(_a0 *types.ReqStateProof) (*types.StateProof, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *types.StateProof
+	if rf, ok := ret.Get(0).(func(*types.ReqStateProof) *types.StateProof); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StateProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqStateProof) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StoreGetTotalCoins provides a mock function with given fields: _a0
 func (_m *QueueProtocolAPI) StoreGetTotalCoins(_a0 *types.IterateRangeByStateHash) (*types.ReplyGetTotalCoins, error) {
 	ret := _m.Called(_a0)
@@ -951,6 +1158,29 @@ func (_m *QueueProtocolAPI) StoreGetTotalCoins(_a0 *types.IterateRangeByStateHas
 	return r0, r1
 }
 
+// UnbanPeer provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) UnbanPeer(param *types.ReqString) (*types.Reply, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.ReqString) *types.Reply); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqString) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Version provides a mock function with given fields:
 func (_m *QueueProtocolAPI) Version() (*types.Reply, error) {
 	ret := _m.Called()
@@ -974,6 +1204,29 @@ func (_m *QueueProtocolAPI) Version() (*types.Reply, error) {
 	return r0, r1
 }
 
+// WalletCreateOfflineTx provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) WalletCreateOfflineTx(param *types.ReqWalletSendToAddress) (*types.OfflineTx, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.OfflineTx
+	if rf, ok := ret.Get(0).(func(*types.ReqWalletSendToAddress) *types.OfflineTx); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.OfflineTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqWalletSendToAddress) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WalletCreateTx provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) WalletCreateTx(param *types.ReqCreateTransaction) (*types.Transaction, error) {
 	ret := _m.Called(param)
@@ -997,6 +1250,29 @@ func (_m *QueueProtocolAPI) WalletCreateTx(param *types.ReqCreateTransaction) (*
 	return r0, r1
 }
 
+// WalletExport provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) WalletExport(param *types.ReqWalletExport) (*types.ReplyString, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ReplyString
+	if rf, ok := ret.Get(0).(func(*types.ReqWalletExport) *types.ReplyString); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyString)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqWalletExport) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WalletGetAccountList provides a mock function with given fields: req
 func (_m *QueueProtocolAPI) WalletGetAccountList(req *types.ReqAccountList) (*types.WalletAccounts, error) {
 	ret := _m.Called(req)
@@ -1020,6 +1296,52 @@ func (_m *QueueProtocolAPI) WalletGetAccountList(req *types.ReqAccountList) (*ty
 	return r0, r1
 }
 
+// WalletImport provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) WalletImport(param *types.ReqWalletImport) (*types.WalletAccounts, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.WalletAccounts
+	if rf, ok := ret.Get(0).(func(*types.ReqWalletImport) *types.WalletAccounts); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.WalletAccounts)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqWalletImport) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WalletImportWatchOnly provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) WalletImportWatchOnly(param *types.ReqImportWatchOnly) (*types.WalletAccount, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.WalletAccount
+	if rf, ok := ret.Get(0).(func(*types.ReqImportWatchOnly) *types.WalletAccount); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.WalletAccount)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqImportWatchOnly) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WalletImportprivkey provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) WalletImportprivkey(param *types.ReqWalletImportPrivkey) (*types.WalletAccount, error) {
 	ret := _m.Called(param)
@@ -178,6 +178,23 @@ func (q *QueueProtocol) QueryTx(param *types.ReqHash) (*types.TransactionDetail,
 	return nil, types.ErrTypeAsset
 }
 
+func (q *QueueProtocol) GetReceiptProof(param *types.ReqHash) (*types.ReceiptProof, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("GetReceiptProof", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(blockchainKey, types.EventGetReceiptProof, param)
+	if err != nil {
+		log.Error("GetReceiptProof", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReceiptProof); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
 func (q *QueueProtocol) GetTransactionByAddr(param *types.ReqAddr) (*types.ReplyTxInfos, error) {
 	if param == nil {
 		err := types.ErrInvalidParam
@@ -546,6 +563,125 @@ func (q *QueueProtocol) GetSeed(param *types.GetSeedByPw) (*types.ReplySeed, err
 	return nil, types.ErrTypeAsset
 }
 
+func (q *QueueProtocol) WalletImportWatchOnly(param *types.ReqImportWatchOnly) (*types.WalletAccount, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("WalletImportWatchOnly", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(walletKey, types.EventWalletImportWatchOnly, param)
+	if err != nil {
+		log.Error("WalletImportWatchOnly", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.WalletAccount); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+func (q *QueueProtocol) WalletCreateOfflineTx(param *types.ReqWalletSendToAddress) (*types.OfflineTx, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("WalletCreateOfflineTx", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(walletKey, types.EventWalletCreateOfflineTx, param)
+	if err != nil {
+		log.Error("WalletCreateOfflineTx", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.OfflineTx); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+func (q *QueueProtocol) DeriveAccount(param *types.ReqDeriveAccount) (*types.WalletAccount, error) {
+	if param == nil || len(param.Path) == 0 {
+		err := types.ErrInvalidParam
+		log.Error("DeriveAccount", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(walletKey, types.EventDeriveAccount, param)
+	if err != nil {
+		log.Error("DeriveAccount", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.WalletAccount); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+func (q *QueueProtocol) GetExtendedPubKey(param *types.ReqExtendedPubKey) (*types.ReplyString, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("GetExtendedPubKey", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(walletKey, types.EventGetExtendedPubKey, param)
+	if err != nil {
+		log.Error("GetExtendedPubKey", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReplyString); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+func (q *QueueProtocol) DiscoverAccounts(param *types.ReqDiscoverAccounts) (*types.WalletAccounts, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("DiscoverAccounts", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(walletKey, types.EventDiscoverAccounts, param)
+	if err != nil {
+		log.Error("DiscoverAccounts", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.WalletAccounts); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+func (q *QueueProtocol) WalletExport(param *types.ReqWalletExport) (*types.ReplyString, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("WalletExport", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(walletKey, types.EventWalletExport, param)
+	if err != nil {
+		log.Error("WalletExport", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReplyString); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+func (q *QueueProtocol) WalletImport(param *types.ReqWalletImport) (*types.WalletAccounts, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("WalletImport", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(walletKey, types.EventWalletImport, param)
+	if err != nil {
+		log.Error("WalletImport", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.WalletAccounts); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
 func (q *QueueProtocol) GetWalletStatus() (*types.WalletStatus, error) {
 	msg, err := q.query(walletKey, types.EventGetWalletStatus, &types.ReqNil{})
 	if err != nil {
@@ -737,6 +873,58 @@ func (q *QueueProtocol) GetNetInfo() (*types.NodeNetInfo, error) {
 	return nil, err
 }
 
+func (q *QueueProtocol) GetPeerScores() (*types.PeerScoreList, error) {
+	msg, err := q.query(p2pKey, types.EventGetPeerScores, &types.ReqNil{})
+	if err != nil {
+		log.Error("GetPeerScores", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.PeerScoreList); ok {
+		return reply, nil
+	}
+	err = types.ErrTypeAsset
+	log.Error("GetPeerScores", "Error", err.Error())
+	return nil, err
+}
+
+func (q *QueueProtocol) BanPeer(param *types.ReqBanPeer) (*types.Reply, error) {
+	if param == nil || len(param.GetAddr()) == 0 {
+		err := types.ErrInvalidParam
+		log.Error("BanPeer", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(p2pKey, types.EventBanPeer, param)
+	if err != nil {
+		log.Error("BanPeer", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Reply); ok {
+		return reply, nil
+	}
+	err = types.ErrTypeAsset
+	log.Error("BanPeer", "Error", err.Error())
+	return nil, err
+}
+
+func (q *QueueProtocol) UnbanPeer(param *types.ReqString) (*types.Reply, error) {
+	if param == nil || len(param.GetData()) == 0 {
+		err := types.ErrInvalidParam
+		log.Error("UnbanPeer", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(p2pKey, types.EventUnbanPeer, param)
+	if err != nil {
+		log.Error("UnbanPeer", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Reply); ok {
+		return reply, nil
+	}
+	err = types.ErrTypeAsset
+	log.Error("UnbanPeer", "Error", err.Error())
+	return nil, err
+}
+
 func (q *QueueProtocol) SignRawTx(param *types.ReqSignRawTx) (*types.ReplySignRawTx, error) {
 	if param == nil {
 		err := types.ErrInvalidParam
@@ -796,6 +984,25 @@ func (q *QueueProtocol) StoreGetTotalCoins(param *types.IterateRangeByStateHash)
 	return nil, err
 }
 
+func (q *QueueProtocol) StoreGetProof(param *types.ReqStateProof) (*types.StateProof, error) {
+	if param == nil || len(param.Key) == 0 {
+		err := types.ErrInvalidParam
+		log.Error("StoreGetProof", "Error", err)
+		return nil, err
+	}
+	msg, err := q.query(storeKey, types.EventStoreGetProof, param)
+	if err != nil {
+		log.Error("StoreGetProof", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.StateProof); ok {
+		return reply, nil
+	}
+	err = types.ErrTypeAsset
+	log.Error("StoreGetProof", "Error", err.Error())
+	return nil, err
+}
+
 func (q *QueueProtocol) GetFatalFailure() (*types.Int32, error) {
 	msg, err := q.query(walletKey, types.EventFatalFailure, &types.ReqNil{})
 	if err != nil {
@@ -812,6 +1019,14 @@ func (q *QueueProtocol) CloseQueue() (*types.Reply, error) {
 	return q.client.CloseQueue()
 }
 
+func (q *QueueProtocol) QueueStats() (*types.QueueStats, error) {
+	return q.client.QueueStats()
+}
+
+func (q *QueueProtocol) SignVerifyStats() (*types.SignVerifyStats, error) {
+	return types.GetSignVerifier().Stats(), nil
+}
+
 func (q *QueueProtocol) GetLastBlockSequence() (*types.Int64, error) {
 	msg, err := q.query(blockchainKey, types.EventGetLastBlockSequence, &types.ReqNil{})
 	if err != nil {
@@ -62,6 +62,7 @@ func TestQueueProtocol(t *testing.T) {
 	testGetBlocks(t, api)
 	testGetTransactionByAddr(t, api)
 	testQueryTx(t, api)
+	testGetReceiptProof(t, api)
 	testGetTransactionByHash(t, api)
 	testGetMempool(t, api)
 	testWalletGetAccountList(t, api)
@@ -84,6 +85,9 @@ func TestQueueProtocol(t *testing.T) {
 	testGenSeed(t, api)
 	testSaveSeed(t, api)
 	testGetSeed(t, api)
+	testHDAccount(t, api)
+	testWatchOnly(t, api)
+	testWalletBackup(t, api)
 	testGetWalletStatus(t, api)
 	testDumpPrivkey(t, api)
 	testIsSync(t, api)
@@ -93,6 +97,7 @@ func TestQueueProtocol(t *testing.T) {
 	testGetLastHeader(t, api)
 	testSignRawTx(t, api)
 	testStoreGetTotalCoins(t, api)
+	testStoreGetProof(t, api)
 	testBlockChainQuery(t, api)
 }
 
@@ -132,6 +137,16 @@ func testStoreGetTotalCoins(t *testing.T, api client.QueueProtocolAPI) {
 	}
 }
 
+func testStoreGetProof(t *testing.T, api client.QueueProtocolAPI) {
+	proof, err := api.StoreGetProof(&types.ReqStateProof{Key: []byte("key")})
+	require.Nil(t, err)
+	require.Equal(t, []byte("key"), proof.Key)
+	_, err = api.StoreGetProof(nil)
+	require.Equal(t, types.ErrInvalidParam, err)
+	_, err = api.StoreGetProof(&types.ReqStateProof{Key: []byte("nokey")})
+	require.Equal(t, types.ErrNotFound, err)
+}
+
 func testSignRawTx(t *testing.T, api client.QueueProtocolAPI) {
 	_, err := api.SignRawTx(&types.ReqSignRawTx{})
 	if err != nil {
@@ -227,6 +242,48 @@ func testGetSeed(t *testing.T, api client.QueueProtocolAPI) {
 	}
 }
 
+func testHDAccount(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.DeriveAccount(&types.ReqDeriveAccount{Path: "m/44'/13107'/0'/0/0"})
+	require.Nil(t, err)
+	_, err = api.DeriveAccount(&types.ReqDeriveAccount{})
+	require.Equal(t, types.ErrInvalidParam, err)
+	reply, err := api.GetExtendedPubKey(&types.ReqExtendedPubKey{})
+	require.Nil(t, err)
+	require.Equal(t, "xpub", reply.Data)
+	_, err = api.GetExtendedPubKey(nil)
+	require.Equal(t, types.ErrInvalidParam, err)
+	_, err = api.DiscoverAccounts(&types.ReqDiscoverAccounts{GapLimit: 20})
+	require.Nil(t, err)
+	_, err = api.DiscoverAccounts(nil)
+	require.Equal(t, types.ErrInvalidParam, err)
+}
+
+func testWatchOnly(t *testing.T, api client.QueueProtocolAPI) {
+	acc, err := api.WalletImportWatchOnly(&types.ReqImportWatchOnly{Addr: "addr", Label: "watch"})
+	require.Nil(t, err)
+	require.True(t, acc.WatchOnly)
+	_, err = api.WalletImportWatchOnly(nil)
+	require.Equal(t, types.ErrInvalidParam, err)
+	tx, err := api.WalletCreateOfflineTx(&types.ReqWalletSendToAddress{From: "addr", To: "to", Amount: 1})
+	require.Nil(t, err)
+	require.Equal(t, "0x00", tx.TxHex)
+	_, err = api.WalletCreateOfflineTx(nil)
+	require.Equal(t, types.ErrInvalidParam, err)
+}
+
+func testWalletBackup(t *testing.T, api client.QueueProtocolAPI) {
+	backup, err := api.WalletExport(&types.ReqWalletExport{Passwd: "backup"})
+	require.Nil(t, err)
+	require.Equal(t, "backup", backup.Data)
+	_, err = api.WalletExport(nil)
+	require.Equal(t, types.ErrInvalidParam, err)
+	accs, err := api.WalletImport(&types.ReqWalletImport{Data: backup.Data, Passwd: "backup"})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(accs.Wallets))
+	_, err = api.WalletImport(nil)
+	require.Equal(t, types.ErrInvalidParam, err)
+}
+
 func testSaveSeed(t *testing.T, api client.QueueProtocolAPI) {
 	_, err := api.SaveSeed(&types.SaveSeedByPw{})
 	if err != nil {
@@ -523,6 +580,21 @@ func testQueryTx(t *testing.T, api client.QueueProtocolAPI) {
 	}
 }
 
+func testGetReceiptProof(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.GetReceiptProof(&types.ReqHash{})
+	if err != nil {
+		t.Error("Call GetReceiptProof Failed.", err)
+	}
+	_, err = api.GetReceiptProof(nil)
+	if err == nil {
+		t.Error("GetReceiptProof(nil) need return error.")
+	}
+	_, err = api.GetReceiptProof(&types.ReqHash{Hash: []byte("case1")})
+	if err == nil {
+		t.Error("GetReceiptProof(&ReqHash{Hash:[]byte(\"case1\")}) need return error.")
+	}
+}
+
 func testGetTransactionByAddr(t *testing.T, api client.QueueProtocolAPI) {
 	_, err := api.GetTransactionByAddr(&types.ReqAddr{})
 	if err != nil {
@@ -39,6 +39,12 @@ type QueueProtocolAPI interface {
 	PeerInfo() (*types.PeerList, error)
 	// types.EventGetNetInfo
 	GetNetInfo() (*types.NodeNetInfo, error)
+	// types.EventGetPeerScores
+	GetPeerScores() (*types.PeerScoreList, error)
+	// types.EventBanPeer
+	BanPeer(param *types.ReqBanPeer) (*types.Reply, error)
+	// types.EventUnbanPeer
+	UnbanPeer(param *types.ReqString) (*types.Reply, error)
 	// --------------- p2p interfaces end
 	// +++++++++++++++ wallet interfaces begin
 	// types.EventLocalGet
@@ -73,6 +79,20 @@ type QueueProtocolAPI interface {
 	SaveSeed(param *types.SaveSeedByPw) (*types.Reply, error)
 	// types.EventGetSeed
 	GetSeed(param *types.GetSeedByPw) (*types.ReplySeed, error)
+	// types.EventWalletImportWatchOnly
+	WalletImportWatchOnly(param *types.ReqImportWatchOnly) (*types.WalletAccount, error)
+	// types.EventWalletCreateOfflineTx
+	WalletCreateOfflineTx(param *types.ReqWalletSendToAddress) (*types.OfflineTx, error)
+	// types.EventDeriveAccount
+	DeriveAccount(param *types.ReqDeriveAccount) (*types.WalletAccount, error)
+	// types.EventGetExtendedPubKey
+	GetExtendedPubKey(param *types.ReqExtendedPubKey) (*types.ReplyString, error)
+	// types.EventDiscoverAccounts
+	DiscoverAccounts(param *types.ReqDiscoverAccounts) (*types.WalletAccounts, error)
+	// types.EventWalletExport
+	WalletExport(param *types.ReqWalletExport) (*types.ReplyString, error)
+	// types.EventWalletImport
+	WalletImport(param *types.ReqWalletImport) (*types.WalletAccounts, error)
 	// types.EventGetWalletStatus
 	GetWalletStatus() (*types.WalletStatus, error)
 	// types.EventDumpPrivkey
@@ -86,6 +106,8 @@ type QueueProtocolAPI interface {
 	GetBlocks(param *types.ReqBlocks) (*types.BlockDetails, error)
 	// types.EventQueryTx
 	QueryTx(param *types.ReqHash) (*types.TransactionDetail, error)
+	// types.EventGetReceiptProof
+	GetReceiptProof(param *types.ReqHash) (*types.ReceiptProof, error)
 	// types.EventGetTransactionByAddr
 	GetTransactionByAddr(param *types.ReqAddr) (*types.ReplyTxInfos, error)
 	// types.EventGetTransactionByHash
@@ -117,10 +139,16 @@ type QueueProtocolAPI interface {
 	// +++++++++++++++ store interfaces begin
 	StoreGet(*types.StoreGet) (*types.StoreReplyValue, error)
 	StoreGetTotalCoins(*types.IterateRangeByStateHash) (*types.ReplyGetTotalCoins, error)
+	// types.EventStoreGetProof
+	StoreGetProof(*types.ReqStateProof) (*types.StateProof, error)
 	// --------------- store interfaces end
 
 	// +++++++++++++++ other interfaces begin
 	// close chain33
 	CloseQueue() (*types.Reply, error)
+	// get the stats of every queue topic
+	QueueStats() (*types.QueueStats, error)
+	// get the stats of transaction signature verification
+	SignVerifyStats() (*types.SignVerifyStats, error)
 	// --------------- other interfaces end
 }
@@ -0,0 +1,74 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+//远程签名服务, 私钥用 keystore 保存在独立的进程中,
+//钱包配置 signer="remote", 并且 [wallet.sub.remote] 中的 addr 和这里的 -addr 一致
+//keystore 的密码从环境变量 CHAIN33_SIGNER_PASSWORD 读取, 没有设置的时候从标准输入读取一行
+import (
+	"bufio"
+	"flag"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	clog "github.com/33cn/chain33/common/log"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/wallet/signer"
+
+	_ "github.com/33cn/chain33/system/crypto/init"
+)
+
+var addr = flag.String("addr", "unix://signer.sock", "listen address, unix://path or 127.0.0.1:port")
+var dir = flag.String("dir", "datadir/keystore", "keystore dir")
+var scryptN = flag.Int("scryptN", 0, "scrypt N of keystore, must be power of 2")
+var signType = flag.String("signtype", "secp256k1", "sign type: secp256k1, ed25519, sm2, schnorr")
+
+func readPassword() string {
+	password := os.Getenv("CHAIN33_SIGNER_PASSWORD")
+	if password != "" {
+		return password
+	}
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		panic("read password: " + err.Error())
+	}
+	return strings.TrimRight(line, "\r\n")
+}
+
+func main() {
+	clog.SetLogLevel("info")
+	flag.Parse()
+	ty := types.GetSignType("", *signType)
+	if ty == types.Invalid {
+		panic("sign type not support: " + *signType)
+	}
+	password := readPassword()
+	if password == "" {
+		panic("password is empty")
+	}
+	store, err := signer.NewKeystore(ty, *dir, *scryptN, 0)
+	if err != nil {
+		panic(err)
+	}
+	lis, err := signer.Listen(*addr)
+	if err != nil {
+		panic(err)
+	}
+	server := signer.NewRemoteServer(store, password, ty)
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-c
+		server.Stop()
+	}()
+	log.Info("signer start", "addr", *addr, "dir", *dir)
+	err = server.Serve(lis)
+	if err != nil {
+		log.Error("signer stop", "err", err)
+	}
+}
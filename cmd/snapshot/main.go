@@ -0,0 +1,65 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+//这个软件包的主要目的是导出已经同步好的区块链的状态快照
+//新节点启动的时候通过 -snapshot 参数导入快照, 然后从快照的高度开始同步
+//导出的时候节点必须是停止的状态
+import (
+	"flag"
+	"os"
+	"os/user"
+	"path/filepath"
+
+	"github.com/33cn/chain33/blockchain"
+	"github.com/33cn/chain33/common"
+	clog "github.com/33cn/chain33/common/log"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/store"
+	drivers "github.com/33cn/chain33/system/store"
+	"github.com/33cn/chain33/types"
+)
+
+var datadir = flag.String("datadir", "", "data dir of chain33, include logs and datas")
+var configPath = flag.String("f", "chain33.toml", "configfile")
+var output = flag.String("o", "chain33.snapshot", "snapshot file")
+
+func resetDatadir(cfg *types.Config, datadir string) {
+	// Check in case of paths like "/something/~/something/"
+	if datadir[:2] == "~/" {
+		usr, _ := user.Current()
+		dir := usr.HomeDir
+		datadir = filepath.Join(dir, datadir[2:])
+	}
+	log.Info("current user data dir is ", "dir", datadir)
+	cfg.BlockChain.DbPath = filepath.Join(datadir, cfg.BlockChain.DbPath)
+	cfg.Store.DbPath = filepath.Join(datadir, cfg.Store.DbPath)
+}
+
+func main() {
+	clog.SetLogLevel("info")
+	flag.Parse()
+	cfg, sub := types.InitCfg(*configPath)
+	if *datadir != "" {
+		resetDatadir(cfg, *datadir)
+	}
+	types.Init(cfg.Title, cfg)
+	s := store.New(cfg.Store, sub.Store)
+	defer s.Close()
+	st, ok := s.(drivers.SnapshotStore)
+	if !ok {
+		panic("store driver not support snapshot: " + cfg.Store.Name)
+	}
+	f, err := os.Create(*output)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+	meta, err := blockchain.ExportSnapshot(cfg.BlockChain, st, f)
+	if err != nil {
+		panic(err)
+	}
+	log.Info("export snapshot", "file", *output, "height", meta.Height, "hash", common.ToHex(meta.Hash), "stateHash", common.ToHex(meta.StateHash))
+}
@@ -37,6 +37,11 @@ type Crypto interface {
 	PubKeyFromBytes([]byte) (PubKey, error)
 }
 
+//支持批量验证签名的算法实现这个接口, 所有签名都有效时返回true
+type BatchVerifier interface {
+	VerifyBatch(pubs []PubKey, msgs [][]byte, sigs []Signature) bool
+}
+
 var (
 	drivers     = make(map[string]Crypto)
 	driversType = make(map[string]int)
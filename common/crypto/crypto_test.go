@@ -18,6 +18,8 @@ func TestAll(t *testing.T) {
 	testFromBytes(t, "ed25519")
 	testCrypto(t, "secp256k1")
 	testFromBytes(t, "secp256k1")
+	testCrypto(t, "schnorr")
+	testFromBytes(t, "schnorr")
 }
 
 func testFromBytes(t *testing.T, name string) {
@@ -193,6 +193,22 @@ func CalcMerkleRoot(txs []*types.Transaction) []byte {
 	return merkleroot
 }
 
+//CalcReceiptMerkleRoot 计算区块中全部交易执行结果的 merkle roothash
+func CalcReceiptMerkleRoot(receipts []*types.ReceiptData) []byte {
+	var hashes [][]byte
+	for _, receipt := range receipts {
+		hashes = append(hashes, receipt.Hash())
+	}
+	if hashes == nil {
+		return zeroHash[:]
+	}
+	merkleroot := GetMerkleRoot(hashes)
+	if merkleroot == nil {
+		panic("calc merkle root error")
+	}
+	return merkleroot
+}
+
 func CalcMerkleRootCache(txs []*types.TransactionCache) []byte {
 	var hashes [][]byte
 	for _, tx := range txs {
@@ -23,6 +23,9 @@ type StateDB struct {
 	height    int64
 	local     *db.SimpleMVCC
 	opt       *StateDBOption
+	//并行执行时记录读写过的key, 为nil 表示不记录
+	rset map[string]bool
+	wset map[string]bool
 }
 
 type StateDBOption struct {
@@ -76,6 +79,9 @@ func (s *StateDB) Rollback() {
 func (s *StateDB) Commit() {
 	for k, v := range s.txcache {
 		s.cache[k] = v
+		if s.wset != nil {
+			s.wset[k] = true
+		}
 	}
 	s.intx = false
 	s.keys = nil
@@ -98,6 +104,9 @@ func (s *StateDB) Get(key []byte) ([]byte, error) {
 
 func (s *StateDB) get(key []byte) ([]byte, error) {
 	skey := string(key)
+	if s.rset != nil {
+		s.rset[skey] = true
+	}
 	if s.intx && s.txcache != nil {
 		if value, ok := s.txcache[skey]; ok {
 			return value, nil
@@ -168,10 +177,66 @@ func (s *StateDB) Set(key []byte, value []byte) error {
 		setmap(s.txcache, skey, value)
 	} else {
 		setmap(s.cache, skey, value)
+		if s.wset != nil {
+			s.wset[skey] = true
+		}
 	}
 	return nil
 }
 
+//开启读写集合的记录
+func (s *StateDB) enableTrack() {
+	s.rset = make(map[string]bool)
+	s.wset = make(map[string]bool)
+}
+
+//fork 一个新的StateDB, 和原来的StateDB 读取同一个状态, 但是cache 相互独立
+//并行预执行交易的时候使用, 新的StateDB 会记录读写集合
+func (s *StateDB) fork(localdb db.KVDB) *StateDB {
+	forkdb := &StateDB{
+		cache:     make(map[string][]byte),
+		txcache:   make(map[string][]byte),
+		intx:      false,
+		client:    s.client,
+		stateHash: s.stateHash,
+		height:    s.height,
+		version:   s.version,
+		local:     db.NewSimpleMVCC(localdb),
+		opt:       s.opt,
+	}
+	forkdb.enableTrack()
+	return forkdb
+}
+
+//获取实际写入的kv, value 为nil 表示删除
+func (s *StateDB) getWriteSet() map[string][]byte {
+	kvs := make(map[string][]byte)
+	for k := range s.wset {
+		kvs[k] = s.cache[k]
+	}
+	return kvs
+}
+
+//判断读集合和已经写入的key 是否有冲突
+func (s *StateDB) isConflict(rset map[string]bool) bool {
+	for k := range rset {
+		if s.wset[k] {
+			return true
+		}
+	}
+	return false
+}
+
+//把预执行的结果合并到当前的状态
+func (s *StateDB) mergeWriteSet(kvs map[string][]byte) {
+	for k, v := range kvs {
+		setmap(s.cache, k, v)
+		if s.wset != nil {
+			s.wset[k] = true
+		}
+	}
+}
+
 func setmap(data map[string][]byte, key string, value []byte) {
 	if value == nil {
 		delete(data, key)
@@ -43,6 +43,8 @@ type Executor struct {
 	qclient      client.QueueProtocolAPI
 	pluginEnable map[string]bool
 	alias        map[string]string
+	//是否开启交易的并行执行
+	enableParallel bool
 }
 
 func execInit(sub map[string][]byte) {
@@ -72,6 +74,8 @@ func New(cfg *types.Exec, sub map[string][]byte) *Executor {
 	exec.pluginEnable["addrindex"] = !cfg.DisableAddrIndex
 	exec.pluginEnable["txindex"] = true
 	exec.pluginEnable["fee"] = true
+	exec.pluginEnable["logindex"] = cfg.EnableLogIndex
+	exec.enableParallel = cfg.EnableParallel
 
 	exec.alias = make(map[string]string)
 	for _, v := range cfg.Alias {
@@ -178,8 +182,14 @@ func (exec *Executor) procExecTxList(msg queue.Message) {
 	execute := newExecutor(datas.StateHash, exec, datas.Height, datas.BlockTime, datas.Difficulty, datas.Txs, nil)
 	execute.enableMVCC()
 	execute.api = exec.qclient
+	var preResults []*parallelResult
+	if exec.enableParallel && execute.canParallel() {
+		execute.stateDB.(*StateDB).enableTrack()
+		preResults = execute.preExecTxList(datas.Txs)
+	}
 	var receipts []*types.Receipt
 	index := 0
+	reexec := 0
 	for i := 0; i < len(datas.Txs); i++ {
 		tx := datas.Txs[i]
 		//检查groupcount
@@ -188,7 +198,16 @@ func (exec *Executor) procExecTxList(msg queue.Message) {
 			continue
 		}
 		if tx.GroupCount == 0 {
-			receipt, err := execute.execTx(tx, index)
+			var receipt *types.Receipt
+			var err error
+			if preResults != nil && execute.mergeResult(preResults[i], index) {
+				receipt, err = preResults[i].receipt, preResults[i].err
+			} else {
+				if preResults != nil {
+					reexec++
+				}
+				receipt, err = execute.execTx(tx, index)
+			}
 			if err != nil {
 				receipts = append(receipts, types.NewErrReceipt(err))
 				continue
@@ -221,6 +240,9 @@ func (exec *Executor) procExecTxList(msg queue.Message) {
 		receipts = append(receipts, receiptlist...)
 		index += int(tx.GroupCount)
 	}
+	if preResults != nil {
+		elog.Debug("procExecTxList parallel", "height", datas.Height, "ntx", len(datas.Txs), "reexec", reexec)
+	}
 	msg.Reply(exec.client.NewMessage("", types.EventReceipts,
 		&types.Receipts{receipts}))
 }
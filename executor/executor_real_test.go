@@ -12,6 +12,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/merkle"
 	_ "github.com/33cn/chain33/system"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
@@ -214,6 +215,71 @@ func TestExecBlock2(t *testing.T) {
 	}
 }
 
+func TestExecBlockReceiptHash(t *testing.T) {
+	mock33 := newMockNode()
+	defer mock33.Close()
+	mock33.WaitHeight(0)
+	block := mock33.GetBlock(0)
+	txs := util.GenCoinsTxs(mock33.GetGenesisKey(), 2)
+	block2 := util.CreateNewBlock(block, txs)
+	detail, _, err := util.ExecBlock(mock33.GetClient(), block.StateHash, block2, false, true)
+	assert.Nil(t, err)
+	assert.Equal(t, merkle.CalcReceiptMerkleRoot(detail.Receipts), detail.Block.ReceiptHash)
+	assert.Equal(t, detail.Block.ReceiptHash, detail.Block.GetHeader().ReceiptHash)
+
+	//receiptHash 不正确的区块执行失败
+	block3 := *detail.Block
+	block3.ReceiptHash = merkle.CalcReceiptMerkleRoot(nil)
+	_, _, err = util.ExecBlock(mock33.GetClient(), block.StateHash, &block3, true, true)
+	assert.Equal(t, types.ErrCheckReceiptHash, err)
+}
+
+func TestExecBlockParallel(t *testing.T) {
+	_, priv1 := util.Genaddress()
+	addr2, priv2 := util.Genaddress()
+	addr3, priv3 := util.Genaddress()
+	addr4, priv4 := util.Genaddress()
+	addr5, _ := util.Genaddress()
+	addr6, _ := util.Genaddress()
+	_, priv7 := util.Genaddress()
+	var txs1, txs2 []*types.Transaction
+	//genesis key
+	var genkey = util.TestPrivkeyList[1]
+	txs1 = append(txs1, util.CreateCoinsTx(genkey, addr2, 10*types.Coin))
+	txs1 = append(txs1, util.CreateCoinsTx(genkey, addr3, 10*types.Coin))
+	//互不冲突的交易
+	txs2 = append(txs2, util.CreateCoinsTx(priv2, addr4, types.Coin))
+	txs2 = append(txs2, util.CreateCoinsTx(priv3, addr5, types.Coin))
+	//依赖前面的交易的结果, 需要重新执行
+	txs2 = append(txs2, util.CreateCoinsTx(priv4, addr6, types.Coin/2))
+	//余额不足的交易
+	txs2 = append(txs2, util.CreateCoinsTx(priv7, addr6, types.Coin))
+	txs2 = append(txs2, util.CreateCoinsTx(priv1, addr6, types.Coin))
+	txs2 = append(txs2, util.CreateCoinsTx(genkey, addr2, types.Coin))
+
+	execBlocks := func(parallel bool) (*types.Block, []*types.ReceiptData) {
+		cfg, sub := testnode.GetDefaultConfig()
+		cfg.Consensus.Minerstart = false
+		cfg.Exec.EnableParallel = parallel
+		mock33 := testnode.NewWithConfig(cfg, sub, nil)
+		defer mock33.Close()
+		mock33.WaitHeight(0)
+		block := mock33.GetBlock(0)
+		block, err := util.ExecAndCheckBlock(mock33.GetClient(), block, txs1, types.ExecOk)
+		assert.Nil(t, err)
+		block2 := util.CreateNewBlock(block, txs2)
+		detail, _, err := util.ExecBlock(mock33.GetClient(), block.StateHash, block2, false, true)
+		assert.Nil(t, err)
+		return detail.Block, detail.Receipts
+	}
+	block1, receipts1 := execBlocks(false)
+	block2, receipts2 := execBlocks(true)
+	assert.Equal(t, 4, len(block1.Txs))
+	assert.Equal(t, block1.StateHash, block2.StateHash)
+	assert.Equal(t, block1.TxHash, block2.TxHash)
+	assert.Equal(t, receipts1, receipts2)
+}
+
 func TestExecBlock(t *testing.T) {
 	mock33 := newMockNode()
 	defer mock33.Close()
@@ -238,3 +304,66 @@ func BenchmarkExecBlock(b *testing.B) {
 		util.ExecBlock(mock33.GetClient(), nil, block, false, true)
 	}
 }
+
+func TestGetLogs(t *testing.T) {
+	cfg, sub := testnode.GetDefaultConfig()
+	cfg.Exec.EnableLogIndex = true
+	mock33 := testnode.NewWithConfig(cfg, sub, nil)
+	defer mock33.Close()
+	mock33.Listen()
+	mock33.WaitHeight(0)
+	addr1, _ := util.Genaddress()
+	addr2, _ := util.Genaddress()
+	hash1 := mock33.SendTx(util.CreateCoinsTx(mock33.GetGenesisKey(), addr1, types.Coin))
+	detail1, err := mock33.WaitTx(hash1)
+	assert.Nil(t, err)
+	hash2 := mock33.SendTx(util.CreateCoinsTx(mock33.GetGenesisKey(), addr2, types.Coin))
+	detail2, err := mock33.WaitTx(hash2)
+	assert.Nil(t, err)
+
+	getLogs := func(req *types.ReqGetLogs) *types.ReplyReceiptLogs {
+		msg, err := mock33.GetAPI().Query(types.ExecName("coins"), "GetLogs", req)
+		assert.Nil(t, err)
+		return msg.(*types.ReplyReceiptLogs)
+	}
+	//按地址查询
+	reply := getLogs(&types.ReqGetLogs{Addr: addr1, ToHeight: -1, Count: 100, Direction: 1})
+	assert.Equal(t, len(detail1.Receipt.Logs), len(reply.Logs))
+	for i, l := range reply.Logs {
+		assert.Equal(t, hash1, l.TxHash)
+		assert.Equal(t, int64(i), l.LogIndex)
+		assert.Equal(t, addr1, l.To)
+	}
+	//按执行器, 类型和高度查询
+	req := &types.ReqGetLogs{Execer: "coins", Tys: []int32{types.TyLogFee}, FromHeight: detail1.Height, ToHeight: detail2.Height, Count: 100, Direction: 1}
+	reply = getLogs(req)
+	assert.Equal(t, 2, len(reply.Logs))
+	assert.Equal(t, hash1, reply.Logs[0].TxHash)
+	assert.Equal(t, hash2, reply.Logs[1].TxHash)
+	req.Direction = 0
+	reply = getLogs(req)
+	assert.Equal(t, 2, len(reply.Logs))
+	assert.Equal(t, hash2, reply.Logs[0].TxHash)
+	req.FromHeight = detail2.Height
+	reply = getLogs(req)
+	assert.Equal(t, 1, len(reply.Logs))
+	assert.Equal(t, hash2, reply.Logs[0].TxHash)
+
+	//翻页查询的结果和一次查询的结果一致
+	all := getLogs(&types.ReqGetLogs{ToHeight: -1, Count: 100})
+	assert.True(t, len(all.Logs) > len(detail1.Receipt.Logs)+len(detail2.Receipt.Logs))
+	var pages []*types.ReceiptLogInfo
+	page := &types.ReqGetLogs{ToHeight: -1, Count: 3}
+	for {
+		reply = getLogs(page)
+		pages = append(pages, reply.Logs...)
+		if len(reply.Logs) < 3 {
+			break
+		}
+		page.PrimaryKey = reply.PrimaryKey
+	}
+	assert.Equal(t, all.Logs, pages)
+
+	_, err = mock33.GetAPI().Query(types.ExecName("coins"), "GetLogs", &types.ReqGetLogs{Count: 0})
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
@@ -186,3 +186,44 @@ func TestKeyLocalAllow(t *testing.T) {
 	assert.Equal(t, err, types.ErrLocalPrefix)
 	err = isAllowLocalKey([]byte("paracross"), []byte("LODB-user.p.para.paracross-xxxx"))
 }
+
+func TestLogIndexPlugin(t *testing.T) {
+	_, priv := util.Genaddress()
+	addr, _ := util.Genaddress()
+	tx1 := util.CreateCoinsTx(priv, addr, types.Coin)
+	tx2 := util.CreateNoneTx(priv)
+	receipt1 := &types.ReceiptData{Ty: types.ExecOk, Logs: []*types.ReceiptLog{{Ty: types.TyLogFee}, {Ty: types.TyLogTransfer}}}
+	receipt2 := &types.ReceiptData{Ty: types.ExecOk}
+	detail := &types.BlockDetail{
+		Block:    &types.Block{Height: 10, Txs: []*types.Transaction{tx1, tx2}},
+		Receipts: []*types.ReceiptData{receipt1, receipt2},
+	}
+	p := &logindexPlugin{}
+	exec := &executor{height: 10}
+	kvs, err := p.ExecLocal(exec, detail)
+	assert.Nil(t, err)
+	//每个log: all, exec, ty, from, to 五个索引
+	assert.Equal(t, 10, len(kvs))
+	heightindex := drivers.LogIndexStr(10, 0, 1)
+	assert.Equal(t, types.CalcLogIndexKey(heightindex), kvs[5].Key)
+	assert.Equal(t, types.CalcLogTyKey("coins", types.TyLogTransfer, heightindex), kvs[7].Key)
+	//log 只在 all 索引中保存, 其他索引保存 log 的位置
+	for i := 6; i < 10; i++ {
+		assert.Equal(t, []byte(heightindex), kvs[i].Value)
+	}
+	var info types.ReceiptLogInfo
+	assert.Nil(t, types.Decode(kvs[5].Value, &info))
+	assert.Equal(t, tx1.Hash(), info.TxHash)
+	assert.Equal(t, tx1.From(), info.From)
+	assert.Equal(t, addr, info.To)
+	assert.Equal(t, int64(1), info.LogIndex)
+
+	//回滚的时候删除全部的索引
+	delkvs, err := p.ExecDelLocal(exec, detail)
+	assert.Nil(t, err)
+	assert.Equal(t, len(kvs), len(delkvs))
+	for i := range kvs {
+		assert.Equal(t, kvs[i].Key, delkvs[i].Key)
+		assert.Nil(t, delkvs[i].Value)
+	}
+}
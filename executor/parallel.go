@@ -0,0 +1,103 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/types"
+)
+
+/*
+并行执行交易:
+1. 预执行: 每笔普通交易(非交易组)在 fork 出来的 StateDB 上并行执行, 并记录读写过的 key
+2. 合并: 按照区块中交易的顺序合并预执行的结果
+   如果交易读过的 key 被前面的交易修改过, 或者交易的 index 和预执行时不一致, 那么在当前状态上串行重新执行
+交易组, 以及不满足条件的区块, 全部串行执行, 保证 receipt 和 stateHash 和串行执行完全一致
+*/
+
+type parallelResult struct {
+	index   int
+	receipt *types.Receipt
+	err     error
+	rset    map[string]bool
+	wset    map[string][]byte
+}
+
+//只有 ForkExecRollback 以后才能并行执行, 之前的 StateDB 事务的语义不能按照交易拆分
+func (e *executor) canParallel() bool {
+	return e.height > 0 && types.IsFork(e.height, "ForkExecRollback")
+}
+
+func (e *executor) fork() *executor {
+	statedb := e.stateDB.(*StateDB)
+	localdb := NewLocalDB(statedb.client)
+	f := &executor{
+		stateDB:      statedb.fork(localdb),
+		localDB:      localdb,
+		coinsAccount: account.NewCoinsAccount(),
+		height:       e.height,
+		blocktime:    e.blocktime,
+		difficulty:   e.difficulty,
+		txs:          e.txs,
+		api:          e.api,
+		receipts:     e.receipts,
+	}
+	f.coinsAccount.SetDB(f.stateDB)
+	return f
+}
+
+//并行预执行交易列表中的普通交易, 交易组不预执行, 对应的结果为nil
+//预执行的时候, 假设前面的交易都执行成功, 以此计算交易的 index
+func (e *executor) preExecTxList(txs []*types.Transaction) []*parallelResult {
+	results := make([]*parallelResult, len(txs))
+	jobs := make(chan int, len(txs))
+	index := 0
+	for i := 0; i < len(txs); i++ {
+		if txs[i].GroupCount == 0 {
+			results[i] = &parallelResult{index: index}
+			jobs <- i
+			index++
+			continue
+		}
+		if txs[i].GroupCount > 0 {
+			index += int(txs[i].GroupCount)
+			i += int(txs[i].GroupCount) - 1
+		}
+	}
+	close(jobs)
+	var wg sync.WaitGroup
+	for n := 0; n < runtime.NumCPU(); n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				r := results[i]
+				f := e.fork()
+				r.receipt, r.err = f.execTx(txs[i], r.index)
+				statedb := f.stateDB.(*StateDB)
+				r.rset = statedb.rset
+				r.wset = statedb.getWriteSet()
+			}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+//合并预执行的结果, 如果结果失效返回 false, 需要重新串行执行
+func (e *executor) mergeResult(r *parallelResult, index int) bool {
+	if r == nil || r.index != index {
+		return false
+	}
+	statedb := e.stateDB.(*StateDB)
+	if statedb.isConflict(r.rset) {
+		return false
+	}
+	statedb.mergeWriteSet(r.wset)
+	return true
+}
@@ -0,0 +1,83 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+)
+
+func init() {
+	RegisterPlugin("logindex", &logindexPlugin{pluginBase: &pluginBase{}})
+}
+
+//receipt log 的索引, 用于按照执行器, log类型, 地址查询 log
+type logindexPlugin struct {
+	*pluginBase
+}
+
+func (p *logindexPlugin) CheckEnable(executor *executor, enable bool) (kvs []*types.KeyValue, ok bool, err error) {
+	kvs, ok, err = p.checkFlag(executor, types.LogIndexFlag(), enable)
+	if err == types.ErrDBFlag {
+		panic("logindex config is enable, it must be synchronized from 0 height ")
+	}
+	return kvs, ok, err
+}
+
+func (p *logindexPlugin) ExecLocal(executor *executor, data *types.BlockDetail) ([]*types.KeyValue, error) {
+	var set types.LocalDBSet
+	for i := 0; i < len(data.Block.Txs); i++ {
+		set.KV = append(set.KV, getLogIndex(executor, data.Block.Txs[i], data.Receipts[i], i)...)
+	}
+	return set.KV, nil
+}
+
+func (p *logindexPlugin) ExecDelLocal(executor *executor, data *types.BlockDetail) ([]*types.KeyValue, error) {
+	var set types.LocalDBSet
+	for i := 0; i < len(data.Block.Txs); i++ {
+		//del: log index
+		kvdel := getLogIndex(executor, data.Block.Txs[i], data.Receipts[i], i)
+		for k := range kvdel {
+			kvdel[k].Value = nil
+		}
+		set.KV = append(set.KV, kvdel...)
+	}
+	return set.KV, nil
+}
+
+//交易中每个log的索引, log 只在 all 索引中保存一份,
+//其他索引的 value 是 log 在 all 索引中的位置, 查询的时候再读取 log
+func getLogIndex(executor *executor, tx *types.Transaction, receipt *types.ReceiptData, index int) (kvs []*types.KeyValue) {
+	if len(receipt.GetLogs()) == 0 {
+		return nil
+	}
+	txhash := tx.Hash()
+	execer := string(tx.Execer)
+	from := tx.From()
+	to := tx.GetRealToAddr()
+	for i, l := range receipt.Logs {
+		info := &types.ReceiptLogInfo{
+			Height:   executor.height,
+			Index:    int64(index),
+			LogIndex: int64(i),
+			TxHash:   txhash,
+			Execer:   execer,
+			From:     from,
+			To:       to,
+			Ty:       l.Ty,
+			Log:      l.Log,
+		}
+		heightindex := drivers.LogIndexStr(info.Height, info.Index, info.LogIndex)
+		ref := []byte(heightindex)
+		kvs = append(kvs, &types.KeyValue{Key: types.CalcLogIndexKey(heightindex), Value: types.Encode(info)})
+		kvs = append(kvs, &types.KeyValue{Key: types.CalcLogExecKey(execer, heightindex), Value: ref})
+		kvs = append(kvs, &types.KeyValue{Key: types.CalcLogTyKey(execer, l.Ty, heightindex), Value: ref})
+		kvs = append(kvs, &types.KeyValue{Key: types.CalcLogAddrKey(from, heightindex), Value: ref})
+		if to != "" && to != from {
+			kvs = append(kvs, &types.KeyValue{Key: types.CalcLogAddrKey(to, heightindex), Value: ref})
+		}
+	}
+	return kvs
+}
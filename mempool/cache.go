@@ -6,7 +6,9 @@ package mempool
 
 import (
 	"container/list"
+	"sort"
 
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
 )
 
@@ -19,6 +21,10 @@ type txCache struct {
 	txList     *list.List
 	txFrontTen []*types.Transaction
 	accMap     map[string][]*types.Transaction
+	// 按照手续费优先级排序, 并且支持淘汰低手续费交易和替换交易
+	priority bool
+	// 交易的本地存储, 为nil 表示不保存
+	db dbm.DB
 }
 
 // Item为Mempool中包装交易的数据结构
@@ -66,7 +72,7 @@ func (cache *txCache) Push(tx *types.Transaction) error {
 			// 超过2分钟之后的重发交易返回nil，再次发送给P2P，但是不再次加入mempool
 			// 并修改其enterTime，以避免该交易一直在节点间被重发
 			newEnterTime := types.Now().Unix()
-			resendItem := &Item{value: tx, priority: tx.Fee, enterTime: newEnterTime}
+			resendItem := &Item{value: tx, priority: txPriority(tx), enterTime: newEnterTime}
 			newItem := cache.txList.InsertAfter(resendItem, cache.txMap[string(hash)])
 			cache.txList.Remove(cache.txMap[string(hash)])
 			cache.txMap[string(hash)] = newItem
@@ -75,11 +81,29 @@ func (cache *txCache) Push(tx *types.Transaction) error {
 		}
 	}
 
+	priority := txPriority(tx)
+	if cache.priority {
+		if replaced := cache.getReplaceTx(tx); replaced != nil {
+			// 替换交易的手续费必须比原来的交易高出一定比例
+			if priority*100 < txPriority(replaced)*(100+replaceFeeBump) {
+				return types.ErrReplaceFeeTooLow
+			}
+			cache.Remove(replaced.Hash())
+		} else if cache.txList.Len() >= cache.size {
+			// mempool满了, 淘汰手续费最低的交易
+			lowest := cache.getLowestItem()
+			if lowest == nil || lowest.priority >= priority {
+				return types.ErrMemFull
+			}
+			cache.Remove(lowest.value.Hash())
+		}
+	}
+
 	if cache.txList.Len() >= cache.size {
 		return types.ErrMemFull
 	}
 
-	it := &Item{value: tx, priority: tx.Fee, enterTime: types.Now().Unix()}
+	it := &Item{value: tx, priority: priority, enterTime: types.Now().Unix()}
 	txElement := cache.txList.PushBack(it)
 	cache.txMap[string(hash)] = txElement
 
@@ -91,6 +115,7 @@ func (cache *txCache) Push(tx *types.Transaction) error {
 		cache.txFrontTen = cache.txFrontTen[len(cache.txFrontTen)-9:]
 	}
 	cache.txFrontTen = append(cache.txFrontTen, tx)
+	cache.journalAdd(tx)
 
 	return nil
 }
@@ -104,6 +129,7 @@ func (cache *txCache) GetLatestTx() []*types.Transaction {
 func (cache *txCache) Remove(hash []byte) {
 	value := cache.txList.Remove(cache.txMap[string(hash)])
 	delete(cache.txMap, string(hash))
+	cache.journalDel(hash)
 	// 账户交易数量减1
 	if value == nil {
 		return
@@ -166,3 +192,58 @@ func (cache *txCache) AccountTxNumDecrease(addr string, hash []byte) {
 		}
 	}
 }
+
+// txPriority计算交易的优先级，为每千字节的手续费，交易组按整个组计算
+func txPriority(tx *types.Transaction) int64 {
+	size := int64(tx.Size())
+	if size <= 0 {
+		return tx.Fee
+	}
+	return tx.Fee * 1000 / size
+}
+
+// txCache.getReplaceTx返回同一账户下Nonce相同的待替换交易，不存在返回nil
+// Nonce为0的交易不参与替换
+// 注意: 替换只影响本节点的mempool，被替换的交易如果已经广播出去，仍然可能被其他节点打包
+func (cache *txCache) getReplaceTx(tx *types.Transaction) *types.Transaction {
+	if tx.Nonce == 0 {
+		return nil
+	}
+	for _, t := range cache.accMap[tx.From()] {
+		if t.Nonce == tx.Nonce {
+			return t
+		}
+	}
+	return nil
+}
+
+// txCache.IsReplaceTx判断tx是否是对mempool中交易的替换
+func (cache *txCache) IsReplaceTx(tx *types.Transaction) bool {
+	return cache.priority && cache.getReplaceTx(tx) != nil
+}
+
+// txCache.getLowestItem返回优先级最低的交易，优先级相同的情况下返回最晚加入的交易
+func (cache *txCache) getLowestItem() *Item {
+	var lowest *Item
+	for e := cache.txList.Back(); e != nil; e = e.Prev() {
+		item := e.Value.(*Item)
+		if lowest == nil || item.priority < lowest.priority {
+			lowest = item
+		}
+	}
+	return lowest
+}
+
+// txCache.GetSortedItems返回按优先级从高到低排序的交易，优先级相同的按加入时间排序
+func (cache *txCache) GetSortedItems() []*Item {
+	items := make([]*Item, 0, cache.txList.Len())
+	for e := cache.txList.Front(); e != nil; e = e.Next() {
+		items = append(items, e.Value.(*Item))
+	}
+	if cache.priority {
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].priority > items[j].priority
+		})
+	}
+	return items
+}
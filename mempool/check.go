@@ -26,9 +26,9 @@ func (mem *Mempool) checkTx(msg queue.Message) queue.Message {
 		return msg
 	}
 
-	// 检查交易账户在Mempool中是否存在过多交易
+	// 检查交易账户在Mempool中是否存在过多交易, 替换交易不增加账户的交易数量
 	from := tx.From()
-	if mem.TxNumOfAccount(from) >= maxTxNumPerAccount {
+	if mem.TxNumOfAccount(from) >= maxTxNumPerAccount && !mem.IsReplaceTx(tx) {
 		msg.Data = types.ErrManyTx
 		return msg
 	}
@@ -18,6 +18,7 @@ var (
 	mempoolDupResendInterval int64 = 120    // mempool重复交易可再次发送间隔，120秒
 	mempoolAddedTxSize             = 102400 // 已添加过的交易缓存大小
 	maxTxNumPerAccount       int64 = 100    // TODO 每个账户在mempool中最大交易数量，10
+	replaceFeeBump           int64 = 10     // 替换交易的手续费至少要比原交易高出的百分比
 	processNum               int
 )
 
@@ -0,0 +1,48 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"sync"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+)
+
+//LightMempool 轻节点不执行交易, 也不保存交易
+//只回复 p2p 模块查询 mempool 大小的消息, 其他节点广播的交易直接丢弃
+type LightMempool struct {
+	client queue.Client
+	wg     sync.WaitGroup
+}
+
+func NewLight() *LightMempool {
+	return &LightMempool{}
+}
+
+func (mem *LightMempool) SetQueueClient(client queue.Client) {
+	mem.client = client
+	mem.client.Sub("mempool")
+	mem.wg.Add(1)
+	go func() {
+		defer mem.wg.Done()
+		for msg := range mem.client.Recv() {
+			switch msg.Ty {
+			case types.EventGetMempoolSize:
+				msg.Reply(mem.client.NewMessage("", types.EventMempoolSize, &types.MempoolSize{}))
+			case types.EventTx:
+				msg.Reply(mem.client.NewMessage("", types.EventReply, &types.Reply{IsOk: false, Msg: []byte(types.ErrNotSupport.Error())}))
+			default:
+				msg.Reply(mem.client.NewMessage("", msg.Ty, types.ErrNotSupport))
+			}
+		}
+	}()
+}
+
+func (mem *LightMempool) Close() {
+	mem.client.Close()
+	mem.wg.Wait()
+	mlog.Info("light mempool closed")
+}
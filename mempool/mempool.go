@@ -50,6 +50,8 @@ func New(cfg *types.MemPool) *Mempool {
 	pool := &Mempool{}
 	initConfig(cfg)
 	pool.cache = newTxCache(poolCacheSize)
+	pool.cache.priority = cfg.PriorityMode
+	pool.cache.db = newJournalDB(cfg)
 	pool.in = make(chan queue.Message)
 	pool.out = make(<-chan queue.Message)
 	pool.done = make(chan struct{})
@@ -129,7 +131,7 @@ func (mem *Mempool) TxNumOfAccount(addr string) int64 {
 	return mem.cache.TxNumOfAccount(addr)
 }
 
-// Mempool.GetTxList从txCache中返回给定数目的tx
+// Mempool.GetTxList从txCache中返回给定数目的tx，优先级模式下按每千字节手续费从高到低返回
 func (mem *Mempool) GetTxList(hashList *types.TxHashList) []*types.Transaction {
 	mem.proxyMtx.Lock()
 	defer mem.proxyMtx.Unlock()
@@ -140,12 +142,11 @@ func (mem *Mempool) GetTxList(hashList *types.TxHashList) []*types.Transaction {
 	}
 	var result []*types.Transaction
 	i := 0
-	txlist := mem.cache.txList
-	for v := txlist.Front(); v != nil; v = v.Next() {
-		if v.Value.(*Item).value.IsExpire(mem.header.GetHeight(), mem.header.GetBlockTime()) {
+	for _, item := range mem.cache.GetSortedItems() {
+		if item.value.IsExpire(mem.header.GetHeight(), mem.header.GetBlockTime()) {
 			continue
 		} else {
-			tx := v.Value.(*Item).value
+			tx := item.value
 			if _, ok := dupMap[string(tx.Hash())]; ok {
 				continue
 			}
@@ -159,6 +160,24 @@ func (mem *Mempool) GetTxList(hashList *types.TxHashList) []*types.Transaction {
 	return result
 }
 
+// Mempool.GetTxsByShortIDs返回短id在请求中的交易, 短id冲突的交易都返回, 由调用者处理
+// txMap的key就是交易hash, 不需要重新计算交易hash, 也不需要复制整个Mempool
+func (mem *Mempool) GetTxsByShortIDs(req *types.ReqTxsByShortIDs) []*types.Transaction {
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	ids := make(map[uint64]bool, len(req.GetShortIDs()))
+	for _, id := range req.GetShortIDs() {
+		ids[id] = true
+	}
+	var result []*types.Transaction
+	for hash, v := range mem.cache.txMap {
+		if ids[types.CalcTxShortID(req.GetSalt(), []byte(hash))] {
+			result = append(result, v.Value.(*Item).value)
+		}
+	}
+	return result
+}
+
 // Mempool.RemoveExpiredAndDuplicateMempoolTxs删除过期交易然后复制并返回Mempool内交易
 func (mem *Mempool) RemoveExpiredAndDuplicateMempoolTxs() []*types.Transaction {
 	mem.proxyMtx.Lock()
@@ -249,6 +268,13 @@ func (mem *Mempool) PushTx(tx *types.Transaction) error {
 	return err
 }
 
+// Mempool.IsReplaceTx判断交易是否是对Mempool中交易的替换
+func (mem *Mempool) IsReplaceTx(tx *types.Transaction) bool {
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	return mem.cache.IsReplaceTx(tx)
+}
+
 // Mempool.GetLatestTx返回最新十条加入到Mempool的交易
 func (mem *Mempool) GetLatestTx() []*types.Transaction {
 	mem.proxyMtx.Lock()
@@ -308,21 +334,14 @@ func (mem *Mempool) RemoveBlockedTxs() {
 			}
 
 			// 发送Hash过后的交易列表给blockchain模块
-			hashList := mem.client.NewMessage("blockchain", types.EventTxHashList, &checkHashList)
-			err := mem.client.Send(hashList, true)
-			if err != nil {
-				mlog.Error("blockchain closed", "err", err.Error())
+			dupTxs, err := mem.getDupTxs(&checkHashList)
+			if err == types.ErrChannelClosed {
 				return
 			}
-			dupTxList, err := mem.client.Wait(hashList)
 			if err != nil {
-				mlog.Error("blockchain get txhashlist err", "err", err)
 				continue
 			}
 
-			// 取出blockchain返回的重复交易列表
-			dupTxs := dupTxList.GetData().(*types.TxHashList).Hashes
-
 			if len(dupTxs) == 0 {
 				continue
 			}
@@ -342,6 +361,23 @@ func (mem *Mempool) RemoveBlockedTxs() {
 	}
 }
 
+// Mempool.getDupTxs发送交易哈希列表给blockchain模块，返回已经打包的交易哈希
+func (mem *Mempool) getDupTxs(hashList *types.TxHashList) ([][]byte, error) {
+	msg := mem.client.NewMessage("blockchain", types.EventTxHashList, hashList)
+	err := mem.client.Send(msg, true)
+	if err != nil {
+		mlog.Error("blockchain closed", "err", err.Error())
+		return nil, types.ErrChannelClosed
+	}
+	dupTxList, err := mem.client.Wait(msg)
+	if err != nil {
+		mlog.Error("blockchain get txhashlist err", "err", err)
+		return nil, err
+	}
+	// 取出blockchain返回的重复交易列表
+	return dupTxList.GetData().(*types.TxHashList).Hashes, nil
+}
+
 // Mempool.GetHeader获取Mempool.header
 func (mem *Mempool) GetHeader() *types.Header {
 	mem.proxyMtx.Lock()
@@ -381,6 +417,18 @@ func (mem *Mempool) SendTxToP2P(tx *types.Transaction) {
 	mlog.Debug("tx sent to p2p", "tx.Hash", common.ToHex(tx.Hash()))
 }
 
+// Mempool.SendTxToRPC向"rpc"发送消息，推送给订阅者，消息队列满的时候直接丢弃
+func (mem *Mempool) SendTxToRPC(tx *types.Transaction) {
+	if !types.IsEnable("subscribe") {
+		return
+	}
+	msg := mem.client.NewMessage("rpc", types.EventPushTx, tx)
+	err := mem.client.SendTimeout(msg, false, 0)
+	if err != nil {
+		mlog.Debug("SendTxToRPC", "tx.Hash", common.ToHex(tx.Hash()), "err", err)
+	}
+}
+
 // Mempool.CheckExpireValid检查交易过期有效性，过期返回false，未过期返回true
 func (mem *Mempool) CheckExpireValid(msg queue.Message) (bool, error) {
 	mem.proxyMtx.Lock()
@@ -415,6 +463,9 @@ func (mem *Mempool) Close() {
 	mem.removeBlockTicket.Stop()
 	mlog.Info("mempool module closing")
 	mem.wg.Wait()
+	if mem.cache.db != nil {
+		mem.cache.db.Close()
+	}
 	mlog.Info("mempool module closed")
 }
 
@@ -455,6 +506,7 @@ func (mem *Mempool) pollLastHeader() {
 		}
 		h := lastHeader.(queue.Message).Data.(*types.Header)
 		mem.setHeader(h)
+		mem.reloadTxs()
 		return
 	}
 }
@@ -586,7 +638,9 @@ func (mem *Mempool) SetQueueClient(client queue.Client) {
 				m.Reply(mem.client.NewMessage("rpc", types.EventReply,
 					&types.Reply{false, []byte(m.Err().Error())}))
 			} else {
-				mem.SendTxToP2P(m.GetData().(types.TxGroup).Tx())
+				tx := m.GetData().(types.TxGroup).Tx()
+				mem.SendTxToP2P(tx)
+				mem.SendTxToRPC(tx)
 				m.Reply(mem.client.NewMessage("rpc", types.EventReply, &types.Reply{true, nil}))
 			}
 		}
@@ -613,6 +667,10 @@ func (mem *Mempool) SetQueueClient(client queue.Client) {
 				// 消息类型EventGetMempool：获取Mempool内所有交易
 				msg.Reply(mem.client.NewMessage("rpc", types.EventReplyTxList,
 					&types.ReplyTxList{mem.RemoveExpiredAndDuplicateMempoolTxs()}))
+			case types.EventGetTxsByShortIDs:
+				// 消息类型EventGetTxsByShortIDs：按压缩区块的短id获取交易
+				req := msg.GetData().(*types.ReqTxsByShortIDs)
+				msg.Reply(mem.client.NewMessage("", types.EventReplyTxList, &types.ReplyTxList{Txs: mem.GetTxsByShortIDs(req)}))
 			case types.EventTxList:
 				// 消息类型EventTxList：获取Mempool中一定数量交易
 				hashList := msg.GetData().(*types.TxHashList)
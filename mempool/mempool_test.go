@@ -6,7 +6,9 @@ package mempool
 
 import (
 	"errors"
+	"io/ioutil"
 	"math/rand"
+	"os"
 	"testing"
 
 	"github.com/33cn/chain33/blockchain"
@@ -569,6 +571,36 @@ func TestDuplicateMempool(t *testing.T) {
 	}
 }
 
+func TestGetTxsByShortIDs(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+
+	// add 10 txs
+	err := add10Tx(mem.client)
+	if err != nil {
+		t.Error("add tx error", err.Error())
+		return
+	}
+
+	salt := []byte("blockhash")
+	req := &types.ReqTxsByShortIDs{Salt: salt, ShortIDs: []uint64{types.CalcTxShortID(salt, tx2.Hash()), types.CalcTxShortID(salt, tx11.Hash())}}
+	msg := mem.client.NewMessage("mempool", types.EventGetTxsByShortIDs, req)
+	mem.client.Send(msg, true)
+
+	reply, err := mem.client.Wait(msg)
+
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	txs := reply.GetData().(*types.ReplyTxList).GetTxs()
+	if len(txs) != 1 || string(txs[0].Hash()) != string(tx2.Hash()) {
+		t.Error("TestGetTxsByShortIDs failed")
+	}
+}
+
 func TestGetLatestTx(t *testing.T) {
 	q, mem := initEnv(0)
 	defer q.Close()
@@ -790,6 +822,133 @@ func TestAddTxGroup(t *testing.T) {
 	}
 }
 
+func TestPriorityGetTxList(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+	mem.cache.priority = true
+
+	_, err := add4TxHash(mem.client)
+	if err != nil {
+		t.Error("add tx error", err.Error())
+		return
+	}
+	txs := mem.GetTxList(&types.TxHashList{Count: 4})
+	hashes := []string{string(tx5.Hash()), string(tx4.Hash()), string(tx3.Hash()), string(tx2.Hash())}
+	if len(txs) != len(hashes) {
+		t.Error("get txlist number error", len(txs))
+		return
+	}
+	for i, tx := range txs {
+		if hashes[i] != string(tx.Hash()) {
+			t.Error("gettxlist not in fee order", "index", i)
+		}
+	}
+}
+
+func TestPriorityEvictLowFee(t *testing.T) {
+	q, mem := initEnv(4)
+	defer q.Close()
+	defer mem.Close()
+	mem.cache.priority = true
+
+	err := add4Tx(mem.client)
+	if err != nil {
+		t.Error("add tx error", err.Error())
+		return
+	}
+	msg5 := mem.client.NewMessage("mempool", types.EventTx, tx5)
+	mem.client.Send(msg5, true)
+	mem.client.Wait(msg5)
+
+	if mem.Size() != 4 || !mem.cache.Exists(tx5.Hash()) || mem.cache.Exists(tx1.Hash()) {
+		t.Error("TestPriorityEvictLowFee failed", mem.Size(), mem.cache.Exists(tx5.Hash()))
+	}
+	lowtx := createTx(mainPriv, toAddr, 10000)
+	lowtx.Fee = 1000
+	lowtx.Sign(types.SECP256K1, mainPriv)
+	if err := mem.PushTx(lowtx); err != types.ErrMemFull {
+		t.Error("TestPriorityEvictLowFee low fee tx must be rejected", err)
+	}
+}
+
+func TestReplaceTx(t *testing.T) {
+	cache := newTxCache(10)
+	cache.priority = true
+	to, _ := genaddress()
+	tx := createTx(mainPriv, to, 10000)
+	err := cache.Push(tx)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	//手续费增加不够
+	ctx := *tx
+	ctx.Fee = tx.Fee + tx.Fee/20
+	ctx.Sign(types.SECP256K1, mainPriv)
+	if !cache.IsReplaceTx(&ctx) {
+		t.Error("TestReplaceTx must be replace tx")
+	}
+	if err := cache.Push(&ctx); err != types.ErrReplaceFeeTooLow {
+		t.Error("TestReplaceTx replace fee too low", err)
+	}
+	ctx.Fee = tx.Fee * 2
+	ctx.Sign(types.SECP256K1, mainPriv)
+	if err := cache.Push(&ctx); err != nil {
+		t.Error("TestReplaceTx replace failed", err)
+	}
+	if cache.Size() != 1 || cache.Exists(tx.Hash()) || !cache.Exists(ctx.Hash()) {
+		t.Error("TestReplaceTx replace tx not removed")
+	}
+	if cache.TxNumOfAccount(tx.From()) != 1 {
+		t.Error("TestReplaceTx account tx num error")
+	}
+}
+
+func TestReloadTxs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mempool")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+	cfg, _ := types.InitCfg("../cmd/chain33/chain33.test.toml")
+	cfg.MemPool.DbPath = dir
+
+	q := queue.New("channel")
+	blockchainProcess(q)
+	execProcess(q)
+	mem := New(cfg.MemPool)
+	mem.SetQueueClient(q.Client())
+	mem.setSync(true)
+	mem.WaitPollLastHeader()
+	err = add4Tx(mem.client)
+	if err != nil {
+		t.Error("add tx error", err.Error())
+		return
+	}
+	if mem.Size() != 4 {
+		t.Error("TestReloadTxs add tx failed", mem.Size())
+	}
+	mem.Close()
+	q.Close()
+
+	//tx3 已经被打包, tx1 已经过期
+	q = queue.New("channel")
+	dupBlockchainProcess(q, &types.Header{Height: 2, BlockTime: 1}, [][]byte{tx3.Hash()})
+	mem = New(cfg.MemPool)
+	mem.SetQueueClient(q.Client())
+	mem.WaitPollLastHeader()
+	defer q.Close()
+	defer mem.Close()
+	if mem.Size() != 2 || !mem.cache.Exists(tx2.Hash()) || !mem.cache.Exists(tx4.Hash()) {
+		t.Error("TestReloadTxs reload failed", mem.Size())
+	}
+	if len(mem.cache.loadJournal()) != 2 {
+		t.Error("TestReloadTxs journal not cleaned")
+	}
+}
+
 func BenchmarkMempool(b *testing.B) {
 	q, mem := initEnv(0)
 	defer q.Close()
@@ -827,6 +986,22 @@ func blockchainProcess(q queue.Queue) {
 	}()
 }
 
+func dupBlockchainProcess(q queue.Queue, header *types.Header, dupTxs [][]byte) {
+	go func() {
+		client := q.Client()
+		client.Sub("blockchain")
+		for msg := range client.Recv() {
+			if msg.Ty == types.EventGetLastHeader {
+				msg.Reply(client.NewMessage("", types.EventHeader, header))
+			} else if msg.Ty == types.EventIsSync {
+				msg.Reply(client.NewMessage("", types.EventReplyIsSync, &types.IsCaughtUp{Iscaughtup: true}))
+			} else if msg.Ty == types.EventTxHashList {
+				msg.Reply(client.NewMessage("", types.EventTxHashListReply, &types.TxHashList{Hashes: dupTxs}))
+			}
+		}
+	}()
+}
+
 func execProcess(q queue.Queue) {
 	go func() {
 		client := q.Client()
@@ -0,0 +1,124 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+//mempool 中的交易保存到本地数据库，节点重启以后重新加载
+
+var txJournalPrefix = []byte("mempool-tx-")
+
+func txJournalKey(hash []byte) []byte {
+	return append(append([]byte{}, txJournalPrefix...), hash...)
+}
+
+func newJournalDB(cfg *types.MemPool) dbm.DB {
+	if cfg.DbPath == "" {
+		return nil
+	}
+	driver := cfg.Driver
+	if driver == "" {
+		driver = "leveldb"
+	}
+	return dbm.NewDB("mempool", driver, cfg.DbPath, cfg.DbCache)
+}
+
+// txCache.journalAdd将交易写入本地数据库
+func (cache *txCache) journalAdd(tx *types.Transaction) {
+	if cache.db == nil {
+		return
+	}
+	err := cache.db.Set(txJournalKey(tx.Hash()), types.Encode(tx))
+	if err != nil {
+		mlog.Error("journalAdd", "err", err)
+	}
+}
+
+// txCache.journalDel从本地数据库删除交易
+func (cache *txCache) journalDel(hash []byte) {
+	if cache.db == nil {
+		return
+	}
+	err := cache.db.Delete(txJournalKey(hash))
+	if err != nil {
+		mlog.Error("journalDel", "err", err)
+	}
+}
+
+// txCache.loadJournal读取本地数据库中保存的全部交易
+func (cache *txCache) loadJournal() []*types.Transaction {
+	if cache.db == nil {
+		return nil
+	}
+	var txs []*types.Transaction
+	values := dbm.NewListHelper(cache.db).PrefixScan(txJournalPrefix)
+	for _, value := range values {
+		tx := &types.Transaction{}
+		err := types.Decode(value, tx)
+		if err != nil {
+			mlog.Error("loadJournal", "err", err)
+			continue
+		}
+		txs = append(txs, tx)
+	}
+	return txs
+}
+
+// Mempool.reloadTxs重新加载本地数据库中保存的交易，删除过期的以及已经打包的交易
+func (mem *Mempool) reloadTxs() {
+	mem.proxyMtx.Lock()
+	txs := mem.cache.loadJournal()
+	mem.proxyMtx.Unlock()
+	if len(txs) == 0 {
+		return
+	}
+	header := mem.GetHeader()
+	var valid []*types.Transaction
+	var hashList types.TxHashList
+	for _, tx := range txs {
+		mem.proxyMtx.Lock()
+		ok := mem.checkExpireValid(tx)
+		mem.proxyMtx.Unlock()
+		if !ok || tx.Check(header.GetHeight(), mem.GetMinFee()) != nil {
+			mem.cache.journalDel(tx.Hash())
+			continue
+		}
+		valid = append(valid, tx)
+		hashList.Hashes = append(hashList.Hashes, tx.Hash())
+	}
+	if len(valid) == 0 {
+		return
+	}
+	hashList.Count = header.GetHeight()
+	dupTxs, err := mem.getDupTxs(&hashList)
+	if err != nil {
+		mlog.Error("reloadTxs", "err", err)
+		return
+	}
+	dupMap := make(map[string]bool)
+	for _, hash := range dupTxs {
+		dupMap[string(hash)] = true
+	}
+	count := 0
+	for _, tx := range valid {
+		hash := tx.Hash()
+		if dupMap[string(hash)] {
+			mem.addedTxs.Add(string(hash), nil)
+			mem.cache.journalDel(hash)
+			continue
+		}
+		err := mem.PushTx(tx)
+		if err != nil && err != types.ErrTxExist {
+			mlog.Error("reloadTxs", "err", err)
+			mem.cache.journalDel(hash)
+			continue
+		}
+		count++
+	}
+	mlog.Info("reloadTxs", "journal", len(txs), "reload", count)
+}
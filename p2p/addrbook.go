@@ -339,6 +339,30 @@ func (a *AddrBook) GetAddrs() []string {
 	return addrlist
 }
 
+//savePeerBan 保存节点的封禁记录
+func (a *AddrBook) savePeerBan(score *types.PeerScore) {
+	a.bookDb.Set([]byte(peerBanTag+score.GetAddr()), types.Encode(score))
+}
+
+func (a *AddrBook) deletePeerBan(addr string) {
+	a.bookDb.Delete([]byte(peerBanTag + addr))
+}
+
+func (a *AddrBook) loadPeerBans() []*types.PeerScore {
+	var scores []*types.PeerScore
+	iteror := a.bookDb.Iterator([]byte(peerBanTag), nil, false)
+	defer iteror.Close()
+	for iteror.Next() {
+		var score types.PeerScore
+		if err := types.Decode(iteror.Value(), &score); err != nil {
+			log.Error("loadPeerBans", "key", string(iteror.Key()), "err", err)
+			continue
+		}
+		scores = append(scores, &score)
+	}
+	return scores
+}
+
 func (a *AddrBook) initKey() {
 
 	priv, pub, err := P2pComm.GenPrivPubkey()
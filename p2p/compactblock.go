@@ -0,0 +1,302 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/33cn/chain33/common/merkle"
+	pb "github.com/33cn/chain33/types"
+	lru "github.com/hashicorp/golang-lru"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+//压缩区块的广播:
+//1. 发送方只发送区块头和交易的短id, 挖矿交易直接放在压缩区块中
+//2. 接收方从mempool中找到短id对应的交易恢复区块, 缺失的交易通过GetBlockTxs向其他节点请求
+//3. 恢复的区块交易默克尔根不一致(短id冲突)的时候, 请求区块中的所有交易
+//恢复区块可能需要请求其他节点, 在单独的协程中进行, 不阻塞读取消息
+//双方在Version2中协商区块广播协议的版本, 不支持压缩区块的节点仍然发送完整的区块
+
+const (
+	fullBlockRelay    int32 = 0
+	compactBlockRelay int32 = 1
+	//交易通过交易清单广播, 见txrelay.go
+	invTxRelay int32 = 2
+	//当前节点支持的区块广播协议的版本
+	relayVersion   = invTxRelay
+	blockCacheSize = 128
+	//同时恢复的压缩区块数, 超过的区块由区块同步处理
+	maxCompactWorkers = 8
+)
+
+var errCompactBlock = errors.New("ErrCompactBlock")
+
+//双方都支持的区块广播协议版本
+func negotiateRelay(remote int32) int32 {
+	if remote < fullBlockRelay {
+		return fullBlockRelay
+	}
+	if remote > relayVersion {
+		return relayVersion
+	}
+	return remote
+}
+
+//广播过的区块和对应的压缩区块
+type cacheBlock struct {
+	block   *pb.Block
+	compact *pb.P2PCompactBlock
+}
+
+func newBlockCache() *lru.Cache {
+	cache, err := lru.New(blockCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return cache
+}
+
+func newCompactBlock(block *pb.Block) *pb.P2PCompactBlock {
+	header := *block
+	header.Txs = nil
+	blockHash := block.Hash()
+	compact := &pb.P2PCompactBlock{Header: &header, ShortIDs: make([]uint64, len(block.Txs))}
+	for i, tx := range block.Txs {
+		compact.ShortIDs[i] = pb.CalcTxShortID(blockHash, tx.Hash())
+	}
+	//第一个交易是挖矿交易, 不会在mempool中
+	if len(block.Txs) > 0 {
+		compact.Prefilled = append(compact.Prefilled, &pb.P2PPrefilledTx{Index: 0, Tx: block.Txs[0]})
+	}
+	return compact
+}
+
+//压缩区块对应的区块hash, 区块hash中包含了交易的数量
+func compactBlockHash(compact *pb.P2PCompactBlock) []byte {
+	header := *compact.GetHeader()
+	header.Txs = make([]*pb.Transaction, len(compact.GetShortIDs()))
+	return header.Hash()
+}
+
+//获取广播区块使用的压缩区块, 同时缓存区块用于响应其他节点请求缺失的交易
+func (n *Node) compactBlock(block *pb.Block) *pb.P2PCompactBlock {
+	hash := string(block.Hash())
+	if cache, ok := n.blockCache.Get(hash); ok {
+		return cache.(*cacheBlock).compact
+	}
+	compact := newCompactBlock(block)
+	n.blockCache.Add(hash, &cacheBlock{block: block, compact: compact})
+	return compact
+}
+
+//先从广播的区块缓存中查找, 再从blockchain中查找
+func (n *Node) getBlockByHash(hash []byte) (*pb.Block, error) {
+	if cache, ok := n.blockCache.Get(string(hash)); ok {
+		return cache.(*cacheBlock).block, nil
+	}
+	client := n.nodeInfo.client
+	msg := client.NewMessage("blockchain", pb.EventGetBlockByHashes, &pb.ReqHashes{Hashes: [][]byte{hash}})
+	err := client.SendTimeout(msg, true, time.Minute)
+	if err != nil {
+		log.Error("getBlockByHash", "Error", err.Error())
+		return nil, err
+	}
+	resp, err := client.WaitTimeout(msg, time.Second*20)
+	if err != nil {
+		return nil, err
+	}
+	details, ok := resp.GetData().(*pb.BlockDetails)
+	if !ok || len(details.GetItems()) == 0 || details.GetItems()[0] == nil {
+		return nil, pb.ErrBlockNotFound
+	}
+	return details.GetItems()[0].GetBlock(), nil
+}
+
+//只从mempool中取短id对应的交易, 不用取出整个mempool
+func (n *Node) getMempoolTxs(blockHash []byte, shortIDs []uint64) ([]*pb.Transaction, error) {
+	client := n.nodeInfo.client
+	msg := client.NewMessage("mempool", pb.EventGetTxsByShortIDs, &pb.ReqTxsByShortIDs{Salt: blockHash, ShortIDs: shortIDs})
+	err := client.SendTimeout(msg, true, time.Minute)
+	if err != nil {
+		log.Error("getMempoolTxs", "Error", err.Error())
+		return nil, err
+	}
+	resp, err := client.WaitTimeout(msg, time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	txlist, ok := resp.GetData().(*pb.ReplyTxList)
+	if !ok {
+		return nil, pb.ErrNotSupport
+	}
+	return txlist.GetTxs(), nil
+}
+
+//用mempool中的交易填充区块, 返回缺失交易的序号
+func (n *Node) fillFromMempool(blockHash []byte, txs []*pb.Transaction, shortIDs []uint64) []int32 {
+	var missing []int32
+	for i, tx := range txs {
+		if tx == nil {
+			missing = append(missing, int32(i))
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	missingIDs := make([]uint64, len(missing))
+	for i, index := range missing {
+		missingIDs[i] = shortIDs[index]
+	}
+	memtxs, err := n.getMempoolTxs(blockHash, missingIDs)
+	if err != nil {
+		return missing
+	}
+	ids := make(map[uint64]*pb.Transaction, len(memtxs))
+	conflicts := make(map[uint64]bool)
+	for _, tx := range memtxs {
+		id := pb.CalcTxShortID(blockHash, tx.Hash())
+		if _, ok := ids[id]; ok {
+			conflicts[id] = true
+		}
+		ids[id] = tx
+	}
+	var stillMissing []int32
+	for _, index := range missing {
+		id := shortIDs[index]
+		if tx, ok := ids[id]; ok && !conflicts[id] {
+			txs[index] = tx
+			continue
+		}
+		stillMissing = append(stillMissing, index)
+	}
+	return stillMissing
+}
+
+//relayCompactBlock 在单独的协程中恢复压缩区块, 恢复以后和收到的完整区块一样发送给blockchain
+func (n *Node) relayCompactBlock(compact *pb.P2PCompactBlock, addr string, pid string) {
+	select {
+	case n.compactWorkers <- struct{}{}:
+	default:
+		log.Debug("relayCompactBlock", "too many compact blocks, drop height", compact.GetHeader().GetHeight(), "from", addr)
+		return
+	}
+	go func() {
+		defer func() { <-n.compactWorkers }()
+		block, err := n.processCompactBlock(compact, addr)
+		if err != nil {
+			return
+		}
+		blockhash := hex.EncodeToString(block.Hash())
+		Filter.GetLock()
+		if Filter.QueryRecvData(blockhash) {
+			Filter.ReleaseLock()
+			return
+		}
+		Filter.RegRecvData(blockhash)
+		Filter.ReleaseLock()
+		log.Info("relayCompactBlock", "Recv block==+=====+=>Height", block.GetHeight(), "from", addr, "block hash", blockhash)
+		msg := n.nodeInfo.client.NewMessage("blockchain", pb.EventBroadcastAddBlock, &pb.BlockPid{Pid: pid, Block: block})
+		err = n.nodeInfo.client.Send(msg, false)
+		if err != nil {
+			log.Error("relayCompactBlock", "send to blockchain Error", err.Error())
+		}
+	}()
+}
+
+//processCompactBlock 收到过的区块不再恢复, 恢复失败的区块由区块同步处理
+func (n *Node) processCompactBlock(compact *pb.P2PCompactBlock, addr string) (*pb.Block, error) {
+	if compact.GetHeader() == nil {
+		return nil, errCompactBlock
+	}
+	blockhash := hex.EncodeToString(compactBlockHash(compact))
+	Filter.GetLock()
+	recv := Filter.QueryRecvData(blockhash)
+	Filter.ReleaseLock()
+	if recv {
+		return nil, pb.ErrBlockExist
+	}
+	block, err := n.recvCompactBlock(compact, n.blockTxsPeers(addr))
+	if err != nil {
+		log.Error("processCompactBlock", "height", compact.GetHeader().GetHeight(), "from", addr, "err", err)
+		return nil, err
+	}
+	return block, nil
+}
+
+//recvCompactBlock 恢复压缩区块, peers是可以请求缺失交易的节点, 按顺序请求直到成功
+func (n *Node) recvCompactBlock(compact *pb.P2PCompactBlock, peers []*Peer) (*pb.Block, error) {
+	if compact.GetHeader() == nil {
+		return nil, errCompactBlock
+	}
+	blockHash := compactBlockHash(compact)
+	txs := make([]*pb.Transaction, len(compact.GetShortIDs()))
+	for _, prefilled := range compact.GetPrefilled() {
+		index := prefilled.GetIndex()
+		if index < 0 || int(index) >= len(txs) || prefilled.GetTx() == nil {
+			return nil, errCompactBlock
+		}
+		txs[index] = prefilled.GetTx()
+	}
+	missing := n.fillFromMempool(blockHash, txs, compact.GetShortIDs())
+	block := *compact.GetHeader()
+	if len(missing) == 0 && bytes.Equal(merkle.CalcMerkleRoot(txs), block.TxHash) {
+		block.Txs = txs
+		return &block, nil
+	}
+
+	err := errCompactBlock
+	for _, peer := range peers {
+		block.Txs, err = peer.fetchBlockTxs(blockHash, block.TxHash, txs, missing)
+		if err == nil {
+			return &block, nil
+		}
+		log.Debug("recvCompactBlock", "peer", peer.Addr(), "height", block.Height, "err", err)
+	}
+	return nil, err
+}
+
+//请求缺失的交易, 默克尔根不一致的时候请求区块中所有的交易
+func (p *Peer) fetchBlockTxs(blockHash []byte, txHash []byte, txs []*pb.Transaction, missing []int32) ([]*pb.Transaction, error) {
+	txs = append([]*pb.Transaction{}, txs...)
+	if len(missing) > 0 {
+		resp, err := p.getBlockTxs(blockHash, missing)
+		if err != nil {
+			return nil, err
+		}
+		if len(resp) != len(missing) {
+			return nil, errCompactBlock
+		}
+		for i, index := range missing {
+			txs[index] = resp[i]
+		}
+		if bytes.Equal(merkle.CalcMerkleRoot(txs), txHash) {
+			return txs, nil
+		}
+	}
+	log.Info("fetchBlockTxs", "merkle root mismatch, request all txs from", p.Addr())
+	txs, err := p.getBlockTxs(blockHash, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(merkle.CalcMerkleRoot(txs), txHash) {
+		return nil, errCompactBlock
+	}
+	return txs, nil
+}
+
+func (p *Peer) getBlockTxs(blockHash []byte, indexes []int32) ([]*pb.Transaction, error) {
+	resp, err := p.mconn.gcli.GetBlockTxs(context.Background(), &pb.P2PGetBlockTxs{Version: p.node.nodeInfo.cfg.Version,
+		BlockHash: blockHash, Indexes: indexes}, grpc.FailFast(true))
+	P2pComm.CollectPeerStat(err, p)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetTxs(), nil
+}
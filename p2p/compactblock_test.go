@@ -0,0 +1,182 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/queue"
+	pb "github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func newTestBlock(n int) *pb.Block {
+	block := &pb.Block{Height: 10, BlockTime: 1}
+	for i := 0; i < n; i++ {
+		block.Txs = append(block.Txs, &pb.Transaction{Execer: []byte("coins"), Payload: []byte("tx"), Nonce: int64(i)})
+	}
+	block.TxHash = merkle.CalcMerkleRoot(block.Txs)
+	return block
+}
+
+//mempool中只有txs中的交易, 只支持按短id查找
+func newTestNode(t *testing.T, txs []*pb.Transaction) *Node {
+	return newTestNodeWithQueue(t, queue.New("channel"), txs)
+}
+
+func newTestNodeWithQueue(t *testing.T, q queue.Queue, txs []*pb.Transaction) *Node {
+	client := q.Client()
+	go func() {
+		mem := q.Client()
+		mem.Sub("mempool")
+		for msg := range mem.Recv() {
+			if msg.Ty != pb.EventGetTxsByShortIDs {
+				msg.Reply(mem.NewMessage("", msg.Ty, pb.ErrNotSupport))
+				continue
+			}
+			req := msg.GetData().(*pb.ReqTxsByShortIDs)
+			ids := make(map[uint64]bool)
+			for _, id := range req.ShortIDs {
+				ids[id] = true
+			}
+			var found []*pb.Transaction
+			for _, tx := range txs {
+				if ids[pb.CalcTxShortID(req.Salt, tx.Hash())] {
+					found = append(found, tx)
+				}
+			}
+			msg.Reply(mem.NewMessage("", pb.EventReplyTxList, &pb.ReplyTxList{Txs: found}))
+		}
+	}()
+	cfg := &pb.P2P{Version: 10, VerMix: 10, VerMax: 11}
+	return &Node{
+		blockCache:     newBlockCache(),
+		compactWorkers: make(chan struct{}, maxCompactWorkers),
+		relayTxs:       newTxCache(relayTxCacheSize),
+		requestedTxs:   newTxCache(relayTxCacheSize),
+		nodeInfo:       &NodeInfo{cfg: cfg, client: client, monitorChan: make(chan *Peer, 1024)},
+	}
+}
+
+func TestCompactBlock(t *testing.T) {
+	block := newTestBlock(5)
+	compact := newCompactBlock(block)
+	assert.Nil(t, compact.Header.Txs)
+	assert.Equal(t, 5, len(compact.ShortIDs))
+	assert.Equal(t, 1, len(compact.Prefilled))
+	assert.Equal(t, block.Hash(), compactBlockHash(compact))
+	assert.Equal(t, pb.CalcTxShortID(block.Hash(), block.Txs[1].Hash()), compact.ShortIDs[1])
+	assert.NotEqual(t, pb.CalcTxShortID([]byte("other"), block.Txs[1].Hash()), compact.ShortIDs[1])
+
+	//mempool中有全部的交易
+	node := newTestNode(t, block.Txs[1:])
+	recv, err := node.recvCompactBlock(compact, nil)
+	require.NoError(t, err)
+	assert.Equal(t, block.Hash(), recv.Hash())
+	assert.Equal(t, pb.Encode(block), pb.Encode(recv))
+
+	//缺失交易并且没有可以请求的节点
+	node = newTestNode(t, block.Txs[1:3])
+	_, err = node.recvCompactBlock(compact, nil)
+	assert.Equal(t, errCompactBlock, err)
+
+	compact.Prefilled[0].Index = 5
+	_, err = node.recvCompactBlock(compact, nil)
+	assert.Equal(t, errCompactBlock, err)
+}
+
+//恢复压缩区块不阻塞读取消息, 恢复以后发送给blockchain
+func TestRelayCompactBlock(t *testing.T) {
+	block := newTestBlock(5)
+	block.Height = 11
+	q := queue.New("channel")
+	chain := q.Client()
+	chain.Sub("blockchain")
+	node := newTestNodeWithQueue(t, q, block.Txs[1:])
+	node.relayCompactBlock(newCompactBlock(block), "127.0.0.1:13802", "pid")
+	select {
+	case msg := <-chain.Recv():
+		assert.Equal(t, int64(pb.EventBroadcastAddBlock), msg.Ty)
+		recv := msg.GetData().(*pb.BlockPid)
+		assert.Equal(t, "pid", recv.Pid)
+		assert.Equal(t, pb.Encode(block), pb.Encode(recv.Block))
+	case <-time.After(time.Second):
+		t.Fatal("relayCompactBlock timeout")
+	}
+	//同时恢复的区块太多的时候直接丢弃
+	for i := 0; i < maxCompactWorkers; i++ {
+		node.compactWorkers <- struct{}{}
+	}
+	block.Height = 12
+	node.relayCompactBlock(newCompactBlock(block), "127.0.0.1:13802", "pid")
+	select {
+	case <-chain.Recv():
+		t.Fatal("relayCompactBlock should drop the block")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestNegotiateRelay(t *testing.T) {
+	assert.Equal(t, fullBlockRelay, negotiateRelay(-1))
+	assert.Equal(t, fullBlockRelay, negotiateRelay(fullBlockRelay))
+	assert.Equal(t, compactBlockRelay, negotiateRelay(compactBlockRelay))
+	assert.Equal(t, relayVersion, negotiateRelay(relayVersion+1))
+}
+
+func TestGetBlockTxs(t *testing.T) {
+	block := newTestBlock(5)
+	sender := newTestNode(t, nil)
+	compact := sender.compactBlock(block)
+	assert.Equal(t, compact, sender.compactBlock(block))
+
+	server := NewP2pServer()
+	server.node = sender
+	resp, err := server.GetBlockTxs(context.Background(), &pb.P2PGetBlockTxs{Version: 10, BlockHash: block.Hash(), Indexes: []int32{4, 2}})
+	require.NoError(t, err)
+	assert.Equal(t, []*pb.Transaction{block.Txs[4], block.Txs[2]}, resp.Txs)
+	resp, err = server.GetBlockTxs(context.Background(), &pb.P2PGetBlockTxs{Version: 10, BlockHash: block.Hash()})
+	require.NoError(t, err)
+	assert.Equal(t, block.Txs, resp.Txs)
+	_, err = server.GetBlockTxs(context.Background(), &pb.P2PGetBlockTxs{Version: 10, BlockHash: block.Hash(), Indexes: []int32{5}})
+	assert.Equal(t, pb.ErrInvalidParam, err)
+	_, err = server.GetBlockTxs(context.Background(), &pb.P2PGetBlockTxs{Version: 1, BlockHash: block.Hash()})
+	assert.Equal(t, pb.ErrVersion, err)
+
+	//接收方通过grpc请求缺失的交易恢复区块
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	gserver := grpc.NewServer()
+	pb.RegisterP2PgserviceServer(gserver, server)
+	go gserver.Serve(l)
+	defer gserver.Stop()
+	conn, err := grpc.Dial(l.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	receiver := newTestNode(t, block.Txs[1:3])
+	addr, err := NewNetAddressString(l.Addr().String())
+	require.NoError(t, err)
+	peer := NewPeer(conn, receiver, addr)
+	peer.SetAddr(addr)
+	recv, err := receiver.recvCompactBlock(compact, []*Peer{peer})
+	require.NoError(t, err)
+	assert.Equal(t, pb.Encode(block), pb.Encode(recv))
+
+	//短id冲突的交易导致默克尔根不一致时, 请求全部交易
+	fake := *block.Txs[3]
+	fake.Nonce = 100
+	txs := make([]*pb.Transaction, 5)
+	copy(txs, block.Txs)
+	txs[3] = &fake
+	got, err := peer.fetchBlockTxs(block.Hash(), block.TxHash, txs, nil)
+	require.NoError(t, err)
+	assert.Equal(t, block.Txs, got)
+}
@@ -0,0 +1,208 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	pb "github.com/33cn/chain33/types"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+//kademlia 风格的节点发现:
+//1. 节点ID是节点公钥的sha256, 两个节点之间的距离是ID的异或
+//2. 每一轮随机选择一个目标ID, 从离目标最近的已连接节点开始, 通过GetAddrList查询对方连接的节点
+//3. 返回的节点按照到目标的距离排序, 继续查询更近的没有查询过的节点, 没有更近的节点或者超过最大查询次数时结束
+//4. 发现的节点加入地址簿, 由getAddrFromAddrBook负责连接
+
+const (
+	//每次并发查询的节点数量
+	kadAlpha = 3
+	//每一轮最多查询的次数
+	kadMaxRounds = 5
+	//每一轮最多记录的离目标最近的节点数量
+	kadBucketSize = 16
+	kadIDLen      = 32
+)
+
+var discoveryInterval = time.Minute
+
+type kadNode struct {
+	id   []byte
+	addr string
+}
+
+//kadID 节点名称是公钥的hex编码, 无法解析的节点名称直接计算hash
+func kadID(name string) []byte {
+	pubkey, err := hex.DecodeString(name)
+	if err != nil {
+		pubkey = []byte(name)
+	}
+	return common.Sha256(pubkey)
+}
+
+func kadDistance(a, b []byte) []byte {
+	dist := make([]byte, kadIDLen)
+	for i := 0; i < kadIDLen && i < len(a) && i < len(b); i++ {
+		dist[i] = a[i] ^ b[i]
+	}
+	return dist
+}
+
+func sortByDistance(target []byte, nodes []*kadNode) {
+	sort.Slice(nodes, func(i, j int) bool {
+		return bytes.Compare(kadDistance(nodes[i].id, target), kadDistance(nodes[j].id, target)) < 0
+	})
+}
+
+//kadLookup 查找离target最近的节点, 返回查询过程中发现的所有节点
+func kadLookup(target []byte, start []*kadNode, query func(addr string) ([]*pb.P2PPeerInfo, error)) []*kadNode {
+	seen := make(map[string]bool)
+	var closest []*kadNode
+	for _, node := range start {
+		if !seen[node.addr] {
+			seen[node.addr] = true
+			closest = append(closest, node)
+		}
+	}
+	sortByDistance(target, closest)
+	var found []*kadNode
+	queried := make(map[string]bool)
+	for round := 0; round < kadMaxRounds; round++ {
+		var batch []*kadNode
+		for _, node := range closest {
+			if !queried[node.addr] {
+				queried[node.addr] = true
+				batch = append(batch, node)
+			}
+			if len(batch) == kadAlpha {
+				break
+			}
+		}
+		if len(batch) == 0 {
+			break
+		}
+		var mtx sync.Mutex
+		var wg sync.WaitGroup
+		var results []*kadNode
+		for _, node := range batch {
+			wg.Add(1)
+			go func(node *kadNode) {
+				defer wg.Done()
+				infos, err := query(node.addr)
+				if err != nil {
+					log.Debug("kadLookup", "query", node.addr, "err", err)
+					return
+				}
+				mtx.Lock()
+				defer mtx.Unlock()
+				for _, info := range infos {
+					//节点名称是对方自己报告的, 没有经过验证, 所以距离只用来决定查询的顺序,
+					//不能依赖它来选择或者信任节点
+					results = append(results, &kadNode{id: kadID(info.GetName()), addr: fmt.Sprintf("%v:%v", info.GetAddr(), info.GetPort())})
+				}
+			}(node)
+		}
+		wg.Wait()
+
+		best := closest[0]
+		for _, node := range results {
+			if seen[node.addr] {
+				continue
+			}
+			seen[node.addr] = true
+			found = append(found, node)
+			closest = append(closest, node)
+		}
+		sortByDistance(target, closest)
+		if len(closest) > kadBucketSize {
+			closest = closest[:kadBucketSize]
+		}
+		//没有发现更近的节点
+		if closest[0] == best {
+			break
+		}
+	}
+	return found
+}
+
+//discoverPeers 定时查找随机目标附近的节点, 发现的节点加入地址簿
+func (n *Node) discoverPeers() {
+	if !n.nodeInfo.cfg.EnableDiscovery {
+		return
+	}
+	ticker := time.NewTicker(discoveryInterval)
+	defer ticker.Stop()
+	for {
+		<-ticker.C
+		if n.isClose() {
+			log.Info("discoverPeers", "loop", "done")
+			return
+		}
+		_, infos := n.GetActivePeers()
+		var start []*kadNode
+		for addr, info := range infos {
+			start = append(start, &kadNode{id: kadID(info.GetName()), addr: addr})
+		}
+		if len(start) == 0 {
+			continue
+		}
+		target := make([]byte, kadIDLen)
+		if _, err := rand.Read(target); err != nil {
+			continue
+		}
+		found := kadLookup(target, start, n.queryAddrList)
+		for _, node := range found {
+			if n.nodeInfo.blacklist.Has(node.addr) || n.nodeInfo.addrBook.IsOurStringAddress(node.addr) {
+				continue
+			}
+			netAddr, err := NewNetAddressString(node.addr)
+			if err != nil {
+				continue
+			}
+			n.nodeInfo.addrBook.AddAddress(netAddr, nil)
+		}
+		log.Debug("discoverPeers", "found", len(found), "addrbook", n.nodeInfo.addrBook.Size())
+	}
+}
+
+//queryAddrList 查询节点连接的节点列表, 没有连接的节点临时建立连接
+func (n *Node) queryAddrList(addr string) ([]*pb.P2PPeerInfo, error) {
+	req := &pb.P2PGetAddr{Nonce: pb.Now().UnixNano()}
+	if peer := n.GetRegisterPeer(addr); peer != nil {
+		resp, err := peer.mconn.gcli.GetAddrList(context.Background(), req, grpc.FailFast(true))
+		if err != nil {
+			return nil, err
+		}
+		return resp.GetPeerinfo(), nil
+	}
+	if n.nodeInfo.blacklist.Has(addr) {
+		return nil, pb.ErrPeerBanned
+	}
+	netAddr, err := NewNetAddressString(addr)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := netAddr.DialTimeout(n.nodeInfo.cfg.Version)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), DialTimeout)
+	defer cancel()
+	resp, err := pb.NewP2PgserviceClient(conn).GetAddrList(ctx, req, grpc.FailFast(true))
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPeerinfo(), nil
+}
@@ -178,6 +178,7 @@ func (d *downloadJob) syncDownloadBlock(peer *Peer, inv *pb.Inventory, bchan cha
 	P2pComm.CollectPeerStat(err, peer)
 	if err != nil {
 		log.Error("syncDownloadBlock", "GetData err", err.Error())
+		d.p2pcli.network.node.misbehave(peer.Addr(), peer.GetPeerName(), pb.MisbehaveTimeout, err.Error())
 		return err
 	}
 	defer resp.CloseSend()
@@ -189,6 +190,7 @@ func (d *downloadJob) syncDownloadBlock(peer *Peer, inv *pb.Inventory, bchan cha
 				return nil
 			}
 			log.Error("download", "resp,Recv err", err.Error(), "download from", peer.Addr())
+			d.p2pcli.network.node.misbehave(peer.Addr(), peer.GetPeerName(), pb.MisbehaveTimeout, err.Error())
 			return err
 		}
 		for _, item := range invdatas.Items {
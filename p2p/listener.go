@@ -67,7 +67,11 @@ func NewListener(protocol string, node *Node) Listener {
 	maxStreams := grpc.MaxConcurrentStreams(1000)
 	keepOp := grpc.KeepaliveParams(keepparm)
 
-	dl.server = grpc.NewServer(msgRecvOp, msgSendOp, keepOp, maxStreams)
+	opts := []grpc.ServerOption{msgRecvOp, msgSendOp, keepOp, maxStreams}
+	if secureCreds != nil {
+		opts = append(opts, grpc.Creds(secureCreds))
+	}
+	dl.server = grpc.NewServer(opts...)
 	dl.p2pserver = pServer
 	pb.RegisterP2PgserviceServer(dl.server, pServer)
 	return dl
@@ -5,10 +5,6 @@
 package p2p
 
 import (
-	"bytes"
-	"io"
-	"net/http"
-	"strings"
 	"time"
 
 	"github.com/33cn/chain33/types"
@@ -30,8 +26,8 @@ func (n *Node) monitorErrPeer() {
 			//如果版本不支持,直接删除节点
 			log.Debug("VersoinMonitor", "NotSupport,addr", peer.Addr())
 			n.destroyPeer(peer)
-			//加入黑名单12小时
-			n.nodeInfo.blacklist.Add(peer.Addr(), int64(3600*12))
+			//封禁12小时
+			n.nodeInfo.scoreBook.Ban(peer.Addr(), int64(3600*12), types.ErrVersion.Error())
 			continue
 		}
 		if peer.IsMaxInbouds {
@@ -52,41 +48,6 @@ func (n *Node) monitorErrPeer() {
 	}
 }
 
-func (n *Node) getAddrFromGithub() {
-	if !n.nodeInfo.cfg.UseGithub {
-		return
-	}
-	//从github 上下载种子节点文件
-	res, err := http.Get("https://raw.githubusercontent.com/chainseed/seeds/master/bty.txt")
-	if err != nil {
-		log.Error("getAddrFromGithub", "http.Get", err.Error())
-		return
-	}
-
-	bf := new(bytes.Buffer)
-	_, err = io.Copy(bf, res.Body)
-	if err != nil {
-		log.Error("getAddrFromGithub", "io.Copy", err.Error())
-		return
-	}
-
-	fileContent := bf.String()
-	st := strings.TrimSpace(fileContent)
-	strs := strings.Split(st, "\n")
-	log.Info("getAddrFromGithub", "download file", fileContent)
-	for _, linestr := range strs {
-		pidaddr := strings.Split(linestr, "@")
-		if len(pidaddr) == 2 {
-			addr := pidaddr[1]
-			if n.Has(addr) || n.nodeInfo.blacklist.Has(addr) {
-				return
-			}
-			n.pubsub.FIFOPub(addr, "addr")
-
-		}
-	}
-}
-
 //从在线节点获取地址列表
 func (n *Node) getAddrFromOnline() {
 	ticker := time.NewTicker(GetAddrFromOnlineInterval)
@@ -210,9 +171,9 @@ func (n *Node) getAddrFromAddrBook() {
 			log.Debug("GetAddrFromOnLine", "loop", "done")
 			return
 		}
-		//12个循环后， 则去github下载
+		//12个循环后， 则从种子节点的来源重新获取
 		if tickerTimes > 12 && n.Size() == 0 {
-			n.getAddrFromGithub()
+			n.getAddrFromSeeds()
 			tickerTimes = 0
 		}
 
@@ -513,8 +474,10 @@ func (n *Node) monitorBlackList() {
 		}
 
 		<-ticker.C
-		badPeers := n.nodeInfo.blacklist.GetBadPeers()
 		now := types.Now().Unix()
+		//到期的封禁在黑名单之前解除, 没有被封禁的节点恢复信誉分数
+		n.nodeInfo.scoreBook.Recover(now)
+		badPeers := n.nodeInfo.blacklist.GetBadPeers()
 		for badPeer, intime := range badPeers {
 			if n.nodeInfo.addrBook.IsOurStringAddress(badPeer) {
 				continue
@@ -138,6 +138,14 @@ func isCompressSupport(err error) bool {
 	return true
 }
 
+//启用加密的时候使用加密的连接
+func transportOption() grpc.DialOption {
+	if secureCreds != nil {
+		return grpc.WithTransportCredentials(secureCreds)
+	}
+	return grpc.WithInsecure()
+}
+
 func (na *NetAddress) DialTimeout(version int32) (*grpc.ClientConn, error) {
 	ch := make(chan grpc.ServiceConfig, 1)
 	ch <- P2pComm.GrpcConfig()
@@ -149,7 +157,7 @@ func (na *NetAddress) DialTimeout(version int32) (*grpc.ClientConn, error) {
 	keepaliveOp := grpc.WithKeepaliveParams(cliparm)
 	timeoutOp := grpc.WithTimeout(time.Second * 3)
 	log.Debug("NetAddress", "Dial", na.String())
-	conn, err := grpc.Dial(na.String(), grpc.WithInsecure(),
+	conn, err := grpc.Dial(na.String(), transportOption(),
 		grpc.WithDefaultCallOptions(grpc.UseCompressor("gzip")), grpc.WithServiceConfig(ch), keepaliveOp, timeoutOp)
 	if err != nil {
 		log.Debug("grpc DialCon", "did not connect", err, "addr", na.String())
@@ -165,7 +173,7 @@ func (na *NetAddress) DialTimeout(version int32) (*grpc.ClientConn, error) {
 		ch2 := make(chan grpc.ServiceConfig, 1)
 		ch2 <- P2pComm.GrpcConfig()
 		log.Debug("NetAddress", "Dial with unCompressor", na.String())
-		conn, err = grpc.Dial(na.String(), grpc.WithInsecure(), grpc.WithServiceConfig(ch2), keepaliveOp, timeoutOp)
+		conn, err = grpc.Dial(na.String(), transportOption(), grpc.WithServiceConfig(ch2), keepaliveOp, timeoutOp)
 	}
 
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	"github.com/33cn/chain33/p2p/nat"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/types"
+	lru "github.com/hashicorp/golang-lru"
 )
 
 // 启动Node节点
@@ -66,6 +67,15 @@ type Node struct {
 	listener   Listener
 	closed     int32
 	pubsub     *pubsub.PubSub
+	//最近广播的区块, 响应其他节点请求压缩区块中缺失的交易
+	blockCache *lru.Cache
+	//正在恢复的压缩区块
+	compactWorkers chan struct{}
+	//最近广播的交易和正在请求的交易
+	relayTxs     *lru.Cache
+	requestedTxs *lru.Cache
+	//种子节点的来源
+	seedSources []SeedSource
 }
 
 func (n *Node) SetQueueClient(client queue.Client) {
@@ -75,9 +85,13 @@ func (n *Node) SetQueueClient(client queue.Client) {
 func NewNode(cfg *types.P2P) (*Node, error) {
 
 	node := &Node{
-		outBound:   make(map[string]*Peer),
-		cacheBound: make(map[string]*Peer),
-		pubsub:     pubsub.NewPubSub(10200),
+		outBound:       make(map[string]*Peer),
+		cacheBound:     make(map[string]*Peer),
+		pubsub:         pubsub.NewPubSub(10200),
+		blockCache:     newBlockCache(),
+		compactWorkers: make(chan struct{}, maxCompactWorkers),
+		relayTxs:       newTxCache(relayTxCacheSize),
+		requestedTxs:   newTxCache(relayTxCacheSize),
 	}
 	if cfg.InnerSeedEnable {
 		if types.IsTestNet() {
@@ -88,7 +102,22 @@ func NewNode(cfg *types.P2P) (*Node, error) {
 
 	}
 
+	seeds, seedPubkeys := parseSeeds(cfg.Seeds)
+	cfg.Seeds = seeds
+	node.seedSources = newSeedSources(cfg)
 	node.nodeInfo = NewNodeInfo(cfg)
+	secureCreds = nil
+	if cfg.EnableEncrypt {
+		privkey, _ := node.nodeInfo.addrBook.GetPrivPubKey()
+		creds, err := newSecureTransport(privkey, cfg.AllowPubkeys)
+		if err != nil {
+			return nil, err
+		}
+		for addr, pubkey := range seedPubkeys {
+			creds.setExpected(addr, pubkey)
+		}
+		secureCreds = creds
+	}
 	if cfg.ServerStart {
 		node.listener = NewListener(protocol, node)
 	}
@@ -263,6 +292,18 @@ func (n *Node) GetRegisterPeers() []*Peer {
 	return peers
 }
 
+//blockTxsPeers 请求压缩区块中缺失交易的节点, 优先请求发送区块的节点
+func (n *Node) blockTxsPeers(addr string) []*Peer {
+	peers := n.GetRegisterPeers()
+	for i, peer := range peers {
+		if peer.Addr() == addr {
+			peers[0], peers[i] = peers[i], peers[0]
+			break
+		}
+	}
+	return peers
+}
+
 func (n *Node) GetActivePeers() (map[string]*Peer, map[string]*types.Peer) {
 	regPeers := n.GetRegisterPeers()
 	infos := n.nodeInfo.peerInfos.GetPeerInfos()
@@ -306,6 +347,8 @@ func (n *Node) monitor() {
 	go n.monitorFilter()
 	go n.monitorPeers()
 	go n.nodeReBalance()
+	go n.getAddrFromSeeds()
+	go n.discoverPeers()
 }
 
 func (n *Node) needMore() bool {
@@ -23,6 +23,7 @@ type NodeInfo struct {
 	cfg            *types.P2P
 	client         queue.Client
 	blacklist      *BlackList
+	scoreBook      *ScoreBook
 	peerInfos      *PeerInfos
 	addrBook       *AddrBook // known peers
 	natDone        int32
@@ -42,6 +43,7 @@ func NewNodeInfo(cfg *types.P2P) *NodeInfo {
 	nodeInfo.externalAddr = new(NetAddress)
 	nodeInfo.listenAddr = new(NetAddress)
 	nodeInfo.addrBook = NewAddrBook(cfg)
+	nodeInfo.scoreBook = NewScoreBook(nodeInfo.addrBook, nodeInfo.blacklist)
 	return nodeInfo
 }
 
@@ -225,6 +227,13 @@ func (bl *BlackList) Add(addr string, deadline int64) {
 
 }
 
+//AddForever 永久加入黑名单, 不会被monitorBlackList删除
+func (bl *BlackList) AddForever(addr string) {
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+	bl.badPeers[addr] = 0
+}
+
 func (bl *BlackList) Delete(addr string) {
 	bl.mtx.Lock()
 	defer bl.mtx.Unlock()
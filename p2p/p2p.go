@@ -88,7 +88,10 @@ func (network *P2p) SetQueueClient(client queue.Client) {
 		log.Info("p2p", "setqueuecliet", "ok")
 		network.node.Start()
 		network.subP2pMsg()
-		network.loadP2PPrivKeyToWallet()
+		//轻节点不加载钱包模块
+		if !types.IsEnable("lightMode") {
+			network.loadP2PPrivKeyToWallet()
+		}
 	}()
 }
 
@@ -211,6 +214,16 @@ func (network *P2p) subP2pMsg() {
 				go network.p2pCli.GetHeaders(msg, taskIndex)
 			case types.EventGetNetInfo:
 				go network.p2pCli.GetNetInfo(msg, taskIndex)
+			case types.EventFetchStateProof:
+				go network.p2pCli.GetStateProof(msg, taskIndex)
+			case types.EventPeerMisbehave:
+				go network.p2pCli.PeerMisbehave(msg, taskIndex)
+			case types.EventGetPeerScores:
+				go network.p2pCli.GetPeerScores(msg, taskIndex)
+			case types.EventBanPeer:
+				go network.p2pCli.BanPeer(msg, taskIndex)
+			case types.EventUnbanPeer:
+				go network.p2pCli.UnbanPeer(msg, taskIndex)
 			default:
 				log.Warn("unknown msgtype", "msg", msg)
 				msg.Reply(network.client.NewMessage("", msg.Ty, types.Reply{false, []byte("unknown msgtype")}))
@@ -19,6 +19,7 @@ import (
 	pb "github.com/33cn/chain33/types"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	pr "google.golang.org/grpc/peer"
 )
 
 //p2p 订阅的事件处理函数接口
@@ -30,6 +31,11 @@ type EventInterface interface {
 	GetBlocks(msg queue.Message, taskindex int64)
 	BlockBroadcast(msg queue.Message, taskindex int64)
 	GetNetInfo(msg queue.Message, taskindex int64)
+	GetStateProof(msg queue.Message, taskindex int64)
+	PeerMisbehave(msg queue.Message, taskindex int64)
+	GetPeerScores(msg queue.Message, taskindex int64)
+	BanPeer(msg queue.Message, taskindex int64)
+	UnbanPeer(msg queue.Message, taskindex int64)
 }
 
 //非p2p 订阅的事件处理函数接口
@@ -233,9 +239,10 @@ func (m *Cli) SendVersion(peer *Peer, nodeinfo *NodeInfo) (string, error) {
 	}
 	addrfrom := nodeinfo.GetExternalAddr().String()
 
+	var remote pr.Peer
 	resp, err := peer.mconn.gcli.Version2(context.Background(), &pb.P2PVersion{Version: nodeinfo.cfg.Version, Service: int64(nodeinfo.ServiceTy()), Timestamp: pb.Now().Unix(),
 		AddrRecv: peer.Addr(), AddrFrom: addrfrom, Nonce: int64(rand.Int31n(102040)),
-		UserAgent: hex.EncodeToString(in.Sign.GetPubkey()), StartHeight: blockheight}, grpc.FailFast(true))
+		UserAgent: hex.EncodeToString(in.Sign.GetPubkey()), StartHeight: blockheight, RelayVersion: relayVersion}, grpc.FailFast(true), grpc.Peer(&remote))
 	log.Debug("SendVersion", "resp", resp, "addrfrom", addrfrom, "sendto", peer.Addr())
 	if err != nil {
 		log.Error("SendVersion", "Verson", err.Error(), "peer", peer.Addr())
@@ -246,9 +253,18 @@ func (m *Cli) SendVersion(peer *Peer, nodeinfo *NodeInfo) (string, error) {
 		return "", err
 	}
 
+	//节点名称是节点的公钥, 必须和加密连接认证的公钥一致
+	if !checkAuthPubkey(remote.AuthInfo, resp.GetUserAgent()) {
+		log.Error("SendVersion", "peer identity err", resp.GetUserAgent(), "peer", peer.Addr())
+		err = pb.ErrPeerIdentity
+		P2pComm.CollectPeerStat(err, peer)
+		return "", err
+	}
+
 	P2pComm.CollectPeerStat(err, peer)
 	log.Debug("SHOW VERSION BACK", "VersionBack", resp, "peer", peer.Addr())
 	peer.version.SetVersion(resp.GetVersion())
+	peer.version.SetRelayVersion(resp.GetRelayVersion())
 
 	ip, _, err := net.SplitHostPort(resp.GetAddrRecv())
 	if err == nil {
@@ -530,12 +546,39 @@ func (m *Cli) GetBlocks(msg queue.Message, taskindex int64) {
 
 }
 
+//GetStateProof 依次向连接的节点请求状态数据的默克尔证明, 返回第一个成功的结果
+//证明的校验由请求的模块负责
+func (m *Cli) GetStateProof(msg queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("GetStateProof", "task complete:", taskindex)
+	}()
+	req := msg.GetData().(*pb.ReqStateProof)
+	peers, _ := m.network.node.GetActivePeers()
+	for _, peer := range peers {
+		proof, err := peer.mconn.gcli.GetStateProof(context.Background(), &pb.P2PGetStateProof{StateHash: req.GetStateHash(), Key: req.GetKey(),
+			Version: m.network.node.nodeInfo.cfg.Version}, grpc.FailFast(true))
+		P2pComm.CollectPeerStat(err, peer)
+		//key 不存在的时候节点必须返回不存在的证明, 没有证明的 ErrNotFound 不能相信
+		if err != nil {
+			log.Debug("GetStateProof", "peer", peer.Addr(), "Err", err.Error())
+			continue
+		}
+		msg.Reply(m.network.client.NewMessage("", pb.EventStoreGetProofReply, proof))
+		return
+	}
+	msg.Reply(m.network.client.NewMessage("", pb.EventStoreGetProofReply, pb.ErrNoPeer))
+}
+
 func (m *Cli) BlockBroadcast(msg queue.Message, taskindex int64) {
 	defer func() {
 		<-m.network.otherFactory
 		log.Debug("BlockBroadcast", "task complete:", taskindex)
 	}()
-	m.network.node.pubsub.FIFOPub(&pb.P2PBlock{Block: msg.GetData().(*pb.Block)}, "block")
+	block := msg.GetData().(*pb.Block)
+	//缓存广播的区块, 其他节点会请求压缩区块中缺失的交易
+	m.network.node.compactBlock(block)
+	m.network.node.pubsub.FIFOPub(&pb.P2PBlock{Block: block}, "block")
 }
 
 func (m *Cli) GetNetInfo(msg queue.Message, taskindex int64) {
@@ -554,6 +597,57 @@ func (m *Cli) GetNetInfo(msg queue.Message, taskindex int64) {
 
 }
 
+//PeerMisbehave 其他模块报告的节点不良行为, 比如blockchain执行失败的区块
+func (m *Cli) PeerMisbehave(msg queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("PeerMisbehave", "task complete:", taskindex)
+	}()
+	req := msg.GetData().(*pb.PeerMisbehave)
+	m.network.node.misbehave(req.GetAddr(), req.GetPid(), req.GetReason(), req.GetInfo())
+}
+
+func (m *Cli) GetPeerScores(msg queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("GetPeerScores", "task complete:", taskindex)
+	}()
+	scores := m.network.node.nodeInfo.scoreBook.GetScores()
+	msg.Reply(m.network.client.NewMessage("rpc", pb.EventGetPeerScores, &pb.PeerScoreList{Peers: scores}))
+}
+
+func (m *Cli) BanPeer(msg queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("BanPeer", "task complete:", taskindex)
+	}()
+	req := msg.GetData().(*pb.ReqBanPeer)
+	if _, err := NewNetAddressString(req.GetAddr()); err != nil || req.GetDuration() < 0 {
+		msg.Reply(m.network.client.NewMessage("rpc", pb.EventBanPeer, pb.ErrInvalidParam))
+		return
+	}
+	reason := req.GetReason()
+	if len(reason) == 0 {
+		reason = "manual"
+	}
+	m.network.node.nodeInfo.scoreBook.Ban(req.GetAddr(), req.GetDuration(), reason)
+	m.network.node.banPeer(req.GetAddr())
+	msg.Reply(m.network.client.NewMessage("rpc", pb.EventBanPeer, &pb.Reply{IsOk: true}))
+}
+
+func (m *Cli) UnbanPeer(msg queue.Message, taskindex int64) {
+	defer func() {
+		<-m.network.otherFactory
+		log.Debug("UnbanPeer", "task complete:", taskindex)
+	}()
+	req := msg.GetData().(*pb.ReqString)
+	if err := m.network.node.nodeInfo.scoreBook.Unban(req.GetData()); err != nil {
+		msg.Reply(m.network.client.NewMessage("rpc", pb.EventUnbanPeer, err))
+		return
+	}
+	msg.Reply(m.network.client.NewMessage("rpc", pb.EventUnbanPeer, &pb.Reply{IsOk: true}))
+}
+
 func (m *Cli) CheckPeerNatOk(addr string) bool {
 	//连接自己的地址信息做测试
 	return !(len(P2pComm.AddrRouteble([]string{addr})) == 0)
@@ -28,6 +28,10 @@ type P2pServer struct {
 	inboundpeers map[string]*innerpeer
 	deleteSChan  chan pb.P2Pgservice_ServerStreamSendServer
 	closed       int32
+	//连接的客户端节点协商的区块广播协议版本
+	relayVersions map[string]int32
+	//连接的客户端节点的交易清单广播状态
+	txRelays map[string]*txRelay
 }
 type innerpeer struct {
 	addr        string
@@ -51,9 +55,11 @@ func (s *P2pServer) IsClose() bool {
 
 func NewP2pServer() *P2pServer {
 	return &P2pServer{
-		streams:      make(map[pb.P2Pgservice_ServerStreamSendServer]chan interface{}),
-		deleteSChan:  make(chan pb.P2Pgservice_ServerStreamSendServer, 1024),
-		inboundpeers: make(map[string]*innerpeer),
+		streams:       make(map[pb.P2Pgservice_ServerStreamSendServer]chan interface{}),
+		deleteSChan:   make(chan pb.P2Pgservice_ServerStreamSendServer, 1024),
+		inboundpeers:  make(map[string]*innerpeer),
+		relayVersions: make(map[string]int32),
+		txRelays:      make(map[string]*txRelay),
 	}
 
 }
@@ -64,6 +70,10 @@ func (s *P2pServer) Ping(ctx context.Context, in *pb.P2PPing) (*pb.P2PPong, erro
 		log.Error("Ping", "p2p server", "check sig err")
 		return nil, pb.ErrPing
 	}
+	if !checkPeerIdentity(ctx, hex.EncodeToString(in.GetSign().GetPubkey())) {
+		log.Error("Ping", "p2p server", "peer identity err")
+		return nil, pb.ErrPeerIdentity
+	}
 	var peerip string
 	var err error
 	getctx, ok := pr.FromContext(ctx)
@@ -134,6 +144,10 @@ func (s *P2pServer) Version2(ctx context.Context, in *pb.P2PVersion) (*pb.P2PVer
 	if !s.checkVersion(in.GetVersion()) {
 		return nil, pb.ErrVersion
 	}
+	if !checkPeerIdentity(ctx, in.GetUserAgent()) {
+		log.Error("Version2", "peer identity err", in.GetUserAgent())
+		return nil, pb.ErrPeerIdentity
+	}
 
 	log.Debug("Version2", "before", "GetPrivPubKey")
 	_, pub := s.node.nodeInfo.addrBook.GetPrivPubKey()
@@ -145,13 +159,18 @@ func (s *P2pServer) Version2(ctx context.Context, in *pb.P2PVersion) (*pb.P2PVer
 	}
 	remoteNetwork, err := NewNetAddressString(fmt.Sprintf("%v:%v", peerip, port))
 	if err == nil {
+		if s.node.nodeInfo.scoreBook.IsBanned(remoteNetwork.String()) {
+			log.Error("Version2", "banned peer", remoteNetwork.String())
+			return nil, pb.ErrPeerBanned
+		}
 		if !s.node.nodeInfo.blacklist.Has(remoteNetwork.String()) {
 			s.node.nodeInfo.addrBook.AddAddress(remoteNetwork, nil)
 		}
 	}
 
+	s.setRelayVersion(in.GetUserAgent(), in.GetRelayVersion())
 	return &pb.P2PVersion{Version: s.node.nodeInfo.cfg.Version, Service: int64(s.node.nodeInfo.ServiceTy()), Nonce: in.Nonce,
-		AddrFrom: in.AddrRecv, AddrRecv: fmt.Sprintf("%v:%v", peerip, port), UserAgent: pub}, nil
+		AddrFrom: in.AddrRecv, AddrRecv: fmt.Sprintf("%v:%v", peerip, port), UserAgent: pub, RelayVersion: relayVersion}, nil
 
 }
 
@@ -366,6 +385,50 @@ func (s *P2pServer) GetPeerInfo(ctx context.Context, in *pb.P2PGetPeerInfo) (*pb
 	return &peerinfo, nil
 }
 
+//GetStateProof 轻节点查询状态数据的默克尔证明, 证明由轻节点用自己的区块头校验
+//key 不存在的时候返回不存在的证明
+func (s *P2pServer) GetStateProof(ctx context.Context, in *pb.P2PGetStateProof) (*pb.StateProof, error) {
+	log.Debug("p2pServer GetStateProof", "p2p version", in.GetVersion())
+	if !s.checkVersion(in.GetVersion()) {
+		return nil, pb.ErrVersion
+	}
+	client := s.node.nodeInfo.client
+	msg := client.NewMessage("store", pb.EventStoreGetProof, &pb.ReqStateProof{StateHash: in.GetStateHash(), Key: in.GetKey(), Absence: true})
+	err := client.SendTimeout(msg, true, time.Minute)
+	if err != nil {
+		log.Error("GetStateProof", "Error", err.Error())
+		return nil, err
+	}
+	resp, err := client.WaitTimeout(msg, time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetData().(*pb.StateProof), nil
+}
+
+//GetBlockTxs 其他节点恢复压缩区块的时候请求缺失的交易
+func (s *P2pServer) GetBlockTxs(ctx context.Context, in *pb.P2PGetBlockTxs) (*pb.P2PBlockTxs, error) {
+	log.Debug("p2pServer GetBlockTxs", "p2p version", in.GetVersion())
+	if !s.checkVersion(in.GetVersion()) {
+		return nil, pb.ErrVersion
+	}
+	block, err := s.node.getBlockByHash(in.GetBlockHash())
+	if err != nil {
+		return nil, err
+	}
+	if len(in.GetIndexes()) == 0 {
+		return &pb.P2PBlockTxs{BlockHash: in.GetBlockHash(), Txs: block.GetTxs()}, nil
+	}
+	txs := make([]*pb.Transaction, 0, len(in.GetIndexes()))
+	for _, index := range in.GetIndexes() {
+		if index < 0 || int(index) >= len(block.GetTxs()) {
+			return nil, pb.ErrInvalidParam
+		}
+		txs = append(txs, block.GetTxs()[index])
+	}
+	return &pb.P2PBlockTxs{BlockHash: in.GetBlockHash(), Txs: txs}, nil
+}
+
 func (s *P2pServer) BroadCastBlock(ctx context.Context, in *pb.P2PBlock) (*pb.Reply, error) {
 	log.Debug("BroadCastBlock")
 	client := s.node.nodeInfo.client
@@ -385,29 +448,53 @@ func (s *P2pServer) ServerStreamSend(in *pb.P2PPing, stream pb.P2Pgservice_Serve
 	log.Debug("ServerStreamSend")
 	peername := hex.EncodeToString(in.GetSign().GetPubkey())
 	dataChain := s.addStreamHandler(stream)
-	for data := range dataChain {
-		if s.IsClose() {
-			return fmt.Errorf("node close")
-		}
-		p2pdata := new(pb.BroadCastData)
-		if block, ok := data.(*pb.P2PBlock); ok {
-			if block.GetBlock() != nil {
-				log.Debug("ServerStreamSend", "blockhash", hex.EncodeToString(block.GetBlock().GetTxHash()))
+	relay := s.addTxRelay(peername)
+	defer s.deleteTxRelay(peername, relay)
+	invTicker := time.NewTicker(txInvInterval)
+	defer invTicker.Stop()
+	for {
+		var p2pdata *pb.BroadCastData
+		select {
+		case data, ok := <-dataChain:
+			if !ok {
+				return nil
+			}
+			if s.IsClose() {
+				return fmt.Errorf("node close")
+			}
+			//增加过滤，如果自己连接了远程节点，则不需要通过stream send 重复发送数据给这个节点
+			if peerinfo := s.getInBoundPeerInfo(peername); peerinfo != nil {
+				if s.node.Has(peerinfo.addr) {
+					continue
+				}
 			}
+			if block, ok := data.(*pb.P2PBlock); ok {
+				if block.GetBlock() != nil {
+					log.Debug("ServerStreamSend", "blockhash", hex.EncodeToString(block.GetBlock().GetTxHash()))
+				}
 
-			p2pdata.Value = &pb.BroadCastData_Block{Block: block}
-		} else if tx, ok := data.(*pb.P2PTx); ok {
-			log.Debug("ServerStreamSend", "txhash", hex.EncodeToString(tx.GetTx().Hash()))
-			p2pdata.Value = &pb.BroadCastData_Tx{Tx: tx}
-		} else {
-			log.Error("RoutChate", "Convert error", data)
-			continue
-		}
-		//增加过滤，如果自己连接了远程节点，则不需要通过stream send 重复发送数据给这个节点
-		if peerinfo := s.getInBoundPeerInfo(peername); peerinfo != nil {
-			if s.node.Has(peerinfo.addr) {
+				if s.getRelayVersion(peername) >= compactBlockRelay && block.GetBlock() != nil {
+					p2pdata = &pb.BroadCastData{Value: &pb.BroadCastData_CompactBlock{CompactBlock: s.node.compactBlock(block.GetBlock())}}
+				} else {
+					p2pdata = &pb.BroadCastData{Value: &pb.BroadCastData_Block{Block: block}}
+				}
+			} else if tx, ok := data.(*pb.P2PTx); ok {
+				log.Debug("ServerStreamSend", "txhash", hex.EncodeToString(tx.GetTx().Hash()))
+				p2pdata = relay.txData(s.node, tx, s.getRelayVersion(peername))
+			} else {
+				log.Error("RoutChate", "Convert error", data)
 				continue
 			}
+		case req := <-relay.requests:
+			p2pdata = requestData(req)
+		case <-invTicker.C:
+			if s.IsClose() {
+				return fmt.Errorf("node close")
+			}
+			p2pdata = relay.invData()
+		}
+		if p2pdata == nil {
+			continue
 		}
 
 		err := stream.Send(p2pdata)
@@ -417,8 +504,6 @@ func (s *P2pServer) ServerStreamSend(in *pb.P2PPing, stream pb.P2Pgservice_Serve
 			return err
 		}
 	}
-
-	return nil
 }
 
 func (s *P2pServer) ServerStreamRead(stream pb.P2Pgservice_ServerStreamReadServer) error {
@@ -444,8 +529,15 @@ func (s *P2pServer) ServerStreamRead(stream pb.P2Pgservice_ServerStreamReadServe
 			log.Error("ServerStreamRead", "Recv", err)
 			return err
 		}
+		//被封禁的节点断开连接
+		if len(peeraddr) != 0 && s.node.nodeInfo.scoreBook.IsBanned(peeraddr) {
+			return pb.ErrPeerBanned
+		}
 
-		if block := in.GetBlock(); block != nil {
+		if compact := in.GetCompactBlock(); compact != nil {
+			s.node.relayCompactBlock(compact, peeraddr, peername)
+
+		} else if block := in.GetBlock(); block != nil {
 			hex.Encode(hash[:], block.GetBlock().Hash())
 			blockhash := string(hash[:])
 
@@ -465,8 +557,27 @@ func (s *P2pServer) ServerStreamRead(stream pb.P2Pgservice_ServerStreamReadServe
 				s.node.nodeInfo.client.Send(msg, false)
 			}
 
+		} else if inv := in.GetTxInv(); inv != nil {
+			//请求的交易需要通过对方的ServerStreamSend连接返回
+			if relay := s.getTxRelay(peername); relay != nil {
+				if err := s.node.processTxInv(relay, inv); err != nil {
+					s.node.misbehave(peeraddr, peername, pb.MisbehaveSpam, err.Error())
+				}
+			}
+
+		} else if req := in.GetGetTxs(); req != nil {
+			if relay := s.getTxRelay(peername); relay != nil {
+				if err := s.node.processGetTxs(relay, req); err != nil {
+					s.node.misbehave(peeraddr, peername, pb.MisbehaveSpam, err.Error())
+				}
+			}
+
 		} else if tx := in.GetTx(); tx != nil {
-			hex.Encode(hash[:], tx.GetTx().Hash())
+			txHash := tx.GetTx().Hash()
+			if relay := s.getTxRelay(peername); relay != nil {
+				relay.markKnown(txHash)
+			}
+			hex.Encode(hash[:], txHash)
 			txhash := string(hash[:])
 			log.Debug("ServerStreamRead", "txhash:", txhash)
 			Filter.GetLock()
@@ -476,7 +587,7 @@ func (s *P2pServer) ServerStreamRead(stream pb.P2Pgservice_ServerStreamReadServe
 			}
 			Filter.RegRecvData(txhash)
 			Filter.ReleaseLock()
-			if tx.GetTx() != nil {
+			if tx.GetTx() != nil && s.node.checkRelayTx(tx.GetTx(), peeraddr, peername) {
 				msg := s.node.nodeInfo.client.NewMessage("mempool", pb.EventTx, tx.GetTx())
 				s.node.nodeInfo.client.Send(msg, false)
 			}
@@ -489,20 +600,31 @@ func (s *P2pServer) ServerStreamRead(stream pb.P2Pgservice_ServerStreamReadServe
 				log.Error("ServerStreamRead", "check stream", "check sig err")
 				return pb.ErrStreamPing
 			}
+			if !checkPeerIdentity(stream.Context(), hex.EncodeToString(ping.GetSign().GetPubkey())) {
+				log.Error("ServerStreamRead", "check stream", "peer identity err")
+				return pb.ErrPeerIdentity
+			}
 
 			getctx, ok := pr.FromContext(stream.Context())
-			if ok && s.node.Size() > 0 {
-				//peerIp := strings.Split(getctx.Addr.String(), ":")[0]
-				peerIp, _, err := net.SplitHostPort(getctx.Addr.String())
-				if err != nil {
-					return fmt.Errorf("ctx.Addr format err")
-				}
-				if peerIp != LocalAddr && peerIp != s.node.nodeInfo.GetExternalAddr().IP.String() {
-					s.node.nodeInfo.SetServiceTy(Service)
-				}
+			if !ok {
+				return fmt.Errorf("ctx.Addr not found")
+			}
+			//peerIp := strings.Split(getctx.Addr.String(), ":")[0]
+			peerIp, _, err := net.SplitHostPort(getctx.Addr.String())
+			if err != nil {
+				return fmt.Errorf("ctx.Addr format err")
+			}
+			if s.node.Size() > 0 && peerIp != LocalAddr && peerIp != s.node.nodeInfo.GetExternalAddr().IP.String() {
+				s.node.nodeInfo.SetServiceTy(Service)
 			}
 			peername = hex.EncodeToString(ping.GetSign().GetPubkey())
-			peeraddr = fmt.Sprintf("%s:%v", in.GetPing().GetAddr(), in.GetPing().GetPort())
+			//ping 中的 ip 是对方自己填写的, 不能用来记录分数和封禁, 否则可以冒充其他节点或者换一个地址逃避封禁
+			//使用连接的 ip 和对方监听的端口, 和 Version2 中加入地址簿的地址一致
+			peeraddr = fmt.Sprintf("%s:%v", peerIp, in.GetPing().GetPort())
+			if s.node.nodeInfo.scoreBook.IsBanned(peeraddr) {
+				log.Error("ServerStreamRead", "banned peer", peeraddr)
+				return pb.ErrPeerBanned
+			}
 			s.addInBoundPeerInfo(peername, innerpeer{addr: peeraddr, name: peername, timestamp: pb.Now().Unix()})
 		} else if ver := in.GetVersion(); ver != nil {
 			//接收版本信息
@@ -605,6 +727,41 @@ func (s *P2pServer) loadMempool() (map[string]*pb.Transaction, error) {
 	return txmap, nil
 }
 
+func (s *P2pServer) setRelayVersion(peername string, ver int32) {
+	s.imtx.Lock()
+	defer s.imtx.Unlock()
+	s.relayVersions[peername] = negotiateRelay(ver)
+}
+
+func (s *P2pServer) getRelayVersion(peername string) int32 {
+	s.imtx.Lock()
+	defer s.imtx.Unlock()
+	return s.relayVersions[peername]
+}
+
+//ServerStreamSend建立连接时创建节点的交易清单广播状态, ServerStreamRead收到的请求通过这个连接返回
+func (s *P2pServer) addTxRelay(peername string) *txRelay {
+	s.imtx.Lock()
+	defer s.imtx.Unlock()
+	relay := newTxRelay()
+	s.txRelays[peername] = relay
+	return relay
+}
+
+func (s *P2pServer) getTxRelay(peername string) *txRelay {
+	s.imtx.Lock()
+	defer s.imtx.Unlock()
+	return s.txRelays[peername]
+}
+
+func (s *P2pServer) deleteTxRelay(peername string, relay *txRelay) {
+	s.imtx.Lock()
+	defer s.imtx.Unlock()
+	if s.txRelays[peername] == relay {
+		delete(s.txRelays, peername)
+	}
+}
+
 func (s *P2pServer) manageStream() {
 	go s.deleteDisableStream()
 	go func() { //发送空的block stream ping
@@ -45,6 +45,7 @@ type Peer struct {
 	taskChan     chan interface{} //tx block
 	inBounds     int32            //连接此节点的客户端节点数量
 	IsMaxInbouds bool
+	txRelay      *txRelay
 }
 
 func NewPeer(conn *grpc.ClientConn, node *Node, remote *NetAddress) *Peer {
@@ -55,6 +56,7 @@ func NewPeer(conn *grpc.ClientConn, node *Node, remote *NetAddress) *Peer {
 	p.peerStat = new(Stat)
 	p.version = new(Version)
 	p.version.SetSupport(true)
+	p.txRelay = newTxRelay()
 	p.mconn = NewMConnection(conn, remote, p)
 	return p
 }
@@ -63,6 +65,7 @@ type Version struct {
 	mtx            sync.Mutex
 	version        int32
 	versionSupport bool
+	relayVersion   int32
 }
 
 type Stat struct {
@@ -112,6 +115,19 @@ func (v *Version) GetVersion() int32 {
 	return v.version
 }
 
+//SetRelayVersion 设置和对方协商的区块广播协议版本
+func (v *Version) SetRelayVersion(ver int32) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	v.relayVersion = negotiateRelay(ver)
+}
+
+func (v *Version) GetRelayVersion() int32 {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	return v.relayVersion
+}
+
 func (p *Peer) heartBeat() {
 
 	pcli := NewNormalP2PCli()
@@ -209,10 +225,12 @@ func (p *Peer) sendStream() {
 		}
 		timeout := time.NewTimer(time.Second * 2)
 		defer timeout.Stop()
+		invTicker := time.NewTicker(txInvInterval)
+		defer invTicker.Stop()
 		var hash [64]byte
 	SEND_LOOP:
 		for {
-
+			var p2pdata *pb.BroadCastData
 			select {
 			case task := <-p.taskChan:
 				if !p.GetRunning() {
@@ -221,7 +239,7 @@ func (p *Peer) sendStream() {
 					log.Error("sendStream peer is not running")
 					return
 				}
-				p2pdata := new(pb.BroadCastData)
+				p2pdata = new(pb.BroadCastData)
 				if block, ok := task.(*pb.P2PBlock); ok {
 					height := block.GetBlock().GetHeight()
 					hex.Encode(hash[:], block.GetBlock().Hash())
@@ -236,31 +254,26 @@ func (p *Peer) sendStream() {
 						}
 					}
 
-					p2pdata.Value = &pb.BroadCastData_Block{Block: block}
+					if p.version.GetRelayVersion() >= compactBlockRelay {
+						p2pdata.Value = &pb.BroadCastData_CompactBlock{CompactBlock: p.node.compactBlock(block.GetBlock())}
+					} else {
+						p2pdata.Value = &pb.BroadCastData_Block{Block: block}
+					}
 					Filter.RegRecvData(blockhash)
 
 				} else if tx, ok := task.(*pb.P2PTx); ok {
 					hex.Encode(hash[:], tx.GetTx().Hash())
 					txhash := string(hash[:])
 					log.Debug("sendStream", "will send tx", txhash)
-					p2pdata.Value = &pb.BroadCastData_Tx{Tx: tx}
+					p2pdata = p.txRelay.txData(p.node, tx, p.version.GetRelayVersion())
 					Filter.RegRecvData(txhash)
 				}
 
-				err := resp.Send(p2pdata)
-				P2pComm.CollectPeerStat(err, p)
-				if err != nil {
-					log.Error("sendStream", "send", err)
-					if grpc.Code(err) == codes.Unimplemented { //maybe order peers delete peer to BlackList
-						p.node.nodeInfo.blacklist.Add(p.Addr(), 3600)
-					}
-					time.Sleep(time.Second) //have a rest
-					resp.CloseSend()
-					cancel()
+			case req := <-p.txRelay.requests:
+				p2pdata = requestData(req)
 
-					break SEND_LOOP //下一次外循环重新获取stream
-				}
-				log.Debug("sendStream", "send data", "ok")
+			case <-invTicker.C:
+				p2pdata = p.txRelay.invData()
 
 			case <-timeout.C:
 				if !p.GetRunning() {
@@ -272,6 +285,24 @@ func (p *Peer) sendStream() {
 				timeout.Reset(time.Second * 2)
 
 			}
+			if p2pdata == nil {
+				continue
+			}
+
+			err := resp.Send(p2pdata)
+			P2pComm.CollectPeerStat(err, p)
+			if err != nil {
+				log.Error("sendStream", "send", err)
+				if grpc.Code(err) == codes.Unimplemented { //maybe order peers delete peer to BlackList
+					p.node.misbehave(p.Addr(), p.GetPeerName(), pb.MisbehaveProtocol, err.Error())
+				}
+				time.Sleep(time.Second) //have a rest
+				resp.CloseSend()
+				cancel()
+
+				break SEND_LOOP //下一次外循环重新获取stream
+			}
+			log.Debug("sendStream", "send data", "ok")
 		}
 
 	}
@@ -312,7 +343,7 @@ func (p *Peer) readStream() {
 				log.Error("readStream", "recv,err:", err.Error())
 				resp.CloseSend()
 				if grpc.Code(err) == codes.Unimplemented { //maybe order peers delete peer to BlackList
-					p.node.nodeInfo.blacklist.Add(p.Addr(), 3600)
+					p.node.misbehave(p.Addr(), p.GetPeerName(), pb.MisbehaveProtocol, err.Error())
 				}
 				//beyound max inbound num
 				if strings.Contains(err.Error(), "beyound max inbound num") {
@@ -324,7 +355,10 @@ func (p *Peer) readStream() {
 				break
 			}
 
-			if block := data.GetBlock(); block != nil {
+			if compact := data.GetCompactBlock(); compact != nil {
+				p.node.relayCompactBlock(compact, p.Addr(), p.GetPeerName())
+
+			} else if block := data.GetBlock(); block != nil {
 				if block.GetBlock() != nil {
 					//如果已经有登记过的消息记录，则不发送给本地blockchain
 					hex.Encode(hash[:], block.GetBlock().Hash())
@@ -357,10 +391,22 @@ func (p *Peer) readStream() {
 					//Filter.RegRecvData(blockhash) //添加发送登记，下次通过stream 接收同样的消息的时候可以过滤
 				}
 
+			} else if inv := data.GetTxInv(); inv != nil {
+				if err := p.node.processTxInv(p.txRelay, inv); err != nil {
+					p.node.misbehave(p.Addr(), p.GetPeerName(), pb.MisbehaveSpam, err.Error())
+				}
+
+			} else if req := data.GetGetTxs(); req != nil {
+				if err := p.node.processGetTxs(p.txRelay, req); err != nil {
+					p.node.misbehave(p.Addr(), p.GetPeerName(), pb.MisbehaveSpam, err.Error())
+				}
+
 			} else if tx := data.GetTx(); tx != nil {
 
 				if tx.GetTx() != nil {
-					hex.Encode(hash[:], tx.Tx.Hash())
+					txHash := tx.Tx.Hash()
+					p.txRelay.markKnown(txHash)
+					hex.Encode(hash[:], txHash)
 					txhash := string(hash[:])
 					log.Debug("readStream", "tx", txhash)
 					Filter.GetLock()
@@ -370,6 +416,9 @@ func (p *Peer) readStream() {
 					}
 					Filter.RegRecvData(txhash)
 					Filter.ReleaseLock()
+					if !p.node.checkRelayTx(tx.GetTx(), p.Addr(), p.GetPeerName()) {
+						continue
+					}
 					msg := p.node.nodeInfo.client.NewMessage("mempool", pb.EventTx, tx.GetTx())
 					p.node.nodeInfo.client.Send(msg, false)
 					//Filter.RegRecvData(txhash) //登记
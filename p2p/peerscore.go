@@ -0,0 +1,259 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"net"
+	"sort"
+	"sync"
+
+	pb "github.com/33cn/chain33/types"
+)
+
+//节点信誉分数:
+//1. 每个节点初始分数为defaultPeerScore, 无效区块, 无效交易, 下载超时, 垃圾数据以及协议错误按不同的权重扣分
+//2. 分数降到banScoreThreshold以下的时候临时封禁节点, 每次封禁的时间翻倍, 封禁次数超过maxTempBanCount后永久封禁
+//3. 没有被封禁的节点每隔CheckBlackListInterVal恢复scoreRecoverStep分, 最多恢复到初始分数
+//4. 封禁记录保存在addrbook的数据库中, 重启后仍然有效
+//5. 分数按照连接的ip和监听端口记录, 封禁的时候同一个ip的所有端口都不能连接, 节点换一个端口不能逃避封禁
+
+const (
+	defaultPeerScore  int64 = 100
+	banScoreThreshold int64 = 0
+	scoreRecoverStep  int64 = 1
+	//第一次临时封禁的时间, 单位秒
+	baseBanDuration int64 = 3600
+	maxTempBanCount int32 = 5
+	//封禁记录在数据库中的key前缀
+	peerBanTag = "peerban-"
+)
+
+//不同不良行为扣除的分数
+var misbehavePenalty = map[int32]int64{
+	pb.MisbehaveInvalidBlock: 50,
+	pb.MisbehaveInvalidTx:    10,
+	pb.MisbehaveTimeout:      5,
+	pb.MisbehaveSpam:         20,
+	pb.MisbehaveProtocol:     100,
+}
+
+var misbehaveName = map[int32]string{
+	pb.MisbehaveInvalidBlock: "InvalidBlock",
+	pb.MisbehaveInvalidTx:    "InvalidTx",
+	pb.MisbehaveTimeout:      "Timeout",
+	pb.MisbehaveSpam:         "Spam",
+	pb.MisbehaveProtocol:     "Protocol",
+}
+
+//ScoreBook 记录节点的信誉分数, 封禁的节点同时加入黑名单
+type ScoreBook struct {
+	mtx       sync.Mutex
+	scores    map[string]*pb.PeerScore
+	bannedIPs map[string]int
+	addrBook  *AddrBook
+	blacklist *BlackList
+}
+
+func NewScoreBook(addrBook *AddrBook, blacklist *BlackList) *ScoreBook {
+	sb := &ScoreBook{
+		scores:    make(map[string]*pb.PeerScore),
+		bannedIPs: make(map[string]int),
+		addrBook:  addrBook,
+		blacklist: blacklist,
+	}
+	sb.loadBans()
+	return sb
+}
+
+func (sb *ScoreBook) loadBans() {
+	for _, score := range sb.addrBook.loadPeerBans() {
+		log.Debug("loadBans", "addr", score.GetAddr(), "banUntil", score.GetBanUntil())
+		sb.scores[score.GetAddr()] = score
+		sb.bannedIPs[addrIP(score.GetAddr())]++
+		sb.addBlackList(score)
+	}
+}
+
+func (sb *ScoreBook) addBlackList(score *pb.PeerScore) {
+	if score.GetBanUntil() == 0 {
+		sb.blacklist.AddForever(score.GetAddr())
+		return
+	}
+	sb.blacklist.Add(score.GetAddr(), score.GetBanUntil()-pb.Now().Unix())
+}
+
+func (sb *ScoreBook) getScore(addr string) *pb.PeerScore {
+	score, ok := sb.scores[addr]
+	if !ok {
+		score = &pb.PeerScore{Addr: addr, Score: defaultPeerScore}
+		sb.scores[addr] = score
+	}
+	return score
+}
+
+//Misbehave 节点不良行为扣分, 返回节点是否因此被封禁
+func (sb *ScoreBook) Misbehave(addr, name string, reason int32, info string) bool {
+	penalty, ok := misbehavePenalty[reason]
+	if !ok {
+		log.Error("Misbehave", "unknown reason", reason)
+		return false
+	}
+	sb.mtx.Lock()
+	defer sb.mtx.Unlock()
+	score := sb.getScore(addr)
+	if len(name) != 0 {
+		score.Name = name
+	}
+	if score.GetBanned() {
+		return false
+	}
+	score.Score -= penalty
+	log.Info("Misbehave", "addr", addr, "reason", misbehaveName[reason], "info", info, "score", score.GetScore())
+	if score.GetScore() > banScoreThreshold {
+		return false
+	}
+	var duration int64
+	if score.GetBanCount() < maxTempBanCount {
+		duration = baseBanDuration << uint(score.GetBanCount())
+	}
+	sb.ban(score, duration, misbehaveName[reason])
+	return true
+}
+
+//duration为0的时候永久封禁
+func (sb *ScoreBook) ban(score *pb.PeerScore, duration int64, reason string) {
+	if !score.GetBanned() {
+		sb.bannedIPs[addrIP(score.GetAddr())]++
+	}
+	score.Banned = true
+	score.BanCount++
+	score.Reason = reason
+	score.BanUntil = 0
+	if duration > 0 {
+		score.BanUntil = pb.Now().Unix() + duration
+	}
+	log.Info("ban peer", "addr", score.GetAddr(), "reason", reason, "banCount", score.GetBanCount(), "banUntil", score.GetBanUntil())
+	sb.addBlackList(score)
+	sb.addrBook.savePeerBan(score)
+}
+
+//Ban 手动封禁节点, duration单位秒, 为0的时候永久封禁
+func (sb *ScoreBook) Ban(addr string, duration int64, reason string) {
+	sb.mtx.Lock()
+	defer sb.mtx.Unlock()
+	sb.ban(sb.getScore(addr), duration, reason)
+}
+
+//Unban 解除节点的封禁, 分数恢复到初始值, 封禁次数保留
+func (sb *ScoreBook) Unban(addr string) error {
+	sb.mtx.Lock()
+	defer sb.mtx.Unlock()
+	score, ok := sb.scores[addr]
+	if !ok || !score.GetBanned() {
+		return pb.ErrPeerNotBanned
+	}
+	sb.unban(score)
+	return nil
+}
+
+func (sb *ScoreBook) unban(score *pb.PeerScore) {
+	log.Info("unban peer", "addr", score.GetAddr())
+	ip := addrIP(score.GetAddr())
+	sb.bannedIPs[ip]--
+	if sb.bannedIPs[ip] <= 0 {
+		delete(sb.bannedIPs, ip)
+	}
+	score.Banned = false
+	score.BanUntil = 0
+	score.Reason = ""
+	score.Score = defaultPeerScore
+	sb.blacklist.Delete(score.GetAddr())
+	sb.addrBook.deletePeerBan(score.GetAddr())
+}
+
+//IsBanned 地址或者地址的ip被封禁
+func (sb *ScoreBook) IsBanned(addr string) bool {
+	sb.mtx.Lock()
+	defer sb.mtx.Unlock()
+	if score, ok := sb.scores[addr]; ok && score.GetBanned() {
+		return true
+	}
+	return sb.bannedIPs[addrIP(addr)] > 0
+}
+
+func addrIP(addr string) string {
+	ip, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return ip
+}
+
+//Recover 解除到期的封禁, 没有被封禁的节点恢复分数
+func (sb *ScoreBook) Recover(now int64) {
+	sb.mtx.Lock()
+	defer sb.mtx.Unlock()
+	for _, score := range sb.scores {
+		if score.GetBanned() {
+			if score.GetBanUntil() != 0 && now > score.GetBanUntil() {
+				sb.unban(score)
+			}
+			continue
+		}
+		if score.GetScore() < defaultPeerScore {
+			score.Score += scoreRecoverStep
+		}
+	}
+}
+
+//GetScores 返回按地址排序的节点分数
+func (sb *ScoreBook) GetScores() []*pb.PeerScore {
+	sb.mtx.Lock()
+	defer sb.mtx.Unlock()
+	scores := make([]*pb.PeerScore, 0, len(sb.scores))
+	for _, score := range sb.scores {
+		copyScore := *score
+		scores = append(scores, &copyScore)
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		return scores[i].GetAddr() < scores[j].GetAddr()
+	})
+	return scores
+}
+
+//peerAddr 通过节点名称查找连接的节点地址, 包括主动连接和被连接的节点
+func (n *Node) peerAddr(name string) string {
+	_, infos := n.GetActivePeers()
+	for addr, info := range infos {
+		if info.GetName() == name {
+			return addr
+		}
+	}
+	if l, ok := n.listener.(*listener); ok && l != nil {
+		if inpeer := l.p2pserver.getInBoundPeerInfo(name); inpeer != nil {
+			return inpeer.addr
+		}
+	}
+	return ""
+}
+
+//misbehave 节点扣分, 封禁的节点立即断开连接
+func (n *Node) misbehave(addr, name string, reason int32, info string) {
+	if len(addr) == 0 {
+		addr = n.peerAddr(name)
+	}
+	if len(addr) == 0 || n.nodeInfo.addrBook.IsOurStringAddress(addr) {
+		return
+	}
+	if n.nodeInfo.scoreBook.Misbehave(addr, name, reason, info) {
+		n.banPeer(addr)
+	}
+}
+
+//banPeer 断开封禁节点的连接, 不再从地址簿中连接
+func (n *Node) banPeer(addr string) {
+	n.nodeInfo.addrBook.RemoveAddr(addr)
+	n.remove(addr)
+}
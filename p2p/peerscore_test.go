@@ -0,0 +1,109 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	pb "github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestScoreBook(t *testing.T, dir string) (*ScoreBook, *AddrBook, *BlackList) {
+	addrBook := NewAddrBook(&pb.P2P{Driver: "leveldb", DbPath: dir, DbCache: 4})
+	blacklist := &BlackList{badPeers: make(map[string]int64)}
+	return NewScoreBook(addrBook, blacklist), addrBook, blacklist
+}
+
+func TestScoreBookMisbehave(t *testing.T) {
+	dir, err := ioutil.TempDir("", "peerscore")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	sb, addrBook, blacklist := newTestScoreBook(t, dir)
+	addr := "192.168.1.1:13802"
+
+	assert.False(t, sb.Misbehave(addr, "peer1", pb.MisbehaveTimeout, "timeout"))
+	assert.False(t, sb.Misbehave(addr, "peer1", 100, "unknown"))
+	assert.False(t, sb.Misbehave(addr, "peer1", pb.MisbehaveInvalidBlock, "block"))
+	scores := sb.GetScores()
+	assert.Equal(t, 1, len(scores))
+	assert.Equal(t, defaultPeerScore-55, scores[0].GetScore())
+	assert.Equal(t, "peer1", scores[0].GetName())
+
+	//没有封禁的节点恢复分数
+	sb.Recover(pb.Now().Unix())
+	assert.Equal(t, defaultPeerScore-54, sb.GetScores()[0].GetScore())
+
+	assert.True(t, sb.Misbehave(addr, "peer1", pb.MisbehaveInvalidBlock, "block"))
+	assert.True(t, sb.IsBanned(addr))
+	assert.True(t, blacklist.Has(addr))
+	score := sb.GetScores()[0]
+	assert.Equal(t, int32(1), score.GetBanCount())
+	assert.Equal(t, "InvalidBlock", score.GetReason())
+	assert.True(t, score.GetBanUntil() > pb.Now().Unix())
+	//封禁期间不再扣分
+	assert.False(t, sb.Misbehave(addr, "peer1", pb.MisbehaveProtocol, "protocol"))
+
+	//封禁记录重启后仍然有效
+	addrBook.Close()
+	sb, addrBook, blacklist = newTestScoreBook(t, dir)
+	defer addrBook.Close()
+	assert.True(t, sb.IsBanned(addr))
+	assert.True(t, blacklist.Has(addr))
+	assert.Equal(t, score.GetBanUntil(), sb.GetScores()[0].GetBanUntil())
+
+	//到期解除封禁
+	sb.Recover(score.GetBanUntil() + 1)
+	assert.False(t, sb.IsBanned(addr))
+	assert.False(t, blacklist.Has(addr))
+	assert.Equal(t, 0, len(addrBook.loadPeerBans()))
+
+	//第二次封禁的时间翻倍
+	now := pb.Now().Unix()
+	assert.True(t, sb.Misbehave(addr, "peer1", pb.MisbehaveProtocol, "protocol"))
+	score = sb.GetScores()[0]
+	assert.Equal(t, int32(2), score.GetBanCount())
+	assert.True(t, score.GetBanUntil() >= now+2*baseBanDuration)
+}
+
+func TestScoreBookBan(t *testing.T) {
+	dir, err := ioutil.TempDir("", "peerscore")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	sb, addrBook, blacklist := newTestScoreBook(t, dir)
+	defer addrBook.Close()
+	addr := "192.168.1.2:13802"
+
+	assert.Equal(t, pb.ErrPeerNotBanned, sb.Unban(addr))
+	sb.Ban(addr, 0, "manual")
+	assert.True(t, sb.IsBanned(addr))
+	assert.Equal(t, int64(0), blacklist.GetBadPeers()[addr])
+	//永久封禁不会到期
+	sb.Recover(pb.Now().Unix() + 100*baseBanDuration)
+	assert.True(t, sb.IsBanned(addr))
+
+	assert.Nil(t, sb.Unban(addr))
+	assert.False(t, sb.IsBanned(addr))
+	assert.False(t, blacklist.Has(addr))
+	assert.Equal(t, defaultPeerScore, sb.GetScores()[0].GetScore())
+	assert.Equal(t, pb.ErrPeerNotBanned, sb.Unban(addr))
+
+	//封禁以后同一个ip的其他端口也不能连接
+	sb.Ban(addr, 0, "manual")
+	assert.True(t, sb.IsBanned("192.168.1.2:13803"))
+	assert.False(t, sb.IsBanned("192.168.1.3:13802"))
+	assert.Nil(t, sb.Unban(addr))
+	assert.False(t, sb.IsBanned("192.168.1.2:13803"))
+
+	//临时封禁次数超过限制后永久封禁
+	for i := int32(1); i < maxTempBanCount; i++ {
+		assert.True(t, sb.Misbehave(addr, "", pb.MisbehaveProtocol, "protocol"))
+		assert.Nil(t, sb.Unban(addr))
+	}
+	assert.True(t, sb.Misbehave(addr, "", pb.MisbehaveProtocol, "protocol"))
+	assert.Equal(t, int64(0), sb.GetScores()[0].GetBanUntil())
+}
@@ -0,0 +1,457 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/credentials"
+	pr "google.golang.org/grpc/peer"
+)
+
+//p2p连接的加密和身份认证, 在grpc的连接建立的时候完成握手:
+//1. 双方交换临时的x25519公钥和节点的p2p公钥(addrbook中保存的secp256k1密钥)
+//2. 双方用p2p私钥对整个握手过程的数据签名, 证明持有节点公钥对应的私钥
+//3. 用临时密钥协商的共享密钥派生出两个方向的aes-gcm密钥, 之后所有的数据都加密传输
+//握手时会检查对方的公钥是否在允许的列表中, 以及是否和种子节点配置的公钥一致
+
+const (
+	secureProtocol   = "chain33-p2p"
+	secureVersion    = "1.0"
+	secureLabel      = "chain33/p2p/handshake"
+	handshakeTimeout = 10 * time.Second
+	ephKeyLen        = 32
+	nodePubKeyLen    = 33
+	helloLen         = ephKeyLen + nodePubKeyLen
+	maxAuthLen       = 1024
+	maxFrameSize     = 16 * 1024
+	frameHeaderLen   = 4
+)
+
+var (
+	errHandshakeSign   = errors.New("ErrP2PHandshakeSign")
+	errHandshakeKey    = errors.New("ErrP2PHandshakeKey")
+	errPeerNotAllowed  = errors.New("ErrP2PPeerNotAllowed")
+	errPeerPubkey      = errors.New("ErrP2PPeerPubkeyMismatch")
+	errFrameSize       = errors.New("ErrP2PFrameSize")
+	errFrameDecryption = errors.New("ErrP2PFrameDecryption")
+)
+
+//启用加密的时候全局使用的连接认证, 为nil的时候使用不加密的连接
+var secureCreds *secureTransport
+
+type secureTransport struct {
+	priv   crypto.PrivKey
+	pubkey []byte
+	//允许连接的节点公钥, 为空表示不限制
+	allow map[string]bool
+	mtx   sync.Mutex
+	//地址对应的节点公钥, 连接这些地址的时候对方必须使用这个公钥
+	expected map[string]string
+}
+
+func newSecureTransport(privkey string, allowPubkeys []string) (*secureTransport, error) {
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		return nil, err
+	}
+	privBytes, err := hex.DecodeString(privkey)
+	if err != nil {
+		return nil, err
+	}
+	priv, err := cr.PrivKeyFromBytes(privBytes)
+	if err != nil {
+		return nil, err
+	}
+	s := &secureTransport{
+		priv:     priv,
+		pubkey:   priv.PubKey().Bytes(),
+		allow:    make(map[string]bool),
+		expected: make(map[string]string),
+	}
+	for _, pubkey := range allowPubkeys {
+		if _, err := decodeNodePubkey(pubkey); err != nil {
+			return nil, err
+		}
+		s.allow[strings.ToLower(pubkey)] = true
+	}
+	return s, nil
+}
+
+//setExpected 设置地址对应的节点公钥
+func (s *secureTransport) setExpected(addr string, pubkey string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.expected[addr] = strings.ToLower(pubkey)
+}
+
+func (s *secureTransport) getExpected(addr string) (string, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	pubkey, ok := s.expected[addr]
+	return pubkey, ok
+}
+
+func (s *secureTransport) isAllowed(pubkey string) bool {
+	if len(s.allow) == 0 || pubkey == hex.EncodeToString(s.pubkey) {
+		return true
+	}
+	return s.allow[pubkey]
+}
+
+func (s *secureTransport) ClientHandshake(ctx context.Context, authority string, rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(handshakeTimeout)
+	}
+	conn, auth, err := s.handshake(rawConn, deadline, true)
+	if err != nil {
+		log.Error("ClientHandshake", "addr", authority, "err", err)
+		return nil, nil, err
+	}
+	if expected, ok := s.getExpected(authority); ok && expected != auth.PubKey {
+		log.Error("ClientHandshake", "addr", authority, "expected", expected, "pubkey", auth.PubKey)
+		conn.Close()
+		return nil, nil, errPeerPubkey
+	}
+	return conn, auth, nil
+}
+
+func (s *secureTransport) ServerHandshake(rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	conn, auth, err := s.handshake(rawConn, time.Now().Add(handshakeTimeout), false)
+	if err != nil {
+		log.Error("ServerHandshake", "addr", rawConn.RemoteAddr(), "err", err)
+		return nil, nil, err
+	}
+	return conn, auth, nil
+}
+
+func (s *secureTransport) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: secureProtocol, SecurityVersion: secureVersion}
+}
+
+//Clone 公钥列表需要在所有的连接中共享, 不做拷贝
+func (s *secureTransport) Clone() credentials.TransportCredentials {
+	return s
+}
+
+func (s *secureTransport) OverrideServerName(string) error {
+	return nil
+}
+
+//hello: 临时公钥 || 节点公钥, 客户端先发送
+//auth: 节点私钥对 H(label || clientHello || serverHello) || role 的签名
+func (s *secureTransport) handshake(rawConn net.Conn, deadline time.Time, isClient bool) (*secureConn, *secureAuthInfo, error) {
+	if err := rawConn.SetDeadline(deadline); err != nil {
+		return nil, nil, err
+	}
+	var ephPriv, ephPub [ephKeyLen]byte
+	if _, err := io.ReadFull(rand.Reader, ephPriv[:]); err != nil {
+		return nil, nil, err
+	}
+	curve25519.ScalarBaseMult(&ephPub, &ephPriv)
+	hello := make([]byte, 0, helloLen)
+	hello = append(hello, ephPub[:]...)
+	hello = append(hello, s.pubkey...)
+
+	var peerHello []byte
+	var err error
+	if isClient {
+		if _, err = rawConn.Write(hello); err != nil {
+			return nil, nil, err
+		}
+		peerHello, err = readFull(rawConn, helloLen)
+	} else {
+		peerHello, err = readFull(rawConn, helloLen)
+		if err == nil {
+			_, err = rawConn.Write(hello)
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	peerPubkey := hex.EncodeToString(peerHello[ephKeyLen:])
+	peerPub, err := decodeNodePubkey(peerPubkey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !s.isAllowed(peerPubkey) {
+		return nil, nil, errPeerNotAllowed
+	}
+
+	clientHello, serverHello := hello, peerHello
+	if !isClient {
+		clientHello, serverHello = peerHello, hello
+	}
+	transcript := sha256.New()
+	transcript.Write([]byte(secureLabel))
+	transcript.Write(clientHello)
+	transcript.Write(serverHello)
+	hash := transcript.Sum(nil)
+
+	//交换签名, 签名数据中包含角色, 防止把对方的签名原样发回来
+	//客户端先发送签名, 服务端验证通过之后再发送自己的签名
+	if isClient {
+		if err = s.writeAuth(rawConn, hash, isClient); err != nil {
+			return nil, nil, err
+		}
+		err = verifyAuth(rawConn, peerPub, hash, !isClient)
+	} else {
+		err = verifyAuth(rawConn, peerPub, hash, !isClient)
+		if err == nil {
+			err = s.writeAuth(rawConn, hash, isClient)
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var peerEph, secret [ephKeyLen]byte
+	copy(peerEph[:], peerHello[:ephKeyLen])
+	curve25519.ScalarMult(&secret, &ephPriv, &peerEph)
+	var zero [ephKeyLen]byte
+	if bytes.Equal(secret[:], zero[:]) {
+		return nil, nil, errHandshakeKey
+	}
+	c2s, err := newFrameCipher(deriveKey(secret[:], hash, "c2s"))
+	if err != nil {
+		return nil, nil, err
+	}
+	s2c, err := newFrameCipher(deriveKey(secret[:], hash, "s2c"))
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = rawConn.SetDeadline(time.Time{}); err != nil {
+		return nil, nil, err
+	}
+	conn := &secureConn{Conn: rawConn, reader: s2c, writer: c2s}
+	if !isClient {
+		conn.reader, conn.writer = c2s, s2c
+	}
+	return conn, &secureAuthInfo{PubKey: peerPubkey}, nil
+}
+
+func (s *secureTransport) writeAuth(conn net.Conn, hash []byte, isClient bool) error {
+	sig := s.priv.Sign(authData(hash, isClient)).Bytes()
+	auth := make([]byte, 2+len(sig))
+	binary.BigEndian.PutUint16(auth, uint16(len(sig)))
+	copy(auth[2:], sig)
+	_, err := conn.Write(auth)
+	return err
+}
+
+func verifyAuth(conn net.Conn, peerPub crypto.PubKey, hash []byte, isClient bool) error {
+	peerSig, err := readAuth(conn)
+	if err != nil {
+		return err
+	}
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		return err
+	}
+	signature, err := cr.SignatureFromBytes(peerSig)
+	if err != nil {
+		return errHandshakeSign
+	}
+	if !peerPub.VerifyBytes(authData(hash, isClient), signature) {
+		return errHandshakeSign
+	}
+	return nil
+}
+
+func authData(hash []byte, isClient bool) []byte {
+	role := "server"
+	if isClient {
+		role = "client"
+	}
+	return append(append([]byte{}, hash...), role...)
+}
+
+func deriveKey(secret []byte, hash []byte, direction string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(hash)
+	mac.Write([]byte(direction))
+	return mac.Sum(nil)
+}
+
+func decodeNodePubkey(pubkey string) (crypto.PubKey, error) {
+	pubBytes, err := hex.DecodeString(pubkey)
+	if err != nil || len(pubBytes) != nodePubKeyLen {
+		return nil, types.ErrPubKeyLen
+	}
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		return nil, err
+	}
+	return cr.PubKeyFromBytes(pubBytes)
+}
+
+func readFull(conn net.Conn, size int) ([]byte, error) {
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+func readAuth(conn net.Conn) ([]byte, error) {
+	head, err := readFull(conn, 2)
+	if err != nil {
+		return nil, err
+	}
+	size := int(binary.BigEndian.Uint16(head))
+	if size == 0 || size > maxAuthLen {
+		return nil, errHandshakeSign
+	}
+	return readFull(conn, size)
+}
+
+//secureAuthInfo 握手之后认证过的对方节点公钥
+type secureAuthInfo struct {
+	PubKey string
+}
+
+func (a *secureAuthInfo) AuthType() string {
+	return secureProtocol
+}
+
+//checkPeerIdentity 检查对方在消息中声明的节点公钥是否和加密连接认证的公钥一致, 没有加密的连接不检查
+func checkPeerIdentity(ctx context.Context, pubkey string) bool {
+	p, ok := pr.FromContext(ctx)
+	if !ok {
+		return true
+	}
+	return checkAuthPubkey(p.AuthInfo, pubkey)
+}
+
+func checkAuthPubkey(authInfo credentials.AuthInfo, pubkey string) bool {
+	auth, ok := authInfo.(*secureAuthInfo)
+	if !ok {
+		return true
+	}
+	return auth.PubKey == strings.ToLower(pubkey)
+}
+
+type frameCipher struct {
+	aead  cipher.AEAD
+	nonce uint64
+}
+
+func newFrameCipher(key []byte) (*frameCipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &frameCipher{aead: aead}, nil
+}
+
+//每个方向的nonce是递增的计数器, 重放或者调换顺序的数据帧会解密失败
+func (c *frameCipher) nextNonce() []byte {
+	nonce := make([]byte, c.aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], c.nonce)
+	c.nonce++
+	return nonce
+}
+
+//secureConn 加密的连接, 数据按照帧传输: 长度(4字节) || aes-gcm密文
+type secureConn struct {
+	net.Conn
+	rmtx   sync.Mutex
+	wmtx   sync.Mutex
+	reader *frameCipher
+	writer *frameCipher
+	//已经解密还没有被读取的数据
+	rbuf []byte
+}
+
+func (c *secureConn) Read(b []byte) (int, error) {
+	c.rmtx.Lock()
+	defer c.rmtx.Unlock()
+	if len(c.rbuf) == 0 {
+		head, err := readFull(c.Conn, frameHeaderLen)
+		if err != nil {
+			return 0, err
+		}
+		size := int(binary.BigEndian.Uint32(head))
+		if size > maxFrameSize+c.reader.aead.Overhead() {
+			return 0, errFrameSize
+		}
+		frame, err := readFull(c.Conn, size)
+		if err != nil {
+			return 0, err
+		}
+		c.rbuf, err = c.reader.aead.Open(frame[:0], c.reader.nextNonce(), frame, nil)
+		if err != nil {
+			return 0, errFrameDecryption
+		}
+	}
+	n := copy(b, c.rbuf)
+	c.rbuf = c.rbuf[n:]
+	return n, nil
+}
+
+func (c *secureConn) Write(b []byte) (int, error) {
+	c.wmtx.Lock()
+	defer c.wmtx.Unlock()
+	written := 0
+	for len(b) > 0 {
+		size := len(b)
+		if size > maxFrameSize {
+			size = maxFrameSize
+		}
+		frame := make([]byte, frameHeaderLen, frameHeaderLen+size+c.writer.aead.Overhead())
+		frame = c.writer.aead.Seal(frame, c.writer.nextNonce(), b[:size], nil)
+		binary.BigEndian.PutUint32(frame, uint32(len(frame)-frameHeaderLen))
+		if _, err := c.Conn.Write(frame); err != nil {
+			return written, err
+		}
+		written += size
+		b = b[size:]
+	}
+	return written, nil
+}
+
+//parseSeeds 种子节点可以配置成 公钥@ip:port 的格式, 返回去掉公钥之后的地址和地址对应的公钥
+func parseSeeds(seeds []string) ([]string, map[string]string) {
+	var addrs []string
+	pubkeys := make(map[string]string)
+	for _, seed := range seeds {
+		index := strings.Index(seed, "@")
+		if index < 0 {
+			addrs = append(addrs, seed)
+			continue
+		}
+		pubkey, addr := seed[:index], seed[index+1:]
+		addrs = append(addrs, addr)
+		//前缀不是合法的节点公钥, 比如旧版本配置的 peerid@addr, 只保留地址不做公钥校验
+		if _, err := decodeNodePubkey(pubkey); err != nil {
+			log.Warn("parseSeeds", "seed", seed, "err", err)
+			continue
+		}
+		pubkeys[addr] = pubkey
+	}
+	return addrs, pubkeys
+}
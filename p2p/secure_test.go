@@ -0,0 +1,194 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"net"
+	"testing"
+
+	_ "github.com/33cn/chain33/system/crypto/secp256k1"
+	pb "github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	pr "google.golang.org/grpc/peer"
+)
+
+func newTestTransport(t *testing.T, allow []string) (*secureTransport, string) {
+	priv, pub, err := P2pComm.GenPrivPubkey()
+	require.NoError(t, err)
+	s, err := newSecureTransport(hex.EncodeToString(priv), allow)
+	require.NoError(t, err)
+	return s, hex.EncodeToString(pub)
+}
+
+type handshakeResult struct {
+	conn net.Conn
+	auth *secureAuthInfo
+	err  error
+}
+
+func testHandshake(client, server *secureTransport, authority string) (cli, srv handshakeResult) {
+	c1, c2 := net.Pipe()
+	done := make(chan handshakeResult, 1)
+	go func() {
+		conn, auth, err := server.ServerHandshake(c2)
+		if err != nil {
+			c2.Close()
+			done <- handshakeResult{err: err}
+			return
+		}
+		done <- handshakeResult{conn: conn, auth: auth.(*secureAuthInfo)}
+	}()
+	conn, auth, err := client.ClientHandshake(context.Background(), authority, c1)
+	if err != nil {
+		c1.Close()
+		cli = handshakeResult{err: err}
+	} else {
+		cli = handshakeResult{conn: conn, auth: auth.(*secureAuthInfo)}
+	}
+	srv = <-done
+	return cli, srv
+}
+
+func TestSecureHandshake(t *testing.T) {
+	client, clientPub := newTestTransport(t, nil)
+	server, serverPub := newTestTransport(t, nil)
+
+	cli, srv := testHandshake(client, server, "127.0.0.1:13802")
+	require.NoError(t, cli.err)
+	require.NoError(t, srv.err)
+	assert.Equal(t, serverPub, cli.auth.PubKey)
+	assert.Equal(t, clientPub, srv.auth.PubKey)
+
+	//超过一帧的数据需要分帧传输
+	data := bytes.Repeat([]byte("chain33"), maxFrameSize)
+	go func() {
+		cli.conn.Write(data)
+		cli.conn.Write([]byte("end"))
+	}()
+	recv := make([]byte, len(data)+3)
+	_, err := io.ReadFull(srv.conn, recv)
+	require.NoError(t, err)
+	assert.Equal(t, append(data, "end"...), recv)
+
+	go srv.conn.Write([]byte("pong"))
+	recv = make([]byte, 4)
+	_, err = io.ReadFull(cli.conn, recv)
+	require.NoError(t, err)
+	assert.Equal(t, "pong", string(recv))
+	cli.conn.Close()
+	srv.conn.Close()
+}
+
+func TestSecureHandshakeReject(t *testing.T) {
+	client, clientPub := newTestTransport(t, nil)
+	other, otherPub := newTestTransport(t, nil)
+
+	//不在允许列表中的节点
+	server, serverPub := newTestTransport(t, []string{otherPub})
+	_, srv := testHandshake(client, server, "127.0.0.1:13802")
+	assert.Equal(t, errPeerNotAllowed, srv.err)
+	_, srv = testHandshake(other, server, "127.0.0.1:13802")
+	assert.NoError(t, srv.err)
+
+	server, _ = newTestTransport(t, []string{clientPub})
+	_, srv = testHandshake(client, server, "127.0.0.1:13802")
+	assert.NoError(t, srv.err)
+
+	//种子节点的公钥不一致
+	client.setExpected("127.0.0.1:13802", otherPub)
+	cli, _ := testHandshake(client, server, "127.0.0.1:13802")
+	assert.Equal(t, errPeerPubkey, cli.err)
+	cli, _ = testHandshake(client, other, "127.0.0.1:13802")
+	assert.NoError(t, cli.err)
+	cli, _ = testHandshake(client, server, "127.0.0.1:13803")
+	assert.NoError(t, cli.err)
+
+	_, err := newSecureTransport(hex.EncodeToString([]byte("key")), nil)
+	assert.Error(t, err)
+	priv, _, err := P2pComm.GenPrivPubkey()
+	require.NoError(t, err)
+	_, err = newSecureTransport(hex.EncodeToString(priv), []string{serverPub[2:]})
+	assert.Equal(t, pb.ErrPubKeyLen, err)
+}
+
+func TestSecureConnTamper(t *testing.T) {
+	client, _ := newTestTransport(t, nil)
+	server, _ := newTestTransport(t, nil)
+	cli, srv := testHandshake(client, server, "127.0.0.1:13802")
+	require.NoError(t, cli.err)
+	require.NoError(t, srv.err)
+
+	//直接修改底层连接中的密文
+	raw := cli.conn.(*secureConn).Conn
+	frame := cli.conn.(*secureConn).writer.aead.Seal(nil, cli.conn.(*secureConn).writer.nextNonce(), []byte("hello"), nil)
+	frame[0] ^= 1
+	head := make([]byte, frameHeaderLen)
+	head[3] = byte(len(frame))
+	go raw.Write(append(head, frame...))
+	_, err := srv.conn.Read(make([]byte, 5))
+	assert.Equal(t, errFrameDecryption, err)
+	cli.conn.Close()
+	srv.conn.Close()
+}
+
+type testP2PServer struct {
+	pb.P2PgserviceServer
+}
+
+func (testP2PServer) Version2(ctx context.Context, in *pb.P2PVersion) (*pb.P2PVersion, error) {
+	if !checkPeerIdentity(ctx, in.GetUserAgent()) {
+		return nil, pb.ErrPeerIdentity
+	}
+	return &pb.P2PVersion{Nonce: in.Nonce}, nil
+}
+
+func TestSecureGrpc(t *testing.T) {
+	client, clientPub := newTestTransport(t, nil)
+	server, serverPub := newTestTransport(t, nil)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	gserver := grpc.NewServer(grpc.Creds(server))
+	pb.RegisterP2PgserviceServer(gserver, testP2PServer{})
+	go gserver.Serve(l)
+	defer gserver.Stop()
+
+	conn, err := grpc.Dial(l.Addr().String(), grpc.WithTransportCredentials(client))
+	require.NoError(t, err)
+	defer conn.Close()
+	var remote pr.Peer
+	cli := pb.NewP2PgserviceClient(conn)
+	resp, err := cli.Version2(context.Background(), &pb.P2PVersion{Nonce: 1, UserAgent: clientPub}, grpc.Peer(&remote))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.Nonce)
+	assert.True(t, checkAuthPubkey(remote.AuthInfo, serverPub))
+	assert.False(t, checkAuthPubkey(remote.AuthInfo, clientPub))
+
+	//声明的公钥和连接认证的公钥不一致
+	_, err = cli.Version2(context.Background(), &pb.P2PVersion{Nonce: 2, UserAgent: serverPub})
+	assert.Error(t, err)
+
+	//未加密的客户端无法连接
+	plain, err := grpc.Dial(l.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer plain.Close()
+	_, err = pb.NewP2PgserviceClient(plain).Version2(context.Background(), &pb.P2PVersion{Nonce: 3}, grpc.FailFast(true))
+	assert.Error(t, err)
+}
+
+func TestParseSeeds(t *testing.T) {
+	_, pub, err := P2pComm.GenPrivPubkey()
+	require.NoError(t, err)
+	pubkey := hex.EncodeToString(pub)
+	addrs, pubkeys := parseSeeds([]string{"1.1.1.1:13802", pubkey + "@2.2.2.2:13802", "00@3.3.3.3:13802", "pid1@4.4.4.4:13802"})
+	assert.Equal(t, []string{"1.1.1.1:13802", "2.2.2.2:13802", "3.3.3.3:13802", "4.4.4.4:13802"}, addrs)
+	assert.Equal(t, map[string]string{"2.2.2.2:13802": pubkey}, pubkeys)
+}
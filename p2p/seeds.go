@@ -0,0 +1,219 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/33cn/chain33/types"
+)
+
+//种子节点的来源:
+//1. static: 配置文件中的seeds
+//2. file: 本地的种子节点文件, 每行一个地址, #开头的是注释
+//3. dns: 域名的TXT记录中的种子节点地址, 以及A/AAAA记录解析的ip加上端口
+//4. http: 从http地址下载种子节点文件, useGithub对应原来github上的种子节点文件
+//种子节点的地址可以是 "ip:port" 或者 "公钥@ip:port" 的格式, 没有端口的使用默认端口,
+//所有来源的公钥都和配置文件中的seeds一样通过parseSeeds解析, 开启加密的时候连接这个地址必须使用这个公钥
+
+const (
+	githubSeedURL = "https://raw.githubusercontent.com/chainseed/seeds/master/bty.txt"
+	//http下载种子节点文件的超时时间和大小限制
+	seedHTTPTimeout  = 30 * time.Second
+	maxSeedFileBytes = 1 << 20
+)
+
+//SeedSource 种子节点的来源
+type SeedSource interface {
+	Name() string
+	Seeds() ([]string, error)
+}
+
+//newSeedSources 根据[p2p]配置创建种子节点的来源
+func newSeedSources(cfg *types.P2P) []SeedSource {
+	var sources []SeedSource
+	if len(cfg.Seeds) != 0 {
+		sources = append(sources, &staticSeeds{seeds: cfg.Seeds})
+	}
+	if len(cfg.SeedFile) != 0 {
+		sources = append(sources, &fileSeeds{path: cfg.SeedFile})
+	}
+	if len(cfg.DnsSeeds) != 0 {
+		sources = append(sources, newDNSSeeds(cfg.DnsSeeds))
+	}
+	urls := cfg.SeedUrls
+	if cfg.UseGithub {
+		urls = append(urls, githubSeedURL)
+	}
+	for _, url := range urls {
+		sources = append(sources, &httpSeeds{url: url, client: &http.Client{Timeout: seedHTTPTimeout}})
+	}
+	return sources
+}
+
+type staticSeeds struct {
+	seeds []string
+}
+
+func (s *staticSeeds) Name() string {
+	return "static"
+}
+
+func (s *staticSeeds) Seeds() ([]string, error) {
+	return parseSeedList(strings.Join(s.seeds, "\n")), nil
+}
+
+type fileSeeds struct {
+	path string
+}
+
+func (f *fileSeeds) Name() string {
+	return "file:" + f.path
+}
+
+func (f *fileSeeds) Seeds() ([]string, error) {
+	content, err := ioutil.ReadFile(f.path)
+	if err != nil {
+		return nil, err
+	}
+	return parseSeedList(string(content)), nil
+}
+
+type dnsSeeds struct {
+	names      []string
+	lookupTXT  func(string) ([]string, error)
+	lookupHost func(string) ([]string, error)
+}
+
+func newDNSSeeds(names []string) *dnsSeeds {
+	return &dnsSeeds{names: names, lookupTXT: net.LookupTXT, lookupHost: net.LookupHost}
+}
+
+func (d *dnsSeeds) Name() string {
+	return "dns:" + strings.Join(d.names, ",")
+}
+
+//Seeds 域名可以带端口, A/AAAA记录解析的ip使用域名的端口, 没有端口的使用默认端口
+func (d *dnsSeeds) Seeds() ([]string, error) {
+	var seeds []string
+	var lastErr error
+	for _, name := range d.names {
+		host, port := splitSeedHost(name)
+		records, err := d.lookupTXT(host)
+		if err != nil {
+			log.Debug("dnsSeeds", "LookupTXT", host, "err", err)
+			lastErr = err
+		}
+		seeds = append(seeds, parseSeedList(strings.Join(records, "\n"))...)
+		ips, err := d.lookupHost(host)
+		if err != nil {
+			log.Debug("dnsSeeds", "LookupHost", host, "err", err)
+			lastErr = err
+		}
+		for _, ip := range ips {
+			seeds = append(seeds, net.JoinHostPort(ip, port))
+		}
+	}
+	if len(seeds) == 0 {
+		return nil, lastErr
+	}
+	return seeds, nil
+}
+
+type httpSeeds struct {
+	url    string
+	client *http.Client
+}
+
+func (h *httpSeeds) Name() string {
+	return "http:" + h.url
+}
+
+func (h *httpSeeds) Seeds() ([]string, error) {
+	res, err := h.client.Get(h.url)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("http status %v", res.Status)
+	}
+	content, err := ioutil.ReadAll(io.LimitReader(res.Body, maxSeedFileBytes))
+	if err != nil {
+		return nil, err
+	}
+	return parseSeedList(string(content)), nil
+}
+
+func splitSeedHost(name string) (string, string) {
+	host, port, err := net.SplitHostPort(name)
+	if err != nil {
+		return name, strconv.Itoa(defaultPort)
+	}
+	return host, port
+}
+
+//parseSeedList 解析种子节点列表, 地址之间用换行, 空格或者逗号分隔, 去掉注释, 保留地址前面的公钥
+func parseSeedList(content string) []string {
+	var seeds []string
+	for _, line := range strings.Split(content, "\n") {
+		if index := strings.Index(line, "#"); index >= 0 {
+			line = line[:index]
+		}
+		for _, seed := range strings.Fields(strings.Replace(line, ",", " ", -1)) {
+			var pubkey string
+			if index := strings.Index(seed, "@"); index >= 0 {
+				pubkey, seed = seed[:index+1], seed[index+1:]
+			}
+			host, port := splitSeedHost(seed)
+			if len(host) == 0 {
+				continue
+			}
+			seeds = append(seeds, pubkey+net.JoinHostPort(host, port))
+		}
+	}
+	return seeds
+}
+
+//getAddrFromSeeds 从所有的种子节点来源获取地址, 交给monitorDialPeers连接
+func (n *Node) getAddrFromSeeds() {
+	for _, source := range n.seedSources {
+		seeds, err := source.Seeds()
+		if err != nil {
+			log.Error("getAddrFromSeeds", "source", source.Name(), "err", err)
+			continue
+		}
+		seeds, pubkeys := parseSeeds(seeds)
+		log.Info("getAddrFromSeeds", "source", source.Name(), "seeds", len(seeds))
+		n.setSeedPubkeys(pubkeys)
+		for _, addr := range seeds {
+			if n.Has(addr) || n.nodeInfo.blacklist.Has(addr) || n.nodeInfo.addrBook.IsOurStringAddress(addr) {
+				continue
+			}
+			n.pubsub.FIFOPub(addr, "addr")
+		}
+	}
+}
+
+//setSeedPubkeys 记录种子节点地址对应的公钥, 已经记录过的地址不会被覆盖,
+//所以配置文件中的seeds的公钥优先, 其他来源不能替换已经固定的公钥
+func (n *Node) setSeedPubkeys(pubkeys map[string]string) {
+	if secureCreds == nil {
+		return
+	}
+	for addr, pubkey := range pubkeys {
+		if _, ok := secureCreds.getExpected(addr); ok {
+			continue
+		}
+		secureCreds.setExpected(addr, pubkey)
+	}
+}
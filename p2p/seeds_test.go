@@ -0,0 +1,151 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	pb "github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSeedList(t *testing.T) {
+	content := "# seeds\n192.168.1.1:13802\n pid1@192.168.1.2:13803, 192.168.1.3 #comment\n\n"
+	seeds := parseSeedList(content)
+	assert.Equal(t, []string{"192.168.1.1:13802", "pid1@192.168.1.2:13803", fmt.Sprintf("192.168.1.3:%v", defaultPort)}, seeds)
+	assert.Equal(t, 0, len(parseSeedList("#only comment")))
+}
+
+func TestSetSeedPubkeys(t *testing.T) {
+	creds, pubkey := newTestTransport(t, nil)
+	_, otherPub := newTestTransport(t, nil)
+	secureCreds = creds
+	defer func() { secureCreds = nil }()
+	creds.setExpected("1.1.1.1:13802", pubkey)
+
+	seeds, pubkeys := parseSeeds(parseSeedList(otherPub + "@1.1.1.1:13802\n" + otherPub + "@2.2.2.2"))
+	assert.Equal(t, []string{"1.1.1.1:13802", fmt.Sprintf("2.2.2.2:%v", defaultPort)}, seeds)
+	n := &Node{}
+	n.setSeedPubkeys(pubkeys)
+	//已经固定的公钥不会被其他来源替换
+	expected, _ := creds.getExpected("1.1.1.1:13802")
+	assert.Equal(t, pubkey, expected)
+	expected, ok := creds.getExpected(fmt.Sprintf("2.2.2.2:%v", defaultPort))
+	assert.True(t, ok)
+	assert.Equal(t, otherPub, expected)
+}
+
+func TestFileSeeds(t *testing.T) {
+	file, err := ioutil.TempFile("", "seeds")
+	assert.Nil(t, err)
+	defer os.Remove(file.Name())
+	_, err = file.WriteString("192.168.1.1:13802\n192.168.1.2:13802\n")
+	assert.Nil(t, err)
+	file.Close()
+
+	source := &fileSeeds{path: file.Name()}
+	seeds, err := source.Seeds()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(seeds))
+
+	source = &fileSeeds{path: file.Name() + ".notexist"}
+	_, err = source.Seeds()
+	assert.NotNil(t, err)
+}
+
+func TestDNSSeeds(t *testing.T) {
+	source := newDNSSeeds([]string{"seed.example.com:13803", "bad.example.com"})
+	source.lookupTXT = func(host string) ([]string, error) {
+		if host == "seed.example.com" {
+			return []string{"192.168.1.1:13802,192.168.1.2:13802"}, nil
+		}
+		return nil, errors.New("no such host")
+	}
+	source.lookupHost = func(host string) ([]string, error) {
+		if host == "seed.example.com" {
+			return []string{"192.168.1.3"}, nil
+		}
+		return nil, errors.New("no such host")
+	}
+	seeds, err := source.Seeds()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"192.168.1.1:13802", "192.168.1.2:13802", "192.168.1.3:13803"}, seeds)
+
+	source.names = []string{"bad.example.com"}
+	_, err = source.Seeds()
+	assert.NotNil(t, err)
+}
+
+func TestHTTPSeeds(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/seeds.txt" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, "192.168.1.1:13802\n192.168.1.2:13802\n")
+	}))
+	defer server.Close()
+
+	source := &httpSeeds{url: server.URL + "/seeds.txt", client: server.Client()}
+	seeds, err := source.Seeds()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(seeds))
+
+	source = &httpSeeds{url: server.URL + "/notfound", client: server.Client()}
+	_, err = source.Seeds()
+	assert.NotNil(t, err)
+}
+
+func TestNewSeedSources(t *testing.T) {
+	cfg := &pb.P2P{
+		Seeds:     []string{"192.168.1.1:13802"},
+		SeedFile:  "seeds.txt",
+		DnsSeeds:  []string{"seed.example.com"},
+		SeedUrls:  []string{"http://127.0.0.1/seeds.txt"},
+		UseGithub: true,
+	}
+	sources := newSeedSources(cfg)
+	assert.Equal(t, 5, len(sources))
+	assert.Equal(t, "static", sources[0].Name())
+	assert.Equal(t, "http:"+githubSeedURL, sources[4].Name())
+	assert.Equal(t, 0, len(newSeedSources(&pb.P2P{})))
+}
+
+func TestKadLookup(t *testing.T) {
+	//节点i连接节点i+1, 查询应该沿着链发现所有的节点
+	peer := func(i int) *pb.P2PPeerInfo {
+		return &pb.P2PPeerInfo{Name: fmt.Sprintf("peer%d", i), Addr: fmt.Sprintf("192.168.1.%d", i), Port: 13802}
+	}
+	query := func(addr string) ([]*pb.P2PPeerInfo, error) {
+		var i int
+		fmt.Sscanf(addr, "192.168.1.%d:13802", &i)
+		if i >= 20 {
+			return nil, errors.New("timeout")
+		}
+		return []*pb.P2PPeerInfo{peer(i + 1), peer(i + 2)}, nil
+	}
+	start := []*kadNode{{id: kadID("peer1"), addr: "192.168.1.1:13802"}}
+	target := kadID("peer1")
+	found := kadLookup(target, start, query)
+	assert.NotEqual(t, 0, len(found))
+	seen := make(map[string]bool)
+	for _, node := range found {
+		assert.False(t, seen[node.addr])
+		seen[node.addr] = true
+		assert.NotEqual(t, "192.168.1.1:13802", node.addr)
+	}
+	//查询失败的时候没有发现新节点
+	found = kadLookup(target, start, func(addr string) ([]*pb.P2PPeerInfo, error) {
+		return nil, errors.New("timeout")
+	})
+	assert.Equal(t, 0, len(found))
+	assert.Equal(t, 0, len(kadLookup(target, nil, query)))
+}
@@ -0,0 +1,188 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	pb "github.com/33cn/chain33/types"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+//交易清单的广播:
+//1. 交易不再直接广播, 每个连接把需要广播的交易hash加入待发送的清单, 定时批量发送
+//2. 接收方只请求没有收到过并且没有在请求中的交易, 对方通过同一个节点的连接返回交易
+//3. 每个连接记录对方已经知道的交易, 对方发送过清单或者交易的不再广播给对方
+//双方协商的区块广播协议版本不支持交易清单的时候仍然广播完整的交易
+
+const (
+	//发送交易清单的间隔
+	txInvInterval = 200 * time.Millisecond
+	//一个交易清单中最多的交易数量
+	maxTxInvSize = 1000
+	//每个连接记录的对方已经知道的交易数量
+	knownTxSize = 10240
+	//最近广播的交易数量, 用于响应其他节点的交易请求
+	relayTxCacheSize = 10240
+	//请求的交易超时没有收到的时候可以向其他节点请求, 单位秒
+	txRequestTimeout int64 = 10
+	txRelayQueueSize       = 1024
+)
+
+//交易清单中的交易数量超过限制的节点按发送垃圾数据处理
+var errTxInvSize = errors.New("ErrTxInvSize")
+
+func newTxCache(size int) *lru.Cache {
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return cache
+}
+
+//txRelay 一个节点连接上交易清单广播的状态
+type txRelay struct {
+	mtx      sync.Mutex
+	known    *lru.Cache       //对方已经知道的交易
+	pending  [][]byte         //待发送的交易清单
+	requests chan interface{} //需要发送给对方的交易请求和请求的交易
+}
+
+func newTxRelay() *txRelay {
+	return &txRelay{known: newTxCache(knownTxSize), requests: make(chan interface{}, txRelayQueueSize)}
+}
+
+func (r *txRelay) markKnown(hash []byte) {
+	r.known.Add(string(hash), struct{}{})
+}
+
+func (r *txRelay) isKnown(hash []byte) bool {
+	return r.known.Contains(string(hash))
+}
+
+//加入待发送的清单, 清单中的交易达到最大数量的时候返回true
+func (r *txRelay) announce(hash []byte) bool {
+	if r.isKnown(hash) {
+		return false
+	}
+	r.markKnown(hash)
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.pending = append(r.pending, hash)
+	return len(r.pending) >= maxTxInvSize
+}
+
+func (r *txRelay) flush() [][]byte {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	hashes := r.pending
+	r.pending = nil
+	return hashes
+}
+
+//队列满的时候丢弃, 不阻塞接收数据
+func (r *txRelay) push(data interface{}) {
+	select {
+	case r.requests <- data:
+	default:
+		log.Debug("txRelay", "requests queue full, drop", len(r.requests))
+	}
+}
+
+//广播交易发送的数据, 返回nil表示交易hash已经加入待发送的清单
+func (r *txRelay) txData(n *Node, tx *pb.P2PTx, version int32) *pb.BroadCastData {
+	if version < invTxRelay {
+		return &pb.BroadCastData{Value: &pb.BroadCastData_Tx{Tx: tx}}
+	}
+	hash := tx.GetTx().Hash()
+	n.relayTxs.Add(string(hash), tx.GetTx())
+	if !r.announce(hash) {
+		return nil
+	}
+	return r.invData()
+}
+
+//待发送的交易清单, 清单为空的时候返回nil
+func (r *txRelay) invData() *pb.BroadCastData {
+	hashes := r.flush()
+	if len(hashes) == 0 {
+		return nil
+	}
+	return &pb.BroadCastData{Value: &pb.BroadCastData_TxInv{TxInv: &pb.P2PTxInv{Hashes: hashes}}}
+}
+
+func requestData(data interface{}) *pb.BroadCastData {
+	switch req := data.(type) {
+	case *pb.P2PGetTxs:
+		return &pb.BroadCastData{Value: &pb.BroadCastData_GetTxs{GetTxs: req}}
+	case *pb.P2PTx:
+		return &pb.BroadCastData{Value: &pb.BroadCastData_Tx{Tx: req}}
+	}
+	return nil
+}
+
+//收到过的交易和正在请求的交易不需要再请求
+func (n *Node) needTx(hash []byte) bool {
+	Filter.GetLock()
+	recv := Filter.QueryRecvData(hex.EncodeToString(hash))
+	Filter.ReleaseLock()
+	if recv {
+		return false
+	}
+	now := pb.Now().Unix()
+	if reqtime, ok := n.requestedTxs.Get(string(hash)); ok && now-reqtime.(int64) < txRequestTimeout {
+		return false
+	}
+	n.requestedTxs.Add(string(hash), now)
+	return true
+}
+
+//processTxInv 收到交易清单, 请求本地没有的交易
+func (n *Node) processTxInv(r *txRelay, inv *pb.P2PTxInv) error {
+	if len(inv.GetHashes()) > maxTxInvSize {
+		log.Error("processTxInv", "too many txs", len(inv.GetHashes()))
+		return errTxInvSize
+	}
+	var hashes [][]byte
+	for _, hash := range inv.GetHashes() {
+		r.markKnown(hash)
+		if n.needTx(hash) {
+			hashes = append(hashes, hash)
+		}
+	}
+	if len(hashes) > 0 {
+		r.push(&pb.P2PGetTxs{Hashes: hashes})
+	}
+	return nil
+}
+
+//processGetTxs 返回对方请求的交易, 只返回最近广播过的交易
+func (n *Node) processGetTxs(r *txRelay, req *pb.P2PGetTxs) error {
+	if len(req.GetHashes()) > maxTxInvSize {
+		log.Error("processGetTxs", "too many txs", len(req.GetHashes()))
+		return errTxInvSize
+	}
+	for _, hash := range req.GetHashes() {
+		tx, ok := n.relayTxs.Get(string(hash))
+		if !ok {
+			continue
+		}
+		r.markKnown(hash)
+		r.push(&pb.P2PTx{Tx: tx.(*pb.Transaction)})
+	}
+	return nil
+}
+
+//checkRelayTx 收到的交易签名错误的节点扣分, 验证结果缓存在共享的签名验证服务中
+func (n *Node) checkRelayTx(tx *pb.Transaction, addr, name string) bool {
+	if pb.GetSignVerifier().VerifyTx(tx) {
+		return true
+	}
+	n.misbehave(addr, name, pb.MisbehaveInvalidTx, hex.EncodeToString(tx.Hash()))
+	return false
+}
@@ -0,0 +1,78 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"encoding/hex"
+	"testing"
+
+	pb "github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxRelayAnnounce(t *testing.T) {
+	node := newTestNode(t, nil)
+	relay := newTxRelay()
+	block := newTestBlock(3)
+	tx := &pb.P2PTx{Tx: block.Txs[0]}
+
+	//不支持交易清单的节点直接发送交易
+	data := relay.txData(node, tx, compactBlockRelay)
+	assert.Equal(t, tx, data.GetTx())
+	assert.Nil(t, relay.invData())
+
+	assert.Nil(t, relay.txData(node, tx, invTxRelay))
+	assert.Nil(t, relay.txData(node, tx, invTxRelay))
+	relay.markKnown(block.Txs[1].Hash())
+	assert.Nil(t, relay.txData(node, &pb.P2PTx{Tx: block.Txs[1]}, invTxRelay))
+	assert.Nil(t, relay.txData(node, &pb.P2PTx{Tx: block.Txs[2]}, invTxRelay))
+	data = relay.invData()
+	assert.Equal(t, [][]byte{block.Txs[0].Hash(), block.Txs[2].Hash()}, data.GetTxInv().GetHashes())
+	assert.Nil(t, relay.invData())
+
+	//清单满的时候立即发送
+	for i := 0; i < maxTxInvSize-1; i++ {
+		tx := &pb.Transaction{Execer: []byte("coins"), Nonce: int64(i + 100)}
+		assert.Nil(t, relay.txData(node, &pb.P2PTx{Tx: tx}, invTxRelay))
+	}
+	tx = &pb.P2PTx{Tx: &pb.Transaction{Execer: []byte("coins"), Nonce: 1}}
+	data = relay.txData(node, tx, invTxRelay)
+	assert.Equal(t, maxTxInvSize, len(data.GetTxInv().GetHashes()))
+	assert.Nil(t, relay.invData())
+}
+
+func TestTxRelayRequest(t *testing.T) {
+	sender := newTestNode(t, nil)
+	receiver := newTestNode(t, nil)
+	senderRelay, receiverRelay := newTxRelay(), newTxRelay()
+	block := newTestBlock(3)
+	for _, tx := range block.Txs {
+		senderRelay.txData(sender, &pb.P2PTx{Tx: tx}, invTxRelay)
+	}
+	Filter.RegRecvData(hex.EncodeToString(block.Txs[0].Hash()))
+	defer Filter.RemoveRecvData(hex.EncodeToString(block.Txs[0].Hash()))
+
+	//只请求没有收到过的交易
+	receiver.processTxInv(receiverRelay, senderRelay.invData().GetTxInv())
+	req := requestData(<-receiverRelay.requests).GetGetTxs()
+	assert.Equal(t, [][]byte{block.Txs[1].Hash(), block.Txs[2].Hash()}, req.GetHashes())
+	for _, tx := range block.Txs {
+		assert.True(t, receiverRelay.isKnown(tx.Hash()))
+	}
+	//正在请求的交易不重复请求
+	other := newTxRelay()
+	receiver.processTxInv(other, &pb.P2PTxInv{Hashes: req.GetHashes()})
+	assert.Equal(t, 0, len(other.requests))
+
+	sender.processGetTxs(senderRelay, &pb.P2PGetTxs{Hashes: append(req.GetHashes(), []byte("unknown"))})
+	assert.Equal(t, 2, len(senderRelay.requests))
+	assert.Equal(t, block.Txs[1], requestData(<-senderRelay.requests).GetTx().GetTx())
+	assert.Equal(t, block.Txs[2], requestData(<-senderRelay.requests).GetTx().GetTx())
+
+	hashes := make([][]byte, maxTxInvSize+1)
+	assert.Equal(t, errTxInvSize, receiver.processTxInv(receiverRelay, &pb.P2PTxInv{Hashes: hashes}))
+	assert.Equal(t, 0, len(receiverRelay.requests))
+	assert.Equal(t, errTxInvSize, sender.processGetTxs(senderRelay, &pb.P2PGetTxs{Hashes: hashes}))
+}
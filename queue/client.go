@@ -36,6 +36,7 @@ type Client interface {
 	Sub(topic string) //订阅消息
 	Close()
 	CloseQueue() (*types.Reply, error)
+	QueueStats() (*types.QueueStats, error) //获取每个 topic 的统计信息
 	NewMessage(topic string, ty int64, data interface{}) (msg Message)
 }
 
@@ -73,6 +74,7 @@ func (client *client) Send(msg Message, waitReply bool) (err error) {
 	}
 	err = client.SendTimeout(msg, waitReply, timeout)
 	if err == types.ErrTimeout {
+		qlog.Crit("send timeout", "msg", msg, "stat", client.q.topicStat(msg.Topic))
 		panic(err)
 	}
 	return err
@@ -172,6 +174,13 @@ func (client *client) CloseQueue() (*types.Reply, error) {
 	return &types.Reply{IsOk: true}, nil
 }
 
+func (client *client) QueueStats() (*types.QueueStats, error) {
+	if client.q.isClosed() {
+		return nil, types.ErrChannelClosed
+	}
+	return client.q.stats(), nil
+}
+
 func (client *client) isEnd(data Message, ok bool) bool {
 	if !ok {
 		return true
@@ -205,6 +214,7 @@ func (client *client) Sub(topic string) {
 					return
 				}
 				client.Recv() <- data
+				sub.onRecv(data)
 			default:
 				select {
 				case data, ok := <-sub.high:
@@ -213,12 +223,14 @@ func (client *client) Sub(topic string) {
 						return
 					}
 					client.Recv() <- data
+					sub.onRecv(data)
 				case data, ok := <-sub.low:
 					if client.isEnd(data, ok) {
 						qlog.Info("unsub3", "topic", topic)
 						return
 					}
 					client.Recv() <- data
+					sub.onRecv(data)
 				case <-client.done:
 					qlog.Error("unsub4", "topic", topic)
 					return
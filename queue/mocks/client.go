@@ -57,6 +57,29 @@ func (_m *Client) NewMessage(topic string, ty int64, data interface{}) queue.Mes
 	return r0
 }
 
+// QueueStats provides a mock function with given fields:
+func (_m *Client) QueueStats() (*types.QueueStats, error) {
+	ret := _m.Called()
+
+	var r0 *types.QueueStats
+	if rf, ok := ret.Get(0).(func() *types.QueueStats); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueueStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Recv provides a mock function with given fields:
 func (_m *Client) Recv() chan queue.Message {
 	ret := _m.Called()
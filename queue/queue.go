@@ -7,7 +7,9 @@ package queue
 import (
 	"fmt"
 	"os"
+	"net"
 	"os/signal"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -39,6 +41,97 @@ type chanSub struct {
 	high    chan Message
 	low     chan Message
 	isClose int32
+	stat    topicStat
+	//队列积压超过 3/4 以后拒绝新的消息, 而不是阻塞到超时
+	reject bool
+}
+
+//topic 的统计信息, 用原子操作更新
+type topicStat struct {
+	send       int64
+	recv       int64
+	timeout    int64
+	full       int64
+	latency    int64
+	maxLatency int64
+	busy       int32
+}
+
+func newChanSub(highBuffer, lowBuffer int) *chanSub {
+	return &chanSub{high: make(chan Message, highBuffer), low: make(chan Message, lowBuffer)}
+}
+
+//消息进入队列
+func (sub *chanSub) onSend(topic string) {
+	atomic.AddInt64(&sub.stat.send, 1)
+	sub.checkBusy(topic)
+}
+
+//订阅者取走消息, 统计消息在队列中等待的时间
+func (sub *chanSub) onRecv(msg Message) {
+	atomic.AddInt64(&sub.stat.recv, 1)
+	if msg.sendTime == 0 {
+		return
+	}
+	latency := time.Now().UnixNano() - msg.sendTime
+	atomic.AddInt64(&sub.stat.latency, latency)
+	for {
+		max := atomic.LoadInt64(&sub.stat.maxLatency)
+		if latency <= max || atomic.CompareAndSwapInt64(&sub.stat.maxLatency, max, latency) {
+			break
+		}
+	}
+}
+
+//配置了 rejectWhenBusy 的 topic, 队列积压超过 3/4 以后新的消息直接返回 ErrChannelFull,
+//发送方可以马上知道处理慢, 而不是一直阻塞到 Send 超时 panic
+func (sub *chanSub) isFull(ch chan Message) bool {
+	if !sub.reject {
+		return false
+	}
+	if len(ch)*4 >= cap(ch)*3 {
+		atomic.AddInt64(&sub.stat.full, 1)
+		return true
+	}
+	return false
+}
+
+//队列积压超过 3/4 的时候打印警告, 下降到 1/4 以下的时候恢复
+//没有配置 rejectWhenBusy 的 topic, 消息的发送方在队列满了以后会阻塞, 这个日志可以找到处理慢的模块
+func (sub *chanSub) checkBusy(topic string) {
+	highLen, lowLen := len(sub.high), len(sub.low)
+	highCap, lowCap := cap(sub.high), cap(sub.low)
+	if highLen*4 >= highCap*3 || lowLen*4 >= lowCap*3 {
+		if atomic.CompareAndSwapInt32(&sub.stat.busy, 0, 1) {
+			qlog.Warn("queue busy", "topic", topic, "high", highLen, "low", lowLen)
+		}
+		return
+	}
+	if highLen*4 < highCap && lowLen*4 < lowCap {
+		if atomic.CompareAndSwapInt32(&sub.stat.busy, 1, 0) {
+			qlog.Info("queue idle", "topic", topic, "high", highLen, "low", lowLen)
+		}
+	}
+}
+
+func (sub *chanSub) getStat(topic string) *types.TopicStat {
+	stat := &types.TopicStat{
+		Topic:        topic,
+		HighLen:      int32(len(sub.high)),
+		HighCap:      int32(cap(sub.high)),
+		LowLen:       int32(len(sub.low)),
+		LowCap:       int32(cap(sub.low)),
+		SendCount:    atomic.LoadInt64(&sub.stat.send),
+		RecvCount:    atomic.LoadInt64(&sub.stat.recv),
+		TimeoutCount: atomic.LoadInt64(&sub.stat.timeout),
+		FullCount:    atomic.LoadInt64(&sub.stat.full),
+		MaxLatency:   atomic.LoadInt64(&sub.stat.maxLatency) / int64(time.Microsecond),
+		Busy:         atomic.LoadInt32(&sub.stat.busy) == 1,
+	}
+	if stat.RecvCount > 0 {
+		stat.AvgLatency = atomic.LoadInt64(&sub.stat.latency) / stat.RecvCount / int64(time.Microsecond)
+	}
+	return stat
 }
 
 /// Queue only one obj in project
@@ -58,6 +151,8 @@ type queue struct {
 	interupt chan struct{}
 	isClose  int32
 	name     string
+	buffers  map[string]*types.QueueTopic
+	listener net.Listener
 }
 
 func New(name string) Queue {
@@ -65,6 +160,25 @@ func New(name string) Queue {
 	return q
 }
 
+//NewWithConfig 按照配置设置每个 topic 的队列长度, 配置了 listenAddr 的时候其他进程中的模块可以连接到这个队列
+func NewWithConfig(name string, cfg *types.Queue) Queue {
+	q := New(name).(*queue)
+	if cfg == nil {
+		return q
+	}
+	q.buffers = make(map[string]*types.QueueTopic)
+	for _, topic := range cfg.Topics {
+		q.buffers[topic.Topic] = topic
+	}
+	if cfg.ListenAddr != "" {
+		err := q.listen(cfg.ListenAddr)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return q
+}
+
 func (q *queue) Name() string {
 	return q.name
 }
@@ -105,6 +219,9 @@ func (q *queue) Close() {
 		}
 	}
 	q.mu.Unlock()
+	if q.listener != nil {
+		q.listener.Close()
+	}
 	q.done <- struct{}{}
 	close(q.done)
 	atomic.StoreInt32(&q.isClose, 1)
@@ -116,11 +233,49 @@ func (q *queue) chanSub(topic string) *chanSub {
 	defer q.mu.Unlock()
 	_, ok := q.chanSubs[topic]
 	if !ok {
-		q.chanSubs[topic] = &chanSub{make(chan Message, defaultChanBuffer), make(chan Message, defaultLowChanBuffer), 0}
+		highBuffer, lowBuffer := defaultChanBuffer, defaultLowChanBuffer
+		buffer, ok := q.buffers[topic]
+		if ok {
+			if buffer.HighBuffer > 0 {
+				highBuffer = int(buffer.HighBuffer)
+			}
+			if buffer.LowBuffer > 0 {
+				lowBuffer = int(buffer.LowBuffer)
+			}
+		}
+		q.chanSubs[topic] = newChanSub(highBuffer, lowBuffer)
+		q.chanSubs[topic].reject = ok && buffer.RejectWhenBusy
 	}
 	return q.chanSubs[topic]
 }
 
+func (q *queue) topicStat(topic string) *types.TopicStat {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	sub, ok := q.chanSubs[topic]
+	if !ok {
+		return &types.TopicStat{Topic: topic}
+	}
+	return sub.getStat(topic)
+}
+
+//统计信息按照 topic 排序
+func (q *queue) stats() *types.QueueStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	stats := &types.QueueStats{}
+	for topic, sub := range q.chanSubs {
+		if sub.isClose == 1 {
+			continue
+		}
+		stats.Topics = append(stats.Topics, sub.getStat(topic))
+	}
+	sort.Slice(stats.Topics, func(i, j int) bool {
+		return stats.Topics[i].Topic < stats.Topics[j].Topic
+	})
+	return stats
+}
+
 func (q *queue) closeTopic(topic string) {
 	q.mu.Lock()
 	defer q.mu.Unlock()
@@ -149,12 +304,19 @@ func (q *queue) send(msg Message, timeout time.Duration) (err error) {
 			err = res.(error)
 		}
 	}()
+	if sub.isFull(sub.high) {
+		qlog.Debug("send reject", "msg", msg, "topic", msg.Topic, "sub", sub)
+		return types.ErrChannelFull
+	}
+	msg.sendTime = time.Now().UnixNano()
 	if timeout == 0 {
 		select {
 		case sub.high <- msg:
+			sub.onSend(msg.Topic)
 			qlog.Debug("send ok", "msg", msg, "topic", msg.Topic, "sub", sub)
 			return nil
 		default:
+			atomic.AddInt64(&sub.stat.full, 1)
 			qlog.Debug("send chainfull", "msg", msg, "topic", msg.Topic, "sub", sub)
 			return types.ErrChannelFull
 		}
@@ -163,7 +325,9 @@ func (q *queue) send(msg Message, timeout time.Duration) (err error) {
 	defer t.Stop()
 	select {
 	case sub.high <- msg:
+		sub.onSend(msg.Topic)
 	case <-t.C:
+		atomic.AddInt64(&sub.stat.timeout, 1)
 		qlog.Debug("send timeout", "msg", msg, "topic", msg.Topic, "sub", sub)
 		return types.ErrTimeout
 	}
@@ -181,11 +345,18 @@ func (q *queue) sendAsyn(msg Message) error {
 	if sub.isClose == 1 {
 		return types.ErrChannelClosed
 	}
+	if sub.isFull(sub.low) {
+		qlog.Error("send asyn reject", "msg", msg, "err", types.ErrChannelFull)
+		return types.ErrChannelFull
+	}
+	msg.sendTime = time.Now().UnixNano()
 	select {
 	case sub.low <- msg:
+		sub.onSend(msg.Topic)
 		qlog.Debug("send asyn ok", "msg", msg)
 		return nil
 	default:
+		atomic.AddInt64(&sub.stat.full, 1)
 		qlog.Error("send asyn err", "msg", msg, "err", types.ErrChannelFull)
 		return types.ErrChannelFull
 	}
@@ -202,13 +373,20 @@ func (q *queue) sendLowTimeout(msg Message, timeout time.Duration) error {
 	if timeout == 0 {
 		return q.sendAsyn(msg)
 	}
+	if sub.isFull(sub.low) {
+		qlog.Error("send asyn reject", "msg", msg, "err", types.ErrChannelFull)
+		return types.ErrChannelFull
+	}
+	msg.sendTime = time.Now().UnixNano()
 	t := time.NewTimer(timeout)
 	defer t.Stop()
 	select {
 	case sub.low <- msg:
+		sub.onSend(msg.Topic)
 		qlog.Debug("send asyn ok", "msg", msg)
 		return nil
 	case <-t.C:
+		atomic.AddInt64(&sub.stat.timeout, 1)
 		qlog.Error("send asyn timeout", "msg", msg)
 		return types.ErrTimeout
 	}
@@ -224,6 +402,8 @@ type Message struct {
 	Id      int64
 	Data    interface{}
 	chReply chan Message
+	//进入队列的时间, 用来统计消息的等待时间
+	sendTime int64
 }
 
 func NewMessage(id int64, topic string, ty int64, data interface{}) (msg Message) {
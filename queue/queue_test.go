@@ -210,3 +210,82 @@ func TestPrintMessage(t *testing.T) {
 	msg := client.NewMessage("mempool", types.EventReply, types.Reply{IsOk: true, Msg: []byte("word")})
 	t.Log(msg)
 }
+
+func TestQueueStats(t *testing.T) {
+	q := NewWithConfig("channel", &types.Queue{Topics: []*types.QueueTopic{{Topic: "mempool", HighBuffer: 2}}})
+	client := q.Client()
+	for i := 0; i < 2; i++ {
+		err := client.SendTimeout(client.NewMessage("mempool", types.EventTx, "hello"), true, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := client.SendTimeout(client.NewMessage("mempool", types.EventTx, "hello"), true, 0)
+	if err != types.ErrChannelFull {
+		t.Fatal(err)
+	}
+	err = client.SendTimeout(client.NewMessage("mempool", types.EventTx, "hello"), true, time.Millisecond)
+	if err != types.ErrTimeout {
+		t.Fatal(err)
+	}
+	stats, err := client.QueueStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stat := stats.Topics[0]
+	if stat.Topic != "mempool" || stat.HighCap != 2 || stat.HighLen != 2 || stat.LowCap != defaultLowChanBuffer || !stat.Busy {
+		t.Fatal(stat)
+	}
+	if stat.SendCount != 2 || stat.FullCount != 1 || stat.TimeoutCount != 1 || stat.RecvCount != 0 {
+		t.Fatal(stat)
+	}
+
+	mem := q.Client()
+	mem.Sub("mempool")
+	for i := 0; i < 2; i++ {
+		<-mem.Recv()
+	}
+	time.Sleep(10 * time.Millisecond)
+	stats, _ = client.QueueStats()
+	if stats.Topics[0].RecvCount != 2 || stats.Topics[0].HighLen != 0 {
+		t.Fatal(stats.Topics[0])
+	}
+	q.Close()
+	_, err = client.QueueStats()
+	if err != types.ErrChannelClosed {
+		t.Fatal(err)
+	}
+}
+
+func TestQueueRejectWhenBusy(t *testing.T) {
+	q := NewWithConfig("channel", &types.Queue{Topics: []*types.QueueTopic{{Topic: "mempool", HighBuffer: 4, LowBuffer: 4, RejectWhenBusy: true}}})
+	defer q.Close()
+	client := q.Client()
+	for i := 0; i < 3; i++ {
+		err := client.SendTimeout(client.NewMessage("mempool", types.EventTx, "hello"), true, time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = client.SendTimeout(client.NewMessage("mempool", types.EventTx, "hello"), false, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	//积压超过 3/4 以后马上拒绝, 不等待超时
+	err := client.SendTimeout(client.NewMessage("mempool", types.EventTx, "hello"), true, time.Minute)
+	if err != types.ErrChannelFull {
+		t.Fatal(err)
+	}
+	err = client.Send(client.NewMessage("mempool", types.EventTx, "hello"), true)
+	if err != types.ErrChannelFull {
+		t.Fatal(err)
+	}
+	err = client.SendTimeout(client.NewMessage("mempool", types.EventTx, "hello"), false, 0)
+	if err != types.ErrChannelFull {
+		t.Fatal(err)
+	}
+	stats, _ := client.QueueStats()
+	if stats.Topics[0].FullCount != 3 || stats.Topics[0].SendCount != 6 {
+		t.Fatal(stats.Topics[0])
+	}
+}
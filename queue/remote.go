@@ -0,0 +1,594 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queue
+
+//进程之间的消息队列:
+//主进程的队列监听一个本地的 socket, 其他进程中的模块通过 NewRemote 连接.
+//连接没有认证, 所以只能监听 unix socket(只有当前用户可以访问) 或者本机回环地址.
+//每个连接在主进程中对应一个本地的 client, 订阅, 发送和回复消息都通过这个 client 转发,
+//所以对模块来说远程的 client 和本地的 client 用法完全一样.
+//消息的数据必须是注册过的 protobuf 消息, 错误在传输以后只保留错误信息
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	cmdSend  = 0
+	cmdReply = 1
+	cmdSub   = 2
+)
+
+var (
+	//单个消息的最大长度
+	maxRemoteMsgSize uint32 = 256 * 1024 * 1024
+	//主进程等待本地模块回复远程消息的时间
+	remoteWaitTimeout = 10 * time.Minute
+	//每个连接同时处理的远程消息个数, 超过以后不再读取连接, 远程模块的发送会阻塞
+	maxRemoteSending = 1024
+	//常用的错误在传输以后还是同一个对象, 调用方可以直接比较
+	remoteErrors = make(map[string]error)
+
+	errRemoteAddr = errors.New("queue listen addr must be unix socket or loopback tcp")
+)
+
+func init() {
+	for _, err := range []error{types.ErrTimeout, types.ErrChannelClosed, types.ErrChannelFull, types.ErrIsClosed,
+		types.ErrNotSupport, types.ErrNotFound, types.ErrInvalidParam} {
+		remoteErrors[err.Error()] = err
+	}
+}
+
+//地址的格式: unix:/path/to/socket 或者 tcp:host:port, 没有前缀的时候是 tcp
+func parseAddr(addr string) (network, address string) {
+	if strings.HasPrefix(addr, "unix:") {
+		return "unix", strings.TrimPrefix(addr, "unix:")
+	}
+	return "tcp", strings.TrimPrefix(addr, "tcp:")
+}
+
+//tcp 只能监听本机回环地址, 其他机器不能连接到消息队列
+func checkListenAddr(network, address string) error {
+	if network == "unix" {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if host == "localhost" {
+		return nil
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return errRemoteAddr
+	}
+	return nil
+}
+
+func writeQueueMessage(w io.Writer, qmsg *types.QueueMessage) error {
+	data := types.Encode(qmsg)
+	buf := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	copy(buf[4:], data)
+	_, err := w.Write(buf)
+	return err
+}
+
+func readQueueMessage(r io.Reader) (*types.QueueMessage, error) {
+	var head [4]byte
+	_, err := io.ReadFull(r, head[:])
+	if err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(head[:])
+	if size > maxRemoteMsgSize {
+		return nil, types.ErrSize
+	}
+	data := make([]byte, size)
+	_, err = io.ReadFull(r, data)
+	if err != nil {
+		return nil, err
+	}
+	var qmsg types.QueueMessage
+	err = types.Decode(data, &qmsg)
+	if err != nil {
+		return nil, err
+	}
+	return &qmsg, nil
+}
+
+func encodeData(qmsg *types.QueueMessage, data interface{}) error {
+	switch data := data.(type) {
+	case nil:
+		return nil
+	case error:
+		qmsg.Err = data.Error()
+		return nil
+	case proto.Message:
+		name := proto.MessageName(data)
+		if name == "" {
+			return types.ErrNotSupport
+		}
+		qmsg.DataType = name
+		if v := reflect.ValueOf(data); v.Kind() == reflect.Ptr && v.IsNil() {
+			return nil
+		}
+		b, err := proto.Marshal(data)
+		if err != nil {
+			return err
+		}
+		qmsg.Data = b
+		return nil
+	}
+	return types.ErrNotSupport
+}
+
+func decodeData(qmsg *types.QueueMessage) interface{} {
+	if qmsg.Err != "" {
+		if err, ok := remoteErrors[qmsg.Err]; ok {
+			return err
+		}
+		return errors.New(qmsg.Err)
+	}
+	if qmsg.DataType == "" {
+		return nil
+	}
+	ty := proto.MessageType(qmsg.DataType)
+	if ty == nil {
+		return types.ErrNotSupport
+	}
+	data := reflect.New(ty.Elem()).Interface().(proto.Message)
+	err := types.Decode(qmsg.Data, data)
+	if err != nil {
+		return err
+	}
+	return data
+}
+
+func (q *queue) listen(addr string) error {
+	network, address := parseAddr(addr)
+	err := checkListenAddr(network, address)
+	if err != nil {
+		return err
+	}
+	if network == "unix" {
+		//上次没有正常退出留下的 socket 文件
+		if info, err := os.Stat(address); err == nil && info.Mode()&os.ModeSocket != 0 {
+			os.Remove(address)
+		}
+	}
+	listener, err := net.Listen(network, address)
+	if err != nil {
+		return err
+	}
+	if network == "unix" {
+		err = os.Chmod(address, 0600)
+		if err != nil {
+			listener.Close()
+			return err
+		}
+	}
+	q.listener = listener
+	qlog.Info("queue listen", "addr", addr)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				qlog.Info("queue listener closed", "err", err)
+				return
+			}
+			qlog.Info("remote module connected", "addr", conn.RemoteAddr())
+			rc := &remoteConn{conn: conn, client: q.Client(), pending: make(map[int64]Message), sending: make(chan struct{}, maxRemoteSending)}
+			go rc.serve()
+		}
+	}()
+	return nil
+}
+
+//主进程中的一个远程连接
+type remoteConn struct {
+	conn    net.Conn
+	client  Client
+	wlock   sync.Mutex
+	plock   sync.Mutex
+	pending map[int64]Message //等待远程模块回复的消息
+	closed  bool
+	sending chan struct{} //正在处理的远程消息
+}
+
+func (rc *remoteConn) serve() {
+	defer rc.close()
+	for {
+		qmsg, err := readQueueMessage(rc.conn)
+		if err != nil {
+			if err != io.EOF {
+				qlog.Error("remote conn read", "err", err)
+			}
+			return
+		}
+		switch qmsg.Cmd {
+		case cmdSub:
+			rc.sub(qmsg.Topic)
+		case cmdSend:
+			rc.sending <- struct{}{}
+			go func(qmsg *types.QueueMessage) {
+				defer func() { <-rc.sending }()
+				rc.send(qmsg)
+			}(qmsg)
+		case cmdReply:
+			rc.reply(qmsg)
+		}
+	}
+}
+
+func (rc *remoteConn) write(qmsg *types.QueueMessage) error {
+	rc.wlock.Lock()
+	defer rc.wlock.Unlock()
+	return writeQueueMessage(rc.conn, qmsg)
+}
+
+//把订阅的消息转发给远程模块, 需要回复的消息保存起来等待远程模块回复
+func (rc *remoteConn) sub(topic string) {
+	rc.client.Sub(topic)
+	go func() {
+		for msg := range rc.client.Recv() {
+			qmsg := &types.QueueMessage{Cmd: cmdSend, Topic: msg.Topic, Ty: msg.Ty, Id: msg.Id, WaitReply: msg.chReply != nil}
+			err := encodeData(qmsg, msg.Data)
+			if err != nil {
+				qlog.Error("remote conn encode", "msg", msg, "err", err)
+				msg.Reply(NewMessage(msg.Id, "", msg.Ty, err))
+				continue
+			}
+			if qmsg.WaitReply {
+				rc.plock.Lock()
+				if rc.closed {
+					rc.plock.Unlock()
+					msg.Reply(NewMessage(msg.Id, "", msg.Ty, types.ErrIsClosed))
+					continue
+				}
+				rc.pending[msg.Id] = msg
+				rc.plock.Unlock()
+			}
+			err = rc.write(qmsg)
+			if err != nil {
+				qlog.Error("remote conn write", "msg", msg, "err", err)
+			}
+		}
+	}()
+}
+
+//远程模块发送的消息, 通过本地的 client 发送并且把回复发回去
+func (rc *remoteConn) send(qmsg *types.QueueMessage) {
+	msg := rc.client.NewMessage(qmsg.Topic, qmsg.Ty, decodeData(qmsg))
+	err := rc.client.SendTimeout(msg, qmsg.WaitReply, time.Duration(qmsg.Timeout))
+	if !qmsg.WaitReply {
+		if err != nil {
+			qlog.Error("remote conn send", "msg", msg, "err", err)
+		}
+		return
+	}
+	reply := &types.QueueMessage{Cmd: cmdReply, Id: qmsg.Id, Ty: qmsg.Ty}
+	if err == nil {
+		var resp Message
+		resp, err = rc.client.WaitTimeout(msg, remoteWaitTimeout)
+		if err == nil {
+			reply.Ty = resp.Ty
+			err = encodeData(reply, resp.Data)
+		}
+	}
+	if err != nil {
+		reply.Err = err.Error()
+	}
+	err = rc.write(reply)
+	if err != nil {
+		qlog.Error("remote conn write reply", "msg", msg, "err", err)
+	}
+}
+
+func (rc *remoteConn) reply(qmsg *types.QueueMessage) {
+	rc.plock.Lock()
+	msg, ok := rc.pending[qmsg.Id]
+	delete(rc.pending, qmsg.Id)
+	rc.plock.Unlock()
+	if !ok {
+		return
+	}
+	msg.Reply(NewMessage(qmsg.Id, "", qmsg.Ty, decodeData(qmsg)))
+}
+
+//连接断开以后, 还没有回复的消息都回复错误, 防止发送方一直等待
+func (rc *remoteConn) close() {
+	rc.conn.Close()
+	rc.client.Close()
+	rc.plock.Lock()
+	rc.closed = true
+	for id, msg := range rc.pending {
+		msg.Reply(NewMessage(id, "", msg.Ty, types.ErrIsClosed))
+	}
+	rc.pending = make(map[int64]Message)
+	rc.plock.Unlock()
+	qlog.Info("remote module disconnected", "addr", rc.conn.RemoteAddr())
+}
+
+type remoteQueue struct {
+	name     string
+	addr     string
+	done     chan struct{}
+	interupt chan struct{}
+	isClose  int32
+}
+
+//NewRemote 连接到其他进程中的消息队列, 每次调用 Client() 都会建立一个新的连接
+func NewRemote(name, addr string) Queue {
+	return &remoteQueue{name: name, addr: addr, done: make(chan struct{}, 1), interupt: make(chan struct{}, 1)}
+}
+
+func (q *remoteQueue) Name() string {
+	return q.name
+}
+
+func (q *remoteQueue) Start() {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt)
+	select {
+	case <-q.done:
+	case <-q.interupt:
+		qlog.Info("closing remote queue")
+	case s := <-c:
+		qlog.Info("Got signal", "signal", s)
+	}
+}
+
+func (q *remoteQueue) isClosed() bool {
+	return atomic.LoadInt32(&q.isClose) == 1
+}
+
+func (q *remoteQueue) Close() {
+	if !atomic.CompareAndSwapInt32(&q.isClose, 0, 1) {
+		return
+	}
+	q.done <- struct{}{}
+	close(q.done)
+	qlog.Info("remote queue closed")
+}
+
+func (q *remoteQueue) Client() Client {
+	client, err := newRemoteClient(q)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+type remoteClient struct {
+	q         *remoteQueue
+	conn      net.Conn
+	recv      chan Message
+	done      chan struct{}
+	wlock     sync.Mutex
+	mu        sync.Mutex
+	waiting   map[int64]chan Message //等待主进程回复的消息
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+	isClosed  int32
+}
+
+func newRemoteClient(q *remoteQueue) (*remoteClient, error) {
+	network, address := parseAddr(q.addr)
+	conn, err := net.Dial(network, address)
+	if err != nil {
+		return nil, err
+	}
+	client := &remoteClient{
+		q:       q,
+		conn:    conn,
+		recv:    make(chan Message, 5),
+		done:    make(chan struct{}),
+		waiting: make(map[int64]chan Message),
+	}
+	client.wg.Add(1)
+	go client.readLoop()
+	return client, nil
+}
+
+func (client *remoteClient) write(qmsg *types.QueueMessage) error {
+	client.wlock.Lock()
+	defer client.wlock.Unlock()
+	return writeQueueMessage(client.conn, qmsg)
+}
+
+func (client *remoteClient) readLoop() {
+	defer func() {
+		client.shutdown()
+		close(client.recv)
+		client.wg.Done()
+	}()
+	for {
+		qmsg, err := readQueueMessage(client.conn)
+		if err != nil {
+			//主进程退出以后, 远程模块所在的进程也退出
+			if !client.isClose() {
+				qlog.Error("remote queue disconnected", "err", err)
+				client.q.Close()
+			}
+			return
+		}
+		switch qmsg.Cmd {
+		case cmdSend:
+			msg := NewMessage(qmsg.Id, qmsg.Topic, qmsg.Ty, decodeData(qmsg))
+			if qmsg.WaitReply {
+				client.wg.Add(1)
+				go client.waitReply(msg)
+			} else {
+				msg.chReply = nil
+			}
+			select {
+			case client.recv <- msg:
+			case <-client.done:
+				return
+			}
+		case cmdReply:
+			client.mu.Lock()
+			ch, ok := client.waiting[qmsg.Id]
+			delete(client.waiting, qmsg.Id)
+			client.mu.Unlock()
+			if ok {
+				ch <- NewMessage(qmsg.Id, "", qmsg.Ty, decodeData(qmsg))
+			}
+		}
+	}
+}
+
+//模块处理完消息以后把回复发给主进程
+func (client *remoteClient) waitReply(msg Message) {
+	defer client.wg.Done()
+	select {
+	case reply := <-msg.chReply:
+		qmsg := &types.QueueMessage{Cmd: cmdReply, Id: msg.Id, Ty: reply.Ty}
+		err := encodeData(qmsg, reply.Data)
+		if err != nil {
+			qmsg.Err = err.Error()
+		}
+		err = client.write(qmsg)
+		if err != nil {
+			qlog.Error("remote queue write reply", "msg", msg, "err", err)
+		}
+	case <-client.done:
+	}
+}
+
+func (client *remoteClient) Send(msg Message, waitReply bool) (err error) {
+	timeout := 10 * time.Minute
+	if types.IsTestNet() {
+		timeout = time.Minute
+	}
+	err = client.SendTimeout(msg, waitReply, timeout)
+	if err == types.ErrTimeout {
+		panic(err)
+	}
+	return err
+}
+
+func (client *remoteClient) SendTimeout(msg Message, waitReply bool, timeout time.Duration) (err error) {
+	if client.isClose() {
+		return types.ErrIsClosed
+	}
+	qmsg := &types.QueueMessage{Cmd: cmdSend, Topic: msg.Topic, Ty: msg.Ty, Id: msg.Id, WaitReply: waitReply, Timeout: int64(timeout)}
+	err = encodeData(qmsg, msg.Data)
+	if err != nil {
+		return err
+	}
+	if waitReply {
+		if msg.chReply == nil {
+			return errors.New("empty wait channel")
+		}
+		client.mu.Lock()
+		client.waiting[msg.Id] = msg.chReply
+		client.mu.Unlock()
+	}
+	err = client.write(qmsg)
+	if err != nil && waitReply {
+		client.mu.Lock()
+		delete(client.waiting, msg.Id)
+		client.mu.Unlock()
+	}
+	return err
+}
+
+func (client *remoteClient) WaitTimeout(msg Message, timeout time.Duration) (Message, error) {
+	if msg.chReply == nil {
+		return Message{}, errors.New("empty wait channel")
+	}
+	defer func() {
+		client.mu.Lock()
+		delete(client.waiting, msg.Id)
+		client.mu.Unlock()
+	}()
+	t := time.NewTimer(timeout)
+	defer t.Stop()
+	select {
+	case msg = <-msg.chReply:
+		return msg, msg.Err()
+	case <-client.done:
+		return Message{}, errors.New("client is closed")
+	case <-t.C:
+		return Message{}, types.ErrTimeout
+	}
+}
+
+func (client *remoteClient) Wait(msg Message) (Message, error) {
+	timeout := 10 * time.Minute
+	if types.IsTestNet() {
+		timeout = 5 * time.Minute
+	}
+	msg, err := client.WaitTimeout(msg, timeout)
+	if err == types.ErrTimeout {
+		panic(err)
+	}
+	return msg, err
+}
+
+func (client *remoteClient) Recv() chan Message {
+	return client.recv
+}
+
+func (client *remoteClient) Sub(topic string) {
+	if client.isClose() {
+		return
+	}
+	err := client.write(&types.QueueMessage{Cmd: cmdSub, Topic: topic})
+	if err != nil {
+		qlog.Error("remote queue sub", "topic", topic, "err", err)
+	}
+}
+
+func (client *remoteClient) isClose() bool {
+	return atomic.LoadInt32(&client.isClosed) == 1
+}
+
+func (client *remoteClient) shutdown() {
+	client.closeOnce.Do(func() {
+		atomic.StoreInt32(&client.isClosed, 1)
+		close(client.done)
+		client.conn.Close()
+	})
+}
+
+func (client *remoteClient) Close() {
+	client.shutdown()
+	client.wg.Wait()
+}
+
+func (client *remoteClient) CloseQueue() (*types.Reply, error) {
+	if client.q.isClosed() {
+		return &types.Reply{IsOk: true}, nil
+	}
+	client.q.interupt <- struct{}{}
+	return &types.Reply{IsOk: true}, nil
+}
+
+//统计信息在主进程中
+func (client *remoteClient) QueueStats() (*types.QueueStats, error) {
+	return nil, types.ErrNotSupport
+}
+
+func (client *remoteClient) NewMessage(topic string, ty int64, data interface{}) (msg Message) {
+	id := atomic.AddInt64(&gid, 1)
+	return NewMessage(id, topic, ty, data)
+}
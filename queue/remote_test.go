@@ -0,0 +1,136 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queue
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/types"
+)
+
+func TestRemoteQueue(t *testing.T) {
+	dir, err := ioutil.TempDir("", "queue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	addr := "unix:" + filepath.Join(dir, "chain33.sock")
+	q := NewWithConfig("channel", &types.Queue{ListenAddr: addr})
+	defer q.Close()
+
+	//本地的 blockchain 模块
+	go func() {
+		client := q.Client()
+		client.Sub("blockchain")
+		for msg := range client.Recv() {
+			switch msg.Ty {
+			case types.EventGetBlockHeight:
+				msg.Reply(client.NewMessage("", types.EventReplyBlockHeight, &types.ReplyBlockHeight{Height: 100}))
+			default:
+				msg.Reply(client.NewMessage("", msg.Ty, types.ErrNotFound))
+			}
+		}
+	}()
+
+	//其他进程中的 mempool 模块
+	remote := NewRemote("remote", addr)
+	rclient := remote.Client()
+	rclient.Sub("mempool")
+	go func() {
+		for msg := range rclient.Recv() {
+			tx := msg.GetData().(*types.Transaction)
+			msg.Reply(rclient.NewMessage("", types.EventReply, &types.Reply{IsOk: true, Msg: tx.Execer}))
+		}
+	}()
+
+	client := q.Client()
+	msg := client.NewMessage("mempool", types.EventTx, &types.Transaction{Execer: []byte("coins")})
+	err = client.SendTimeout(msg, true, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.WaitTimeout(msg, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reply := resp.GetData().(*types.Reply)
+	if !reply.IsOk || string(reply.Msg) != "coins" {
+		t.Fatal(reply)
+	}
+
+	//远程模块发送消息给本地的模块
+	msg = rclient.NewMessage("blockchain", types.EventGetBlockHeight, &types.ReqNil{})
+	err = rclient.SendTimeout(msg, true, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = rclient.WaitTimeout(msg, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Ty != types.EventReplyBlockHeight || resp.GetData().(*types.ReplyBlockHeight).Height != 100 {
+		t.Fatal(resp)
+	}
+	msg = rclient.NewMessage("blockchain", types.EventGetBlockHash, &types.ReqInt{Height: 1})
+	err = rclient.SendTimeout(msg, true, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = rclient.WaitTimeout(msg, time.Second)
+	if err != types.ErrNotFound {
+		t.Fatal(err)
+	}
+
+	//不是 protobuf 的数据不能发送
+	err = rclient.SendTimeout(rclient.NewMessage("blockchain", types.EventGetBlockHeight, "hello"), true, time.Second)
+	if err != types.ErrNotSupport {
+		t.Fatal(err)
+	}
+
+	//远程模块断开以后, 发送给它的消息留在队列中, 重新连接以后继续处理
+	rclient.Close()
+	//等待主进程关闭连接
+	time.Sleep(100 * time.Millisecond)
+	msg = client.NewMessage("mempool", types.EventTx, &types.Transaction{Execer: []byte("token")})
+	err = client.SendTimeout(msg, true, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rclient = remote.Client()
+	rclient.Sub("mempool")
+	go func() {
+		for msg := range rclient.Recv() {
+			tx := msg.GetData().(*types.Transaction)
+			msg.Reply(rclient.NewMessage("", types.EventReply, &types.Reply{IsOk: true, Msg: tx.Execer}))
+		}
+	}()
+	resp, err = client.WaitTimeout(msg, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reply = resp.GetData().(*types.Reply)
+	if !reply.IsOk || string(reply.Msg) != "token" {
+		t.Fatal(reply)
+	}
+	rclient.Close()
+}
+
+func TestRemoteListenAddr(t *testing.T) {
+	q := New("channel").(*queue)
+	defer q.Close()
+	//没有认证的连接不能监听其他机器可以访问的地址
+	for _, addr := range []string{"tcp:0.0.0.0:0", ":0", "tcp:192.168.1.1:0"} {
+		if err := q.listen(addr); err != errRemoteAddr {
+			t.Fatal(addr, err)
+		}
+	}
+	if err := q.listen("tcp:127.0.0.1:0"); err != nil {
+		t.Fatal(err)
+	}
+}
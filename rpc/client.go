@@ -197,6 +197,19 @@ func (c *channelClient) GetAllExecBalance(in *types.ReqAddr) (*types.AllExecBala
 	return allBalance, nil
 }
 
+//receipt log 的索引保存在 coins 执行器的 localdb 中
+func (c *channelClient) GetLogs(in *types.ReqGetLogs) (*types.ReplyReceiptLogs, error) {
+	msg, err := c.Query(types.ExecName("coins"), "GetLogs", in)
+	if err != nil {
+		return nil, err
+	}
+	reply, ok := msg.(*types.ReplyReceiptLogs)
+	if !ok {
+		return nil, types.ErrTypeAsset
+	}
+	return reply, nil
+}
+
 func (c *channelClient) GetTotalCoins(in *types.ReqGetTotalCoins) (*types.ReplyGetTotalCoins, error) {
 	//获取地址账户的余额通过account模块
 	resp, err := c.accountdb.GetTotalCoins(c.QueueProtocolAPI, in)
@@ -206,6 +219,14 @@ func (c *channelClient) GetTotalCoins(in *types.ReqGetTotalCoins) (*types.ReplyG
 	return resp, nil
 }
 
+func (c *channelClient) GetStateProof(in *types.ReqStateProof) (*types.StateProof, error) {
+	return c.StoreGetProof(in)
+}
+
+func (c *channelClient) GetAccountProof(in *types.ReqAccountProof) (*types.AccountProof, error) {
+	return account.GetAccountProof(c.QueueProtocolAPI, in)
+}
+
 func (c *channelClient) DecodeRawTransaction(param *types.ReqDecodeRawTransaction) (*types.Transaction, error) {
 	var tx types.Transaction
 	bytes, err := common.FromHex(param.TxHex)
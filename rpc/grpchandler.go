@@ -185,6 +185,34 @@ func (g *Grpc) GetSeed(ctx context.Context, in *pb.GetSeedByPw) (*pb.ReplySeed,
 	return g.cli.GetSeed(in)
 }
 
+func (g *Grpc) ImportWatchOnly(ctx context.Context, in *pb.ReqImportWatchOnly) (*pb.WalletAccount, error) {
+	return g.cli.WalletImportWatchOnly(in)
+}
+
+func (g *Grpc) CreateOfflineTx(ctx context.Context, in *pb.ReqWalletSendToAddress) (*pb.OfflineTx, error) {
+	return g.cli.WalletCreateOfflineTx(in)
+}
+
+func (g *Grpc) DeriveAccount(ctx context.Context, in *pb.ReqDeriveAccount) (*pb.WalletAccount, error) {
+	return g.cli.DeriveAccount(in)
+}
+
+func (g *Grpc) GetExtendedPubKey(ctx context.Context, in *pb.ReqExtendedPubKey) (*pb.ReplyString, error) {
+	return g.cli.GetExtendedPubKey(in)
+}
+
+func (g *Grpc) DiscoverAccounts(ctx context.Context, in *pb.ReqDiscoverAccounts) (*pb.WalletAccounts, error) {
+	return g.cli.DiscoverAccounts(in)
+}
+
+func (g *Grpc) ExportWallet(ctx context.Context, in *pb.ReqWalletExport) (*pb.ReplyString, error) {
+	return g.cli.WalletExport(in)
+}
+
+func (g *Grpc) ImportWallet(ctx context.Context, in *pb.ReqWalletImport) (*pb.WalletAccounts, error) {
+	return g.cli.WalletImport(in)
+}
+
 func (g *Grpc) SaveSeed(ctx context.Context, in *pb.SaveSeedByPw) (*pb.Reply, error) {
 	return g.cli.SaveSeed(in)
 }
@@ -263,6 +291,21 @@ func (g *Grpc) NetInfo(ctx context.Context, in *pb.ReqNil) (*pb.NodeNetInfo, err
 	return g.cli.GetNetInfo()
 }
 
+//GetPeerScores 获取节点的信誉分数和封禁状态
+func (g *Grpc) GetPeerScores(ctx context.Context, in *pb.ReqNil) (*pb.PeerScoreList, error) {
+	return g.cli.GetPeerScores()
+}
+
+//BanPeer 封禁节点, duration单位秒, 为0的时候永久封禁
+func (g *Grpc) BanPeer(ctx context.Context, in *pb.ReqBanPeer) (*pb.Reply, error) {
+	return g.cli.BanPeer(in)
+}
+
+//UnbanPeer 解除节点的封禁
+func (g *Grpc) UnbanPeer(ctx context.Context, in *pb.ReqString) (*pb.Reply, error) {
+	return g.cli.UnbanPeer(in)
+}
+
 func (g *Grpc) GetFatalFailure(ctx context.Context, in *pb.ReqNil) (*pb.Int32, error) {
 	return g.cli.GetFatalFailure()
 }
@@ -276,6 +319,16 @@ func (g *Grpc) CloseQueue(ctx context.Context, in *pb.ReqNil) (*pb.Reply, error)
 	return &pb.Reply{IsOk: true}, nil
 }
 
+//GetQueueStats 获取消息队列每个 topic 的统计信息
+func (g *Grpc) GetQueueStats(ctx context.Context, in *pb.ReqNil) (*pb.QueueStats, error) {
+	return g.cli.QueueStats()
+}
+
+//GetSignVerifyStats 获取交易签名验证的统计信息
+func (g *Grpc) GetSignVerifyStats(ctx context.Context, in *pb.ReqNil) (*pb.SignVerifyStats, error) {
+	return g.cli.SignVerifyStats()
+}
+
 func (g *Grpc) GetLastBlockSequence(ctx context.Context, in *pb.ReqNil) (*pb.Int64, error) {
 	return g.cli.GetLastBlockSequence()
 }
@@ -289,3 +342,44 @@ func (g *Grpc) GetBlockByHashes(ctx context.Context, in *pb.ReqHashes) (*pb.Bloc
 func (g *Grpc) SignRawTx(ctx context.Context, in *pb.ReqSignRawTx) (*pb.ReplySignRawTx, error) {
 	return g.cli.SignRawTx(in)
 }
+
+func (g *Grpc) Subscribe(in *pb.ReqSubscribe, stream pb.Chain33_SubscribeServer) error {
+	if g.hub == nil {
+		return pb.ErrNotSupport
+	}
+	sub, err := g.hub.subscribe(in)
+	if err != nil {
+		return err
+	}
+	defer g.hub.unsubscribe(sub)
+	for {
+		select {
+		case event, ok := <-sub.ch:
+			//订阅者处理太慢或者服务关闭
+			if !ok {
+				return pb.ErrChannelClosed
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+func (g *Grpc) GetLogs(ctx context.Context, in *pb.ReqGetLogs) (*pb.ReplyReceiptLogs, error) {
+	return g.cli.GetLogs(in)
+}
+
+func (g *Grpc) GetReceiptProof(ctx context.Context, in *pb.ReqHash) (*pb.ReceiptProof, error) {
+	return g.cli.GetReceiptProof(in)
+}
+
+func (g *Grpc) GetStateProof(ctx context.Context, in *pb.ReqStateProof) (*pb.StateProof, error) {
+	return g.cli.GetStateProof(in)
+}
+
+func (g *Grpc) GetAccountProof(ctx context.Context, in *pb.ReqAccountProof) (*pb.AccountProof, error) {
+	return g.cli.GetAccountProof(in)
+}
@@ -18,7 +18,6 @@ import (
 
 	"github.com/rs/cors"
 	"golang.org/x/net/context"
-	"google.golang.org/grpc"
 	pr "google.golang.org/grpc/peer"
 )
 
@@ -64,6 +63,10 @@ func (j *JSONRPCServer) Listen() (int, error) {
 			writeError(w, r, 0, fmt.Sprintf(`The %s Address is not authorized!`, ip))
 			return
 		}
+		if r.URL.Path == "/ws" && j.hub != nil {
+			j.serveWebsocket(w, r, ip)
+			return
+		}
 		if r.URL.Path == "/" {
 			data, err := ioutil.ReadAll(r.Body)
 			if err != nil {
@@ -81,10 +84,14 @@ func (j *JSONRPCServer) Listen() (int, error) {
 				writeError(w, r, 0, fmt.Sprintf(`parse request err %s`, err.Error()))
 				return
 			}
+			funcName := strings.Split(client.Method, ".")[len(strings.Split(client.Method, "."))-1]
+			if !checkLightJrpcFunc(funcName) {
+				writeError(w, r, client.Id, fmt.Sprintf(`The %s method is not supported in light mode!`, funcName))
+				return
+			}
 			//Release local request
 			ipaddr := net.ParseIP(ip)
 			if !ipaddr.IsLoopback() {
-				funcName := strings.Split(client.Method, ".")[len(strings.Split(client.Method, "."))-1]
 				if checkJrpcFuncBlacklist(funcName) || !checkJrpcFuncWhitelist(funcName) {
 					writeError(w, r, client.Id, fmt.Sprintf(`The %s method is not authorized!`, funcName))
 					return
@@ -144,9 +151,13 @@ func isLoopBackAddr(addr net.Addr) bool {
 	return false
 }
 
-func auth(ctx context.Context, info *grpc.UnaryServerInfo) error {
+func auth(ctx context.Context, fullMethod string) error {
 	getctx, ok := pr.FromContext(ctx)
 	if ok {
+		funcName := strings.Split(fullMethod, "/")[len(strings.Split(fullMethod, "/"))-1]
+		if !checkLightGrpcFunc(funcName) {
+			return fmt.Errorf("The %s method is not supported in light mode!", funcName)
+		}
 		if isLoopBackAddr(getctx.Addr) {
 			return nil
 		}
@@ -160,7 +171,6 @@ func auth(ctx context.Context, info *grpc.UnaryServerInfo) error {
 			return fmt.Errorf("The %s Address is not authorized!", ip)
 		}
 
-		funcName := strings.Split(info.FullMethod, "/")[len(strings.Split(info.FullMethod, "/"))-1]
 		if checkGrpcFuncBlacklist(funcName) || !checkGrpcFuncWhitelist(funcName) {
 			return fmt.Errorf("The %s method is not authorized!", funcName)
 		}
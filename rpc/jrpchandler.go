@@ -192,6 +192,7 @@ func (c *Chain33) GetBlocks(in rpctypes.BlockParam, result *interface{}) error {
 			block.ParentHash = common.ToHex(item.Block.GetParentHash())
 			block.StateHash = common.ToHex(item.Block.GetStateHash())
 			block.TxHash = common.ToHex(item.Block.GetTxHash())
+			block.ReceiptHash = common.ToHex(item.Block.GetReceiptHash())
 			txs := item.Block.GetTxs()
 			if len(txs) != len(item.Receipts) {
 				return types.ErrDecode
@@ -246,6 +247,7 @@ func (c *Chain33) GetLastHeader(in *types.ReqNil, result *interface{}) error {
 		header.Hash = common.ToHex(reply.GetHash())
 		header.TxCount = reply.TxCount
 		header.Difficulty = reply.GetDifficulty()
+		header.ReceiptHash = common.ToHex(reply.GetReceiptHash())
 		/* 空值，斩不显示
 		Signature: &Signature{
 			Ty:        reply.GetSignature().GetTy(),
@@ -259,6 +261,41 @@ func (c *Chain33) GetLastHeader(in *types.ReqNil, result *interface{}) error {
 	return nil
 }
 
+//GetReceiptProof 获取交易执行结果的默克尔证明以及交易所在区块的区块头
+func (c *Chain33) GetReceiptProof(in rpctypes.QueryParm, result *interface{}) error {
+	hash, err := common.FromHex(in.Hash)
+	if err != nil {
+		return err
+	}
+	reply, err := c.cli.GetReceiptProof(&types.ReqHash{Hash: hash})
+	if err != nil {
+		return err
+	}
+	receipt := &rpctypes.ReceiptData{Ty: reply.GetReceipt().GetTy()}
+	for _, log := range reply.GetReceipt().GetLogs() {
+		receipt.Logs = append(receipt.Logs, &rpctypes.ReceiptLog{Ty: log.GetTy(), Log: common.ToHex(log.GetLog())})
+	}
+	var proofs []string
+	for _, proof := range reply.GetProofs() {
+		proofs = append(proofs, common.ToHex(proof))
+	}
+	head := reply.GetHeader()
+	header := &rpctypes.Header{
+		Version:     head.GetVersion(),
+		ParentHash:  common.ToHex(head.GetParentHash()),
+		TxHash:      common.ToHex(head.GetTxHash()),
+		StateHash:   common.ToHex(head.GetStateHash()),
+		Height:      head.GetHeight(),
+		BlockTime:   head.GetBlockTime(),
+		TxCount:     head.GetTxCount(),
+		Hash:        common.ToHex(head.GetHash()),
+		Difficulty:  head.GetDifficulty(),
+		ReceiptHash: common.ToHex(head.GetReceiptHash()),
+	}
+	*result = &rpctypes.ReceiptProof{Receipt: receipt, Index: reply.GetIndex(), Proofs: proofs, Header: header}
+	return nil
+}
+
 //GetTxByAddr(parm *types.ReqAddr) (*types.ReplyTxInfo, error)
 func (c *Chain33) GetTxByAddr(in types.ReqAddr, result *interface{}) error {
 	reply, err := c.cli.GetTransactionByAddr(&in)
@@ -278,6 +315,42 @@ func (c *Chain33) GetTxByAddr(in types.ReqAddr, result *interface{}) error {
 	return nil
 }
 
+//GetLogs 按照执行器, log类型, 地址和高度查询 receipt log
+func (c *Chain33) GetLogs(in types.ReqGetLogs, result *interface{}) error {
+	reply, err := c.cli.GetLogs(&in)
+	if err != nil {
+		return err
+	}
+	var logs rpctypes.ReplyReceiptLogs
+	for _, info := range reply.GetLogs() {
+		l := &rpctypes.ReceiptLogInfo{
+			Height:   info.Height,
+			Index:    info.Index,
+			LogIndex: info.LogIndex,
+			TxHash:   common.ToHex(info.TxHash),
+			Execer:   info.Execer,
+			From:     info.From,
+			To:       info.To,
+			Ty:       info.Ty,
+			RawLog:   common.ToHex(info.Log),
+		}
+		logType := types.LoadLog([]byte(info.Execer), int64(info.Ty))
+		if logType == nil {
+			l.TyName = "unkownType"
+		} else {
+			l.TyName = logType.Name()
+			l.Log, err = logType.Json(info.Log)
+			if err != nil {
+				return err
+			}
+		}
+		logs.Logs = append(logs.Logs, l)
+	}
+	logs.PrimaryKey = reply.GetPrimaryKey()
+	*result = &logs
+	return nil
+}
+
 /*
 GetTxByHashes(parm *types.ReqHashes) (*types.TransactionDetails, error)
 	GetMempool() (*types.ReplyTxList, error)
@@ -399,7 +472,7 @@ func (c *Chain33) GetAccounts(in *types.ReqAccountList, result *interface{}) err
 	}
 	var accounts rpctypes.WalletAccounts
 	for _, wallet := range reply.Wallets {
-		accounts.Wallets = append(accounts.Wallets, &rpctypes.WalletAccount{Label: wallet.GetLabel(),
+		accounts.Wallets = append(accounts.Wallets, &rpctypes.WalletAccount{Label: wallet.GetLabel(), WatchOnly: wallet.GetWatchOnly(),
 			Acc: &rpctypes.Account{Currency: wallet.GetAcc().GetCurrency(), Balance: wallet.GetAcc().GetBalance(),
 				Frozen: wallet.GetAcc().GetFrozen(), Addr: wallet.GetAcc().GetAddr()}})
 	}
@@ -629,14 +702,15 @@ func (c *Chain33) GetHeaders(in types.ReqBlocks, result *interface{}) error {
 	{
 		for _, item := range reply.Items {
 			headers.Items = append(headers.Items, &rpctypes.Header{
-				BlockTime:  item.GetBlockTime(),
-				TxCount:    item.GetTxCount(),
-				Hash:       common.ToHex(item.GetHash()),
-				Height:     item.GetHeight(),
-				ParentHash: common.ToHex(item.GetParentHash()),
-				StateHash:  common.ToHex(item.GetStateHash()),
-				TxHash:     common.ToHex(item.GetTxHash()),
-				Difficulty: item.GetDifficulty(),
+				BlockTime:   item.GetBlockTime(),
+				TxCount:     item.GetTxCount(),
+				Hash:        common.ToHex(item.GetHash()),
+				Height:      item.GetHeight(),
+				ParentHash:  common.ToHex(item.GetParentHash()),
+				StateHash:   common.ToHex(item.GetStateHash()),
+				TxHash:      common.ToHex(item.GetTxHash()),
+				Difficulty:  item.GetDifficulty(),
+				ReceiptHash: common.ToHex(item.GetReceiptHash()),
 				/* 空值，斩不显示
 				Signature: &Signature{
 					Ty:        item.GetSignature().GetTy(),
@@ -698,6 +772,7 @@ func (c *Chain33) GetBlockOverview(in rpctypes.QueryParm, result *interface{}) e
 	header.Hash = common.ToHex(reply.GetHead().GetHash())
 	header.TxCount = reply.GetHead().GetTxCount()
 	header.Difficulty = reply.GetHead().GetDifficulty()
+	header.ReceiptHash = common.ToHex(reply.GetHead().GetReceiptHash())
 	/* 空值，斩不显示
 	header.Signature = &Signature{
 		Ty:        reply.GetHead().GetSignature().GetTy(),
@@ -775,6 +850,88 @@ func (c *Chain33) GetSeed(in types.GetSeedByPw, result *interface{}) error {
 	return nil
 }
 
+//ImportWatchOnly 通过地址或者公钥导入只读账户
+func (c *Chain33) ImportWatchOnly(in types.ReqImportWatchOnly, result *interface{}) error {
+	reply, err := c.cli.WalletImportWatchOnly(&in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+//CreateOfflineTx 构造未签名的转账交易, 在离线节点上通过SignRawTx签名后再用SendTransaction广播
+func (c *Chain33) CreateOfflineTx(in types.ReqWalletSendToAddress, result *interface{}) error {
+	reply, err := c.cli.WalletCreateOfflineTx(&in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+//DeriveAccount 按照BIP-44路径从钱包种子推导账户并导入钱包
+func (c *Chain33) DeriveAccount(in types.ReqDeriveAccount, result *interface{}) error {
+	reply, err := c.cli.DeriveAccount(&in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+//GetExtendedPubKey 导出 m/44'/coin'/account' 的扩展公钥
+func (c *Chain33) GetExtendedPubKey(in types.ReqExtendedPubKey, result *interface{}) error {
+	reply, err := c.cli.GetExtendedPubKey(&in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+//DiscoverAccounts 扫描链上交易记录, 把已使用的HD账户导入钱包
+func (c *Chain33) DiscoverAccounts(in types.ReqDiscoverAccounts, result *interface{}) error {
+	reply, err := c.cli.DiscoverAccounts(&in)
+	if err != nil {
+		return err
+	}
+	var accounts rpctypes.WalletAccounts
+	for _, wallet := range reply.Wallets {
+		accounts.Wallets = append(accounts.Wallets, &rpctypes.WalletAccount{Label: wallet.GetLabel(), WatchOnly: wallet.GetWatchOnly(),
+			Acc: &rpctypes.Account{Currency: wallet.GetAcc().GetCurrency(), Balance: wallet.GetAcc().GetBalance(),
+				Frozen: wallet.GetAcc().GetFrozen(), Addr: wallet.GetAcc().GetAddr()}})
+	}
+	*result = &accounts
+	return nil
+}
+
+//ExportWallet 导出使用passwd加密的钱包备份, 包括seed和所有账户
+func (c *Chain33) ExportWallet(in types.ReqWalletExport, result *interface{}) error {
+	reply, err := c.cli.WalletExport(&in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+//ImportWallet 从钱包备份中恢复seed和账户
+func (c *Chain33) ImportWallet(in types.ReqWalletImport, result *interface{}) error {
+	reply, err := c.cli.WalletImport(&in)
+	if err != nil {
+		return err
+	}
+	var accounts rpctypes.WalletAccounts
+	for _, wallet := range reply.Wallets {
+		accounts.Wallets = append(accounts.Wallets, &rpctypes.WalletAccount{Label: wallet.GetLabel(), WatchOnly: wallet.GetWatchOnly(),
+			Acc: &rpctypes.Account{Currency: wallet.GetAcc().GetCurrency(), Balance: wallet.GetAcc().GetBalance(),
+				Frozen: wallet.GetAcc().GetFrozen(), Addr: wallet.GetAcc().GetAddr()}})
+	}
+	*result = &accounts
+	return nil
+}
+
 func (c *Chain33) GetWalletStatus(in types.ReqNil, result *interface{}) error {
 	reply, err := c.cli.GetWalletStatus()
 	if err != nil {
@@ -902,6 +1059,51 @@ func (c *Chain33) GetTotalCoins(in *types.ReqGetTotalCoins, result *interface{})
 	return nil
 }
 
+func convertStateProof(proof *types.StateProof) *rpctypes.StateProof {
+	return &rpctypes.StateProof{
+		StateHash: common.ToHex(proof.StateHash),
+		Key:       common.ToHex(proof.Key),
+		Value:     common.ToHex(proof.Value),
+		Proof:     common.ToHex(proof.Proof),
+	}
+}
+
+//GetStateProof 获取状态数据的值以及默克尔证明, 参数都是 hex 格式
+func (c *Chain33) GetStateProof(in rpctypes.ReqStateProof, result *interface{}) error {
+	stateHash, err := common.FromHex(in.StateHash)
+	if err != nil {
+		return err
+	}
+	key, err := common.FromHex(in.Key)
+	if err != nil {
+		return err
+	}
+	reply, err := c.cli.GetStateProof(&types.ReqStateProof{StateHash: stateHash, Key: key})
+	if err != nil {
+		return err
+	}
+	*result = convertStateProof(reply)
+	return nil
+}
+
+//GetAccountProof 获取账户的值以及默克尔证明, stateHash 为空的时候使用最新的区块
+func (c *Chain33) GetAccountProof(in rpctypes.ReqAccountProof, result *interface{}) error {
+	stateHash, err := common.FromHex(in.StateHash)
+	if err != nil {
+		return err
+	}
+	reply, err := c.cli.GetAccountProof(&types.ReqAccountProof{Addr: in.Addr, Execer: in.Execer, Symbol: in.Symbol, StateHash: stateHash})
+	if err != nil {
+		return err
+	}
+	acc := reply.GetAccount()
+	*result = &rpctypes.AccountProof{
+		Account: &rpctypes.Account{Currency: acc.GetCurrency(), Balance: acc.GetBalance(), Frozen: acc.GetFrozen(), Addr: acc.GetAddr()},
+		Proof:   convertStateProof(reply.GetProof()),
+	}
+	return nil
+}
+
 func (c *Chain33) IsSync(in *types.ReqNil, result *interface{}) error {
 	reply, _ := c.cli.IsSync()
 	ret := false
@@ -922,6 +1124,16 @@ func (c *Chain33) IsNtpClockSync(in *types.ReqNil, result *interface{}) error {
 	return nil
 }
 
+//MineBlocks solo 共识马上打包 n 个区块, 开发和测试的时候用来推进区块高度
+func (c *Chain33) MineBlocks(in types.Int64, result *interface{}) error {
+	reply, err := c.cli.QueryConsensusFunc("solo", "MineBlocks", &in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
 func (c *Chain33) QueryTotalFee(in *types.LocalDBGet, result *interface{}) error {
 	reply, err := c.cli.LocalGet(in)
 	if err != nil {
@@ -956,6 +1168,36 @@ func (c *Chain33) GetNetInfo(in *types.ReqNil, result *interface{}) error {
 	return nil
 }
 
+//GetPeerScores 获取节点的信誉分数和封禁状态
+func (c *Chain33) GetPeerScores(in *types.ReqNil, result *interface{}) error {
+	reply, err := c.cli.GetPeerScores()
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+//BanPeer 封禁节点, duration单位秒, 为0的时候永久封禁
+func (c *Chain33) BanPeer(in *types.ReqBanPeer, result *interface{}) error {
+	reply, err := c.cli.BanPeer(in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+//UnbanPeer 解除节点的封禁
+func (c *Chain33) UnbanPeer(in *types.ReqString, result *interface{}) error {
+	reply, err := c.cli.UnbanPeer(in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
 func (c *Chain33) GetFatalFailure(in *types.ReqNil, result *interface{}) error {
 	resp, err := c.cli.GetFatalFailure()
 	if err != nil {
@@ -1065,6 +1307,26 @@ func (c *Chain33) CloseQueue(in *types.ReqNil, result *interface{}) error {
 	return nil
 }
 
+//GetQueueStats 获取消息队列每个 topic 的统计信息
+func (c *Chain33) GetQueueStats(in *types.ReqNil, result *interface{}) error {
+	reply, err := c.cli.QueueStats()
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+//GetSignVerifyStats 获取交易签名验证的统计信息
+func (c *Chain33) GetSignVerifyStats(in *types.ReqNil, result *interface{}) error {
+	reply, err := c.cli.SignVerifyStats()
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
 func (c *Chain33) GetLastBlockSequence(in *types.ReqNil, result *interface{}) error {
 	resp, err := c.cli.GetLastBlockSequence()
 	if err != nil {
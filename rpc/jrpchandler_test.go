@@ -672,6 +672,30 @@ func TestChain33_GetTxByAddr(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, api)
 }
 
+func TestChain33_GetLogs(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	testChain33 := newTestChain33(api)
+
+	transfer := &types.ReceiptAccountTransfer{Prev: &types.Account{Balance: 1}, Current: &types.Account{Balance: 2}}
+	reply := &types.ReplyReceiptLogs{
+		Logs:       []*types.ReceiptLogInfo{{Height: 1, TxHash: []byte("hash"), Execer: "coins", Ty: types.TyLogTransfer, Log: types.Encode(transfer)}},
+		PrimaryKey: "000000000000100000:00000",
+	}
+	in := types.ReqGetLogs{Execer: "coins", Count: 10}
+	api.On("Query", types.ExecName("coins"), "GetLogs", &in).Return(reply, nil)
+	var testResult interface{}
+	err := testChain33.GetLogs(in, &testResult)
+	assert.Nil(t, err)
+	logs := testResult.(*rpctypes.ReplyReceiptLogs)
+	assert.Equal(t, reply.PrimaryKey, logs.PrimaryKey)
+	assert.Equal(t, 1, len(logs.Logs))
+	assert.Equal(t, "LogTransfer", logs.Logs[0].TyName)
+	assert.Equal(t, common.ToHex([]byte("hash")), logs.Logs[0].TxHash)
+	assert.NotNil(t, logs.Logs[0].Log)
+
+	mock.AssertExpectationsForObjects(t, api)
+}
+
 func TestChain33_GetTxByHashes(t *testing.T) {
 	api := new(mocks.QueueProtocolAPI)
 	testChain33 := newTestChain33(api)
@@ -1161,6 +1185,65 @@ func TestChain33_GetTimeStatus(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestChain33_GetQueueStats(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newTestChain33(api)
+	stats := &types.QueueStats{Topics: []*types.TopicStat{{Topic: "mempool", HighCap: 64, SendCount: 1}}}
+	api.On("QueueStats").Return(stats, nil)
+	var result interface{}
+	err := client.GetQueueStats(&types.ReqNil{}, &result)
+	assert.Nil(t, err)
+	assert.Equal(t, stats, result)
+}
+
+func TestChain33_GetSignVerifyStats(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newTestChain33(api)
+	stats := &types.SignVerifyStats{VerifyCount: 10, CacheHits: 5, Throughput: 1000, Workers: 4}
+	api.On("SignVerifyStats").Return(stats, nil)
+	var result interface{}
+	err := client.GetSignVerifyStats(&types.ReqNil{}, &result)
+	assert.Nil(t, err)
+	assert.Equal(t, stats, result)
+}
+
+func TestChain33_GetPeerScores(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newTestChain33(api)
+	scores := &types.PeerScoreList{Peers: []*types.PeerScore{{Addr: "192.168.1.1:13802", Score: 50}}}
+	api.On("GetPeerScores").Return(scores, nil)
+	var result interface{}
+	err := client.GetPeerScores(&types.ReqNil{}, &result)
+	assert.Nil(t, err)
+	assert.Equal(t, scores, result)
+}
+
+func TestChain33_BanPeer(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newTestChain33(api)
+	req := &types.ReqBanPeer{Addr: "192.168.1.1:13802", Duration: 3600}
+	api.On("BanPeer", req).Return(&types.Reply{IsOk: true}, nil)
+	var result interface{}
+	err := client.BanPeer(req, &result)
+	assert.Nil(t, err)
+	assert.Equal(t, &types.Reply{IsOk: true}, result)
+
+	req = &types.ReqBanPeer{Addr: "192.168.1.2"}
+	api.On("BanPeer", req).Return(nil, types.ErrInvalidParam)
+	err = client.BanPeer(req, &result)
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
+
+func TestChain33_UnbanPeer(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newTestChain33(api)
+	req := &types.ReqString{Data: "192.168.1.1:13802"}
+	api.On("UnbanPeer", req).Return(nil, types.ErrPeerNotBanned)
+	var result interface{}
+	err := client.UnbanPeer(req, &result)
+	assert.Equal(t, types.ErrPeerNotBanned, err)
+}
+
 func TestChain33_GetLastBlockSequence(t *testing.T) {
 	api := new(mocks.QueueProtocolAPI)
 	client := newTestChain33(api)
@@ -1240,3 +1323,148 @@ func TestChain33_CreateTransaction(t *testing.T) {
 	err = client.CreateTransaction(in, &result)
 	assert.Nil(t, err)
 }
+
+func TestChain33_GetStateProof(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	testChain33 := newTestChain33(api)
+
+	req := &types.ReqStateProof{StateHash: []byte("hash"), Key: []byte("key")}
+	proof := &types.StateProof{StateHash: req.StateHash, Key: req.Key, Value: []byte("value"), Proof: []byte("proof")}
+	api.On("StoreGetProof", req).Return(proof, nil)
+	var testResult interface{}
+	err := testChain33.GetStateProof(rpctypes.ReqStateProof{StateHash: common.ToHex(req.StateHash), Key: common.ToHex(req.Key)}, &testResult)
+	assert.Nil(t, err)
+	reply := testResult.(*rpctypes.StateProof)
+	assert.Equal(t, common.ToHex([]byte("value")), reply.Value)
+	assert.Equal(t, common.ToHex([]byte("proof")), reply.Proof)
+
+	err = testChain33.GetStateProof(rpctypes.ReqStateProof{StateHash: "0xzz"}, &testResult)
+	assert.NotNil(t, err)
+	mock.AssertExpectationsForObjects(t, api)
+}
+
+func TestChain33_GetAccountProof(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	testChain33 := newTestChain33(api)
+
+	api.On("GetLastHeader").Return(nil, types.ErrBlockNotFound)
+	var testResult interface{}
+	err := testChain33.GetAccountProof(rpctypes.ReqAccountProof{Addr: "addr"}, &testResult)
+	assert.Equal(t, types.ErrBlockNotFound, err)
+	mock.AssertExpectationsForObjects(t, api)
+}
+
+func TestChain33_GetReceiptProof(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	testChain33 := newTestChain33(api)
+
+	reply := &types.ReceiptProof{
+		Receipt: &types.ReceiptData{Ty: types.ExecOk, Logs: []*types.ReceiptLog{{Ty: types.TyLogFee, Log: []byte("log")}}},
+		Index:   1,
+		Proofs:  [][]byte{[]byte("proof")},
+		Header:  &types.Header{Height: 10, ReceiptHash: []byte("receipthash")},
+	}
+	api.On("GetReceiptProof", &types.ReqHash{Hash: []byte("hash")}).Return(reply, nil)
+	var testResult interface{}
+	err := testChain33.GetReceiptProof(rpctypes.QueryParm{Hash: common.ToHex([]byte("hash"))}, &testResult)
+	assert.Nil(t, err)
+	proof := testResult.(*rpctypes.ReceiptProof)
+	assert.Equal(t, int64(1), proof.Index)
+	assert.Equal(t, common.ToHex([]byte("log")), proof.Receipt.Logs[0].Log)
+	assert.Equal(t, []string{common.ToHex([]byte("proof"))}, proof.Proofs)
+	assert.Equal(t, common.ToHex([]byte("receipthash")), proof.Header.ReceiptHash)
+	mock.AssertExpectationsForObjects(t, api)
+}
+
+func TestChain33_MineBlocks(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	testChain33 := newTestChain33(api)
+
+	api.On("QueryConsensusFunc", "solo", "MineBlocks", &types.Int64{Data: 2}).Return(&types.ReplyBlockHeight{Height: 12}, nil)
+	var testResult interface{}
+	err := testChain33.MineBlocks(types.Int64{Data: 2}, &testResult)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(12), testResult.(*types.ReplyBlockHeight).Height)
+
+	api.On("QueryConsensusFunc", "solo", "MineBlocks", &types.Int64{Data: 0}).Return(nil, types.ErrInvalidParam)
+	err = testChain33.MineBlocks(types.Int64{Data: 0}, &testResult)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	mock.AssertExpectationsForObjects(t, api)
+}
+
+func TestChain33_HDAccount(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	testChain33 := newTestChain33(api)
+
+	derive := &types.ReqDeriveAccount{Label: "hd", Path: "m/44'/13107'/0'/0/1"}
+	api.On("DeriveAccount", derive).Return(&types.WalletAccount{Label: "hd", Acc: &types.Account{Addr: "addr"}}, nil)
+	var testResult interface{}
+	err := testChain33.DeriveAccount(*derive, &testResult)
+	assert.Nil(t, err)
+	assert.Equal(t, "addr", testResult.(*types.WalletAccount).Acc.Addr)
+
+	api.On("GetExtendedPubKey", &types.ReqExtendedPubKey{Account: 1}).Return(nil, types.ErrWalletIsLocked)
+	err = testChain33.GetExtendedPubKey(types.ReqExtendedPubKey{Account: 1}, &testResult)
+	assert.Equal(t, types.ErrWalletIsLocked, err)
+
+	discover := &types.ReqDiscoverAccounts{GapLimit: 5}
+	api.On("DiscoverAccounts", discover).Return(&types.WalletAccounts{Wallets: []*types.WalletAccount{{Label: "hd", Acc: &types.Account{Addr: "addr"}}}}, nil)
+	err = testChain33.DiscoverAccounts(*discover, &testResult)
+	assert.Nil(t, err)
+	accounts := testResult.(*rpctypes.WalletAccounts)
+	assert.Equal(t, 1, len(accounts.Wallets))
+	assert.Equal(t, "addr", accounts.Wallets[0].Acc.Addr)
+	mock.AssertExpectationsForObjects(t, api)
+}
+
+func TestChain33_WatchOnly(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	testChain33 := newTestChain33(api)
+
+	watch := &types.ReqImportWatchOnly{Addr: "addr", Label: "watch"}
+	api.On("WalletImportWatchOnly", watch).Return(&types.WalletAccount{Label: "watch", WatchOnly: true, Acc: &types.Account{Addr: "addr"}}, nil)
+	var testResult interface{}
+	err := testChain33.ImportWatchOnly(*watch, &testResult)
+	assert.Nil(t, err)
+	assert.True(t, testResult.(*types.WalletAccount).WatchOnly)
+
+	send := &types.ReqWalletSendToAddress{From: "addr", To: "to", Amount: 1}
+	api.On("WalletCreateOfflineTx", send).Return(&types.OfflineTx{Addr: "addr", TxHex: "0x00", Expire: "300s"}, nil)
+	err = testChain33.CreateOfflineTx(*send, &testResult)
+	assert.Nil(t, err)
+	assert.Equal(t, "0x00", testResult.(*types.OfflineTx).TxHex)
+
+	locked := &types.ReqWalletSendToAddress{From: "none", To: "to", Amount: 1}
+	api.On("WalletCreateOfflineTx", locked).Return(nil, types.ErrAddrNotExist)
+	err = testChain33.CreateOfflineTx(*locked, &testResult)
+	assert.Equal(t, types.ErrAddrNotExist, err)
+	mock.AssertExpectationsForObjects(t, api)
+}
+
+func TestChain33_WalletBackup(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	testChain33 := newTestChain33(api)
+
+	export := &types.ReqWalletExport{Passwd: "backup"}
+	api.On("WalletExport", export).Return(&types.ReplyString{Data: "backup"}, nil)
+	var testResult interface{}
+	err := testChain33.ExportWallet(*export, &testResult)
+	assert.Nil(t, err)
+	assert.Equal(t, "backup", testResult.(*types.ReplyString).Data)
+
+	imp := &types.ReqWalletImport{Data: "backup", Passwd: "backup"}
+	api.On("WalletImport", imp).Return(&types.WalletAccounts{Wallets: []*types.WalletAccount{
+		{Label: "watch", WatchOnly: true, Acc: &types.Account{Addr: "addr"}}}}, nil)
+	err = testChain33.ImportWallet(*imp, &testResult)
+	assert.Nil(t, err)
+	accounts := testResult.(*rpctypes.WalletAccounts)
+	assert.Equal(t, 1, len(accounts.Wallets))
+	assert.Equal(t, "addr", accounts.Wallets[0].Acc.Addr)
+	assert.True(t, accounts.Wallets[0].WatchOnly)
+
+	wrong := &types.ReqWalletImport{Data: "backup", Passwd: "wrong"}
+	api.On("WalletImport", wrong).Return(nil, types.ErrInputPassword)
+	err = testChain33.ImportWallet(*wrong, &testResult)
+	assert.Equal(t, types.ErrInputPassword, err)
+	mock.AssertExpectationsForObjects(t, api)
+}
@@ -29,12 +29,24 @@ var (
 	grpcFuncBlacklist = make(map[string]bool)
 )
 
+//轻节点没有执行器和钱包, 只提供只读的查询接口
+//轻节点模式下和配置的函数白名单取交集, 本地的调用也只能使用这些接口
+var (
+	lightJrpcFuncs = []string{"GetBlocks", "GetLastHeader", "GetHeaders", "GetBlockHash", "GetBlockOverview",
+		"GetBalance", "GetAllExecBalance", "GetStateProof", "GetAccountProof", "GetPeerInfo", "GetNetInfo",
+		"GetPeerScores", "IsSync", "IsNtpClockSync", "Version", "ConvertExectoAddr", "DecodeRawTransaction"}
+	lightGrpcFuncs = []string{"GetBlocks", "GetLastHeader", "GetHeaders", "GetBlockHash", "GetBlockOverview",
+		"GetBalance", "GetAllExecBalance", "GetStateProof", "GetAccountProof", "GetPeerInfo", "NetInfo",
+		"GetPeerScores", "IsSync", "IsNtpClockSync", "Version"}
+)
+
 type Chain33 struct {
 	cli channelClient
 }
 
 type Grpc struct {
 	cli channelClient
+	hub *pushHub
 }
 
 type Grpcserver struct {
@@ -48,6 +60,7 @@ type JSONRPCServer struct {
 	jrpc Chain33
 	s    *rpc.Server
 	l    net.Listener
+	hub  *pushHub
 	//addr string
 }
 
@@ -99,6 +112,26 @@ func checkGrpcFuncWhitelist(funcName string) bool {
 	}
 	return false
 }
+func checkLightFunc(funcs []string, funcName string) bool {
+	if !types.IsEnable("lightMode") {
+		return true
+	}
+	for _, name := range funcs {
+		if name == funcName {
+			return true
+		}
+	}
+	return false
+}
+
+func checkLightJrpcFunc(funcName string) bool {
+	return checkLightFunc(lightJrpcFuncs, funcName)
+}
+
+func checkLightGrpcFunc(funcName string) bool {
+	return checkLightFunc(lightGrpcFuncs, funcName)
+}
+
 func checkJrpcFuncBlacklist(funcName string) bool {
 	if _, ok := jrpcFuncBlacklist[funcName]; ok {
 		return true
@@ -129,13 +162,20 @@ func NewGRpcServer(c queue.Client, api client.QueueProtocolAPI) *Grpcserver {
 	//register interceptor
 	//var interceptor grpc.UnaryServerInterceptor
 	interceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		if err := auth(ctx, info); err != nil {
+		if err := auth(ctx, info.FullMethod); err != nil {
 			return nil, err
 		}
 		// Continue processing the request
 		return handler(ctx, req)
 	}
 	opts = append(opts, grpc.UnaryInterceptor(interceptor))
+	streamInterceptor := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := auth(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+	opts = append(opts, grpc.StreamInterceptor(streamInterceptor))
 	server := grpc.NewServer(opts...)
 	s.s = server
 	types.RegisterChain33Server(server, &s.grpc)
@@ -155,6 +195,7 @@ type RPC struct {
 	cfg  *types.Rpc
 	gapi *Grpcserver
 	japi *JSONRPCServer
+	hub  *pushHub
 	c    queue.Client
 	api  client.QueueProtocolAPI
 }
@@ -166,6 +207,7 @@ func InitCfg(cfg *types.Rpc) {
 	InitGrpcFuncWhitelist(cfg)
 	InitJrpcFuncBlacklist(cfg)
 	InitGrpcFuncBlacklist(cfg)
+	types.S("subscribe", cfg.EnableSubscribe)
 }
 
 func New(cfg *types.Rpc) *RPC {
@@ -183,6 +225,7 @@ func (r *RPC) SetQueueClient(c queue.Client) {
 	r.gapi = gapi
 	r.japi = japi
 	r.c = c
+	r.initHub(c)
 	//注册系统rpc
 	pluginmgr.AddRPC(r)
 	r.Listen()
@@ -194,6 +237,18 @@ func (r *RPC) SetQueueClientNoListen(c queue.Client) {
 	r.gapi = gapi
 	r.japi = japi
 	r.c = c
+	r.initHub(c)
+}
+
+//开启订阅功能以后, 接收 blockchain 和 mempool 的推送消息
+func (r *RPC) initHub(c queue.Client) {
+	if !r.cfg.EnableSubscribe {
+		return
+	}
+	r.hub = newPushHub()
+	r.hub.start(c)
+	r.gapi.grpc.hub = r.hub
+	r.japi.hub = r.hub
 }
 
 func (rpc *RPC) Listen() (port1 int, port2 int) {
@@ -238,6 +293,10 @@ func (rpc *RPC) Close() {
 	if rpc.japi != nil {
 		rpc.japi.Close()
 	}
+	if rpc.hub != nil {
+		rpc.hub.close()
+		rpc.c.Close()
+	}
 }
 
 func InitIpWhitelist(cfg *types.Rpc) {
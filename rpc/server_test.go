@@ -6,6 +6,7 @@ package rpc
 
 import (
 	"errors"
+	"net"
 	"testing"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/33cn/chain33/types"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	pr "google.golang.org/grpc/peer"
 )
 
 func TestCheckIpWhitelist(t *testing.T) {
@@ -37,6 +39,21 @@ func TestCheckIpWhitelist(t *testing.T) {
 
 }
 
+func TestLightFuncWhitelist(t *testing.T) {
+	types.S("lightMode", true)
+	defer types.S("lightMode", false)
+	InitJrpcFuncWhitelist(&types.Rpc{JrpcFuncWhitelist: []string{"*"}})
+	InitGrpcFuncWhitelist(&types.Rpc{GrpcFuncWhitelist: []string{"*"}})
+	//轻节点模式下即使配置了 "*" 也只能调用轻节点的接口
+	assert.True(t, checkJrpcFuncWhitelist("SendTransaction"))
+	assert.False(t, checkLightJrpcFunc("SendTransaction"))
+	assert.True(t, checkLightJrpcFunc("GetHeaders"))
+	//本地的调用也一样
+	ctx := pr.NewContext(context.Background(), &pr.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8802}})
+	assert.NotNil(t, auth(ctx, "/types.chain33/SendTransaction"))
+	assert.Nil(t, auth(ctx, "/types.chain33/GetHeaders"))
+}
+
 func TestJSONClient_Call(t *testing.T) {
 	rpcCfg = new(types.Rpc)
 	rpcCfg.GrpcBindAddr = "127.0.0.1:8101"
@@ -0,0 +1,212 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"sync"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+)
+
+/*
+订阅推送:
+1. blockchain 在区块添加和回滚的时候, mempool 在交易进入mempool的时候, 发送消息给 "rpc" 模块
+2. pushHub 把消息转换成 PushEvent, 按照订阅条件过滤以后发送给订阅者
+3. 订阅者处理不过来(缓存满了)的时候, 直接断开这个订阅者, 不影响其他订阅者以及系统的处理
+*/
+
+const subscriberBuffer = 1024
+
+type subscriber struct {
+	id     int64
+	req    *types.ReqSubscribe
+	execer map[string]bool
+	addr   map[string]bool
+	ch     chan *types.PushEvent
+}
+
+func newSubscriber(id int64, req *types.ReqSubscribe) *subscriber {
+	sub := &subscriber{
+		id:     id,
+		req:    req,
+		execer: make(map[string]bool),
+		addr:   make(map[string]bool),
+		ch:     make(chan *types.PushEvent, subscriberBuffer),
+	}
+	for _, execer := range req.Execer {
+		sub.execer[execer] = true
+	}
+	for _, addr := range req.Addr {
+		sub.addr[addr] = true
+	}
+	return sub
+}
+
+//交易是否满足订阅的执行器和地址条件
+func (sub *subscriber) matchTx(tx *types.Transaction) bool {
+	if len(sub.execer) > 0 && !sub.execer[string(tx.Execer)] {
+		return false
+	}
+	if len(sub.addr) > 0 && !sub.addr[tx.From()] && !sub.addr[tx.To] {
+		return false
+	}
+	return true
+}
+
+func (sub *subscriber) match(event *types.PushEvent, tx *types.Transaction) bool {
+	switch event.Ty {
+	case types.TyPushBlock:
+		return sub.req.Header
+	case types.TyPushDelBlock:
+		return sub.req.DelHeader
+	case types.TyPushTx:
+		return sub.req.Tx && sub.matchTx(tx)
+	case types.TyPushLog:
+		return sub.req.Log && sub.matchTx(tx)
+	}
+	return false
+}
+
+type pushHub struct {
+	mu     sync.Mutex
+	id     int64
+	subs   map[int64]*subscriber
+	closed bool
+}
+
+func newPushHub() *pushHub {
+	return &pushHub{subs: make(map[int64]*subscriber)}
+}
+
+//添加一个订阅者, 订阅者通过 sub.ch 接收事件, sub.ch 被关闭表示订阅结束
+func (hub *pushHub) subscribe(req *types.ReqSubscribe) (*subscriber, error) {
+	if req == nil || !(req.Header || req.DelHeader || req.Tx || req.Log) {
+		return nil, types.ErrInvalidParam
+	}
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	if hub.closed {
+		return nil, types.ErrIsClosed
+	}
+	hub.id++
+	sub := newSubscriber(hub.id, req)
+	hub.subs[sub.id] = sub
+	return sub, nil
+}
+
+func (hub *pushHub) unsubscribe(sub *subscriber) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	if _, ok := hub.subs[sub.id]; ok {
+		delete(hub.subs, sub.id)
+		close(sub.ch)
+	}
+}
+
+func (hub *pushHub) publish(event *types.PushEvent, tx *types.Transaction) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	for id, sub := range hub.subs {
+		if !sub.match(event, tx) {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			log.Error("pushHub", "subscriber", id, "err", "too slow, disconnect")
+			delete(hub.subs, id)
+			close(sub.ch)
+		}
+	}
+}
+
+func (hub *pushHub) count() int {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	return len(hub.subs)
+}
+
+func (hub *pushHub) close() {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	hub.closed = true
+	for id, sub := range hub.subs {
+		delete(hub.subs, id)
+		close(sub.ch)
+	}
+}
+
+//pushHub.start 订阅 "rpc" 消息, 直到消息队列关闭
+func (hub *pushHub) start(c queue.Client) {
+	c.Sub("rpc")
+	go func() {
+		for msg := range c.Recv() {
+			hub.handleMsg(msg)
+		}
+	}()
+}
+
+func (hub *pushHub) handleMsg(msg queue.Message) {
+	switch msg.Ty {
+	case types.EventAddBlock:
+		detail, ok := msg.GetData().(*types.BlockDetail)
+		if !ok {
+			return
+		}
+		hub.publishBlock(detail)
+	case types.EventDelBlock:
+		detail, ok := msg.GetData().(*types.BlockDetail)
+		if !ok {
+			return
+		}
+		hub.publish(&types.PushEvent{Ty: types.TyPushDelBlock, Header: blockHeader(detail)}, nil)
+	case types.EventPushTx:
+		tx, ok := msg.GetData().(*types.Transaction)
+		if !ok {
+			return
+		}
+		hub.publish(&types.PushEvent{Ty: types.TyPushTx, Tx: tx}, tx)
+	}
+}
+
+func (hub *pushHub) publishBlock(detail *types.BlockDetail) {
+	hub.publish(&types.PushEvent{Ty: types.TyPushBlock, Header: blockHeader(detail)}, nil)
+	block := detail.GetBlock()
+	for i, tx := range block.GetTxs() {
+		if i >= len(detail.Receipts) {
+			break
+		}
+		hash := tx.Hash()
+		for _, l := range detail.Receipts[i].GetLogs() {
+			pushlog := &types.PushLog{
+				Height: block.Height,
+				TxHash: hash,
+				Index:  int32(i),
+				Execer: string(tx.Execer),
+				Log:    l,
+			}
+			hub.publish(&types.PushEvent{Ty: types.TyPushLog, Log: pushlog}, tx)
+		}
+	}
+}
+
+func blockHeader(detail *types.BlockDetail) *types.Header {
+	block := detail.GetBlock()
+	header := &types.Header{
+		Version:     block.Version,
+		ParentHash:  block.ParentHash,
+		TxHash:      block.TxHash,
+		StateHash:   block.StateHash,
+		Height:      block.Height,
+		BlockTime:   block.BlockTime,
+		TxCount:     int64(len(block.Txs)),
+		Difficulty:  block.Difficulty,
+		Signature:   block.Signature,
+		ReceiptHash: block.ReceiptHash,
+	}
+	header.Hash = block.Hash()
+	return header
+}
@@ -0,0 +1,229 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/queue"
+	qmocks "github.com/33cn/chain33/queue/mocks"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func newPushTestBlock() *types.BlockDetail {
+	tx1 := &types.Transaction{Execer: []byte("coins"), To: "addr1", Nonce: 1}
+	tx2 := &types.Transaction{Execer: []byte("ticket"), To: "addr2", Nonce: 2}
+	block := &types.Block{Height: 10, Txs: []*types.Transaction{tx1, tx2}}
+	receipt1 := &types.ReceiptData{Ty: types.ExecOk, Logs: []*types.ReceiptLog{{Ty: types.TyLogFee}, {Ty: types.TyLogTransfer}}}
+	receipt2 := &types.ReceiptData{Ty: types.ExecOk, Logs: []*types.ReceiptLog{{Ty: types.TyLogFee}}}
+	return &types.BlockDetail{Block: block, Receipts: []*types.ReceiptData{receipt1, receipt2}}
+}
+
+func recvEvents(sub *subscriber) []*types.PushEvent {
+	var events []*types.PushEvent
+	for {
+		select {
+		case event := <-sub.ch:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestPushHub(t *testing.T) {
+	hub := newPushHub()
+	_, err := hub.subscribe(&types.ReqSubscribe{})
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	all, err := hub.subscribe(&types.ReqSubscribe{Header: true, DelHeader: true, Tx: true, Log: true})
+	assert.Nil(t, err)
+	coins, err := hub.subscribe(&types.ReqSubscribe{Tx: true, Log: true, Execer: []string{"coins"}})
+	assert.Nil(t, err)
+	addr2, err := hub.subscribe(&types.ReqSubscribe{Log: true, Addr: []string{"addr2"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, hub.count())
+
+	detail := newPushTestBlock()
+	hub.handleMsg(queue.NewMessage(0, "rpc", types.EventPushTx, detail.Block.Txs[0]))
+	hub.handleMsg(queue.NewMessage(0, "rpc", types.EventPushTx, detail.Block.Txs[1]))
+	hub.handleMsg(queue.NewMessage(0, "rpc", types.EventAddBlock, detail))
+	hub.handleMsg(queue.NewMessage(0, "rpc", types.EventDelBlock, detail))
+
+	events := recvEvents(all)
+	assert.Equal(t, 7, len(events))
+	assert.Equal(t, int32(types.TyPushTx), events[0].Ty)
+	assert.Equal(t, int32(types.TyPushBlock), events[2].Ty)
+	assert.Equal(t, int64(10), events[2].Header.Height)
+	assert.Equal(t, detail.Block.Hash(), events[2].Header.Hash)
+	assert.Equal(t, int32(types.TyPushLog), events[3].Ty)
+	assert.Equal(t, int32(types.TyPushDelBlock), events[6].Ty)
+
+	events = recvEvents(coins)
+	assert.Equal(t, 3, len(events))
+	assert.Equal(t, "coins", string(events[0].Tx.Execer))
+	assert.Equal(t, int32(types.TyLogTransfer), events[2].Log.Log.Ty)
+
+	events = recvEvents(addr2)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, int32(1), events[0].Log.Index)
+	assert.Equal(t, detail.Block.Txs[1].Hash(), events[0].Log.TxHash)
+
+	//处理太慢的订阅者被断开
+	for i := 0; i <= subscriberBuffer; i++ {
+		hub.handleMsg(queue.NewMessage(0, "rpc", types.EventPushTx, detail.Block.Txs[1]))
+	}
+	assert.Equal(t, 2, hub.count())
+	for range all.ch {
+	}
+	hub.unsubscribe(coins)
+	assert.Equal(t, 1, hub.count())
+	hub.close()
+	assert.Equal(t, 0, hub.count())
+	_, ok := <-addr2.ch
+	assert.False(t, ok)
+	_, err = hub.subscribe(&types.ReqSubscribe{Header: true})
+	assert.Equal(t, types.ErrIsClosed, err)
+}
+
+func waitSubscriber(hub *pushHub, n int) {
+	for i := 0; i < 100 && hub.count() != n; i++ {
+		time.Sleep(time.Millisecond * 10)
+	}
+}
+
+func writeMaskedFrame(w io.Writer, opcode byte, payload []byte) error {
+	frame := []byte{0x80 | opcode, 0x80 | byte(len(payload))}
+	mask := []byte{1, 2, 3, 4}
+	frame = append(frame, mask...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+	_, err := w.Write(frame)
+	return err
+}
+
+func readServerFrame(r io.Reader) (byte, []byte, error) {
+	var head [2]byte
+	if _, err := io.ReadFull(r, head[:]); err != nil {
+		return 0, nil, err
+	}
+	length := int(head[1] & 0x7f)
+	if length == 126 {
+		var ext [2]byte
+		if _, err := io.ReadFull(r, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		length = int(binary.BigEndian.Uint16(ext[:]))
+	}
+	payload := make([]byte, length)
+	_, err := io.ReadFull(r, payload)
+	return head[0] & 0x0f, payload, err
+}
+
+func TestWebsocketSubscribe(t *testing.T) {
+	rpcCfg = new(types.Rpc)
+	rpcCfg.JrpcBindAddr = "127.0.0.1:8204"
+	rpcCfg.Whitelist = []string{"127.0.0.1"}
+	rpcCfg.JrpcFuncWhitelist = []string{"*"}
+	InitCfg(rpcCfg)
+	server := NewJSONRPCServer(&qmocks.Client{}, nil)
+	server.hub = newPushHub()
+	_, err := server.Listen()
+	assert.Nil(t, err)
+	defer server.l.Close()
+
+	conn, err := net.Dial("tcp", rpcCfg.JrpcBindAddr)
+	assert.Nil(t, err)
+	defer conn.Close()
+	key := "dGhlIHNhbXBsZSBub25jZQ=="
+	req, err := http.NewRequest("GET", "http://"+rpcCfg.JrpcBindAddr+"/ws", nil)
+	assert.Nil(t, err)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Key", key)
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	assert.Nil(t, req.Write(conn))
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+	assert.Equal(t, "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=", resp.Header.Get("Sec-WebSocket-Accept"))
+
+	data, err := types.PBToJson(&types.ReqSubscribe{Header: true})
+	assert.Nil(t, err)
+	assert.Nil(t, writeMaskedFrame(conn, wsOpText, data))
+	waitSubscriber(server.hub, 1)
+	assert.Equal(t, 1, server.hub.count())
+
+	detail := newPushTestBlock()
+	server.hub.handleMsg(queue.NewMessage(0, "rpc", types.EventAddBlock, detail))
+	opcode, payload, err := readServerFrame(br)
+	assert.Nil(t, err)
+	assert.Equal(t, byte(wsOpText), opcode)
+	var event types.PushEvent
+	assert.Nil(t, types.JsonToPB(payload, &event))
+	assert.Equal(t, int32(types.TyPushBlock), event.Ty)
+	assert.Equal(t, int64(10), event.Header.Height)
+
+	//客户端关闭以后取消订阅
+	assert.Nil(t, writeMaskedFrame(conn, wsOpClose, nil))
+	opcode, _, err = readServerFrame(br)
+	assert.Nil(t, err)
+	assert.Equal(t, byte(wsOpClose), opcode)
+	waitSubscriber(server.hub, 0)
+	assert.Equal(t, 0, server.hub.count())
+}
+
+func TestGrpcSubscribe(t *testing.T) {
+	rpcCfg = new(types.Rpc)
+	rpcCfg.GrpcBindAddr = "127.0.0.1:8105"
+	rpcCfg.Whitelist = []string{"127.0.0.1"}
+	rpcCfg.GrpcFuncWhitelist = []string{"*"}
+	InitCfg(rpcCfg)
+	server := NewGRpcServer(&qmocks.Client{}, nil)
+	_, err := server.Listen()
+	assert.Nil(t, err)
+	defer server.s.Stop()
+
+	conn, err := grpc.Dial(rpcCfg.GrpcBindAddr, grpc.WithInsecure())
+	assert.Nil(t, err)
+	defer conn.Close()
+	c := types.NewChain33Client(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	//没有开启订阅功能
+	stream, err := c.Subscribe(ctx, &types.ReqSubscribe{Tx: true})
+	assert.Nil(t, err)
+	_, err = stream.Recv()
+	assert.NotNil(t, err)
+
+	server.grpc.hub = newPushHub()
+	stream, err = c.Subscribe(ctx, &types.ReqSubscribe{Tx: true, Execer: []string{"ticket"}})
+	assert.Nil(t, err)
+	waitSubscriber(server.grpc.hub, 1)
+	assert.Equal(t, 1, server.grpc.hub.count())
+	detail := newPushTestBlock()
+	server.grpc.hub.handleMsg(queue.NewMessage(0, "rpc", types.EventPushTx, detail.Block.Txs[0]))
+	server.grpc.hub.handleMsg(queue.NewMessage(0, "rpc", types.EventPushTx, detail.Block.Txs[1]))
+	event, err := stream.Recv()
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.TyPushTx), event.Ty)
+	assert.Equal(t, "ticket", string(event.Tx.Execer))
+
+	cancel()
+	waitSubscriber(server.grpc.hub, 0)
+	assert.Equal(t, 0, server.grpc.hub.count())
+}
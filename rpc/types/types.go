@@ -40,16 +40,17 @@ type BlockParam struct {
 }
 
 type Header struct {
-	Version    int64      `json:"version"`
-	ParentHash string     `json:"parentHash"`
-	TxHash     string     `json:"txHash"`
-	StateHash  string     `json:"stateHash"`
-	Height     int64      `json:"height"`
-	BlockTime  int64      `json:"blockTime"`
-	TxCount    int64      `json:"txCount"`
-	Hash       string     `json:"hash"`
-	Difficulty uint32     `json:"difficulty"`
-	Signature  *Signature `json:"signature,omitempty"`
+	Version     int64      `json:"version"`
+	ParentHash  string     `json:"parentHash"`
+	TxHash      string     `json:"txHash"`
+	StateHash   string     `json:"stateHash"`
+	Height      int64      `json:"height"`
+	BlockTime   int64      `json:"blockTime"`
+	TxCount     int64      `json:"txCount"`
+	Hash        string     `json:"hash"`
+	Difficulty  uint32     `json:"difficulty"`
+	Signature   *Signature `json:"signature,omitempty"`
+	ReceiptHash string     `json:"receiptHash,omitempty"`
 }
 
 type Signature struct {
@@ -100,13 +101,14 @@ type ReceiptLogResult struct {
 }
 
 type Block struct {
-	Version    int64          `json:"version"`
-	ParentHash string         `json:"parentHash"`
-	TxHash     string         `json:"txHash"`
-	StateHash  string         `json:"stateHash"`
-	Height     int64          `json:"height"`
-	BlockTime  int64          `json:"blockTime"`
-	Txs        []*Transaction `json:"txs"`
+	Version     int64          `json:"version"`
+	ParentHash  string         `json:"parentHash"`
+	TxHash      string         `json:"txHash"`
+	StateHash   string         `json:"stateHash"`
+	Height      int64          `json:"height"`
+	BlockTime   int64          `json:"blockTime"`
+	Txs         []*Transaction `json:"txs"`
+	ReceiptHash string         `json:"receiptHash,omitempty"`
 }
 
 type BlockDetail struct {
@@ -130,6 +132,56 @@ type TransactionDetail struct {
 	ActionName string             `json:"actionName"`
 }
 
+type ReceiptLogInfo struct {
+	Height   int64           `json:"height"`
+	Index    int64           `json:"index"`
+	LogIndex int64           `json:"logIndex"`
+	TxHash   string          `json:"txHash"`
+	Execer   string          `json:"execer"`
+	From     string          `json:"from"`
+	To       string          `json:"to"`
+	Ty       int32           `json:"ty"`
+	TyName   string          `json:"tyName"`
+	Log      json.RawMessage `json:"log"`
+	RawLog   string          `json:"rawLog"`
+}
+
+type ReplyReceiptLogs struct {
+	Logs       []*ReceiptLogInfo `json:"logs"`
+	PrimaryKey string            `json:"primaryKey"`
+}
+
+type ReceiptProof struct {
+	Receipt *ReceiptData `json:"receipt"`
+	Index   int64        `json:"index"`
+	Proofs  []string     `json:"proofs"`
+	Header  *Header      `json:"header"`
+}
+
+type ReqStateProof struct {
+	StateHash string `json:"stateHash"`
+	Key       string `json:"key"`
+}
+
+type StateProof struct {
+	StateHash string `json:"stateHash"`
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+	Proof     string `json:"proof"`
+}
+
+type ReqAccountProof struct {
+	Addr      string `json:"addr"`
+	Execer    string `json:"execer"`
+	Symbol    string `json:"symbol"`
+	StateHash string `json:"stateHash"`
+}
+
+type AccountProof struct {
+	Account *Account    `json:"account"`
+	Proof   *StateProof `json:"proof"`
+}
+
 type ReplyTxInfos struct {
 	TxInfos []*ReplyTxInfo `json:"txInfos"`
 }
@@ -174,8 +226,9 @@ type WalletAccounts struct {
 	Wallets []*WalletAccount `json:"wallets"`
 }
 type WalletAccount struct {
-	Acc   *Account `json:"acc"`
-	Label string   `json:"label"`
+	Acc       *Account `json:"acc"`
+	Label     string   `json:"label"`
+	WatchOnly bool     `json:"watchOnly,omitempty"`
 }
 
 type Account struct {
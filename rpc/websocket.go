@@ -0,0 +1,277 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+)
+
+//websocket 订阅接口, 只实现了推送需要的 RFC6455 的最小子集:
+//客户端连接 /ws 以后, 发送一个 ReqSubscribe 的 json, 服务端之后把 PushEvent 以 json 的格式推送给客户端
+
+const (
+	wsGUID          = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+	wsMaxPayload    = 1 << 20
+	wsWriteTimeout  = 10 * time.Second
+	wsOpContinue    = 0x0
+	wsOpText        = 0x1
+	wsOpBinary      = 0x2
+	wsOpClose       = 0x8
+	wsOpPing        = 0x9
+	wsOpPong        = 0xa
+	wsCloseNormal   = 1000
+	wsClosePolicy   = 1008
+	wsCloseTooLarge = 1009
+)
+
+var (
+	errWsHandshake  = errors.New("ErrWebsocketHandshake")
+	errWsTooLarge   = errors.New("ErrWebsocketFrameTooLarge")
+	errWsBadFrame   = errors.New("ErrWebsocketBadFrame")
+	errWsConnClosed = errors.New("ErrWebsocketClosed")
+)
+
+type wsConn struct {
+	conn net.Conn
+	br   *bufio.Reader
+	mu   sync.Mutex
+}
+
+func wsAcceptKey(key string) string {
+	h := sha1.New()
+	h.Write([]byte(key + wsGUID))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+func headerContains(r *http.Request, name, value string) bool {
+	for _, v := range strings.Split(r.Header.Get(name), ",") {
+		if strings.EqualFold(strings.TrimSpace(v), value) {
+			return true
+		}
+	}
+	return false
+}
+
+func isWebsocketRequest(r *http.Request) bool {
+	return headerContains(r, "Connection", "upgrade") && headerContains(r, "Upgrade", "websocket")
+}
+
+func wsUpgrade(w http.ResponseWriter, r *http.Request) (*wsConn, error) {
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if r.Method != "GET" || !isWebsocketRequest(r) || key == "" || r.Header.Get("Sec-WebSocket-Version") != "13" {
+		http.Error(w, "Bad websocket request", http.StatusBadRequest)
+		return nil, errWsHandshake
+	}
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "websocket not supported", http.StatusInternalServerError)
+		return nil, errWsHandshake
+	}
+	conn, rw, err := hj.Hijack()
+	if err != nil {
+		return nil, err
+	}
+	resp := "HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + wsAcceptKey(key) + "\r\n\r\n"
+	conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	if _, err := conn.Write([]byte(resp)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &wsConn{conn: conn, br: rw.Reader}, nil
+}
+
+//readFrame 读取一个完整的帧
+func (c *wsConn) readFrame() (fin bool, opcode byte, payload []byte, err error) {
+	var head [2]byte
+	if _, err = io.ReadFull(c.br, head[:]); err != nil {
+		return
+	}
+	fin = head[0]&0x80 != 0
+	opcode = head[0] & 0x0f
+	masked := head[1]&0x80 != 0
+	length := uint64(head[1] & 0x7f)
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err = io.ReadFull(c.br, ext[:]); err != nil {
+			return
+		}
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err = io.ReadFull(c.br, ext[:]); err != nil {
+			return
+		}
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+	if length > wsMaxPayload {
+		err = errWsTooLarge
+		return
+	}
+	//客户端发送的帧必须有掩码
+	if !masked {
+		err = errWsBadFrame
+		return
+	}
+	var mask [4]byte
+	if _, err = io.ReadFull(c.br, mask[:]); err != nil {
+		return
+	}
+	payload = make([]byte, length)
+	if _, err = io.ReadFull(c.br, payload); err != nil {
+		return
+	}
+	for i := range payload {
+		payload[i] ^= mask[i%4]
+	}
+	return
+}
+
+//readMessage 读取一个完整的数据消息, 控制帧在这里直接处理
+func (c *wsConn) readMessage() ([]byte, error) {
+	var message []byte
+	for {
+		fin, opcode, payload, err := c.readFrame()
+		if err != nil {
+			if err == errWsTooLarge {
+				c.writeClose(wsCloseTooLarge, err.Error())
+			}
+			return nil, err
+		}
+		switch opcode {
+		case wsOpPing:
+			if err := c.writeFrame(wsOpPong, payload); err != nil {
+				return nil, err
+			}
+			continue
+		case wsOpPong:
+			continue
+		case wsOpClose:
+			c.writeClose(wsCloseNormal, "")
+			return nil, errWsConnClosed
+		case wsOpText, wsOpBinary, wsOpContinue:
+			message = append(message, payload...)
+			if len(message) > wsMaxPayload {
+				c.writeClose(wsCloseTooLarge, errWsTooLarge.Error())
+				return nil, errWsTooLarge
+			}
+			if fin {
+				return message, nil
+			}
+		default:
+			return nil, errWsBadFrame
+		}
+	}
+}
+
+//服务端发送的帧不需要掩码
+func (c *wsConn) writeFrame(opcode byte, payload []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	head := make([]byte, 2, 10)
+	head[0] = 0x80 | opcode
+	length := len(payload)
+	switch {
+	case length < 126:
+		head[1] = byte(length)
+	case length <= 0xffff:
+		head[1] = 126
+		head = append(head, 0, 0)
+		binary.BigEndian.PutUint16(head[2:], uint16(length))
+	default:
+		head[1] = 127
+		head = append(head, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(head[2:], uint64(length))
+	}
+	c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	if _, err := c.conn.Write(append(head, payload...)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *wsConn) writeClose(code uint16, reason string) error {
+	payload := make([]byte, 2, 2+len(reason))
+	binary.BigEndian.PutUint16(payload, code)
+	payload = append(payload, reason...)
+	return c.writeFrame(wsOpClose, payload)
+}
+
+func (c *wsConn) Close() error {
+	return c.conn.Close()
+}
+
+//serveWebsocket 处理 /ws 的订阅请求
+func (j *JSONRPCServer) serveWebsocket(w http.ResponseWriter, r *http.Request, ip string) {
+	conn, err := wsUpgrade(w, r)
+	if err != nil {
+		log.Debug("serveWebsocket", "err", err)
+		return
+	}
+	defer conn.Close()
+	data, err := conn.readMessage()
+	if err != nil {
+		log.Debug("serveWebsocket", "err", err)
+		return
+	}
+	if !checkLightJrpcFunc("Subscribe") {
+		conn.writeClose(wsClosePolicy, "The Subscribe method is not supported in light mode!")
+		return
+	}
+	if !net.ParseIP(ip).IsLoopback() {
+		if checkJrpcFuncBlacklist("Subscribe") || !checkJrpcFuncWhitelist("Subscribe") {
+			conn.writeClose(wsClosePolicy, "The Subscribe method is not authorized!")
+			return
+		}
+	}
+	var req types.ReqSubscribe
+	err = types.JsonToPB(data, &req)
+	if err != nil {
+		conn.writeClose(wsClosePolicy, err.Error())
+		return
+	}
+	sub, err := j.hub.subscribe(&req)
+	if err != nil {
+		conn.writeClose(wsClosePolicy, err.Error())
+		return
+	}
+	defer j.hub.unsubscribe(sub)
+	//客户端断开以后取消订阅, 推送的循环随之退出
+	go func() {
+		for {
+			if _, err := conn.readMessage(); err != nil {
+				j.hub.unsubscribe(sub)
+				return
+			}
+		}
+	}()
+	for event := range sub.ch {
+		msg, err := types.PBToJson(event)
+		if err != nil {
+			log.Error("serveWebsocket", "err", err)
+			continue
+		}
+		if err := conn.writeFrame(wsOpText, msg); err != nil {
+			return
+		}
+	}
+	//订阅被关闭(订阅者处理太慢或者服务关闭)
+	conn.writeClose(wsCloseNormal, "")
+}
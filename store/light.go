@@ -0,0 +1,120 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package store
+
+//轻节点的 store 模块:
+//轻节点没有状态数据库, 查询状态数据的时候从其他节点获取数据以及默克尔证明,
+//然后用请求中的 stateHash 校验, stateHash 来自轻节点已经校验过的区块头
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/queue"
+	mavl "github.com/33cn/chain33/system/store/mavl/db"
+	"github.com/33cn/chain33/types"
+)
+
+var llog = log.New("module", "store.light")
+
+//从其他节点获取证明的超时时间
+var lightFetchTimeout = 30 * time.Second
+
+type LightStore struct {
+	client queue.Client
+	wg     sync.WaitGroup
+}
+
+func NewLight() *LightStore {
+	return &LightStore{}
+}
+
+func (store *LightStore) SetQueueClient(c queue.Client) {
+	store.client = c
+	store.client.Sub("store")
+	store.wg.Add(1)
+	go func() {
+		defer store.wg.Done()
+		for msg := range store.client.Recv() {
+			switch msg.Ty {
+			case types.EventStoreGet:
+				go store.procGet(msg)
+			case types.EventStoreGetProof:
+				go store.procGetProof(msg)
+			default:
+				llog.Debug("light store not support", "msg", types.GetEventName(int(msg.Ty)))
+				msg.Reply(store.client.NewMessage("", msg.Ty, types.ErrNotSupport))
+			}
+		}
+	}()
+}
+
+func (store *LightStore) Close() {
+	store.client.Close()
+	store.wg.Wait()
+	llog.Info("light store closed")
+}
+
+func (store *LightStore) procGet(msg queue.Message) {
+	req := msg.GetData().(*types.StoreGet)
+	values := make([][]byte, len(req.Keys))
+	for i, key := range req.Keys {
+		proof, err := store.GetProof(&types.ReqStateProof{StateHash: req.StateHash, Key: key})
+		//已经校验过不存在的证明, 和 store 的 Get 一样返回 nil
+		if err == types.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			msg.Reply(store.client.NewMessage("", types.EventStoreGetReply, err))
+			return
+		}
+		values[i] = proof.Value
+	}
+	msg.Reply(store.client.NewMessage("", types.EventStoreGetReply, &types.StoreReplyValue{Values: values}))
+}
+
+func (store *LightStore) procGetProof(msg queue.Message) {
+	proof, err := store.GetProof(msg.GetData().(*types.ReqStateProof))
+	if err != nil {
+		msg.Reply(store.client.NewMessage("", types.EventStoreGetProofReply, err))
+		return
+	}
+	msg.Reply(store.client.NewMessage("", types.EventStoreGetProofReply, proof))
+}
+
+//GetProof 通过 p2p 模块从其他节点获取证明, 校验通过以后返回
+//key 不存在的证明校验通过以后返回 ErrNotFound
+func (store *LightStore) GetProof(req *types.ReqStateProof) (*types.StateProof, error) {
+	msg := store.client.NewMessage("p2p", types.EventFetchStateProof, &types.ReqStateProof{StateHash: req.StateHash, Key: req.Key, Absence: true})
+	err := store.client.SendTimeout(msg, true, lightFetchTimeout)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := store.client.WaitTimeout(msg, lightFetchTimeout)
+	if err != nil {
+		llog.Debug("GetProof", "key", string(req.Key), "err", err)
+		return nil, err
+	}
+	proof := resp.GetData().(*types.StateProof)
+	if !bytes.Equal(proof.Key, req.Key) {
+		return nil, types.ErrStateProof
+	}
+	if proof.Left != nil || proof.Right != nil {
+		err = mavl.VerifyAbsenceProof(req.StateHash, req.Key, proof)
+		if err != nil {
+			llog.Error("GetProof verify absence", "key", string(req.Key), "err", err)
+			return nil, err
+		}
+		return nil, types.ErrNotFound
+	}
+	err = mavl.VerifyStateProof(req.StateHash, proof)
+	if err != nil {
+		llog.Error("GetProof verify", "key", string(req.Key), "err", err)
+		return nil, err
+	}
+	return proof, nil
+}
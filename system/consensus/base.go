@@ -291,8 +291,8 @@ func (bc *BaseClient) RequestLastBlock() (*types.Block, error) {
 	return block, nil
 }
 
-//del mempool
-func (bc *BaseClient) delMempoolTx(deltx []*types.Transaction) error {
+//DelMempoolTx del mempool
+func (bc *BaseClient) DelMempoolTx(deltx []*types.Transaction) error {
 	hashList := buildHashList(deltx)
 	msg := bc.client.NewMessage("mempool", types.EventDelTxList, hashList)
 	bc.client.Send(msg, true)
@@ -327,7 +327,7 @@ func (bc *BaseClient) WriteBlock(prev []byte, block *types.Block) error {
 	//从mempool 中删除错误的交易
 	deltx := diffTx(block.Txs, blockdetail.Block.Txs)
 	if len(deltx) > 0 {
-		bc.delMempoolTx(deltx)
+		bc.DelMempoolTx(deltx)
 	}
 	if blockdetail != nil {
 		bc.SetCurrentBlock(blockdetail.Block)
@@ -398,11 +398,18 @@ func (bc *BaseClient) ConsensusTicketMiner(iscaughtup *types.IsCaughtUp) {
 }
 
 func (bc *BaseClient) AddTxsToBlock(block *types.Block, txs []*types.Transaction) []*types.Transaction {
+	addedTx, _ := bc.AddTxsToBlockWithSize(block, txs, types.MaxBlockSize-100000) //留下100K空间，添加其他的交易
+	return addedTx
+}
+
+//AddTxsToBlockWithSize 添加交易直到区块大小超过 max 字节
+//单独一个交易或者交易组放到空的区块中也超过 max 字节的, 永远打包不了, 跳过并且在 oversized 中返回
+func (bc *BaseClient) AddTxsToBlockWithSize(block *types.Block, txs []*types.Transaction, max int) (addedTx, oversized []*types.Transaction) {
+	emptySize := (&types.Block{}).Size()
 	size := block.Size()
-	max := types.MaxBlockSize - 100000 //留下100K空间，添加其他的交易
 	currentcount := int64(len(block.Txs))
 	maxTx := types.GetP(block.Height).MaxTxNumber
-	addedTx := make([]*types.Transaction, 0, len(txs))
+	addedTx = make([]*types.Transaction, 0, len(txs))
 	for i := 0; i < len(txs); i++ {
 		txgroup, err := txs[i].GetTxGroup()
 		if err != nil {
@@ -410,27 +417,37 @@ func (bc *BaseClient) AddTxsToBlock(block *types.Block, txs []*types.Transaction
 		}
 		if txgroup == nil {
 			if currentcount+1 > maxTx {
-				return addedTx
+				return addedTx, oversized
+			}
+			if emptySize+txs[i].Size() > max {
+				oversized = append(oversized, txs[i])
+				continue
 			}
 			size += txs[i].Size()
 			if size > max {
-				return addedTx
+				return addedTx, oversized
 			}
 			addedTx = append(addedTx, txs[i])
 			block.Txs = append(block.Txs, txs[i])
 		} else {
 			if currentcount+int64(len(txgroup.Txs)) > maxTx {
-				return addedTx
+				return addedTx, oversized
 			}
+			groupSize := 0
 			for i := 0; i < len(txgroup.Txs); i++ {
-				size += txgroup.Txs[i].Size()
+				groupSize += txgroup.Txs[i].Size()
+			}
+			if emptySize+groupSize > max {
+				oversized = append(oversized, txgroup.Txs...)
+				continue
 			}
+			size += groupSize
 			if size > max {
-				return addedTx
+				return addedTx, oversized
 			}
 			addedTx = append(addedTx, txgroup.Txs...)
 			block.Txs = append(block.Txs, txgroup.Txs...)
 		}
 	}
-	return addedTx
+	return addedTx, oversized
 }
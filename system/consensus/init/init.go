@@ -5,5 +5,7 @@
 package init
 
 import (
+	_ "github.com/33cn/chain33/system/consensus/pbft"
+	_ "github.com/33cn/chain33/system/consensus/raft"
 	_ "github.com/33cn/chain33/system/consensus/solo"
 )
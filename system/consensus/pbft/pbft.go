@@ -0,0 +1,372 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbft
+
+import (
+	"bytes"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/consensus"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	"github.com/33cn/chain33/types"
+)
+
+var rlog = log.New("module", "pbft")
+
+//Client pbft 共识, 3f+1 个节点最多容忍 f 个拜占庭节点:
+//primary 提议区块, 其他节点用 CheckBlock 校验, 2f+1 个节点 commit 以后才写入区块链,
+//primary 作恶或者宕机的时候通过 view change 切换 primary
+type Client struct {
+	*drivers.BaseClient
+	subcfg    *subConfig
+	sleepTime time.Duration
+	listener  net.Listener
+	db        dbm.DB
+	priv      crypto.PrivKey
+	pubkeys   [][]byte
+	replica   *replica
+	trans     *transport
+	mu        sync.Mutex
+	quit      chan struct{}
+	//正在执行的 2f+1 个节点 commit 的区块, 只有这个区块可以写入区块链
+	executing *types.Block
+}
+
+func init() {
+	drivers.Reg("pbft", New)
+	drivers.QueryData.Register("pbft", &Client{})
+}
+
+type subConfig struct {
+	Genesis          string `json:"genesis"`
+	GenesisBlockTime int64  `json:"genesisBlockTime"`
+	WaitTxMs         int64  `json:"waitTxMs"`
+	//本节点在 peers 中的序号, 从 0 开始, view 对节点数取余就是这个 view 的 primary
+	ReplicaID uint32 `json:"replicaID"`
+	//本节点的签名私钥, 公钥必须和 pubKeys 中对应的一致
+	PrivKey string `json:"privKey"`
+	//所有节点的地址和公钥, 包括自己, 所有节点的配置必须一致
+	Peers               []string `json:"peers"`
+	PubKeys             []string `json:"pubKeys"`
+	TickMs              int64    `json:"tickMs"`
+	ViewChangeTimeoutMs int64    `json:"viewChangeTimeoutMs"`
+	CheckpointInterval  uint32   `json:"checkpointInterval"`
+	Driver              string   `json:"driver"`
+	DbPath              string   `json:"dbPath"`
+}
+
+func New(cfg *types.Consensus, sub []byte) queue.Module {
+	c := drivers.NewBaseClient(cfg)
+	var subcfg subConfig
+	if sub != nil {
+		types.MustDecode(sub, &subcfg)
+	}
+	if subcfg.WaitTxMs == 0 {
+		subcfg.WaitTxMs = 1000
+	}
+	if subcfg.TickMs == 0 {
+		subcfg.TickMs = 100
+	}
+	if subcfg.ViewChangeTimeoutMs == 0 {
+		subcfg.ViewChangeTimeoutMs = 10000
+	}
+	if subcfg.CheckpointInterval == 0 {
+		subcfg.CheckpointInterval = 10
+	}
+	if subcfg.Driver == "" {
+		subcfg.Driver = "leveldb"
+	}
+	if subcfg.DbPath == "" {
+		subcfg.DbPath = "datadir/pbft"
+	}
+	if len(subcfg.Peers) == 0 || len(subcfg.Peers) != len(subcfg.PubKeys) {
+		panic("pbft peers and pubKeys must have the same length")
+	}
+	if subcfg.ReplicaID >= uint32(len(subcfg.Peers)) {
+		panic("pbft replicaID must in [0, len(peers))")
+	}
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		panic(err)
+	}
+	key, err := common.FromHex(subcfg.PrivKey)
+	if err != nil {
+		panic(err)
+	}
+	priv, err := cr.PrivKeyFromBytes(key)
+	if err != nil {
+		panic(err)
+	}
+	var pubkeys [][]byte
+	for _, pub := range subcfg.PubKeys {
+		key, err := common.FromHex(pub)
+		if err != nil {
+			panic(err)
+		}
+		pubkeys = append(pubkeys, key)
+	}
+	if !bytes.Equal(priv.PubKey().Bytes(), pubkeys[subcfg.ReplicaID]) {
+		panic("pbft privKey not match pubKeys[replicaID]")
+	}
+	listener, err := net.Listen("tcp", subcfg.Peers[subcfg.ReplicaID])
+	if err != nil {
+		panic(err)
+	}
+	pbft := &Client{
+		BaseClient: c,
+		subcfg:     &subcfg,
+		sleepTime:  time.Duration(subcfg.WaitTxMs) * time.Millisecond,
+		listener:   listener,
+		db:         dbm.NewDB("pbft", subcfg.Driver, subcfg.DbPath, 0),
+		priv:       priv,
+		pubkeys:    pubkeys,
+		quit:       make(chan struct{}),
+	}
+	c.SetChild(pbft)
+	return pbft
+}
+
+//在 CreateBlock 中启动, 这时候 InitBlock 已经完成, 可以拿到当前的区块高度
+func (client *Client) startReplica() bool {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	select {
+	case <-client.quit:
+		return false
+	default:
+	}
+	cfg := &replicaConfig{
+		id:                 client.subcfg.ReplicaID,
+		n:                  len(client.subcfg.Peers),
+		tick:               time.Duration(client.subcfg.TickMs) * time.Millisecond,
+		viewChangeTimeout:  time.Duration(client.subcfg.ViewChangeTimeoutMs) * time.Millisecond,
+		checkpointInterval: client.subcfg.CheckpointInterval,
+	}
+	client.trans = newTransport(cfg.id, client.listener, client.subcfg.Peers, client.pubkeys, client.priv)
+	client.replica = newReplica(cfg, client, client.trans, newStorage(client.db))
+	client.trans.start(client.replica.step)
+	client.replica.start()
+	return true
+}
+
+func (client *Client) Close() {
+	client.mu.Lock()
+	close(client.quit)
+	client.mu.Unlock()
+	//关闭队列以后正在写入的区块马上返回, 不会阻塞 replica 的退出
+	client.BaseClient.Close()
+	if client.replica != nil {
+		client.trans.stop()
+		client.replica.stop()
+	} else {
+		client.listener.Close()
+	}
+	client.db.Close()
+	rlog.Info("consensus pbft closed")
+}
+
+func (client *Client) GetGenesisBlockTime() int64 {
+	return client.subcfg.GenesisBlockTime
+}
+
+func (client *Client) CreateGenesisTx() (ret []*types.Transaction) {
+	var tx types.Transaction
+	tx.Execer = []byte("coins")
+	tx.To = client.subcfg.Genesis
+	//gen payload
+	g := &cty.CoinsAction_Genesis{}
+	g.Genesis = &types.AssetsGenesis{}
+	g.Genesis.Amount = 1e8 * types.Coin
+	tx.Payload = types.Encode(&cty.CoinsAction{Value: g, Ty: cty.CoinsActionGenesis})
+	ret = append(ret, &tx)
+	return
+}
+
+func (client *Client) ProcEvent(msg queue.Message) bool {
+	return false
+}
+
+//CheckBlock 只有 2f+1 个节点 commit 的区块才能写入区块链
+//p2p 同步或者广播过来的区块没有 commit 的证明, 都会被拒绝, 落后的节点在高水位以内通过 pbft 的消息追上其他节点,
+//超过高水位以后从其他节点下载 2f+1 个节点 checkpoint 过的区块, 校验以后和 commit 的区块一样执行
+//执行区块的时候可能删除错误的交易, 所以只比较区块头的信息, 交易必须都在 commit 的区块里
+func (client *Client) CheckBlock(parent *types.Block, current *types.BlockDetail) error {
+	client.mu.Lock()
+	executing := client.executing
+	client.mu.Unlock()
+	if executing == nil || !isCommittedBlock(executing, current.Block) {
+		return errNotCommitted
+	}
+	return verifyBlock(parent, current.Block)
+}
+
+func isCommittedBlock(committed, block *types.Block) bool {
+	if committed.Height != block.Height || committed.BlockTime != block.BlockTime ||
+		!bytes.Equal(committed.ParentHash, block.ParentHash) {
+		return false
+	}
+	txs := make(map[string]bool)
+	for _, tx := range committed.Txs {
+		txs[string(tx.Hash())] = true
+	}
+	for _, tx := range block.Txs {
+		if !txs[string(tx.Hash())] {
+			return false
+		}
+	}
+	return true
+}
+
+func (client *Client) setExecuting(block *types.Block) {
+	client.mu.Lock()
+	client.executing = block
+	client.mu.Unlock()
+}
+
+//verifyBlock 校验 primary 提议的区块
+func verifyBlock(parent *types.Block, block *types.Block) error {
+	if block.Height != parent.Height+1 {
+		return types.ErrBlockHeight
+	}
+	if !bytes.Equal(block.ParentHash, parent.Hash()) {
+		return types.ErrParentHash
+	}
+	if block.BlockTime < parent.BlockTime || block.BlockTime > types.Now().Unix()+types.GetP(block.Height).FutureBlockTime {
+		return types.ErrBlockTime
+	}
+	if int64(len(block.Txs)) > types.GetP(block.Height).MaxTxNumber {
+		return types.ErrManyTx
+	}
+	if !bytes.Equal(block.TxHash, merkle.CalcMerkleRoot(block.Txs)) {
+		return types.ErrCheckTxHash
+	}
+	for _, tx := range block.Txs {
+		if !tx.CheckSign() {
+			return types.ErrSign
+		}
+	}
+	return nil
+}
+
+func (client *Client) sleep(d time.Duration) bool {
+	select {
+	case <-client.quit:
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+func (client *Client) CreateBlock() {
+	if !client.startReplica() {
+		return
+	}
+	tick := time.Duration(client.subcfg.TickMs) * time.Millisecond
+	issleep := true
+	for {
+		//上一个区块执行以后 primary 才提议下一个区块
+		st := client.replica.status()
+		lastBlock := client.GetCurrentBlock()
+		if !client.IsMining() || !st.primary || st.inViewChange || st.outstanding || lastBlock.Height != int64(st.executed) {
+			if !client.sleep(tick) {
+				return
+			}
+			continue
+		}
+		if issleep && !client.sleep(client.sleepTime) {
+			return
+		}
+		txs := client.RequestTx(int(types.GetP(lastBlock.Height+1).MaxTxNumber), nil)
+		if len(txs) == 0 {
+			issleep = true
+			continue
+		}
+		issleep = false
+		//check dup
+		txs = client.CheckTxDup(txs)
+		var newblock types.Block
+		newblock.ParentHash = lastBlock.Hash()
+		newblock.Height = lastBlock.Height + 1
+		client.AddTxsToBlock(&newblock, txs)
+		newblock.Difficulty = types.GetP(0).PowLimitBits
+		newblock.TxHash = merkle.CalcMerkleRoot(newblock.Txs)
+		newblock.BlockTime = types.Now().Unix()
+		if lastBlock.BlockTime >= newblock.BlockTime {
+			newblock.BlockTime = lastBlock.BlockTime + 1
+		}
+		err := client.replica.propose(&newblock)
+		if err != nil {
+			rlog.Debug("propose", "height", newblock.Height, "err", err)
+			issleep = true
+		}
+	}
+}
+
+//下面是 pbft 的 application 接口, 序号就是区块的高度
+
+func (client *Client) lastHeight() int64 {
+	return client.GetCurrentHeight()
+}
+
+func (client *Client) checkBlock(block *types.Block) error {
+	return verifyBlock(client.GetCurrentBlock(), block)
+}
+
+func (client *Client) execBlock(block *types.Block) ([]byte, error) {
+	lastBlock := client.GetCurrentBlock()
+	//上一次执行返回错误, 但是区块已经写入了
+	if block.Height <= lastBlock.Height {
+		return client.blockHash(block.Height)
+	}
+	if block.Height != lastBlock.Height+1 {
+		return nil, types.ErrBlockHeight
+	}
+	//执行的时候会修改区块, 这个区块可能还在广播
+	var newblock types.Block
+	err := types.Decode(types.Encode(block), &newblock)
+	if err != nil {
+		return nil, err
+	}
+	client.setExecuting(block)
+	defer client.setExecuting(nil)
+	err = client.WriteBlock(lastBlock.StateHash, &newblock)
+	if err != nil {
+		return nil, err
+	}
+	return client.blockHash(block.Height)
+}
+
+func (client *Client) blockHash(height int64) ([]byte, error) {
+	block, err := client.RequestBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	return block.Hash(), nil
+}
+
+func (client *Client) getBlock(height int64) (*types.Block, error) {
+	return client.RequestBlock(height)
+}
+
+func (client *Client) pending() bool {
+	msg := client.GetQueueClient().NewMessage("mempool", types.EventGetMempoolSize, nil)
+	err := client.GetQueueClient().Send(msg, true)
+	if err != nil {
+		return false
+	}
+	resp, err := client.GetQueueClient().Wait(msg)
+	if err != nil {
+		return false
+	}
+	return resp.GetData().(*types.MempoolSize).GetSize() > 0
+}
@@ -0,0 +1,562 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbft
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	//加载系统内置store, 不要依赖plugin
+	_ "github.com/33cn/chain33/system/dapp/init"
+	_ "github.com/33cn/chain33/system/store/init"
+)
+
+//memChain 用内存中的区块链代替真正的区块链
+type memChain struct {
+	mu     sync.Mutex
+	blocks []*types.Block
+	hasTxs int32
+}
+
+func newMemChain() *memChain {
+	return &memChain{blocks: []*types.Block{{Height: 0, BlockTime: 1}}}
+}
+
+func (chain *memChain) lastBlock() *types.Block {
+	chain.mu.Lock()
+	defer chain.mu.Unlock()
+	return chain.blocks[len(chain.blocks)-1]
+}
+
+func (chain *memChain) lastHeight() int64 {
+	return chain.lastBlock().Height
+}
+
+func (chain *memChain) checkBlock(block *types.Block) error {
+	parent := chain.lastBlock()
+	if block.Height != parent.Height+1 {
+		return types.ErrBlockHeight
+	}
+	if string(block.ParentHash) != string(parent.Hash()) {
+		return types.ErrParentHash
+	}
+	return nil
+}
+
+func (chain *memChain) execBlock(block *types.Block) ([]byte, error) {
+	err := chain.checkBlock(block)
+	if err != nil {
+		return nil, err
+	}
+	chain.mu.Lock()
+	defer chain.mu.Unlock()
+	chain.blocks = append(chain.blocks, block)
+	return block.Hash(), nil
+}
+
+func (chain *memChain) blockHash(height int64) ([]byte, error) {
+	chain.mu.Lock()
+	defer chain.mu.Unlock()
+	if height >= int64(len(chain.blocks)) {
+		return nil, types.ErrNotFound
+	}
+	return chain.blocks[height].Hash(), nil
+}
+
+func (chain *memChain) getBlock(height int64) (*types.Block, error) {
+	chain.mu.Lock()
+	defer chain.mu.Unlock()
+	if height >= int64(len(chain.blocks)) {
+		return nil, types.ErrNotFound
+	}
+	return chain.blocks[height], nil
+}
+
+func (chain *memChain) pending() bool {
+	return atomic.LoadInt32(&chain.hasTxs) == 1
+}
+
+func (chain *memChain) hashes() []string {
+	chain.mu.Lock()
+	defer chain.mu.Unlock()
+	var hashes []string
+	for _, block := range chain.blocks {
+		hashes = append(hashes, common.ToHex(block.Hash()))
+	}
+	return hashes
+}
+
+func newBlock(parent *types.Block, data string) *types.Block {
+	return &types.Block{
+		Height:     parent.Height + 1,
+		ParentHash: parent.Hash(),
+		TxHash:     []byte(data),
+		BlockTime:  parent.BlockTime + 1,
+	}
+}
+
+type testReplica struct {
+	replica *replica
+	trans   *transport
+	chain   *memChain
+	cfg     *replicaConfig
+	store   *storage
+	pubs    [][]byte
+}
+
+func (tr *testReplica) stop() {
+	tr.trans.stop()
+	tr.replica.stop()
+}
+
+func genKeys(t *testing.T, n int) ([]crypto.PrivKey, [][]byte) {
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	require.NoError(t, err)
+	var privs []crypto.PrivKey
+	var pubs [][]byte
+	for i := 0; i < n; i++ {
+		priv, err := cr.GenKey()
+		require.NoError(t, err)
+		privs = append(privs, priv)
+		pubs = append(pubs, priv.PubKey().Bytes())
+	}
+	return privs, pubs
+}
+
+func newTestNetwork(t *testing.T, n int) []*testReplica {
+	privs, pubs := genKeys(t, n)
+	var listeners []net.Listener
+	var addrs []string
+	for i := 0; i < n; i++ {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		listeners = append(listeners, l)
+		addrs = append(addrs, l.Addr().String())
+	}
+	var replicas []*testReplica
+	for i := 0; i < n; i++ {
+		cfg := &replicaConfig{id: uint32(i), n: n, tick: 10 * time.Millisecond,
+			viewChangeTimeout: 2 * time.Second, checkpointInterval: 5}
+		tr := &testReplica{chain: newMemChain(), cfg: cfg, store: newStorage(dbm.NewDB("pbft", "memdb", "", 0)), pubs: pubs}
+		tr.run(listeners[i], addrs, privs[i])
+		replicas = append(replicas, tr)
+	}
+	return replicas
+}
+
+func (tr *testReplica) run(listener net.Listener, addrs []string, priv crypto.PrivKey) {
+	tr.trans = newTransport(tr.cfg.id, listener, addrs, tr.pubs, priv)
+	tr.replica = newReplica(tr.cfg, tr.chain, tr.trans, tr.store)
+	tr.trans.start(tr.replica.step)
+	tr.replica.start()
+}
+
+//用同样的地址, 区块链和 pbft 的状态重启节点
+func (tr *testReplica) restart(t *testing.T) {
+	addrs := tr.trans.addrs
+	listener, err := net.Listen("tcp", addrs[tr.cfg.id])
+	require.NoError(t, err)
+	tr.run(listener, addrs, tr.trans.priv)
+}
+
+func setPending(replicas []*testReplica, pending bool) {
+	for _, tr := range replicas {
+		if pending {
+			atomic.StoreInt32(&tr.chain.hasTxs, 1)
+		} else {
+			atomic.StoreInt32(&tr.chain.hasTxs, 0)
+		}
+	}
+}
+
+func waitPrimary(t *testing.T, replicas []*testReplica) *testReplica {
+	for i := 0; i < 1000; i++ {
+		for _, tr := range replicas {
+			st := tr.replica.status()
+			if st.primary && !st.inViewChange {
+				return tr
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("no primary")
+	return nil
+}
+
+func waitExecuted(t *testing.T, replicas []*testReplica, height int64) {
+	for i := 0; i < 1000; i++ {
+		done := true
+		for _, tr := range replicas {
+			if tr.chain.lastHeight() < height {
+				done = false
+			}
+		}
+		if done {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("wait executed timeout", height)
+}
+
+//由正常节点中的 primary 提议下一个区块, 等待所有正常节点执行
+func proposeNext(t *testing.T, replicas []*testReplica) {
+	height := replicas[0].chain.lastHeight() + 1
+	for i := 0; i < 100; i++ {
+		primary := waitPrimary(t, replicas)
+		parent := primary.chain.lastBlock()
+		if parent.Height+1 == height {
+			err := primary.replica.propose(newBlock(parent, fmt.Sprint("block", height)))
+			if err == nil {
+				waitExecuted(t, replicas, height)
+				return
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("propose failed", height)
+}
+
+func assertSameChain(t *testing.T, replicas []*testReplica) {
+	for _, tr := range replicas {
+		assert.Equal(t, replicas[0].chain.hashes(), tr.chain.hashes())
+	}
+}
+
+func TestVerifyMsg(t *testing.T) {
+	privs, pubs := genKeys(t, 2)
+	req := &types.Request{Value: &types.Request_Prepare{Prepare: &types.RequestPrepare{View: 1, Sequence: 1, Replica: 1}}}
+	assert.True(t, verifyMsg(signMsg(1, req, privs[1]), pubs))
+	//用自己的私钥冒充其他节点
+	assert.False(t, verifyMsg(signMsg(1, req, privs[0]), pubs))
+	forged := signMsg(0, req, privs[0])
+	forged.Replica = 1
+	assert.False(t, verifyMsg(forged, pubs))
+	//篡改签名以后的消息
+	m := signMsg(1, req, privs[1])
+	m.Request = &types.Request{Value: &types.Request_Prepare{Prepare: &types.RequestPrepare{View: 2, Sequence: 1, Replica: 1}}}
+	assert.False(t, verifyMsg(m, pubs))
+	assert.False(t, verifyMsg(signMsg(2, req, privs[1]), pubs))
+}
+
+func TestComputeSummaries(t *testing.T) {
+	d1, d2 := []byte("d1"), []byte("d2")
+	entry := func(seq uint32, digest []byte, view uint32) []*types.Entry {
+		return []*types.Entry{{Sequence: seq, Digest: digest, View: view}}
+	}
+	//序号 6 在 view 0 prepared
+	vcs := []*types.RequestViewChange{
+		{View: 1, Sequence: 5, Preps: entry(6, d1, 0), Prepreps: entry(6, d1, 0), Replica: 1},
+		{View: 1, Sequence: 5, Prepreps: entry(6, d1, 0), Replica: 2},
+		{View: 1, Sequence: 5, Replica: 3},
+	}
+	summaries, ok := computeSummaries(vcs, 1)
+	assert.True(t, ok)
+	assert.Equal(t, []*types.Summary{{Sequence: 6, Digest: d1}}, summaries)
+
+	//拜占庭节点谎报更大的 view 上 prepared 了另外一个区块, 证据不够需要等待更多的 view change
+	vcs[2] = &types.RequestViewChange{View: 1, Sequence: 5, Preps: entry(6, d2, 1), Prepreps: entry(6, d2, 1), Replica: 3}
+	_, ok = computeSummaries(vcs, 1)
+	assert.False(t, ok)
+	vcs = append(vcs, &types.RequestViewChange{View: 1, Sequence: 5, Preps: entry(6, d1, 0), Prepreps: entry(6, d1, 0), Replica: 0})
+	summaries, ok = computeSummaries(vcs, 1)
+	assert.True(t, ok)
+	assert.Equal(t, []*types.Summary{{Sequence: 6, Digest: d1}}, summaries)
+
+	//拜占庭节点谎报 stable checkpoint 不影响低水位
+	vcs = []*types.RequestViewChange{
+		{View: 1, Sequence: 100, Replica: 1},
+		{View: 1, Sequence: 5, Preps: entry(6, d1, 0), Prepreps: entry(6, d1, 0), Replica: 2},
+		{View: 1, Sequence: 5, Preps: entry(6, d1, 0), Prepreps: entry(6, d1, 0), Replica: 3},
+	}
+	summaries, ok = computeSummaries(vcs, 1)
+	assert.True(t, ok)
+	assert.Equal(t, []*types.Summary{{Sequence: 6, Digest: d1}}, summaries)
+	_, ok = computeSummaries(vcs[:2], 1)
+	assert.False(t, ok)
+}
+
+//4 个节点, 节点 0 是第一个 view 的 primary, 并且是拜占庭节点
+func TestPbftFaultyPrimary(t *testing.T) {
+	replicas := newTestNetwork(t, 4)
+	defer func() {
+		for _, tr := range replicas {
+			tr.stop()
+		}
+	}()
+	faulty, honest := replicas[0], replicas[1:]
+	//提议一个接不上链的区块, 正常节点 CheckBlock 失败不会 prepare
+	bad := newBlock(&types.Block{Height: 0, BlockTime: 100}, "bad")
+	require.NoError(t, faulty.replica.propose(bad))
+	setPending(replicas, true)
+	//超时以后切换到 view 1, 由节点 1 出块
+	primary := waitPrimary(t, honest)
+	assert.Equal(t, uint32(1), primary.replica.status().id)
+	for _, tr := range replicas {
+		assert.Equal(t, int64(0), tr.chain.lastHeight())
+	}
+	for i := 0; i < 12; i++ {
+		proposeNext(t, honest)
+	}
+	assertSameChain(t, honest)
+	for _, tr := range honest {
+		st := tr.replica.status()
+		assert.True(t, st.view >= 1)
+		assert.Equal(t, uint32(10), st.stable)
+	}
+	//拜占庭节点冒充节点 1 发送的消息被丢弃
+	forged := signMsg(1, &types.Request{Value: &types.Request_Viewchange{Viewchange: &types.RequestViewChange{View: 100, Replica: 1}}}, faulty.trans.priv)
+	conn, err := net.Dial("tcp", honest[1].trans.addrs[honest[1].trans.id])
+	require.NoError(t, err)
+	require.NoError(t, writeMsg(conn, forged))
+	conn.Close()
+	proposeNext(t, honest)
+	assert.True(t, honest[1].replica.status().view < 100)
+}
+
+func TestPbftPrimaryCrash(t *testing.T) {
+	replicas := newTestNetwork(t, 4)
+	for i := 0; i < 3; i++ {
+		proposeNext(t, replicas)
+	}
+	assertSameChain(t, replicas)
+	//primary 宕机, 剩下的 3 个节点切换 view 以后继续出块
+	replicas[0].stop()
+	rest := replicas[1:]
+	defer func() {
+		for _, tr := range rest {
+			tr.stop()
+		}
+	}()
+	setPending(rest, true)
+	for i := 0; i < 3; i++ {
+		proposeNext(t, rest)
+	}
+	assertSameChain(t, rest)
+	assert.Equal(t, int64(6), rest[0].chain.lastHeight())
+	for _, tr := range rest {
+		assert.Equal(t, uint32(5), tr.replica.status().stable)
+	}
+}
+
+func TestPbftChain(t *testing.T) {
+	privs, pubs := genKeys(t, 1)
+	cfg, sub := testnode.GetDefaultConfig()
+	cfg.Consensus.Name = "pbft"
+	sub.Consensus["pbft"] = []byte(fmt.Sprintf(`{"genesis":"14KEKbYtKKQm4wMthSK9J4La4nAiidGozt","genesisBlockTime":1514533394,
+		"waitTxMs":10,"replicaID":0,"privKey":"%s","peers":["127.0.0.1:0"],"pubKeys":["%s"],"tickMs":10,"driver":"memdb"}`,
+		common.ToHex(privs[0].Bytes()), common.ToHex(pubs[0])))
+	mock33 := testnode.NewWithConfig(cfg, sub, nil)
+	defer mock33.Close()
+	txs := util.GenNoneTxs(mock33.GetGenesisKey(), 10)
+	for i := 0; i < len(txs); i++ {
+		mock33.GetAPI().SendTx(txs[i])
+	}
+	mock33.WaitHeight(1)
+	txs = util.GenNoneTxs(mock33.GetGenesisKey(), 10)
+	for i := 0; i < len(txs); i++ {
+		mock33.GetAPI().SendTx(txs[i])
+	}
+	mock33.WaitHeight(2)
+}
+
+//memSender 记录 replica 广播的消息
+type memSender struct {
+	mu   sync.Mutex
+	reqs []*types.Request
+}
+
+func (m *memSender) broadcast(req *types.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reqs = append(m.reqs, req)
+}
+
+func (m *memSender) send(to uint32, req *types.Request) {
+	m.broadcast(req)
+}
+
+func (m *memSender) count(f func(req *types.Request) bool) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := 0
+	for _, req := range m.reqs {
+		if f(req) {
+			n++
+		}
+	}
+	return n
+}
+
+func TestPbftRestore(t *testing.T) {
+	store := newStorage(dbm.NewDB("pbft", "memdb", "", 0))
+	cfg := &replicaConfig{id: 1, n: 4, tick: 10 * time.Millisecond, viewChangeTimeout: time.Minute, checkpointInterval: 5}
+	chain := newMemChain()
+	sender := &memSender{}
+	r := newReplica(cfg, chain, sender, store)
+	block := newBlock(chain.lastBlock(), "block1")
+	digest := blockDigest(block)
+	r.step(0, &types.Request{Value: &types.Request_Client{Client: r.newRequestClient(block)}})
+	r.step(0, &types.Request{Value: &types.Request_Preprepare{Preprepare: &types.RequestPrePrepare{View: 0, Sequence: 1, Digest: digest, Replica: 0}}})
+	r.step(2, &types.Request{Value: &types.Request_Prepare{Prepare: &types.RequestPrepare{View: 0, Sequence: 1, Digest: digest, Replica: 2}}})
+	isCommit := func(req *types.Request) bool { return req.GetCommit() != nil }
+	assert.Equal(t, 1, sender.count(isCommit))
+	r.mu.Lock()
+	r.startViewChange(1)
+	r.mu.Unlock()
+
+	//重启以后恢复 view 和 prepared 的区块
+	sender = &memSender{}
+	r = newReplica(cfg, chain, sender, store)
+	st := r.status()
+	assert.Equal(t, uint32(1), st.view)
+	assert.True(t, st.inViewChange)
+	s := r.logs[slotKey{0, 1}]
+	require.NotNil(t, s)
+	assert.Equal(t, digest, s.digest)
+	assert.True(t, s.checked)
+	assert.True(t, s.prepared)
+	//view 0 的 primary 对同一个序号提议另外一个区块也不会再 prepare
+	other := newBlock(chain.lastBlock(), "block2")
+	r.mu.Lock()
+	r.view, r.inViewChange = 0, false
+	r.mu.Unlock()
+	r.step(0, &types.Request{Value: &types.Request_Client{Client: r.newRequestClient(other)}})
+	r.step(0, &types.Request{Value: &types.Request_Preprepare{Preprepare: &types.RequestPrePrepare{View: 0, Sequence: 1, Digest: blockDigest(other), Replica: 0}}})
+	assert.Equal(t, 0, sender.count(func(req *types.Request) bool { return req.GetPrepare() != nil }))
+	//view change 中带上 prepared 的区块
+	r.mu.Lock()
+	r.startViewChange(2)
+	r.mu.Unlock()
+	assert.Equal(t, 1, sender.count(func(req *types.Request) bool {
+		vc := req.GetViewchange()
+		return vc != nil && len(vc.Preps) == 1 && string(vc.Preps[0].Digest) == string(digest)
+	}))
+	assert.Equal(t, 1, sender.count(func(req *types.Request) bool {
+		return req.GetClient() != nil && string(blockDigest(req.GetClient().GetOp().GetValue())) == string(digest)
+	}))
+
+	//stable checkpoint 之前的状态都删除
+	r.mu.Lock()
+	r.stableCheckpoint(5, []byte("digest"))
+	r.mu.Unlock()
+	assert.Nil(t, store.preprepared(1))
+	assert.Nil(t, store.prepared(1))
+	assert.Equal(t, 0, len(store.blocks(1)))
+	assert.Equal(t, uint32(5), store.stable())
+}
+
+func TestPbftCheckBlock(t *testing.T) {
+	parent := &types.Block{Height: 0, BlockTime: 1}
+	tx1 := &types.Transaction{Execer: []byte("none"), Nonce: 1}
+	committed := &types.Block{Height: 1, ParentHash: parent.Hash(), BlockTime: 2, TxHash: merkle.CalcMerkleRoot(nil)}
+	client := &Client{}
+	//p2p 同步过来的区块没有 commit, 不能写入
+	block := *committed
+	assert.Equal(t, errNotCommitted, client.CheckBlock(parent, &types.BlockDetail{Block: &block}))
+	client.setExecuting(committed)
+	assert.Nil(t, client.CheckBlock(parent, &types.BlockDetail{Block: &block}))
+	block.Txs = []*types.Transaction{tx1}
+	assert.Equal(t, errNotCommitted, client.CheckBlock(parent, &types.BlockDetail{Block: &block}))
+	block = *committed
+	block.BlockTime++
+	assert.Equal(t, errNotCommitted, client.CheckBlock(parent, &types.BlockDetail{Block: &block}))
+	client.setExecuting(nil)
+	assert.Equal(t, errNotCommitted, client.CheckBlock(parent, &types.BlockDetail{Block: committed}))
+}
+
+//节点宕机超过几个 checkpoint 间隔, 重启以后从其他节点下载 stable checkpoint 之前的区块追上
+func TestPbftStateTransfer(t *testing.T) {
+	replicas := newTestNetwork(t, 4)
+	defer func() {
+		for _, tr := range replicas {
+			tr.stop()
+		}
+	}()
+	for i := 0; i < 2; i++ {
+		proposeNext(t, replicas)
+	}
+	lagging, rest := replicas[3], replicas[:3]
+	lagging.stop()
+	for i := 0; i < 12; i++ {
+		proposeNext(t, rest)
+	}
+	assert.Equal(t, int64(2), lagging.chain.lastHeight())
+	lagging.restart(t)
+	//高水位以外的区块只能等下一个 stable checkpoint 以后下载
+	for i := 0; i < 6; i++ {
+		proposeNext(t, rest)
+	}
+	waitExecuted(t, replicas, 20)
+	assertSameChain(t, replicas)
+	for i := 0; i < 100 && lagging.replica.status().stable < 20; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, uint32(20), lagging.replica.status().stable)
+	//追上以后参与共识, 另外一个节点宕机也能继续出块
+	rest[2].stop()
+	replicas = []*testReplica{rest[0], rest[1], lagging}
+	proposeNext(t, replicas)
+	assertSameChain(t, replicas)
+}
+
+func TestPbftTransferVerify(t *testing.T) {
+	//正常节点的区块链
+	full := newMemChain()
+	for i := 1; i <= 5; i++ {
+		_, err := full.execBlock(newBlock(full.lastBlock(), fmt.Sprint("block", i)))
+		require.NoError(t, err)
+	}
+	digest, err := full.blockHash(5)
+	require.NoError(t, err)
+	cfg := &replicaConfig{id: 1, n: 4, tick: 10 * time.Millisecond, viewChangeTimeout: time.Minute, checkpointInterval: 5}
+	chain := newMemChain()
+	sender := &memSender{}
+	r := newReplica(cfg, chain, sender, newStorage(dbm.NewDB("pbft", "memdb", "", 0)))
+	r.start()
+	defer r.stop()
+	isFetch := func(req *types.Request) bool { return req.GetFetch() != nil }
+	for _, id := range []uint32{0, 2, 3} {
+		r.step(id, &types.Request{Value: &types.Request_Checkpoint{Checkpoint: &types.RequestCheckpoint{Sequence: 5, Digest: digest, Replica: id}}})
+	}
+	assert.Equal(t, 1, sender.count(isFetch))
+	//拜占庭节点发送的区块接不上 checkpoint 的 digest, 换一个节点重新下载
+	forged := newMemChain()
+	var blocks []*types.Block
+	for i := 1; i <= 5; i++ {
+		block := newBlock(forged.lastBlock(), fmt.Sprint("forged", i))
+		_, err := forged.execBlock(block)
+		require.NoError(t, err)
+		blocks = append(blocks, block)
+	}
+	r.step(0, &types.Request{Value: &types.Request_Blocks{Blocks: &types.RequestBlocks{Blocks: blocks, Replica: 0}}})
+	assert.Equal(t, 2, sender.count(isFetch))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int64(0), chain.lastHeight())
+
+	blocks = full.blocks[1:]
+	r.step(2, &types.Request{Value: &types.Request_Blocks{Blocks: &types.RequestBlocks{Blocks: blocks, Replica: 2}}})
+	for i := 0; i < 100 && chain.lastHeight() < 5; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, full.hashes(), chain.hashes())
+	st := r.status()
+	assert.Equal(t, uint32(5), st.executed)
+	assert.Equal(t, uint32(5), st.stable)
+}
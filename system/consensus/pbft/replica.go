@@ -0,0 +1,1033 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbft
+
+//pbft 的核心状态机, 序号就是区块的高度:
+//primary 提议区块(client + preprepare), 其他节点用 CheckBlock 校验以后 prepare,
+//2f 个 prepare 以后 commit, 2f+1 个 commit 以后按顺序执行(写入区块链)。
+//每 checkpointInterval 个区块做一次 checkpoint, 2f+1 个相同的 checkpoint 以后成为 stable checkpoint,
+//之前的日志全部删除。primary 没有在超时时间内出块的时候切换到下一个 view。
+//落后的节点在 stable checkpoint 超过自己执行的高度以后, 从发送相同 checkpoint 的节点下载区块,
+//下载的区块必须和 checkpoint 的 digest 组成一条 hash 链, 并且接上本地的区块链, 然后按顺序执行。
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	errNotPrimary     = errors.New("ErrNotPrimary")
+	errViewChanging   = errors.New("ErrViewChanging")
+	errProposeHeight  = errors.New("ErrProposeHeight")
+	errOutOfWatermark = errors.New("ErrOutOfWatermark")
+	errNotCommitted   = errors.New("ErrBlockNotCommitted")
+)
+
+//application 是 pbft 的状态机, 也就是区块链
+type application interface {
+	//当前的区块高度
+	lastHeight() int64
+	//校验提议的区块, 区块的父区块必须是当前最新的区块
+	checkBlock(block *types.Block) error
+	//执行并写入区块, 返回执行以后的区块 hash, 用作 checkpoint 的 digest
+	execBlock(block *types.Block) ([]byte, error)
+	//已经写入的区块的 hash
+	blockHash(height int64) ([]byte, error)
+	//已经写入的区块, 发送给落后的节点
+	getBlock(height int64) (*types.Block, error)
+	//有没有等待打包的交易, 有交易但是 primary 不出块的时候切换 view
+	pending() bool
+}
+
+type sender interface {
+	broadcast(req *types.Request)
+	send(to uint32, req *types.Request)
+}
+
+type replicaConfig struct {
+	id   uint32
+	n    int
+	tick time.Duration
+	//primary 不出块多长时间以后切换 view, 每次切换失败加倍
+	viewChangeTimeout  time.Duration
+	checkpointInterval uint32
+}
+
+type slotKey struct {
+	view     uint32
+	sequence uint32
+}
+
+//一个 view 中一个序号的共识状态
+type slot struct {
+	digest      []byte
+	preprepared bool
+	//preprepare 已经通过 CheckBlock 校验并且发送了 prepare
+	checked   bool
+	prepares  map[uint32][]byte
+	commits   map[uint32]bool
+	prepared  bool
+	committed bool
+}
+
+func newSlot() *slot {
+	return &slot{prepares: make(map[uint32][]byte), commits: make(map[uint32]bool)}
+}
+
+//状态同步, 下载 (executed, sequence] 之间的区块
+type transfer struct {
+	sequence uint32
+	digest   []byte
+	//发送了相同 checkpoint 的节点, 至少有 f+1 个正常节点
+	peers []uint32
+	next  int
+	//上次发送下载请求的时间和请求的最大序号
+	sent     time.Time
+	sentEnd  uint32
+	blocks   map[uint32]*types.Block
+	verified bool
+}
+
+type replica struct {
+	mu    sync.Mutex
+	cfg   *replicaConfig
+	f     int
+	app   application
+	net   sender
+	store *storage
+
+	view         uint32
+	inViewChange bool
+	executed     uint32
+	//stable checkpoint, 低水位
+	stable       uint32
+	stableDigest []byte
+
+	requests    map[string]*types.Block
+	logs        map[slotKey]*slot
+	checkpoints map[uint32]map[uint32][]byte
+	viewChanges map[uint32]map[uint32]*types.RequestViewChange
+	newViews    map[uint32]*types.RequestNewView
+	//已经发送 new view 的 view
+	sentNewView map[uint32]bool
+
+	//上次有进展的时间, 以及当前的切换超时时间
+	lastProgress time.Time
+	timeout      time.Duration
+
+	transfer *transfer
+
+	execc chan struct{}
+	quit  chan struct{}
+	wg    sync.WaitGroup
+}
+
+func newReplica(cfg *replicaConfig, app application, net sender, store *storage) *replica {
+	r := &replica{
+		cfg:          cfg,
+		f:            (cfg.n - 1) / 3,
+		app:          app,
+		net:          net,
+		store:        store,
+		requests:     make(map[string]*types.Block),
+		logs:         make(map[slotKey]*slot),
+		checkpoints:  make(map[uint32]map[uint32][]byte),
+		viewChanges:  make(map[uint32]map[uint32]*types.RequestViewChange),
+		newViews:     make(map[uint32]*types.RequestNewView),
+		sentNewView:  make(map[uint32]bool),
+		lastProgress: time.Now(),
+		timeout:      cfg.viewChangeTimeout,
+		execc:        make(chan struct{}, 1),
+		quit:         make(chan struct{}),
+	}
+	r.executed = uint32(app.lastHeight())
+	r.view, r.inViewChange = store.view()
+	r.restore()
+	r.stable = r.executed
+	r.stableDigest, _ = app.blockHash(int64(r.executed))
+	return r
+}
+
+//重启以后恢复没有执行的序号上发送过 prepare 和 commit 的区块, 已经执行的序号的状态直接删除
+func (r *replica) restore() {
+	stable := r.store.stable()
+	last := stable + 2*r.cfg.checkpointInterval
+	for sequence := r.executed + 1; sequence <= last; sequence++ {
+		entry := r.store.preprepared(sequence)
+		if entry == nil {
+			continue
+		}
+		for _, block := range r.store.blocks(sequence) {
+			r.requests[string(blockDigest(block))] = block
+		}
+		s := r.getSlot(entry.View, sequence)
+		s.digest = entry.Digest
+		s.preprepared = true
+		s.checked = true
+		entry = r.store.prepared(sequence)
+		if entry == nil {
+			continue
+		}
+		s = r.getSlot(entry.View, sequence)
+		s.digest = entry.Digest
+		s.preprepared = true
+		s.checked = true
+		s.prepared = true
+		s.commits[r.cfg.id] = true
+	}
+	if last > r.executed {
+		last = r.executed
+	}
+	err := r.store.setStable(r.executed, stable, last)
+	if err != nil {
+		rlog.Error("restore setStable", "err", err)
+	}
+}
+
+func (r *replica) start() {
+	r.wg.Add(2)
+	go r.tickLoop()
+	go r.execLoop()
+}
+
+func (r *replica) stop() {
+	close(r.quit)
+	r.wg.Wait()
+}
+
+func (r *replica) primary(view uint32) uint32 {
+	return view % uint32(r.cfg.n)
+}
+
+func (r *replica) isPrimary() bool {
+	return r.primary(r.view) == r.cfg.id
+}
+
+func (r *replica) quorum() int {
+	return 2*r.f + 1
+}
+
+//低水位和高水位之间的序号才接受
+func (r *replica) inWatermark(sequence uint32) bool {
+	return sequence > r.stable && sequence <= r.stable+2*r.cfg.checkpointInterval
+}
+
+func (r *replica) getSlot(view, sequence uint32) *slot {
+	key := slotKey{view, sequence}
+	s, ok := r.logs[key]
+	if !ok {
+		s = newSlot()
+		r.logs[key] = s
+	}
+	return s
+}
+
+func blockDigest(block *types.Block) []byte {
+	return common.Sha256(types.Encode(block))
+}
+
+func (r *replica) tickLoop() {
+	defer r.wg.Done()
+	ticker := time.NewTicker(r.cfg.tick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			pending := r.app.pending()
+			r.mu.Lock()
+			r.checkTimeout(pending)
+			r.checkTransfer()
+			r.mu.Unlock()
+			r.notifyExec()
+		case <-r.quit:
+			return
+		}
+	}
+}
+
+func (r *replica) progress() {
+	r.lastProgress = time.Now()
+	r.timeout = r.cfg.viewChangeTimeout
+}
+
+//有等待执行的区块
+func (r *replica) outstanding() bool {
+	for key, s := range r.logs {
+		if key.view == r.view && key.sequence > r.executed && s.preprepared {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *replica) checkTimeout(pending bool) {
+	if r.inViewChange {
+		//新的 primary 没有在超时时间内完成切换, 切换到下一个 view
+		if time.Since(r.lastProgress) > r.timeout {
+			r.timeout *= 2
+			r.startViewChange(r.view + 1)
+		}
+		return
+	}
+	if !pending && !r.outstanding() {
+		r.lastProgress = time.Now()
+		return
+	}
+	if time.Since(r.lastProgress) > r.timeout {
+		rlog.Info("primary timeout", "id", r.cfg.id, "view", r.view, "executed", r.executed)
+		r.startViewChange(r.view + 1)
+	}
+}
+
+func (r *replica) notifyExec() {
+	select {
+	case r.execc <- struct{}{}:
+	default:
+	}
+}
+
+//执行区块需要执行交易, 不在锁里面做
+func (r *replica) execLoop() {
+	defer r.wg.Done()
+	for {
+		select {
+		case <-r.execc:
+		case <-r.quit:
+			return
+		}
+		for {
+			r.mu.Lock()
+			block := r.nextCommitted()
+			r.mu.Unlock()
+			if block == nil {
+				break
+			}
+			hash, err := r.app.execBlock(block)
+			if err != nil {
+				rlog.Error("execBlock", "height", block.Height, "err", err)
+				break
+			}
+			r.mu.Lock()
+			r.executedTo(uint32(block.Height), hash)
+			r.mu.Unlock()
+		}
+	}
+}
+
+func (r *replica) nextCommitted() *types.Block {
+	next := r.executed + 1
+	for key, s := range r.logs {
+		if key.sequence == next && s.committed {
+			return r.requests[string(s.digest)]
+		}
+	}
+	//状态同步下载并且校验过的区块
+	if r.transfer != nil && r.transfer.verified {
+		return r.transfer.blocks[next]
+	}
+	return nil
+}
+
+func (r *replica) executedTo(sequence uint32, hash []byte) {
+	if sequence != r.executed+1 {
+		return
+	}
+	r.executed = sequence
+	r.progress()
+	if t := r.transfer; t != nil {
+		if sequence == t.sequence && !bytes.Equal(hash, t.digest) {
+			rlog.Error("state transfer digest not match", "id", r.cfg.id, "sequence", sequence)
+		}
+		if sequence >= t.sequence {
+			rlog.Info("state transfer done", "id", r.cfg.id, "sequence", sequence)
+			r.transfer = nil
+		}
+	}
+	if sequence%r.cfg.checkpointInterval == 0 {
+		cp := &types.RequestCheckpoint{Sequence: sequence, Digest: hash, Replica: r.cfg.id}
+		r.net.broadcast(&types.Request{Value: &types.Request_Checkpoint{Checkpoint: cp}})
+		r.handleCheckpoint(cp)
+	}
+	//下一个区块的 preprepare 可能已经收到了
+	r.tryPrepare()
+}
+
+//propose 由 primary 提议下一个区块
+func (r *replica) propose(block *types.Block) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.isPrimary() {
+		return errNotPrimary
+	}
+	if r.inViewChange {
+		return errViewChanging
+	}
+	sequence := uint32(block.Height)
+	if block.Height != int64(r.executed)+1 || r.outstanding() {
+		return errProposeHeight
+	}
+	if !r.inWatermark(sequence) {
+		return errOutOfWatermark
+	}
+	digest := blockDigest(block)
+	err := r.store.setPreprepared(&types.Entry{Sequence: sequence, Digest: digest, View: r.view}, block)
+	if err != nil {
+		return err
+	}
+	r.requests[string(digest)] = block
+	r.net.broadcast(&types.Request{Value: &types.Request_Client{Client: r.newRequestClient(block)}})
+	pp := &types.RequestPrePrepare{View: r.view, Sequence: sequence, Digest: digest, Replica: r.cfg.id}
+	r.net.broadcast(&types.Request{Value: &types.Request_Preprepare{Preprepare: pp}})
+	s := r.getSlot(r.view, sequence)
+	s.digest = digest
+	s.preprepared = true
+	s.checked = true
+	r.checkPrepared(r.view, sequence)
+	return nil
+}
+
+func (r *replica) newRequestClient(block *types.Block) *types.RequestClient {
+	return &types.RequestClient{
+		Op:        &types.Operation{Value: block},
+		Timestamp: fmt.Sprint(types.Now().UnixNano()),
+		Client:    fmt.Sprint(r.cfg.id),
+	}
+}
+
+//step 处理其他节点的消息, from 是已经验证过签名的发送节点
+func (r *replica) step(from uint32, req *types.Request) {
+	select {
+	case <-r.quit:
+		return
+	default:
+	}
+	//读取区块不需要锁
+	if fetch := req.GetFetch(); fetch != nil {
+		if fetch.Replica == from {
+			r.handleFetch(fetch)
+		}
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	switch v := req.Value.(type) {
+	case *types.Request_Client:
+		r.handleClient(v.Client)
+	case *types.Request_Preprepare:
+		if v.Preprepare.Replica == from {
+			r.handlePrePrepare(v.Preprepare)
+		}
+	case *types.Request_Prepare:
+		if v.Prepare.Replica == from {
+			r.handlePrepare(v.Prepare)
+		}
+	case *types.Request_Commit:
+		if v.Commit.Replica == from {
+			r.handleCommit(v.Commit)
+		}
+	case *types.Request_Checkpoint:
+		if v.Checkpoint.Replica == from {
+			r.handleCheckpoint(v.Checkpoint)
+		}
+	case *types.Request_Viewchange:
+		if v.Viewchange.Replica == from {
+			r.handleViewChange(v.Viewchange)
+		}
+	case *types.Request_Newview:
+		if v.Newview.Replica == from {
+			r.handleNewView(v.Newview)
+		}
+	case *types.Request_Blocks:
+		if v.Blocks.Replica == from {
+			r.handleBlocks(from, v.Blocks)
+		}
+	default:
+		rlog.Debug("step not support", "from", from, "req", req)
+	}
+}
+
+func (r *replica) handleClient(req *types.RequestClient) {
+	block := req.GetOp().GetValue()
+	if block == nil || block.Height <= int64(r.stable) {
+		return
+	}
+	r.requests[string(blockDigest(block))] = block
+	r.tryPrepare()
+}
+
+func (r *replica) handlePrePrepare(pp *types.RequestPrePrepare) {
+	//new view 引用的 view change 可能还没有收齐, 先保存新 view 的 preprepare, 进入 view 以后再处理
+	if pp.View < r.view || pp.Replica != r.primary(pp.View) || !r.inWatermark(pp.Sequence) {
+		return
+	}
+	s := r.getSlot(pp.View, pp.Sequence)
+	if s.preprepared {
+		//primary 对同一个序号提议了不同的区块
+		if !bytes.Equal(s.digest, pp.Digest) {
+			rlog.Error("primary preprepare conflict", "view", pp.View, "sequence", pp.Sequence)
+		}
+		return
+	}
+	s.digest = pp.Digest
+	s.preprepared = true
+	r.tryPrepare()
+}
+
+//区块只能在父区块执行以后校验, 所以只处理下一个要执行的序号
+func (r *replica) tryPrepare() {
+	if r.inViewChange {
+		return
+	}
+	sequence := r.executed + 1
+	s, ok := r.logs[slotKey{r.view, sequence}]
+	if !ok || !s.preprepared || s.checked {
+		return
+	}
+	block, ok := r.requests[string(s.digest)]
+	if !ok {
+		return
+	}
+	s.checked = true
+	if int64(sequence) != block.Height {
+		rlog.Error("preprepare block height not match", "sequence", sequence, "height", block.Height)
+		return
+	}
+	err := r.app.checkBlock(block)
+	if err != nil {
+		rlog.Error("preprepare checkBlock", "view", r.view, "sequence", sequence, "err", err)
+		return
+	}
+	r.sendPrepare(sequence, s)
+}
+
+func (r *replica) sendPrepare(sequence uint32, s *slot) {
+	s.checked = true
+	err := r.store.setPreprepared(&types.Entry{Sequence: sequence, Digest: s.digest, View: r.view}, r.requests[string(s.digest)])
+	if err != nil {
+		rlog.Error("sendPrepare setPreprepared", "view", r.view, "sequence", sequence, "err", err)
+		return
+	}
+	if r.isPrimary() {
+		r.checkPrepared(r.view, sequence)
+		return
+	}
+	p := &types.RequestPrepare{View: r.view, Sequence: sequence, Digest: s.digest, Replica: r.cfg.id}
+	r.net.broadcast(&types.Request{Value: &types.Request_Prepare{Prepare: p}})
+	r.handlePrepare(p)
+}
+
+func (r *replica) handlePrepare(p *types.RequestPrepare) {
+	if p.View < r.view || p.Replica == r.primary(p.View) || !r.inWatermark(p.Sequence) {
+		return
+	}
+	s := r.getSlot(p.View, p.Sequence)
+	s.prepares[p.Replica] = p.Digest
+	r.checkPrepared(p.View, p.Sequence)
+}
+
+//preprepare 加上 2f 个相同的 prepare
+func (r *replica) checkPrepared(view, sequence uint32) {
+	s := r.getSlot(view, sequence)
+	if s.prepared || !s.checked || view != r.view || r.inViewChange {
+		return
+	}
+	count := 0
+	for _, digest := range s.prepares {
+		if bytes.Equal(digest, s.digest) {
+			count++
+		}
+	}
+	if count < 2*r.f {
+		return
+	}
+	err := r.store.setPrepared(&types.Entry{Sequence: sequence, Digest: s.digest, View: view})
+	if err != nil {
+		rlog.Error("checkPrepared setPrepared", "view", view, "sequence", sequence, "err", err)
+		return
+	}
+	s.prepared = true
+	c := &types.RequestCommit{View: view, Sequence: sequence, Replica: r.cfg.id}
+	r.net.broadcast(&types.Request{Value: &types.Request_Commit{Commit: c}})
+	r.handleCommit(c)
+}
+
+func (r *replica) handleCommit(c *types.RequestCommit) {
+	if c.View < r.view || !r.inWatermark(c.Sequence) {
+		return
+	}
+	s := r.getSlot(c.View, c.Sequence)
+	s.commits[c.Replica] = true
+	if s.prepared && !s.committed && len(s.commits) >= r.quorum() {
+		s.committed = true
+		r.notifyExec()
+	}
+}
+
+func (r *replica) handleCheckpoint(cp *types.RequestCheckpoint) {
+	if cp.Sequence <= r.stable {
+		return
+	}
+	if r.checkpoints[cp.Sequence] == nil {
+		r.checkpoints[cp.Sequence] = make(map[uint32][]byte)
+	}
+	r.checkpoints[cp.Sequence][cp.Replica] = cp.Digest
+	count := 0
+	for _, digest := range r.checkpoints[cp.Sequence] {
+		if bytes.Equal(digest, cp.Digest) {
+			count++
+		}
+	}
+	if count < r.quorum() {
+		return
+	}
+	var peers []uint32
+	for id, digest := range r.checkpoints[cp.Sequence] {
+		if id != r.cfg.id && bytes.Equal(digest, cp.Digest) {
+			peers = append(peers, id)
+		}
+	}
+	sort.Slice(peers, func(i, j int) bool { return peers[i] < peers[j] })
+	r.stableCheckpoint(cp.Sequence, cp.Digest)
+	//stable checkpoint 之前的日志已经删除, 落后的节点只能下载区块
+	if r.executed < cp.Sequence {
+		r.startTransfer(cp.Sequence, cp.Digest, peers)
+	}
+}
+
+//stable checkpoint 之前的日志都可以删除
+func (r *replica) stableCheckpoint(sequence uint32, digest []byte) {
+	rlog.Debug("stable checkpoint", "id", r.cfg.id, "sequence", sequence)
+	last := r.stable + 2*r.cfg.checkpointInterval
+	if last > sequence {
+		last = sequence
+	}
+	err := r.store.setStable(sequence, r.stable, last)
+	if err != nil {
+		rlog.Error("stableCheckpoint setStable", "sequence", sequence, "err", err)
+	}
+	r.stable = sequence
+	r.stableDigest = digest
+	//还没有执行的日志保留, 已经 commit 的区块不用再下载, 下一个 stable checkpoint 的时候删除
+	gc := sequence
+	if r.executed < gc {
+		gc = r.executed
+	}
+	for key, s := range r.logs {
+		if key.sequence <= gc {
+			delete(r.requests, string(s.digest))
+			delete(r.logs, key)
+		}
+	}
+	for digest, block := range r.requests {
+		if block.Height <= int64(gc) {
+			delete(r.requests, digest)
+		}
+	}
+	for seq := range r.checkpoints {
+		if seq <= sequence {
+			delete(r.checkpoints, seq)
+		}
+	}
+}
+
+func (r *replica) startTransfer(sequence uint32, digest []byte, peers []uint32) {
+	if len(peers) == 0 {
+		return
+	}
+	if r.transfer != nil && r.transfer.sequence >= sequence {
+		return
+	}
+	rlog.Info("start state transfer", "id", r.cfg.id, "executed", r.executed, "sequence", sequence)
+	blocks := make(map[uint32]*types.Block)
+	//目标更新以后, 已经下载的区块还可以用
+	if r.transfer != nil {
+		blocks = r.transfer.blocks
+	}
+	r.transfer = &transfer{sequence: sequence, digest: digest, peers: peers, blocks: blocks}
+	r.sendFetch()
+}
+
+//从第一个没有下载的区块开始, 每次最多请求 2 个 checkpoint 间隔的区块
+func (r *replica) sendFetch() {
+	t := r.transfer
+	start := r.executed + 1
+	for t.blocks[start] != nil && start < t.sequence {
+		start++
+	}
+	end := start + 2*r.cfg.checkpointInterval - 1
+	if end > t.sequence {
+		end = t.sequence
+	}
+	to := t.peers[t.next%len(t.peers)]
+	t.sent = time.Now()
+	t.sentEnd = end
+	r.net.send(to, &types.Request{Value: &types.Request_Fetch{Fetch: &types.RequestFetch{Start: start, End: end, Replica: r.cfg.id}}})
+}
+
+//下载超时, 或者下载的区块校验失败, 换一个节点
+func (r *replica) checkTransfer() {
+	t := r.transfer
+	if t == nil || t.verified || time.Since(t.sent) < r.cfg.viewChangeTimeout {
+		return
+	}
+	t.next++
+	r.sendFetch()
+}
+
+//handleFetch 把已经写入的区块发送给落后的节点, 每条消息不超过一个区块的大小限制
+func (r *replica) handleFetch(fetch *types.RequestFetch) {
+	if fetch.End < fetch.Start || fetch.End-fetch.Start >= 2*r.cfg.checkpointInterval {
+		return
+	}
+	msg := &types.RequestBlocks{Replica: r.cfg.id}
+	size := 0
+	for sequence := fetch.Start; sequence <= fetch.End; sequence++ {
+		block, err := r.app.getBlock(int64(sequence))
+		if err != nil {
+			break
+		}
+		blockSize := types.Size(block)
+		if len(msg.Blocks) > 0 && size+blockSize > types.MaxBlockSize {
+			r.net.send(fetch.Replica, &types.Request{Value: &types.Request_Blocks{Blocks: msg}})
+			msg = &types.RequestBlocks{Replica: r.cfg.id}
+			size = 0
+		}
+		msg.Blocks = append(msg.Blocks, block)
+		size += blockSize
+	}
+	if len(msg.Blocks) > 0 {
+		r.net.send(fetch.Replica, &types.Request{Value: &types.Request_Blocks{Blocks: msg}})
+	}
+}
+
+func (r *replica) handleBlocks(from uint32, msg *types.RequestBlocks) {
+	t := r.transfer
+	if t == nil || t.verified {
+		return
+	}
+	for _, block := range msg.Blocks {
+		if block.Height > int64(r.executed) && block.Height <= int64(t.sequence) {
+			t.blocks[uint32(block.Height)] = block
+		}
+	}
+	for sequence := r.executed + 1; sequence <= t.sentEnd; sequence++ {
+		if t.blocks[sequence] == nil {
+			return
+		}
+	}
+	if t.sentEnd < t.sequence {
+		r.sendFetch()
+		return
+	}
+	if !r.verifyTransfer() {
+		rlog.Error("state transfer with wrong blocks", "id", r.cfg.id, "from", from, "sequence", t.sequence)
+		t.blocks = make(map[uint32]*types.Block)
+		t.next++
+		r.sendFetch()
+		return
+	}
+	t.verified = true
+	r.notifyExec()
+}
+
+//从 checkpoint 的 digest 开始往前校验 hash 链, 最后一个区块必须接上本地最新的区块
+func (r *replica) verifyTransfer() bool {
+	t := r.transfer
+	hash := t.digest
+	for sequence := t.sequence; sequence > r.executed; sequence-- {
+		block := t.blocks[sequence]
+		if block == nil || block.Height != int64(sequence) || !bytes.Equal(block.Hash(), hash) {
+			return false
+		}
+		hash = block.ParentHash
+	}
+	local, err := r.app.blockHash(int64(r.executed))
+	return err == nil && bytes.Equal(local, hash)
+}
+
+func (r *replica) startViewChange(view uint32) {
+	rlog.Info("startViewChange", "id", r.cfg.id, "view", view)
+	err := r.store.setView(view, true)
+	if err != nil {
+		rlog.Error("startViewChange setView", "view", view, "err", err)
+		return
+	}
+	r.view = view
+	r.inViewChange = true
+	r.lastProgress = time.Now()
+	vc := &types.RequestViewChange{
+		View:        view,
+		Sequence:    r.stable,
+		Checkpoints: []*types.Checkpoint{{Sequence: r.stable, Digest: r.stableDigest}},
+		Replica:     r.cfg.id,
+	}
+	//每个序号只取 view 最大的
+	preps := make(map[uint32]*types.Entry)
+	prepreps := make(map[uint32]*types.Entry)
+	for key, s := range r.logs {
+		if key.sequence <= r.stable {
+			continue
+		}
+		entry := &types.Entry{Sequence: key.sequence, Digest: s.digest, View: key.view}
+		if s.prepared && (preps[key.sequence] == nil || preps[key.sequence].View < key.view) {
+			preps[key.sequence] = entry
+		}
+		if s.preprepared && (prepreps[key.sequence] == nil || prepreps[key.sequence].View < key.view) {
+			prepreps[key.sequence] = entry
+		}
+	}
+	vc.Preps = sortEntries(preps)
+	vc.Prepreps = sortEntries(prepreps)
+	//新的 primary 可能没有收到过 prepared 的区块
+	for _, entry := range vc.Preps {
+		if block, ok := r.requests[string(entry.Digest)]; ok {
+			r.net.broadcast(&types.Request{Value: &types.Request_Client{Client: r.newRequestClient(block)}})
+		}
+	}
+	r.net.broadcast(&types.Request{Value: &types.Request_Viewchange{Viewchange: vc}})
+	r.handleViewChange(vc)
+}
+
+func sortEntries(entries map[uint32]*types.Entry) []*types.Entry {
+	var list []*types.Entry
+	for _, entry := range entries {
+		list = append(list, entry)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Sequence < list[j].Sequence })
+	return list
+}
+
+func viewChangeDigest(vc *types.RequestViewChange) []byte {
+	return common.Sha256(types.Encode(vc))
+}
+
+func (r *replica) handleViewChange(vc *types.RequestViewChange) {
+	if vc.View < r.view || (vc.View == r.view && !r.inViewChange) {
+		return
+	}
+	if r.viewChanges[vc.View] == nil {
+		r.viewChanges[vc.View] = make(map[uint32]*types.RequestViewChange)
+	}
+	r.viewChanges[vc.View][vc.Replica] = vc
+	//f+1 个节点要求切换到更大的 view, 说明至少有一个正常节点超时了, 跟着切换到其中最小的 view
+	if vc.View > r.view {
+		maxView := make(map[uint32]uint32)
+		for view, vcs := range r.viewChanges {
+			if view <= r.view {
+				continue
+			}
+			for id := range vcs {
+				if view > maxView[id] {
+					maxView[id] = view
+				}
+			}
+		}
+		if len(maxView) > r.f {
+			var views []uint32
+			for _, view := range maxView {
+				views = append(views, view)
+			}
+			sort.Slice(views, func(i, j int) bool { return views[i] > views[j] })
+			r.startViewChange(views[r.f])
+			return
+		}
+	}
+	if vc.View != r.view {
+		return
+	}
+	if r.primary(r.view) == r.cfg.id && !r.sentNewView[r.view] {
+		r.sendNewView()
+		return
+	}
+	if nv, ok := r.newViews[r.view]; ok {
+		r.handleNewView(nv)
+	}
+}
+
+func findEntry(entries []*types.Entry, sequence uint32) *types.Entry {
+	for _, entry := range entries {
+		if entry.Sequence == sequence {
+			return entry
+		}
+	}
+	return nil
+}
+
+//从 view change 中计算新的 view 需要重新提议的区块, 证据不够的时候返回 false, 需要等待更多的 view change
+//低水位取第 f+1 大的 stable checkpoint, 至少有一个正常节点的 stable checkpoint 不低于它。
+//之后的每个序号, 一个 prepared 的区块被选中需要满足:
+//1. 至少 2f+1 个 view change 在这个序号上没有 prepared, 或者 prepared 的 view 更小, 或者是同一个区块
+//2. 至少 f+1 个 view change 在更大或者相同的 view 上 preprepare 了这个区块
+//序号是区块的高度, 必须连续, 一个序号上没有 prepared 的区块以后就结束
+func computeSummaries(vcs []*types.RequestViewChange, f int) ([]*types.Summary, bool) {
+	quorum := 2*f + 1
+	if len(vcs) < quorum {
+		return nil, false
+	}
+	var stables []uint32
+	for _, vc := range vcs {
+		stables = append(stables, vc.Sequence)
+	}
+	sort.Slice(stables, func(i, j int) bool { return stables[i] > stables[j] })
+	var summaries []*types.Summary
+	for seq := stables[f] + 1; ; seq++ {
+		var candidates []*types.Entry
+		for _, vc := range vcs {
+			if entry := findEntry(vc.Preps, seq); entry != nil {
+				candidates = append(candidates, entry)
+			}
+		}
+		if len(candidates) == 0 {
+			return summaries, true
+		}
+		var chosen *types.Entry
+		for _, e := range candidates {
+			consistent, preprepared := 0, 0
+			for _, vc := range vcs {
+				entry := findEntry(vc.Preps, seq)
+				if entry == nil || entry.View < e.View || (entry.View == e.View && bytes.Equal(entry.Digest, e.Digest)) {
+					consistent++
+				}
+				entry = findEntry(vc.Prepreps, seq)
+				if entry != nil && entry.View >= e.View && bytes.Equal(entry.Digest, e.Digest) {
+					preprepared++
+				}
+			}
+			if consistent >= quorum && preprepared > f && (chosen == nil || e.View > chosen.View) {
+				chosen = e
+			}
+		}
+		if chosen == nil {
+			return nil, false
+		}
+		summaries = append(summaries, &types.Summary{Sequence: seq, Digest: chosen.Digest})
+	}
+}
+
+func (r *replica) sendNewView() {
+	var replicas []uint32
+	for id := range r.viewChanges[r.view] {
+		replicas = append(replicas, id)
+	}
+	sort.Slice(replicas, func(i, j int) bool { return replicas[i] < replicas[j] })
+	nv := &types.RequestNewView{View: r.view, Replica: r.cfg.id}
+	var vcs []*types.RequestViewChange
+	for _, id := range replicas {
+		vc := r.viewChanges[r.view][id]
+		vcs = append(vcs, vc)
+		nv.Viewchanges = append(nv.Viewchanges, &types.ViewChange{Viewchanger: id, Digest: viewChangeDigest(vc)})
+	}
+	summaries, ok := computeSummaries(vcs, r.f)
+	if !ok {
+		return
+	}
+	nv.Summaries = summaries
+	if !r.enterView(nv) {
+		return
+	}
+	r.sentNewView[r.view] = true
+	rlog.Info("sendNewView", "id", r.cfg.id, "view", r.view, "summaries", len(nv.Summaries))
+	r.net.broadcast(&types.Request{Value: &types.Request_Newview{Newview: nv}})
+}
+
+func (r *replica) handleNewView(nv *types.RequestNewView) {
+	if nv.View < r.view || (nv.View == r.view && !r.inViewChange) || nv.Replica != r.primary(nv.View) {
+		return
+	}
+	r.newViews[nv.View] = nv
+	if len(nv.Viewchanges) < r.quorum() {
+		return
+	}
+	//new view 中引用的 view change 都收到以后才能校验
+	var vcs []*types.RequestViewChange
+	seen := make(map[uint32]bool)
+	for _, ref := range nv.Viewchanges {
+		vc := r.viewChanges[nv.View][ref.Viewchanger]
+		if vc == nil || seen[ref.Viewchanger] {
+			return
+		}
+		if !bytes.Equal(viewChangeDigest(vc), ref.Digest) {
+			rlog.Error("new view with wrong view change digest", "view", nv.View, "viewchanger", ref.Viewchanger)
+			return
+		}
+		seen[ref.Viewchanger] = true
+		vcs = append(vcs, vc)
+	}
+	summaries, ok := computeSummaries(vcs, r.f)
+	if !ok || len(summaries) != len(nv.Summaries) {
+		rlog.Error("new view with wrong summaries", "view", nv.View)
+		return
+	}
+	for i := range summaries {
+		if summaries[i].Sequence != nv.Summaries[i].Sequence || !bytes.Equal(summaries[i].Digest, nv.Summaries[i].Digest) {
+			rlog.Error("new view with wrong summaries", "view", nv.View)
+			return
+		}
+	}
+	r.enterView(nv)
+}
+
+//进入新的 view, summaries 中的区块当作新 primary 的 preprepare 重新共识
+func (r *replica) enterView(nv *types.RequestNewView) bool {
+	rlog.Info("enterView", "id", r.cfg.id, "view", nv.View)
+	err := r.store.setView(nv.View, false)
+	if err != nil {
+		rlog.Error("enterView setView", "view", nv.View, "err", err)
+		return false
+	}
+	r.view = nv.View
+	r.inViewChange = false
+	r.lastProgress = time.Now()
+	delete(r.newViews, nv.View)
+	for view := range r.viewChanges {
+		if view <= nv.View {
+			delete(r.viewChanges, view)
+		}
+	}
+	for _, summary := range nv.Summaries {
+		if summary.Sequence <= r.stable {
+			continue
+		}
+		s := r.getSlot(nv.View, summary.Sequence)
+		s.digest = summary.Digest
+		s.preprepared = true
+		//已经执行过的区块不用再校验, 但是要参与共识, 否则落后的节点凑不够 prepare
+		if summary.Sequence <= r.executed {
+			r.sendPrepare(summary.Sequence, s)
+		}
+	}
+	r.tryPrepare()
+	//进入 view 之前收到的 prepare
+	for key := range r.logs {
+		if key.view == r.view {
+			r.checkPrepared(key.view, key.sequence)
+		}
+	}
+	return true
+}
+
+type status struct {
+	id           uint32
+	view         uint32
+	inViewChange bool
+	executed     uint32
+	stable       uint32
+	primary      bool
+	outstanding  bool
+}
+
+func (r *replica) status() *status {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &status{id: r.cfg.id, view: r.view, inViewChange: r.inViewChange, executed: r.executed,
+		stable: r.stable, primary: r.isPrimary(), outstanding: r.outstanding()}
+}
@@ -0,0 +1,145 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbft
+
+import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	viewKey         = []byte("pbft-view")
+	viewChangingKey = []byte("pbft-view-changing")
+	stableKey       = []byte("pbft-stable")
+)
+
+//每个序号上发送过 prepare 的 view 最大的区块
+func prepreparedKey(sequence uint32) []byte {
+	return []byte(fmt.Sprintf("pbft-preprepared-%010d", sequence))
+}
+
+//每个序号上 prepared 的 view 最大的区块
+func preparedKey(sequence uint32) []byte {
+	return []byte(fmt.Sprintf("pbft-prepared-%010d", sequence))
+}
+
+func blockPrefix(sequence uint32) []byte {
+	return []byte(fmt.Sprintf("pbft-block-%010d-", sequence))
+}
+
+func blockKey(sequence uint32, digest []byte) []byte {
+	return append(blockPrefix(sequence), []byte(fmt.Sprintf("%x", digest))...)
+}
+
+//storage 保存 replica 重启以后需要恢复的状态:
+//当前的 view, 以及 stable checkpoint 之后发送过 prepare 和 commit 的区块,
+//否则重启以后可能在同一个 view 的同一个序号上 prepare 另外一个区块, 或者在 view change 中漏掉已经 prepared 的区块
+type storage struct {
+	db dbm.DB
+}
+
+func newStorage(db dbm.DB) *storage {
+	return &storage{db: db}
+}
+
+func (s *storage) getInt64(key []byte) int64 {
+	value, err := s.db.Get(key)
+	if err != nil || len(value) == 0 {
+		return 0
+	}
+	var data types.Int64
+	if types.Decode(value, &data) != nil {
+		return 0
+	}
+	return data.Data
+}
+
+func (s *storage) getEntry(key []byte) *types.Entry {
+	value, err := s.db.Get(key)
+	if err != nil || len(value) == 0 {
+		return nil
+	}
+	var entry types.Entry
+	if types.Decode(value, &entry) != nil {
+		return nil
+	}
+	return &entry
+}
+
+func (s *storage) view() (view uint32, inViewChange bool) {
+	return uint32(s.getInt64(viewKey)), s.getInt64(viewChangingKey) != 0
+}
+
+//发送 view change 和 new view 之前必须先写入
+func (s *storage) setView(view uint32, inViewChange bool) error {
+	var changing int64
+	if inViewChange {
+		changing = 1
+	}
+	batch := s.db.NewBatch(true)
+	batch.Set(viewKey, types.Encode(&types.Int64{Data: int64(view)}))
+	batch.Set(viewChangingKey, types.Encode(&types.Int64{Data: changing}))
+	return batch.Write()
+}
+
+func (s *storage) stable() uint32 {
+	return uint32(s.getInt64(stableKey))
+}
+
+func (s *storage) preprepared(sequence uint32) *types.Entry {
+	return s.getEntry(prepreparedKey(sequence))
+}
+
+func (s *storage) prepared(sequence uint32) *types.Entry {
+	return s.getEntry(preparedKey(sequence))
+}
+
+//发送 preprepare 或者 prepare 之前必须先写入, 已经执行过的区块 block 是 nil
+func (s *storage) setPreprepared(entry *types.Entry, block *types.Block) error {
+	batch := s.db.NewBatch(true)
+	batch.Set(prepreparedKey(entry.Sequence), types.Encode(entry))
+	if block != nil {
+		batch.Set(blockKey(entry.Sequence, entry.Digest), types.Encode(block))
+	}
+	return batch.Write()
+}
+
+//发送 commit 之前必须先写入
+func (s *storage) setPrepared(entry *types.Entry) error {
+	return s.db.SetSync(preparedKey(entry.Sequence), types.Encode(entry))
+}
+
+func (s *storage) blocks(sequence uint32) []*types.Block {
+	var blocks []*types.Block
+	values := dbm.NewListHelper(s.db).PrefixScan(blockPrefix(sequence))
+	for _, value := range values {
+		//memdb 的 batch 删除以后留下空的 value
+		if len(value) == 0 {
+			continue
+		}
+		var block types.Block
+		if types.Decode(value, &block) == nil {
+			blocks = append(blocks, &block)
+		}
+	}
+	return blocks
+}
+
+//setStable 记录新的 stable checkpoint, 并且删除 (from, to] 之间的状态
+//上一个 stable checkpoint 之后只有高水位以内的序号有状态, 所以 to 不用超过上一个高水位
+func (s *storage) setStable(stable, from, to uint32) error {
+	batch := s.db.NewBatch(true)
+	for sequence := from + 1; sequence <= to; sequence++ {
+		batch.Delete(prepreparedKey(sequence))
+		batch.Delete(preparedKey(sequence))
+		for _, block := range s.blocks(sequence) {
+			batch.Delete(blockKey(sequence, blockDigest(block)))
+		}
+	}
+	batch.Set(stableKey, types.Encode(&types.Int64{Data: int64(stable)}))
+	return batch.Write()
+}
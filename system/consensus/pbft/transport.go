@@ -0,0 +1,262 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbft
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	//每个节点的发送队列大小, 队列满的时候丢弃消息, 由 view change 保证活性
+	peerSendBuffer = 1024
+	dialTimeout    = time.Second
+	writeTimeout   = 5 * time.Second
+	maxMsgSize     = 2 * types.MaxBlockSize
+)
+
+func writeMsg(w io.Writer, m *types.PbftMessage) error {
+	data := types.Encode(m)
+	buf := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	copy(buf[4:], data)
+	_, err := w.Write(buf)
+	return err
+}
+
+func readMsg(r io.Reader) (*types.PbftMessage, error) {
+	var head [4]byte
+	_, err := io.ReadFull(r, head[:])
+	if err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(head[:])
+	if size > maxMsgSize {
+		return nil, types.ErrSize
+	}
+	data := make([]byte, size)
+	_, err = io.ReadFull(r, data)
+	if err != nil {
+		return nil, err
+	}
+	var m types.PbftMessage
+	err = types.Decode(data, &m)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func signMsg(replica uint32, req *types.Request, priv crypto.PrivKey) *types.PbftMessage {
+	return &types.PbftMessage{
+		Replica: replica,
+		Request: req,
+		Signature: &types.Signature{
+			Ty:        types.SECP256K1,
+			Pubkey:    priv.PubKey().Bytes(),
+			Signature: priv.Sign(types.Encode(req)).Bytes(),
+		},
+	}
+}
+
+//消息必须是 replica 对应的公钥签名的, 防止拜占庭节点冒充其他节点
+func verifyMsg(m *types.PbftMessage, pubkeys [][]byte) bool {
+	if m.Request == nil || m.Signature == nil || int(m.Replica) >= len(pubkeys) {
+		return false
+	}
+	if !bytes.Equal(m.Signature.Pubkey, pubkeys[m.Replica]) {
+		return false
+	}
+	return types.CheckSign(types.Encode(m.Request), "", m.Signature)
+}
+
+//transport 节点之间用 tcp 长连接广播签名的 pbft 消息
+type transport struct {
+	id       uint32
+	addrs    []string
+	pubkeys  [][]byte
+	priv     crypto.PrivKey
+	listener net.Listener
+	peers    []*peer
+	step     func(from uint32, req *types.Request)
+
+	mu    sync.Mutex
+	conns map[net.Conn]bool
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+type peer struct {
+	id    uint32
+	addr  string
+	sendc chan *types.PbftMessage
+}
+
+func newTransport(id uint32, listener net.Listener, addrs []string, pubkeys [][]byte, priv crypto.PrivKey) *transport {
+	t := &transport{
+		id:       id,
+		addrs:    addrs,
+		pubkeys:  pubkeys,
+		priv:     priv,
+		listener: listener,
+		conns:    make(map[net.Conn]bool),
+		quit:     make(chan struct{}),
+	}
+	for i, addr := range addrs {
+		if uint32(i) == id {
+			continue
+		}
+		t.peers = append(t.peers, &peer{id: uint32(i), addr: addr, sendc: make(chan *types.PbftMessage, peerSendBuffer)})
+	}
+	return t
+}
+
+func (t *transport) start(step func(from uint32, req *types.Request)) {
+	t.step = step
+	for _, p := range t.peers {
+		t.wg.Add(1)
+		go t.sendLoop(p)
+	}
+	t.wg.Add(1)
+	go t.acceptLoop()
+}
+
+func (t *transport) stop() {
+	close(t.quit)
+	t.listener.Close()
+	t.mu.Lock()
+	for conn := range t.conns {
+		conn.Close()
+	}
+	t.mu.Unlock()
+	t.wg.Wait()
+}
+
+func (t *transport) broadcast(req *types.Request) {
+	m := signMsg(t.id, req, t.priv)
+	for _, p := range t.peers {
+		select {
+		case p.sendc <- m:
+		default:
+			rlog.Debug("peer send buffer full, drop message", "to", p.id)
+		}
+	}
+}
+
+//send 只发送给一个节点
+func (t *transport) send(to uint32, req *types.Request) {
+	m := signMsg(t.id, req, t.priv)
+	for _, p := range t.peers {
+		if p.id != to {
+			continue
+		}
+		select {
+		case p.sendc <- m:
+		default:
+			rlog.Debug("peer send buffer full, drop message", "to", p.id)
+		}
+	}
+}
+
+func (t *transport) addConn(conn net.Conn) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	select {
+	case <-t.quit:
+		conn.Close()
+		return false
+	default:
+	}
+	t.conns[conn] = true
+	return true
+}
+
+func (t *transport) removeConn(conn net.Conn) {
+	t.mu.Lock()
+	delete(t.conns, conn)
+	t.mu.Unlock()
+	conn.Close()
+}
+
+func (t *transport) sendLoop(p *peer) {
+	defer t.wg.Done()
+	var conn net.Conn
+	defer func() {
+		if conn != nil {
+			t.removeConn(conn)
+		}
+	}()
+	for {
+		select {
+		case m := <-p.sendc:
+			if conn == nil {
+				c, err := net.DialTimeout("tcp", p.addr, dialTimeout)
+				if err != nil {
+					rlog.Debug("dial peer", "peer", p.id, "addr", p.addr, "err", err)
+					continue
+				}
+				if !t.addConn(c) {
+					return
+				}
+				conn = c
+			}
+			conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			err := writeMsg(conn, m)
+			if err != nil {
+				rlog.Debug("send to peer", "peer", p.id, "err", err)
+				t.removeConn(conn)
+				conn = nil
+			}
+		case <-t.quit:
+			return
+		}
+	}
+}
+
+func (t *transport) acceptLoop() {
+	defer t.wg.Done()
+	for {
+		conn, err := t.listener.Accept()
+		if err != nil {
+			select {
+			case <-t.quit:
+				return
+			default:
+			}
+			rlog.Error("accept", "err", err)
+			time.Sleep(100 * time.Millisecond)
+			continue
+		}
+		if !t.addConn(conn) {
+			return
+		}
+		t.wg.Add(1)
+		go t.recvLoop(conn)
+	}
+}
+
+func (t *transport) recvLoop(conn net.Conn) {
+	defer t.wg.Done()
+	defer t.removeConn(conn)
+	for {
+		m, err := readMsg(conn)
+		if err != nil {
+			return
+		}
+		if m.Replica == t.id || !verifyMsg(m, t.pubkeys) {
+			rlog.Error("recv message with wrong signature", "replica", m.Replica, "remote", conn.RemoteAddr())
+			continue
+		}
+		t.step(m.Replica, m.Request)
+	}
+}
@@ -0,0 +1,596 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package raft
+
+//raft 的核心状态机:
+//日志的 index 就是区块的高度, 日志的内容就是区块, 已经 apply 的日志就是区块链,
+//所以只保存还没有 apply 的日志, apply 以后只记录日志的 term。
+//leader 把提议的区块复制到多数节点以后才提交, 提交以后所有节点按顺序写入区块链。
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	msgVote = iota
+	msgVoteResp
+	msgApp
+	msgAppResp
+)
+
+const (
+	stateFollower = iota
+	stateCandidate
+	stateLeader
+)
+
+//一条追加日志消息最多带的日志条数, 日志的总大小也不超过一个区块的最大值
+const maxEntriesPerMsg = 16
+
+var (
+	errNotLeader    = errors.New("ErrNotLeader")
+	errProposeIndex = errors.New("ErrProposeIndex")
+	errNotCommitted = errors.New("ErrBlockNotCommitted")
+)
+
+//application 是 raft 日志的状态机, 也就是区块链
+type application interface {
+	//已经写入的最大 index (区块高度)
+	lastIndex() int64
+	//已经写入的日志的内容, 用来复制给落后的节点
+	entryData(index int64) ([]byte, error)
+	//按顺序写入已经提交的日志
+	apply(entry *types.RaftEntry) error
+}
+
+type sender interface {
+	send(m *types.RaftMessage)
+}
+
+type nodeConfig struct {
+	id            int64
+	peers         []int64
+	tick          time.Duration
+	electionTick  int
+	heartbeatTick int
+}
+
+type node struct {
+	mu    sync.Mutex
+	cfg   *nodeConfig
+	app   application
+	store *storage
+	trans sender
+	rand  *rand.Rand
+
+	state int
+	term  int64
+	vote  int64
+	lead  int64
+
+	applied int64
+	commit  int64
+	//applied 之后的日志, ents[i].Index == applied+1+i
+	ents []*types.RaftEntry
+
+	next  map[int64]int64
+	match map[int64]int64
+	votes map[int64]bool
+
+	elapsed                int
+	randomizedElectionTick int
+
+	applyc chan struct{}
+	quit   chan struct{}
+	wg     sync.WaitGroup
+}
+
+func newNode(cfg *nodeConfig, app application, store *storage, trans sender) *node {
+	n := &node{
+		cfg:    cfg,
+		app:    app,
+		store:  store,
+		trans:  trans,
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano() + cfg.id)),
+		applyc: make(chan struct{}, 1),
+		quit:   make(chan struct{}),
+	}
+	n.term, n.vote = store.hardState()
+	n.applied = app.lastIndex()
+	n.commit = n.applied
+	//重启之前已经确认但是还没有 apply 的日志
+	n.ents = store.entries(n.applied + 1)
+	n.becomeFollower(n.term, 0)
+	return n
+}
+
+func (n *node) start() {
+	n.wg.Add(2)
+	go n.tickLoop()
+	go n.applyLoop()
+}
+
+func (n *node) stop() {
+	close(n.quit)
+	n.wg.Wait()
+}
+
+func (n *node) tickLoop() {
+	defer n.wg.Done()
+	ticker := time.NewTicker(n.cfg.tick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			last := n.app.lastIndex()
+			n.mu.Lock()
+			n.syncApplied(last)
+			n.tick()
+			n.mu.Unlock()
+			//apply 失败的日志每个 tick 重试一次
+			n.notifyApply()
+		case <-n.quit:
+			return
+		}
+	}
+}
+
+func (n *node) notifyApply() {
+	select {
+	case n.applyc <- struct{}{}:
+	default:
+	}
+}
+
+//写区块需要执行交易, 不能在锁里面做, 否则会阻塞心跳和选举
+func (n *node) applyLoop() {
+	defer n.wg.Done()
+	for {
+		select {
+		case <-n.applyc:
+		case <-n.quit:
+			return
+		}
+		for {
+			n.mu.Lock()
+			var ent *types.RaftEntry
+			if n.commit > n.applied && len(n.ents) > 0 {
+				ent = n.ents[0]
+			}
+			n.mu.Unlock()
+			if ent == nil {
+				break
+			}
+			err := n.app.apply(ent)
+			if err != nil {
+				rlog.Error("apply", "index", ent.Index, "term", ent.Term, "err", err)
+				break
+			}
+			n.mu.Lock()
+			n.appliedTo(ent)
+			n.mu.Unlock()
+		}
+	}
+}
+
+//已经提交的日志不会再改变, 所以 apply 期间 ents[0] 只可能被 syncApplied 删除
+func (n *node) appliedTo(ent *types.RaftEntry) {
+	err := n.store.setEntryTerm(ent.Index, ent.Term)
+	if err != nil {
+		rlog.Error("appliedTo", "index", ent.Index, "err", err)
+	}
+	if ent.Index == n.applied+1 && len(n.ents) > 0 {
+		n.applied = ent.Index
+		n.ents = n.ents[1:]
+	}
+}
+
+//apply 返回错误的时候区块也可能已经写入了, 这时候丢弃已经写入的日志
+func (n *node) syncApplied(last int64) {
+	if last <= n.applied {
+		return
+	}
+	drop := last - n.applied
+	if drop > int64(len(n.ents)) {
+		drop = int64(len(n.ents))
+	}
+	for _, ent := range n.ents[:drop] {
+		err := n.store.setEntryTerm(ent.Index, ent.Term)
+		if err != nil {
+			rlog.Error("syncApplied", "index", ent.Index, "err", err)
+		}
+	}
+	n.ents = n.ents[drop:]
+	if len(n.ents) > 0 && n.ents[0].Index != last+1 {
+		n.ents = nil
+	}
+	n.applied = last
+	if n.commit < last {
+		n.commit = last
+	}
+}
+
+func (n *node) lastIndex() int64 {
+	return n.applied + int64(len(n.ents))
+}
+
+//日志不存在返回 -1
+func (n *node) termAt(index int64) int64 {
+	if index <= 0 {
+		return 0
+	}
+	if index <= n.applied {
+		return n.store.entryTerm(index)
+	}
+	if index > n.lastIndex() {
+		return -1
+	}
+	return n.ents[index-n.applied-1].Term
+}
+
+func (n *node) entryAt(index int64) (*types.RaftEntry, error) {
+	if index > n.applied {
+		return n.ents[index-n.applied-1], nil
+	}
+	data, err := n.app.entryData(index)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RaftEntry{Term: n.store.entryTerm(index), Index: index, Data: data}, nil
+}
+
+func (n *node) quorum() int {
+	return len(n.cfg.peers)/2 + 1
+}
+
+func (n *node) resetRandomizedElectionTick() {
+	n.randomizedElectionTick = n.cfg.electionTick + n.rand.Intn(n.cfg.electionTick)
+}
+
+func (n *node) setHardState(term, vote int64) {
+	if term == n.term && vote == n.vote {
+		return
+	}
+	err := n.store.setHardState(term, vote)
+	if err != nil {
+		//没有写入就投票会破坏安全性
+		panic(err)
+	}
+	n.term, n.vote = term, vote
+}
+
+func (n *node) becomeFollower(term, lead int64) {
+	if term > n.term {
+		n.setHardState(term, 0)
+	}
+	if n.state != stateFollower || n.lead != lead {
+		rlog.Info("becomeFollower", "id", n.cfg.id, "term", term, "lead", lead)
+	}
+	n.state = stateFollower
+	n.lead = lead
+	n.elapsed = 0
+	n.resetRandomizedElectionTick()
+}
+
+func (n *node) becomeLeader() {
+	rlog.Info("becomeLeader", "id", n.cfg.id, "term", n.term, "lastIndex", n.lastIndex())
+	n.state = stateLeader
+	n.lead = n.cfg.id
+	n.elapsed = 0
+	n.next = make(map[int64]int64)
+	n.match = make(map[int64]int64)
+	for _, id := range n.cfg.peers {
+		n.next[id] = n.lastIndex() + 1
+		n.match[id] = 0
+	}
+	//leader 不能通过计数提交之前 term 的日志,
+	//区块没有空操作, 所以把还没有提交的日志改成当前的 term 重新复制, 日志内容不变
+	//日志可能正在被发送, 不能直接修改
+	var renew []*types.RaftEntry
+	for i, ent := range n.ents {
+		if ent.Index > n.commit {
+			n.ents[i] = &types.RaftEntry{Term: n.term, Index: ent.Index, Data: ent.Data}
+			renew = append(renew, n.ents[i])
+		}
+	}
+	if len(renew) > 0 {
+		err := n.store.saveEntries(renew, 0)
+		if err != nil {
+			//leader 自己也计入多数节点, 没有写入就提交会破坏安全性
+			panic(err)
+		}
+	}
+	n.broadcastAppend()
+	n.maybeCommit()
+}
+
+func (n *node) campaign() {
+	n.state = stateCandidate
+	n.lead = 0
+	n.elapsed = 0
+	n.resetRandomizedElectionTick()
+	n.setHardState(n.term+1, n.cfg.id)
+	n.votes = map[int64]bool{n.cfg.id: true}
+	rlog.Info("campaign", "id", n.cfg.id, "term", n.term, "lastIndex", n.lastIndex())
+	if n.quorum() == 1 {
+		n.becomeLeader()
+		return
+	}
+	lastIndex := n.lastIndex()
+	lastTerm := n.termAt(lastIndex)
+	for _, id := range n.cfg.peers {
+		if id == n.cfg.id {
+			continue
+		}
+		n.trans.send(&types.RaftMessage{Type: msgVote, To: id, From: n.cfg.id, Term: n.term, LogTerm: lastTerm, Index: lastIndex})
+	}
+}
+
+func (n *node) tick() {
+	n.elapsed++
+	if n.state == stateLeader {
+		if n.elapsed >= n.cfg.heartbeatTick {
+			n.elapsed = 0
+			n.broadcastAppend()
+		}
+		return
+	}
+	if n.elapsed >= n.randomizedElectionTick {
+		n.campaign()
+	}
+}
+
+func (n *node) broadcastAppend() {
+	for _, id := range n.cfg.peers {
+		if id != n.cfg.id {
+			n.sendAppend(id)
+		}
+	}
+}
+
+func (n *node) sendAppend(to int64) {
+	prev := n.next[to] - 1
+	if prev > n.lastIndex() {
+		prev = n.lastIndex()
+	}
+	m := &types.RaftMessage{Type: msgApp, To: to, From: n.cfg.id, Term: n.term, LogTerm: n.termAt(prev), Index: prev, Commit: n.commit}
+	size := 0
+	for i := prev + 1; i <= n.lastIndex() && len(m.Entries) < maxEntriesPerMsg && size < types.MaxBlockSize; i++ {
+		ent, err := n.entryAt(i)
+		if err != nil {
+			rlog.Error("sendAppend", "index", i, "err", err)
+			break
+		}
+		m.Entries = append(m.Entries, ent)
+		size += len(ent.Data)
+	}
+	n.trans.send(m)
+}
+
+//propose 由 leader 提议一条新的日志, index 必须紧接着当前最后一条日志
+func (n *node) propose(index int64, data []byte) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.state != stateLeader {
+		return errNotLeader
+	}
+	if index != n.lastIndex()+1 {
+		return errProposeIndex
+	}
+	ent := &types.RaftEntry{Term: n.term, Index: index, Data: data}
+	err := n.store.saveEntries([]*types.RaftEntry{ent}, 0)
+	if err != nil {
+		return err
+	}
+	n.ents = append(n.ents, ent)
+	n.broadcastAppend()
+	n.maybeCommit()
+	return nil
+}
+
+func (n *node) step(m *types.RaftMessage) {
+	select {
+	case <-n.quit:
+		return
+	default:
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if m.Term > n.term {
+		lead := int64(0)
+		if m.Type == msgApp {
+			lead = m.From
+		}
+		n.becomeFollower(m.Term, lead)
+	}
+	if m.Term < n.term {
+		//让过期的 leader 和 candidate 知道新的 term
+		switch m.Type {
+		case msgVote:
+			n.trans.send(&types.RaftMessage{Type: msgVoteResp, To: m.From, From: n.cfg.id, Term: n.term, Reject: true})
+		case msgApp:
+			n.trans.send(&types.RaftMessage{Type: msgAppResp, To: m.From, From: n.cfg.id, Term: n.term, Index: m.Index, Reject: true, RejectHint: n.lastIndex()})
+		}
+		return
+	}
+	switch m.Type {
+	case msgVote:
+		n.handleVote(m)
+	case msgVoteResp:
+		n.handleVoteResp(m)
+	case msgApp:
+		if n.state != stateFollower || n.lead != m.From {
+			n.becomeFollower(m.Term, m.From)
+		}
+		n.elapsed = 0
+		n.handleAppend(m)
+	case msgAppResp:
+		n.handleAppendResp(m)
+	}
+}
+
+func (n *node) handleVote(m *types.RaftMessage) {
+	lastIndex := n.lastIndex()
+	lastTerm := n.termAt(lastIndex)
+	canVote := n.vote == 0 || n.vote == m.From
+	upToDate := m.LogTerm > lastTerm || (m.LogTerm == lastTerm && m.Index >= lastIndex)
+	//已经提交的日志一定在多数节点上, 没有这些日志的节点不能成为 leader
+	grant := canVote && upToDate && m.Index >= n.commit
+	if grant {
+		n.setHardState(n.term, m.From)
+		n.elapsed = 0
+	}
+	n.trans.send(&types.RaftMessage{Type: msgVoteResp, To: m.From, From: n.cfg.id, Term: n.term, Reject: !grant})
+}
+
+func (n *node) handleVoteResp(m *types.RaftMessage) {
+	if n.state != stateCandidate {
+		return
+	}
+	n.votes[m.From] = !m.Reject
+	granted, rejected := 0, 0
+	for _, v := range n.votes {
+		if v {
+			granted++
+		} else {
+			rejected++
+		}
+	}
+	if granted >= n.quorum() {
+		n.becomeLeader()
+	} else if rejected >= n.quorum() {
+		n.becomeFollower(n.term, 0)
+	}
+}
+
+func (n *node) handleAppend(m *types.RaftMessage) {
+	resp := &types.RaftMessage{Type: msgAppResp, To: m.From, From: n.cfg.id, Term: n.term, Index: m.Index}
+	//applied 之前的日志已经提交, 一定和 leader 一致
+	if m.Index > n.applied && n.termAt(m.Index) != m.LogTerm {
+		resp.Reject = true
+		resp.RejectHint = m.Index - 1
+		if resp.RejectHint > n.lastIndex() {
+			resp.RejectHint = n.lastIndex()
+		}
+		n.trans.send(resp)
+		return
+	}
+	ents := n.ents
+	var appended []*types.RaftEntry
+	for _, ent := range m.Entries {
+		if ent.Index <= n.applied {
+			continue
+		}
+		pos := ent.Index - n.applied - 1
+		if pos < int64(len(ents)) {
+			if ents[pos].Term == ent.Term {
+				continue
+			}
+			//冲突的日志以及之后的日志都删除
+			ents = ents[:pos:pos]
+		}
+		if pos != int64(len(ents)) {
+			break
+		}
+		ents = append(ents, ent)
+		appended = append(appended, ent)
+	}
+	if len(appended) > 0 {
+		//回复 leader 之前写入, 被覆盖的旧日志一起删除
+		err := n.store.saveEntries(appended, n.lastIndex())
+		if err != nil {
+			//不回复, leader 会重新发送
+			rlog.Error("handleAppend save entries", "index", appended[0].Index, "err", err)
+			return
+		}
+		n.ents = ents
+	}
+	lastNew := m.Index + int64(len(m.Entries))
+	if lastNew > n.lastIndex() {
+		lastNew = n.lastIndex()
+	}
+	commit := m.Commit
+	if commit > lastNew {
+		commit = lastNew
+	}
+	if commit > n.commit {
+		n.commit = commit
+		n.notifyApply()
+	}
+	resp.Index = lastNew
+	n.trans.send(resp)
+}
+
+func (n *node) handleAppendResp(m *types.RaftMessage) {
+	if n.state != stateLeader {
+		return
+	}
+	if m.Reject {
+		//过期的回复不处理
+		if m.Index != n.next[m.From]-1 {
+			return
+		}
+		next := m.RejectHint + 1
+		if next > m.Index {
+			next = m.Index
+		}
+		if next < 1 {
+			next = 1
+		}
+		n.next[m.From] = next
+		n.sendAppend(m.From)
+		return
+	}
+	if m.Index > n.match[m.From] {
+		n.match[m.From] = m.Index
+		n.maybeCommit()
+	}
+	if m.Index+1 > n.next[m.From] {
+		n.next[m.From] = m.Index + 1
+	}
+	if n.next[m.From] <= n.lastIndex() {
+		n.sendAppend(m.From)
+	}
+}
+
+func (n *node) maybeCommit() {
+	var matched []int64
+	for _, id := range n.cfg.peers {
+		if id == n.cfg.id {
+			matched = append(matched, n.lastIndex())
+		} else {
+			matched = append(matched, n.match[id])
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i] > matched[j] })
+	index := matched[n.quorum()-1]
+	if index > n.commit && n.termAt(index) == n.term {
+		n.commit = index
+		n.notifyApply()
+	}
+}
+
+type status struct {
+	id        int64
+	state     int
+	term      int64
+	lead      int64
+	commit    int64
+	applied   int64
+	lastIndex int64
+}
+
+func (n *node) status() *status {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return &status{id: n.cfg.id, state: n.state, term: n.term, lead: n.lead,
+		commit: n.commit, applied: n.applied, lastIndex: n.lastIndex()}
+}
@@ -0,0 +1,328 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package raft
+
+import (
+	"bytes"
+	"net"
+	"sync"
+	"time"
+
+	dbm "github.com/33cn/chain33/common/db"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/consensus"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	"github.com/33cn/chain33/types"
+)
+
+var rlog = log.New("module", "raft")
+
+//Client raft 共识, 适合联盟链:
+//leader 打包区块并且复制到多数节点, 提交以后所有节点才写入区块链,
+//leader 宕机以后剩下的多数节点重新选举 leader 继续出块
+type Client struct {
+	*drivers.BaseClient
+	subcfg    *subConfig
+	sleepTime time.Duration
+	tick      time.Duration
+	listener  net.Listener
+	db        dbm.DB
+	node      *node
+	trans     *transport
+	mu        sync.Mutex
+	quit      chan struct{}
+	//正在 apply 的日志中的区块, 只有这个区块可以写入区块链
+	applying *types.Block
+}
+
+func init() {
+	drivers.Reg("raft", New)
+	drivers.QueryData.Register("raft", &Client{})
+}
+
+type subConfig struct {
+	Genesis          string `json:"genesis"`
+	GenesisBlockTime int64  `json:"genesisBlockTime"`
+	WaitTxMs         int64  `json:"waitTxMs"`
+	//本节点在 peers 中的序号, 从 1 开始
+	NodeID int64 `json:"nodeID"`
+	//所有节点的 raft 地址, 包括自己
+	Peers         []string `json:"peers"`
+	TickMs        int64    `json:"tickMs"`
+	ElectionTick  int      `json:"electionTick"`
+	HeartbeatTick int      `json:"heartbeatTick"`
+	Driver        string   `json:"driver"`
+	DbPath        string   `json:"dbPath"`
+}
+
+func New(cfg *types.Consensus, sub []byte) queue.Module {
+	c := drivers.NewBaseClient(cfg)
+	var subcfg subConfig
+	if sub != nil {
+		types.MustDecode(sub, &subcfg)
+	}
+	if subcfg.WaitTxMs == 0 {
+		subcfg.WaitTxMs = 1000
+	}
+	if subcfg.TickMs == 0 {
+		subcfg.TickMs = 100
+	}
+	if subcfg.ElectionTick == 0 {
+		subcfg.ElectionTick = 10
+	}
+	if subcfg.HeartbeatTick == 0 {
+		subcfg.HeartbeatTick = 1
+	}
+	if subcfg.HeartbeatTick >= subcfg.ElectionTick {
+		panic("raft heartbeatTick must less than electionTick")
+	}
+	if subcfg.Driver == "" {
+		subcfg.Driver = "leveldb"
+	}
+	if subcfg.DbPath == "" {
+		subcfg.DbPath = "datadir/raft"
+	}
+	if subcfg.NodeID <= 0 || subcfg.NodeID > int64(len(subcfg.Peers)) {
+		panic("raft nodeID must in [1, len(peers)]")
+	}
+	listener, err := net.Listen("tcp", subcfg.Peers[subcfg.NodeID-1])
+	if err != nil {
+		panic(err)
+	}
+	raft := &Client{
+		BaseClient: c,
+		subcfg:     &subcfg,
+		sleepTime:  time.Duration(subcfg.WaitTxMs) * time.Millisecond,
+		tick:       time.Duration(subcfg.TickMs) * time.Millisecond,
+		listener:   listener,
+		db:         dbm.NewDB("raft", subcfg.Driver, subcfg.DbPath, 0),
+		quit:       make(chan struct{}),
+	}
+	c.SetChild(raft)
+	return raft
+}
+
+//节点在 CreateBlock 中启动, 这时候 InitBlock 已经完成, 可以拿到当前的区块高度
+func (client *Client) startNode() bool {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	select {
+	case <-client.quit:
+		return false
+	default:
+	}
+	addrs := make(map[int64]string)
+	var ids []int64
+	for i, addr := range client.subcfg.Peers {
+		addrs[int64(i+1)] = addr
+		ids = append(ids, int64(i+1))
+	}
+	cfg := &nodeConfig{
+		id:            client.subcfg.NodeID,
+		peers:         ids,
+		tick:          client.tick,
+		electionTick:  client.subcfg.ElectionTick,
+		heartbeatTick: client.subcfg.HeartbeatTick,
+	}
+	client.trans = newTransport(cfg.id, client.listener, addrs)
+	client.node = newNode(cfg, client, newStorage(client.db), client.trans)
+	client.trans.start(client.node.step)
+	client.node.start()
+	return true
+}
+
+func (client *Client) getNode() *node {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	return client.node
+}
+
+func (client *Client) Close() {
+	client.mu.Lock()
+	close(client.quit)
+	client.mu.Unlock()
+	//关闭队列以后正在写入的区块马上返回, 不会阻塞 node 的退出
+	client.BaseClient.Close()
+	if client.node != nil {
+		client.trans.stop()
+		client.node.stop()
+	} else {
+		client.listener.Close()
+	}
+	client.db.Close()
+	rlog.Info("consensus raft closed")
+}
+
+func (client *Client) GetGenesisBlockTime() int64 {
+	return client.subcfg.GenesisBlockTime
+}
+
+func (client *Client) CreateGenesisTx() (ret []*types.Transaction) {
+	var tx types.Transaction
+	tx.Execer = []byte("coins")
+	tx.To = client.subcfg.Genesis
+	//gen payload
+	g := &cty.CoinsAction_Genesis{}
+	g.Genesis = &types.AssetsGenesis{}
+	g.Genesis.Amount = 1e8 * types.Coin
+	tx.Payload = types.Encode(&cty.CoinsAction{Value: g, Ty: cty.CoinsActionGenesis})
+	ret = append(ret, &tx)
+	return
+}
+
+func (client *Client) ProcEvent(msg queue.Message) bool {
+	return false
+}
+
+//CheckBlock 只有 raft 提交的区块才能写入区块链
+//p2p 同步或者广播过来的区块没有经过 raft 提交, 都会被拒绝, 落后的节点通过 raft 的日志复制追上 leader
+//apply 日志的时候执行区块可能删除错误的交易, 所以只比较区块头的信息, 交易必须都在日志中的区块里
+func (client *Client) CheckBlock(parent *types.Block, current *types.BlockDetail) error {
+	client.mu.Lock()
+	applying := client.applying
+	client.mu.Unlock()
+	if applying == nil || !isProposedBlock(applying, current.Block) {
+		return errNotCommitted
+	}
+	return nil
+}
+
+func isProposedBlock(proposed, block *types.Block) bool {
+	if proposed.Height != block.Height || proposed.BlockTime != block.BlockTime ||
+		!bytes.Equal(proposed.ParentHash, block.ParentHash) {
+		return false
+	}
+	txs := make(map[string]bool)
+	for _, tx := range proposed.Txs {
+		txs[string(tx.Hash())] = true
+	}
+	for _, tx := range block.Txs {
+		if !txs[string(tx.Hash())] {
+			return false
+		}
+	}
+	return true
+}
+
+func (client *Client) setApplying(block *types.Block) {
+	client.mu.Lock()
+	client.applying = block
+	client.mu.Unlock()
+}
+
+//IsLeader 本节点是否是当前的 leader, 只有 leader 打包区块
+func (client *Client) IsLeader() bool {
+	node := client.getNode()
+	if node == nil {
+		return false
+	}
+	return node.status().state == stateLeader
+}
+
+func (client *Client) sleep(d time.Duration) bool {
+	select {
+	case <-client.quit:
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+func (client *Client) CreateBlock() {
+	if !client.startNode() {
+		return
+	}
+	issleep := true
+	for {
+		if !client.IsMining() || !client.IsLeader() {
+			if !client.sleep(client.tick) {
+				return
+			}
+			continue
+		}
+		//上一个区块提交并且写入以后才打包下一个区块
+		st := client.node.status()
+		lastBlock := client.GetCurrentBlock()
+		if st.lastIndex != st.applied || lastBlock.Height != st.applied {
+			if !client.sleep(client.tick) {
+				return
+			}
+			continue
+		}
+		if issleep && !client.sleep(client.sleepTime) {
+			return
+		}
+		txs := client.RequestTx(int(types.GetP(lastBlock.Height+1).MaxTxNumber), nil)
+		if len(txs) == 0 {
+			issleep = true
+			continue
+		}
+		issleep = false
+		//check dup
+		txs = client.CheckTxDup(txs)
+		var newblock types.Block
+		newblock.ParentHash = lastBlock.Hash()
+		newblock.Height = lastBlock.Height + 1
+		client.AddTxsToBlock(&newblock, txs)
+		newblock.Difficulty = types.GetP(0).PowLimitBits
+		newblock.TxHash = merkle.CalcMerkleRoot(newblock.Txs)
+		newblock.BlockTime = types.Now().Unix()
+		if lastBlock.BlockTime >= newblock.BlockTime {
+			newblock.BlockTime = lastBlock.BlockTime + 1
+		}
+		err := client.node.propose(newblock.Height, types.Encode(&newblock))
+		if err != nil {
+			rlog.Debug("propose", "height", newblock.Height, "err", err)
+			issleep = true
+		}
+	}
+}
+
+//下面是 raft 日志的 application 接口, 日志的 index 就是区块的高度
+
+func (client *Client) lastIndex() int64 {
+	return client.GetCurrentHeight()
+}
+
+func (client *Client) entryData(index int64) ([]byte, error) {
+	block, err := client.RequestBlock(index)
+	if err != nil {
+		return nil, err
+	}
+	return types.Encode(block), nil
+}
+
+func (client *Client) apply(entry *types.RaftEntry) error {
+	var block types.Block
+	err := types.Decode(entry.Data, &block)
+	if err != nil {
+		return err
+	}
+	if block.Height != entry.Index {
+		return types.ErrBlockHeight
+	}
+	lastBlock := client.GetCurrentBlock()
+	//上一次 apply 返回错误, 但是区块已经写入了
+	if block.Height <= lastBlock.Height {
+		return nil
+	}
+	if block.Height != lastBlock.Height+1 {
+		return types.ErrBlockHeight
+	}
+	if !bytes.Equal(block.ParentHash, lastBlock.Hash()) {
+		return types.ErrParentHash
+	}
+	//执行区块的时候会修改区块, 所以 CheckBlock 使用单独解码的区块
+	var proposed types.Block
+	err = types.Decode(entry.Data, &proposed)
+	if err != nil {
+		return err
+	}
+	client.setApplying(&proposed)
+	defer client.setApplying(nil)
+	return client.WriteBlock(lastBlock.StateHash, &block)
+}
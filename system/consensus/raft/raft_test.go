@@ -0,0 +1,310 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package raft
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	//加载系统内置store, 不要依赖plugin
+	_ "github.com/33cn/chain33/system/dapp/init"
+	_ "github.com/33cn/chain33/system/store/init"
+)
+
+//memApp 用内存中的日志代替区块链
+type memApp struct {
+	mu   sync.Mutex
+	data [][]byte
+}
+
+func (app *memApp) lastIndex() int64 {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+	return int64(len(app.data))
+}
+
+func (app *memApp) entryData(index int64) ([]byte, error) {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+	if index <= 0 || index > int64(len(app.data)) {
+		return nil, types.ErrNotFound
+	}
+	return app.data[index-1], nil
+}
+
+func (app *memApp) apply(entry *types.RaftEntry) error {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+	if entry.Index != int64(len(app.data))+1 {
+		return types.ErrBlockHeight
+	}
+	app.data = append(app.data, entry.Data)
+	return nil
+}
+
+type testNode struct {
+	node  *node
+	trans *transport
+	app   *memApp
+	store *storage
+}
+
+func startTestNode(t *testing.T, id int64, addrs map[int64]string, l net.Listener, app *memApp, store *storage) *testNode {
+	var ids []int64
+	for i := int64(1); i <= int64(len(addrs)); i++ {
+		ids = append(ids, i)
+	}
+	if l == nil {
+		var err error
+		l, err = net.Listen("tcp", addrs[id])
+		require.NoError(t, err)
+	}
+	cfg := &nodeConfig{id: id, peers: ids, tick: 10 * time.Millisecond, electionTick: 10, heartbeatTick: 1}
+	trans := newTransport(id, l, addrs)
+	nd := newNode(cfg, app, store, trans)
+	trans.start(nd.step)
+	nd.start()
+	return &testNode{node: nd, trans: trans, app: app, store: store}
+}
+
+func (tn *testNode) stop() {
+	tn.trans.stop()
+	tn.node.stop()
+}
+
+//重启节点, 保留区块链和 raft 的状态
+func (tn *testNode) restart(t *testing.T) *testNode {
+	return startTestNode(t, tn.trans.id, tn.trans.addrs, nil, tn.app, tn.store)
+}
+
+func newTestCluster(t *testing.T, n int) []*testNode {
+	var listeners []net.Listener
+	addrs := make(map[int64]string)
+	for i := 1; i <= n; i++ {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		listeners = append(listeners, l)
+		addrs[int64(i)] = l.Addr().String()
+	}
+	var nodes []*testNode
+	for i := 1; i <= n; i++ {
+		store := newStorage(dbm.NewDB("raft", "memdb", "", 0))
+		nodes = append(nodes, startTestNode(t, int64(i), addrs, listeners[i-1], &memApp{}, store))
+	}
+	return nodes
+}
+
+func waitLeader(t *testing.T, nodes []*testNode) *testNode {
+	for i := 0; i < 500; i++ {
+		var leader *testNode
+		count := 0
+		for _, tn := range nodes {
+			if tn.node.status().state == stateLeader {
+				leader = tn
+				count++
+			}
+		}
+		if count == 1 {
+			return leader
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("no leader elected")
+	return nil
+}
+
+func waitApplied(t *testing.T, nodes []*testNode, index int64) {
+	for i := 0; i < 500; i++ {
+		done := true
+		for _, tn := range nodes {
+			if tn.app.lastIndex() < index {
+				done = false
+			}
+		}
+		if done {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("wait applied timeout", index)
+}
+
+func propose(t *testing.T, nodes []*testNode, index int64) {
+	for i := 0; i < 50; i++ {
+		leader := waitLeader(t, nodes)
+		err := leader.node.propose(index, []byte(fmt.Sprint("block", index)))
+		if err == nil {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("propose failed", index)
+}
+
+func TestRaftCluster(t *testing.T) {
+	nodes := newTestCluster(t, 3)
+	leader := waitLeader(t, nodes)
+	assert.Equal(t, errProposeIndex, leader.node.propose(2, []byte("block2")))
+	for _, tn := range nodes {
+		if tn != leader {
+			assert.Equal(t, errNotLeader, tn.node.propose(1, []byte("block1")))
+		}
+	}
+	for i := int64(1); i <= 5; i++ {
+		propose(t, nodes, i)
+		waitApplied(t, nodes, i)
+	}
+	for _, tn := range nodes {
+		assert.Equal(t, nodes[0].app.data, tn.app.data)
+	}
+
+	//leader 宕机以后剩下的节点选出新的 leader 继续提交
+	leader.stop()
+	var rest []*testNode
+	for _, tn := range nodes {
+		if tn != leader {
+			rest = append(rest, tn)
+		}
+	}
+	newLeader := waitLeader(t, rest)
+	assert.True(t, newLeader.node.status().term > leader.node.status().term)
+	for i := int64(6); i <= 10; i++ {
+		propose(t, rest, i)
+		waitApplied(t, rest, i)
+	}
+	assert.Equal(t, rest[0].app.data, rest[1].app.data)
+	assert.Equal(t, []byte("block10"), rest[0].app.data[9])
+
+	//只剩一个节点的时候不能提交
+	newLeader.stop()
+	for _, tn := range rest {
+		if tn != newLeader {
+			time.Sleep(300 * time.Millisecond)
+			assert.NotEqual(t, stateLeader, tn.node.status().state)
+			assert.Equal(t, int64(10), tn.app.lastIndex())
+			tn.stop()
+		}
+	}
+}
+
+func TestRaftFollowerCatchUp(t *testing.T) {
+	nodes := newTestCluster(t, 3)
+	defer func() {
+		for _, tn := range nodes {
+			tn.stop()
+		}
+	}()
+	leader := waitLeader(t, nodes)
+	var follower *testNode
+	for _, tn := range nodes {
+		if tn != leader {
+			follower = tn
+			break
+		}
+	}
+	//节点宕机期间落后的日志, 重启以后从 leader 已经写入的日志中追上
+	follower.stop()
+	var rest []*testNode
+	for _, tn := range nodes {
+		if tn != follower {
+			rest = append(rest, tn)
+		}
+	}
+	for i := int64(1); i <= 40; i++ {
+		propose(t, rest, i)
+		waitApplied(t, rest, i)
+	}
+	assert.Equal(t, int64(0), follower.app.lastIndex())
+	restarted := follower.restart(t)
+	*follower = *restarted
+	waitApplied(t, nodes, 40)
+	assert.Equal(t, leader.app.data, follower.app.data)
+}
+
+func TestRaftChain(t *testing.T) {
+	cfg, sub := testnode.GetDefaultConfig()
+	cfg.Consensus.Name = "raft"
+	sub.Consensus["raft"] = []byte(`{"genesis":"14KEKbYtKKQm4wMthSK9J4La4nAiidGozt","genesisBlockTime":1514533394,
+		"waitTxMs":10,"nodeID":1,"peers":["127.0.0.1:0"],"tickMs":10,"driver":"memdb"}`)
+	mock33 := testnode.NewWithConfig(cfg, sub, nil)
+	defer mock33.Close()
+	txs := util.GenNoneTxs(mock33.GetGenesisKey(), 10)
+	for i := 0; i < len(txs); i++ {
+		mock33.GetAPI().SendTx(txs[i])
+	}
+	mock33.WaitHeight(1)
+	txs = util.GenNoneTxs(mock33.GetGenesisKey(), 10)
+	for i := 0; i < len(txs); i++ {
+		mock33.GetAPI().SendTx(txs[i])
+	}
+	mock33.WaitHeight(2)
+}
+
+type memTrans struct {
+	msgs []*types.RaftMessage
+}
+
+func (trans *memTrans) send(m *types.RaftMessage) {
+	trans.msgs = append(trans.msgs, m)
+}
+
+func TestRaftPersistEntries(t *testing.T) {
+	store := newStorage(dbm.NewDB("raft", "memdb", "", 0))
+	cfg := &nodeConfig{id: 2, peers: []int64{1, 2, 3}, tick: 10 * time.Millisecond, electionTick: 10, heartbeatTick: 1}
+	trans := &memTrans{}
+	nd := newNode(cfg, &memApp{}, store, trans)
+	ents := []*types.RaftEntry{{Term: 1, Index: 1, Data: []byte("block1")}, {Term: 1, Index: 2, Data: []byte("block2")}}
+	nd.step(&types.RaftMessage{Type: msgApp, From: 1, To: 2, Term: 1, Entries: ents})
+	resp := trans.msgs[len(trans.msgs)-1]
+	assert.Equal(t, int32(msgAppResp), resp.Type)
+	assert.False(t, resp.Reject)
+	assert.Equal(t, int64(2), resp.Index)
+
+	//确认以后还没有 apply 的日志, 重启以后还在
+	nd = newNode(cfg, &memApp{}, store, trans)
+	assert.Equal(t, int64(2), nd.lastIndex())
+	assert.Equal(t, ents, store.entries(1))
+
+	//冲突的日志被覆盖, 之后的旧日志删除
+	ents = []*types.RaftEntry{{Term: 2, Index: 1, Data: []byte("block1")}}
+	nd.step(&types.RaftMessage{Type: msgApp, From: 3, To: 2, Term: 2, Entries: ents})
+	assert.Equal(t, ents, store.entries(1))
+	assert.Equal(t, int64(1), newNode(cfg, &memApp{}, store, trans).lastIndex())
+
+	//apply 以后只保留 term
+	nd.appliedTo(ents[0])
+	assert.Equal(t, 0, len(store.entries(1)))
+	assert.Equal(t, int64(2), store.entryTerm(1))
+}
+
+func TestRaftCheckBlock(t *testing.T) {
+	tx1 := &types.Transaction{Execer: []byte("none"), Nonce: 1}
+	tx2 := &types.Transaction{Execer: []byte("none"), Nonce: 2}
+	proposed := &types.Block{Height: 1, ParentHash: []byte("parent"), BlockTime: 100, Txs: []*types.Transaction{tx1, tx2}}
+	client := &Client{}
+	//没有经过 raft 提交的区块不能写入
+	block := *proposed
+	assert.Equal(t, errNotCommitted, client.CheckBlock(nil, &types.BlockDetail{Block: &block}))
+	client.setApplying(proposed)
+	assert.Nil(t, client.CheckBlock(nil, &types.BlockDetail{Block: &block}))
+	//执行的时候删除了错误的交易
+	block.Txs = []*types.Transaction{tx2}
+	assert.Nil(t, client.CheckBlock(nil, &types.BlockDetail{Block: &block}))
+	block.Txs = []*types.Transaction{{Execer: []byte("none"), Nonce: 3}}
+	assert.Equal(t, errNotCommitted, client.CheckBlock(nil, &types.BlockDetail{Block: &block}))
+	block = *proposed
+	block.BlockTime++
+	assert.Equal(t, errNotCommitted, client.CheckBlock(nil, &types.BlockDetail{Block: &block}))
+}
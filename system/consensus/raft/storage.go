@@ -0,0 +1,111 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package raft
+
+import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	termKey = []byte("raft-term")
+	voteKey = []byte("raft-vote")
+)
+
+//已经 apply 的日志的 term, 日志的内容就是区块, 保存在区块链中
+func entryTermKey(index int64) []byte {
+	return []byte(fmt.Sprintf("raft-entry-term-%020d", index))
+}
+
+//还没有 apply 的日志, apply 以后删除
+func entryKey(index int64) []byte {
+	return []byte(fmt.Sprintf("raft-entry-%020d", index))
+}
+
+//storage 保存 raft 重启以后需要恢复的状态:
+//当前的 term, 当前 term 投票给谁, 已经 apply 的日志的 term, 以及还没有 apply 的日志
+type storage struct {
+	db dbm.DB
+}
+
+func newStorage(db dbm.DB) *storage {
+	return &storage{db: db}
+}
+
+func (s *storage) getInt64(key []byte) int64 {
+	value, err := s.db.Get(key)
+	if err != nil || value == nil {
+		return 0
+	}
+	var data types.Int64
+	if types.Decode(value, &data) != nil {
+		return 0
+	}
+	return data.Data
+}
+
+func (s *storage) setInt64(key []byte, data int64) error {
+	return s.db.SetSync(key, types.Encode(&types.Int64{Data: data}))
+}
+
+func (s *storage) hardState() (term, vote int64) {
+	return s.getInt64(termKey), s.getInt64(voteKey)
+}
+
+//投票之前必须先写入, 否则重启以后同一个 term 可能投票两次
+func (s *storage) setHardState(term, vote int64) error {
+	batch := s.db.NewBatch(true)
+	batch.Set(termKey, types.Encode(&types.Int64{Data: term}))
+	batch.Set(voteKey, types.Encode(&types.Int64{Data: vote}))
+	return batch.Write()
+}
+
+//没有记录 term 的区块(比如创世区块)返回 0
+func (s *storage) entryTerm(index int64) int64 {
+	return s.getInt64(entryTermKey(index))
+}
+
+//apply 以后只保留日志的 term
+func (s *storage) setEntryTerm(index, term int64) error {
+	batch := s.db.NewBatch(true)
+	batch.Set(entryTermKey(index), types.Encode(&types.Int64{Data: term}))
+	batch.Delete(entryKey(index))
+	return batch.Write()
+}
+
+//entries 从 index 开始连续的还没有 apply 的日志
+func (s *storage) entries(index int64) []*types.RaftEntry {
+	var ents []*types.RaftEntry
+	for ; ; index++ {
+		value, err := s.db.Get(entryKey(index))
+		if err != nil || value == nil {
+			return ents
+		}
+		var ent types.RaftEntry
+		if types.Decode(value, &ent) != nil || ent.Index != index {
+			return ents
+		}
+		ents = append(ents, &ent)
+	}
+}
+
+//saveEntries 写入连续的新日志, 并且删除新日志之后到 last 之间被覆盖的旧日志
+//确认日志之前必须先写入, 否则重启以后多数节点上已经提交的日志可能丢失
+func (s *storage) saveEntries(ents []*types.RaftEntry, last int64) error {
+	batch := s.db.NewBatch(true)
+	for _, ent := range ents {
+		batch.Set(entryKey(ent.Index), types.Encode(ent))
+	}
+	for i := ents[len(ents)-1].Index + 1; i <= last; i++ {
+		batch.Delete(entryKey(i))
+	}
+	return batch.Write()
+}
+
+func (s *storage) close() {
+	s.db.Close()
+}
@@ -0,0 +1,223 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package raft
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	//每个节点的发送队列大小, 队列满的时候丢弃消息, 由 raft 的重传保证最终一致
+	peerSendBuffer = 256
+	dialTimeout    = time.Second
+	writeTimeout   = 5 * time.Second
+	//一条消息最多带一个区块大小的日志, 再加上最后一个区块
+	maxMsgSize = 2 * types.MaxBlockSize
+)
+
+func writeMsg(w io.Writer, m *types.RaftMessage) error {
+	data := types.Encode(m)
+	buf := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	copy(buf[4:], data)
+	_, err := w.Write(buf)
+	return err
+}
+
+func readMsg(r io.Reader) (*types.RaftMessage, error) {
+	var head [4]byte
+	_, err := io.ReadFull(r, head[:])
+	if err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(head[:])
+	if size > maxMsgSize {
+		return nil, types.ErrSize
+	}
+	data := make([]byte, size)
+	_, err = io.ReadFull(r, data)
+	if err != nil {
+		return nil, err
+	}
+	var m types.RaftMessage
+	err = types.Decode(data, &m)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+//transport 节点之间用 tcp 长连接传递 raft 消息
+//每个节点一个发送队列和一个连接, 连接断开以后发送的时候重新连接
+type transport struct {
+	id       int64
+	addrs    map[int64]string
+	listener net.Listener
+	peers    map[int64]*peer
+	step     func(m *types.RaftMessage)
+
+	mu    sync.Mutex
+	conns map[net.Conn]bool
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+type peer struct {
+	id    int64
+	addr  string
+	sendc chan *types.RaftMessage
+}
+
+func newTransport(id int64, listener net.Listener, addrs map[int64]string) *transport {
+	t := &transport{
+		id:       id,
+		addrs:    addrs,
+		listener: listener,
+		peers:    make(map[int64]*peer),
+		conns:    make(map[net.Conn]bool),
+		quit:     make(chan struct{}),
+	}
+	for pid, addr := range addrs {
+		if pid == id {
+			continue
+		}
+		t.peers[pid] = &peer{id: pid, addr: addr, sendc: make(chan *types.RaftMessage, peerSendBuffer)}
+	}
+	return t
+}
+
+func (t *transport) start(step func(m *types.RaftMessage)) {
+	t.step = step
+	for _, p := range t.peers {
+		t.wg.Add(1)
+		go t.sendLoop(p)
+	}
+	t.wg.Add(1)
+	go t.acceptLoop()
+}
+
+func (t *transport) stop() {
+	close(t.quit)
+	t.listener.Close()
+	t.mu.Lock()
+	for conn := range t.conns {
+		conn.Close()
+	}
+	t.mu.Unlock()
+	t.wg.Wait()
+}
+
+func (t *transport) send(m *types.RaftMessage) {
+	p, ok := t.peers[m.To]
+	if !ok {
+		rlog.Error("send to unknown peer", "to", m.To)
+		return
+	}
+	select {
+	case p.sendc <- m:
+	default:
+		rlog.Debug("peer send buffer full, drop message", "to", m.To, "type", m.Type)
+	}
+}
+
+func (t *transport) addConn(conn net.Conn) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	select {
+	case <-t.quit:
+		conn.Close()
+		return false
+	default:
+	}
+	t.conns[conn] = true
+	return true
+}
+
+func (t *transport) removeConn(conn net.Conn) {
+	t.mu.Lock()
+	delete(t.conns, conn)
+	t.mu.Unlock()
+	conn.Close()
+}
+
+func (t *transport) sendLoop(p *peer) {
+	defer t.wg.Done()
+	var conn net.Conn
+	defer func() {
+		if conn != nil {
+			t.removeConn(conn)
+		}
+	}()
+	for {
+		select {
+		case m := <-p.sendc:
+			if conn == nil {
+				c, err := net.DialTimeout("tcp", p.addr, dialTimeout)
+				if err != nil {
+					rlog.Debug("dial peer", "peer", p.id, "addr", p.addr, "err", err)
+					continue
+				}
+				if !t.addConn(c) {
+					return
+				}
+				conn = c
+			}
+			conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			err := writeMsg(conn, m)
+			if err != nil {
+				rlog.Debug("send to peer", "peer", p.id, "err", err)
+				t.removeConn(conn)
+				conn = nil
+			}
+		case <-t.quit:
+			return
+		}
+	}
+}
+
+func (t *transport) acceptLoop() {
+	defer t.wg.Done()
+	for {
+		conn, err := t.listener.Accept()
+		if err != nil {
+			select {
+			case <-t.quit:
+				return
+			default:
+			}
+			rlog.Error("accept", "err", err)
+			time.Sleep(100 * time.Millisecond)
+			continue
+		}
+		if !t.addConn(conn) {
+			return
+		}
+		t.wg.Add(1)
+		go t.recvLoop(conn)
+	}
+}
+
+func (t *transport) recvLoop(conn net.Conn) {
+	defer t.wg.Done()
+	defer t.removeConn(conn)
+	for {
+		m, err := readMsg(conn)
+		if err != nil {
+			return
+		}
+		if m.To != t.id {
+			rlog.Error("recv message not for me", "to", m.To, "from", m.From)
+			continue
+		}
+		t.step(m)
+	}
+}
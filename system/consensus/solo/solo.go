@@ -5,6 +5,7 @@
 package solo
 
 import (
+	"sync/atomic"
 	"time"
 
 	log "github.com/33cn/chain33/common/log/log15"
@@ -22,6 +23,9 @@ type Client struct {
 	*drivers.BaseClient
 	subcfg    *subConfig
 	sleepTime time.Duration
+	//MineBlocks 要求马上打包, 还没有打包的区块数
+	forceMine int64
+	minec     chan struct{}
 }
 
 func init() {
@@ -33,8 +37,19 @@ type subConfig struct {
 	Genesis          string `json:"genesis"`
 	GenesisBlockTime int64  `json:"genesisBlockTime"`
 	WaitTxMs         int64  `json:"waitTxMs"`
+	//固定的出块间隔, 0 的时候每 waitTxMs 检查一次 mempool, 有交易就出块
+	BlockIntervalMs int64 `json:"blockIntervalMs"`
+	//没有交易的时候也出空块, 一般和 blockIntervalMs 一起使用
+	EmptyBlock bool `json:"emptyBlock"`
+	//区块的最大字节数, 0 的时候使用系统默认值
+	MaxBlockSize int64 `json:"maxBlockSize"`
+	//区块时间固定比父区块大 blockTimeStep 秒, 区块时间只和高度有关, 0 的时候使用系统时间
+	BlockTimeStep int64 `json:"blockTimeStep"`
 }
 
+//一次最多要求打包的区块数
+const maxForceMine = 1000
+
 func New(cfg *types.Consensus, sub []byte) queue.Module {
 	c := drivers.NewBaseClient(cfg)
 	var subcfg subConfig
@@ -44,7 +59,14 @@ func New(cfg *types.Consensus, sub []byte) queue.Module {
 	if subcfg.WaitTxMs == 0 {
 		subcfg.WaitTxMs = 1000
 	}
-	solo := &Client{c, &subcfg, time.Duration(subcfg.WaitTxMs) * time.Millisecond}
+	if subcfg.MaxBlockSize <= 0 || subcfg.MaxBlockSize > types.MaxBlockSize-100000 {
+		subcfg.MaxBlockSize = types.MaxBlockSize - 100000
+	}
+	sleepTime := time.Duration(subcfg.WaitTxMs) * time.Millisecond
+	if subcfg.BlockIntervalMs > 0 {
+		sleepTime = time.Duration(subcfg.BlockIntervalMs) * time.Millisecond
+	}
+	solo := &Client{BaseClient: c, subcfg: &subcfg, sleepTime: sleepTime, minec: make(chan struct{}, 1)}
 	c.SetChild(solo)
 	return solo
 }
@@ -79,6 +101,30 @@ func (client *Client) CheckBlock(parent *types.Block, current *types.BlockDetail
 	return nil
 }
 
+//Query_MineBlocks 要求马上打包 n 个区块, 没有交易的时候打包空块, 返回打包完成以后的高度
+//打包在出块的协程中进行, 调用者需要自己等待区块高度
+func (client *Client) Query_MineBlocks(req *types.Int64) (types.Message, error) {
+	if req.Data <= 0 || req.Data > maxForceMine {
+		return nil, types.ErrInvalidParam
+	}
+	if !client.IsMining() {
+		return nil, types.ErrMinerNotStared
+	}
+	n := atomic.AddInt64(&client.forceMine, req.Data)
+	select {
+	case client.minec <- struct{}{}:
+	default:
+	}
+	return &types.ReplyBlockHeight{Height: client.GetCurrentHeight() + n}, nil
+}
+
+func (client *Client) wait() {
+	select {
+	case <-time.After(client.sleepTime):
+	case <-client.minec:
+	}
+}
+
 func (client *Client) CreateBlock() {
 	issleep := true
 	for {
@@ -86,28 +132,42 @@ func (client *Client) CreateBlock() {
 			time.Sleep(client.sleepTime)
 			continue
 		}
-		if issleep {
-			time.Sleep(client.sleepTime)
+		force := atomic.LoadInt64(&client.forceMine) > 0
+		if !force && (issleep || client.subcfg.BlockIntervalMs > 0) {
+			client.wait()
+			force = atomic.LoadInt64(&client.forceMine) > 0
 		}
 		lastBlock := client.GetCurrentBlock()
 		txs := client.RequestTx(int(types.GetP(lastBlock.Height+1).MaxTxNumber), nil)
-		if len(txs) == 0 {
+		if len(txs) == 0 && !force && !client.subcfg.EmptyBlock {
 			issleep = true
 			continue
 		}
-		issleep = false
 		//check dup
 		txs = client.CheckTxDup(txs)
 		var newblock types.Block
 		newblock.ParentHash = lastBlock.Hash()
 		newblock.Height = lastBlock.Height + 1
-		client.AddTxsToBlock(&newblock, txs)
+		added, oversized := client.AddTxsToBlockWithSize(&newblock, txs, int(client.subcfg.MaxBlockSize))
+		//超过区块大小限制的交易永远打包不了, 从 mempool 中删除, 否则每次都会取到
+		if len(oversized) > 0 {
+			slog.Error("CreateBlock tx too big", "count", len(oversized), "maxBlockSize", client.subcfg.MaxBlockSize)
+			client.DelMempoolTx(oversized)
+		}
+		if len(added) == 0 && !force && !client.subcfg.EmptyBlock {
+			issleep = true
+			continue
+		}
 		//solo 挖矿固定难度
 		newblock.Difficulty = types.GetP(0).PowLimitBits
 		newblock.TxHash = merkle.CalcMerkleRoot(newblock.Txs)
-		newblock.BlockTime = types.Now().Unix()
-		if lastBlock.BlockTime >= newblock.BlockTime {
-			newblock.BlockTime = lastBlock.BlockTime + 1
+		if client.subcfg.BlockTimeStep > 0 {
+			newblock.BlockTime = lastBlock.BlockTime + client.subcfg.BlockTimeStep
+		} else {
+			newblock.BlockTime = types.Now().Unix()
+			if lastBlock.BlockTime >= newblock.BlockTime {
+				newblock.BlockTime = lastBlock.BlockTime + 1
+			}
 		}
 		err := client.WriteBlock(lastBlock.StateHash, &newblock)
 		//判断有没有交易是被删除的，这类交易要从mempool 中删除
@@ -115,5 +175,10 @@ func (client *Client) CreateBlock() {
 			issleep = true
 			continue
 		}
+		//空块以后等待新的交易
+		issleep = len(added) == 0
+		if force {
+			atomic.AddInt64(&client.forceMine, -1)
+		}
 	}
 }
@@ -6,9 +6,13 @@ package solo
 
 import (
 	"testing"
+	"time"
 
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
 	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
 
 	//加载系统内置store, 不要依赖plugin
 	_ "github.com/33cn/chain33/system/dapp/init"
@@ -30,3 +34,101 @@ func TestSolo(t *testing.T) {
 	}
 	mock33.WaitHeight(2)
 }
+
+func newSoloNode(sub string) *testnode.Chain33Mock {
+	cfg, subcfg := testnode.GetDefaultConfig()
+	subcfg.Consensus["solo"] = []byte(sub)
+	return testnode.NewWithConfig(cfg, subcfg, nil)
+}
+
+func TestSoloEmptyBlock(t *testing.T) {
+	mock33 := newSoloNode(`{"genesis":"14KEKbYtKKQm4wMthSK9J4La4nAiidGozt","genesisBlockTime":1514533394,
+		"blockIntervalMs":10,"emptyBlock":true,"blockTimeStep":5}`)
+	defer mock33.Close()
+	mock33.WaitHeight(3)
+	for i := int64(1); i <= 3; i++ {
+		block := mock33.GetBlock(i)
+		assert.Equal(t, 0, len(block.Txs))
+		assert.Equal(t, int64(1514533394)+5*i, block.BlockTime)
+	}
+}
+
+func TestSoloMineBlocks(t *testing.T) {
+	//不会自动出块, 只有 MineBlocks 的时候出块
+	mock33 := newSoloNode(`{"genesis":"14KEKbYtKKQm4wMthSK9J4La4nAiidGozt","genesisBlockTime":1514533394,
+		"waitTxMs":1000000}`)
+	defer mock33.Close()
+	_, err := mock33.GetAPI().QueryConsensusFunc("solo", "MineBlocks", &types.Int64{Data: 0})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	reply, err := mock33.GetAPI().QueryConsensusFunc("solo", "MineBlocks", &types.Int64{Data: 3})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), reply.(*types.ReplyBlockHeight).Height)
+	mock33.WaitHeight(3)
+	txs := util.GenNoneTxs(mock33.GetGenesisKey(), 2)
+	for i := 0; i < len(txs); i++ {
+		mock33.SendTx(txs[i])
+	}
+	reply, err = mock33.GetAPI().QueryConsensusFunc("solo", "MineBlocks", &types.Int64{Data: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), reply.(*types.ReplyBlockHeight).Height)
+	mock33.WaitHeight(4)
+	assert.Equal(t, 2, len(mock33.GetBlock(4).Txs))
+	assert.Equal(t, int64(4), mock33.GetLastBlock().Height)
+}
+
+func TestSoloMaxBlockSize(t *testing.T) {
+	mock33 := newSoloNode(`{"genesis":"14KEKbYtKKQm4wMthSK9J4La4nAiidGozt","genesisBlockTime":1514533394,
+		"waitTxMs":10,"maxBlockSize":1000}`)
+	defer mock33.Close()
+	txs := util.GenNoneTxs(mock33.GetGenesisKey(), 10)
+	for i := 0; i < len(txs); i++ {
+		mock33.SendTx(txs[i])
+	}
+	mock33.Wait()
+	total := 0
+	for i := int64(1); i <= mock33.GetLastBlock().Height; i++ {
+		block := mock33.GetBlock(i)
+		size := 0
+		for _, tx := range block.Txs {
+			size += tx.Size()
+		}
+		assert.True(t, size <= 1000)
+		total += len(block.Txs)
+	}
+	assert.Equal(t, 10, total)
+	assert.True(t, mock33.GetLastBlock().Height > 1)
+}
+
+//超过区块大小限制的交易从 mempool 中删除, 不会一直出空块
+func TestSoloOversizedTx(t *testing.T) {
+	mock33 := newSoloNode(`{"genesis":"14KEKbYtKKQm4wMthSK9J4La4nAiidGozt","genesisBlockTime":1514533394,
+		"waitTxMs":10,"maxBlockSize":1000}`)
+	defer mock33.Close()
+	big := &types.Transaction{Execer: []byte("none"), Payload: make([]byte, 2000), To: address.ExecAddress("none")}
+	big, err := types.FormatTx("none", big)
+	assert.Nil(t, err)
+	big.Sign(types.SECP256K1, mock33.GetGenesisKey())
+	mock33.SendTx(big)
+	txs := util.GenNoneTxs(mock33.GetGenesisKey(), 2)
+	for i := 0; i < len(txs); i++ {
+		mock33.SendTx(txs[i])
+	}
+	mock33.Wait()
+	time.Sleep(100 * time.Millisecond)
+	height := mock33.GetLastBlock().Height
+	total := 0
+	for i := int64(1); i <= height; i++ {
+		block := mock33.GetBlock(i)
+		for _, tx := range block.Txs {
+			assert.NotEqual(t, big.Hash(), tx.Hash())
+		}
+		total += len(block.Txs)
+	}
+	assert.Equal(t, 2, total)
+	reply, err := mock33.GetAPI().GetMempool()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(reply.Txs))
+	//没有可以打包的交易, 不再出块
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, height, mock33.GetLastBlock().Height)
+}
@@ -0,0 +1,249 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schnorr
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/33cn/chain33/common/crypto"
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+)
+
+//MuSig多方签名, 参考MuSig2的两轮协议:
+//1. 每个签名者生成Nonce, 把Public()发给其他签名者, AggregateNonces得到聚合的nonce
+//2. 每个签名者用自己的私钥PartialSign, AggregateSignatures得到一个普通的schnorr签名
+//聚合后的签名可以用AggregatePubKey得到的公钥验证, 链上和单个签名没有区别
+
+const (
+	tagKeyAggList  = "chain33/musig/keyagg list"
+	tagKeyAggCoef  = "chain33/musig/keyagg coef"
+	tagNonceCoef   = "chain33/musig/noncecoef"
+	pubNonceLen    = 2 * pubKeyLen
+	partialSignLen = 32
+)
+
+var (
+	ErrNonceUsed        = errors.New("ErrNonceUsed")
+	ErrInvalidNonce     = errors.New("ErrInvalidNonce")
+	ErrInvalidPartSign  = errors.New("ErrInvalidPartSign")
+	ErrPubKeyNotInGroup = errors.New("ErrPubKeyNotInGroup")
+)
+
+//AggregatePubKey MuSig公钥聚合: X = sum a_i*P_i, a_i = H(L || P_i), L = H(P_1 || ... || P_n)
+//公钥的顺序会影响结果, 所有签名者必须使用相同的顺序
+func AggregatePubKey(pubs []crypto.PubKey) (PubKeySchnorr, error) {
+	x, y, err := aggregatePoint(pubs)
+	if err != nil {
+		return PubKeySchnorr{}, err
+	}
+	return pointToPubKey(x, y), nil
+}
+
+func aggregatePoint(pubs []crypto.PubKey) (*big.Int, *big.Int, error) {
+	if len(pubs) == 0 {
+		return nil, nil, errInvalidPubKey
+	}
+	list := keyAggList(pubs)
+	var x, y *big.Int
+	for _, pub := range pubs {
+		px, py, err := toSchnorrPubKey(pub).point()
+		if err != nil {
+			return nil, nil, errInvalidPubKey
+		}
+		coef := keyAggCoef(list, pub)
+		px, py = curve.ScalarMult(px, py, coef.Bytes())
+		x, y = addPoint(x, y, px, py)
+	}
+	if isInfinity(x, y) {
+		return nil, nil, errInvalidPubKey
+	}
+	return x, y, nil
+}
+
+func keyAggList(pubs []crypto.PubKey) []byte {
+	data := make([][]byte, len(pubs))
+	for i, pub := range pubs {
+		data[i] = pub.Bytes()
+	}
+	return taggedHash(tagKeyAggList, data...)
+}
+
+func keyAggCoef(list []byte, pub crypto.PubKey) *big.Int {
+	coef := new(big.Int).SetBytes(taggedHash(tagKeyAggCoef, list, pub.Bytes()))
+	return coef.Mod(coef, order)
+}
+
+func toSchnorrPubKey(pub crypto.PubKey) PubKeySchnorr {
+	var p PubKeySchnorr
+	copy(p[:], pub.Bytes())
+	return p
+}
+
+//Nonce 签名者的两个秘密nonce, 每次签名都要重新生成, 使用一次之后就会被清除
+type Nonce struct {
+	k1, k2 *big.Int
+	pub    [pubNonceLen]byte
+}
+
+//NewNonce 生成随机的Nonce
+func NewNonce() *Nonce {
+	n := &Nonce{k1: randScalar(), k2: randScalar()}
+	x, y := curve.ScalarBaseMult(n.k1.Bytes())
+	p := pointToPubKey(x, y)
+	copy(n.pub[:pubKeyLen], p[:])
+	x, y = curve.ScalarBaseMult(n.k2.Bytes())
+	p = pointToPubKey(x, y)
+	copy(n.pub[pubKeyLen:], p[:])
+	return n
+}
+
+//Public 发送给其他签名者的公开部分 R1 || R2
+func (n *Nonce) Public() []byte {
+	pub := make([]byte, pubNonceLen)
+	copy(pub, n.pub[:])
+	return pub
+}
+
+//AggregateNonces 聚合所有签名者的公开nonce
+func AggregateNonces(pubNonces [][]byte) ([]byte, error) {
+	var r1x, r1y, r2x, r2y *big.Int
+	for _, pubNonce := range pubNonces {
+		x1, y1, x2, y2, err := parsePubNonce(pubNonce)
+		if err != nil {
+			return nil, err
+		}
+		r1x, r1y = addPoint(r1x, r1y, x1, y1)
+		r2x, r2y = addPoint(r2x, r2y, x2, y2)
+	}
+	if isInfinity(r1x, r1y) || isInfinity(r2x, r2y) {
+		return nil, ErrInvalidNonce
+	}
+	agg := make([]byte, pubNonceLen)
+	p := pointToPubKey(r1x, r1y)
+	copy(agg[:pubKeyLen], p[:])
+	p = pointToPubKey(r2x, r2y)
+	copy(agg[pubKeyLen:], p[:])
+	return agg, nil
+}
+
+func parsePubNonce(pubNonce []byte) (x1, y1, x2, y2 *big.Int, err error) {
+	if len(pubNonce) != pubNonceLen {
+		return nil, nil, nil, nil, ErrInvalidNonce
+	}
+	r1, err := secp256k1.ParsePubKey(pubNonce[:pubKeyLen], curve)
+	if err != nil {
+		return nil, nil, nil, nil, ErrInvalidNonce
+	}
+	r2, err := secp256k1.ParsePubKey(pubNonce[pubKeyLen:], curve)
+	if err != nil {
+		return nil, nil, nil, nil, ErrInvalidNonce
+	}
+	return r1.X, r1.Y, r2.X, r2.Y, nil
+}
+
+//签名会话中所有签名者都相同的参数
+type session struct {
+	aggPub PubKeySchnorr
+	list   []byte
+	b      *big.Int
+	r      []byte
+	negR   bool
+	e      *big.Int
+}
+
+//R = R1 + b*R2, b = H(aggNonce || X || msg)
+func newSession(aggNonce []byte, pubs []crypto.PubKey, msg []byte) (*session, error) {
+	x, y, err := aggregatePoint(pubs)
+	if err != nil {
+		return nil, err
+	}
+	r1x, r1y, r2x, r2y, err := parsePubNonce(aggNonce)
+	if err != nil {
+		return nil, err
+	}
+	s := &session{aggPub: pointToPubKey(x, y), list: keyAggList(pubs)}
+	s.b = new(big.Int).SetBytes(taggedHash(tagNonceCoef, aggNonce, s.aggPub[:], msg))
+	s.b.Mod(s.b, order)
+	bx, by := curve.ScalarMult(r2x, r2y, s.b.Bytes())
+	rx, ry := addPoint(r1x, r1y, bx, by)
+	if isInfinity(rx, ry) {
+		return nil, ErrInvalidNonce
+	}
+	s.r = scalarBytes(rx)
+	s.negR = ry.Bit(0) == 1
+	s.e = challenge(s.r, s.aggPub[:], msg)
+	return s, nil
+}
+
+//PartialSign 签名者的部分签名 s_i = k_i + e*a_i*d_i, k_i = k1 + b*k2
+//nonce签名之后会被清除, 同一个nonce不能用于两次签名
+func PartialSign(priv crypto.PrivKey, nonce *Nonce, aggNonce []byte, pubs []crypto.PubKey, msg []byte) ([]byte, error) {
+	if nonce == nil || nonce.k1 == nil {
+		return nil, ErrNonceUsed
+	}
+	privSchnorr, ok := priv.(PrivKeySchnorr)
+	if !ok {
+		return nil, errInvalidPrivKey
+	}
+	pub := privSchnorr.PubKey()
+	found := false
+	for _, p := range pubs {
+		if bytes.Equal(p.Bytes(), pub.Bytes()) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, ErrPubKeyNotInGroup
+	}
+	s, err := newSession(aggNonce, pubs, msg)
+	if err != nil {
+		return nil, err
+	}
+	k := new(big.Int).Mul(s.b, nonce.k2)
+	k.Add(k, nonce.k1)
+	nonce.k1, nonce.k2 = nil, nil
+	if s.negR {
+		k = negScalar(k)
+	}
+	d := new(big.Int).SetBytes(privSchnorr[:])
+	sig := new(big.Int).Mul(s.e, keyAggCoef(s.list, pub))
+	sig.Mul(sig, d)
+	sig.Add(sig, k)
+	sig.Mod(sig, order)
+	return scalarBytes(sig), nil
+}
+
+//AggregateSignatures 把所有的部分签名合并成 r || sum s_i
+func AggregateSignatures(aggNonce []byte, pubs []crypto.PubKey, msg []byte, partSigs [][]byte) (SignatureSchnorr, error) {
+	var sig SignatureSchnorr
+	s, err := newSession(aggNonce, pubs, msg)
+	if err != nil {
+		return sig, err
+	}
+	sum := new(big.Int)
+	for _, part := range partSigs {
+		if len(part) != partialSignLen {
+			return sig, ErrInvalidPartSign
+		}
+		si := new(big.Int).SetBytes(part)
+		if si.Cmp(order) >= 0 {
+			return sig, ErrInvalidPartSign
+		}
+		sum.Add(sum, si)
+	}
+	sum.Mod(sum, order)
+	copy(sig[:32], s.r)
+	copy(sig[32:], scalarBytes(sum))
+	return sig, nil
+}
+
+func pointToPubKey(x, y *big.Int) PubKeySchnorr {
+	var pub PubKeySchnorr
+	copy(pub[:], (&secp256k1.PublicKey{Curve: curve, X: x, Y: y}).SerializeCompressed())
+	return pub
+}
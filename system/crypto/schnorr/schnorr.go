@@ -0,0 +1,333 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package schnorr secp256k1曲线上的schnorr签名, 支持MuSig公钥聚合和并行的批量验证
+// 公钥使用33字节的压缩格式, 和secp256k1的地址生成方式一致
+// 签名是64字节 r||s, 其中r是R点的x坐标(R的y坐标是偶数), s*G = R + e*P
+package schnorr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/33cn/chain33/common/crypto"
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+)
+
+const (
+	privKeyLen   = 32
+	pubKeyLen    = 33
+	signatureLen = 64
+)
+
+const (
+	tagChallenge = "chain33/schnorr/challenge"
+	tagNonce     = "chain33/schnorr/nonce"
+)
+
+var (
+	curve = secp256k1.S256()
+	order = curve.Params().N
+
+	errInvalidPrivKey   = errors.New("invalid schnorr priv key")
+	errInvalidPubKey    = errors.New("invalid schnorr pub key")
+	errInvalidSignature = errors.New("invalid schnorr signature")
+)
+
+type Driver struct{}
+
+// Ctypto
+func (d Driver) GenKey() (crypto.PrivKey, error) {
+	for {
+		privKeyBytes := crypto.CRandBytes(privKeyLen)
+		if k := new(big.Int).SetBytes(privKeyBytes); k.Sign() != 0 && k.Cmp(order) < 0 {
+			var priv PrivKeySchnorr
+			copy(priv[:], privKeyBytes)
+			return priv, nil
+		}
+	}
+}
+
+func (d Driver) PrivKeyFromBytes(b []byte) (privKey crypto.PrivKey, err error) {
+	if len(b) != privKeyLen {
+		return nil, errInvalidPrivKey
+	}
+	if k := new(big.Int).SetBytes(b); k.Sign() == 0 || k.Cmp(order) >= 0 {
+		return nil, errInvalidPrivKey
+	}
+	var priv PrivKeySchnorr
+	copy(priv[:], b)
+	return priv, nil
+}
+
+func (d Driver) PubKeyFromBytes(b []byte) (pubKey crypto.PubKey, err error) {
+	if len(b) != pubKeyLen {
+		return nil, errInvalidPubKey
+	}
+	if _, err := secp256k1.ParsePubKey(b, curve); err != nil {
+		return nil, errInvalidPubKey
+	}
+	var pub PubKeySchnorr
+	copy(pub[:], b)
+	return pub, nil
+}
+
+func (d Driver) SignatureFromBytes(b []byte) (sig crypto.Signature, err error) {
+	if len(b) != signatureLen {
+		return nil, errInvalidSignature
+	}
+	var s SignatureSchnorr
+	copy(s[:], b)
+	return s, nil
+}
+
+//VerifyBatch 并行的逐个验证签名, 只要有一个签名无效, 整个批量验证失败
+//用随机系数把验证方程合并成一个需要多标量乘法(Strauss/Pippenger)才能比逐个验证快,
+//btcec没有导出雅可比坐标的运算, 用仿射坐标的点加法合并反而比逐个验证慢
+func (d Driver) VerifyBatch(pubs []crypto.PubKey, msgs [][]byte, sigs []crypto.Signature) bool {
+	if len(pubs) != len(msgs) || len(pubs) != len(sigs) {
+		return false
+	}
+	for i := range pubs {
+		if _, ok := pubs[i].(PubKeySchnorr); !ok {
+			return false
+		}
+	}
+	workers := runtime.NumCPU()
+	if workers > len(pubs) {
+		workers = len(pubs)
+	}
+	var failed int32
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(pubs); i += workers {
+				if atomic.LoadInt32(&failed) != 0 {
+					return
+				}
+				if !pubs[i].VerifyBytes(msgs[i], sigs[i]) {
+					atomic.StoreInt32(&failed, 1)
+					return
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	return failed == 0
+}
+
+// PrivKey
+type PrivKeySchnorr [privKeyLen]byte
+
+func (privKey PrivKeySchnorr) Bytes() []byte {
+	s := make([]byte, privKeyLen)
+	copy(s, privKey[:])
+	return s
+}
+
+//Sign nonce由私钥和消息确定性生成, 避免随机数生成器的问题导致私钥泄露
+func (privKey PrivKeySchnorr) Sign(msg []byte) crypto.Signature {
+	d := new(big.Int).SetBytes(privKey[:])
+	pub := privKey.PubKey().(PubKeySchnorr)
+	k := new(big.Int).SetBytes(taggedHash(tagNonce, privKey[:], pub[:], msg))
+	k.Mod(k, order)
+	if k.Sign() == 0 {
+		panic("Error signing schnorr: invalid nonce")
+	}
+	rx, ry := curve.ScalarBaseMult(k.Bytes())
+	if ry.Bit(0) == 1 {
+		k.Sub(order, k)
+	}
+	var sig SignatureSchnorr
+	copy(sig[:32], scalarBytes(rx))
+	e := challenge(sig[:32], pub[:], msg)
+	s := new(big.Int).Mul(e, d)
+	s.Add(s, k)
+	s.Mod(s, order)
+	copy(sig[32:], scalarBytes(s))
+	return sig
+}
+
+func (privKey PrivKeySchnorr) PubKey() crypto.PubKey {
+	_, pub := secp256k1.PrivKeyFromBytes(curve, privKey[:])
+	var pubSchnorr PubKeySchnorr
+	copy(pubSchnorr[:], pub.SerializeCompressed())
+	return pubSchnorr
+}
+
+func (privKey PrivKeySchnorr) Equals(other crypto.PrivKey) bool {
+	if otherSchnorr, ok := other.(PrivKeySchnorr); ok {
+		return bytes.Equal(privKey[:], otherSchnorr[:])
+	}
+	return false
+}
+
+func (privKey PrivKeySchnorr) String() string {
+	return fmt.Sprintf("PrivKeySchnorr{*****}")
+}
+
+// PubKey
+type PubKeySchnorr [pubKeyLen]byte
+
+func (pubKey PubKeySchnorr) Bytes() []byte {
+	s := make([]byte, pubKeyLen)
+	copy(s, pubKey[:])
+	return s
+}
+
+func (pubKey PubKeySchnorr) VerifyBytes(msg []byte, sig crypto.Signature) bool {
+	sigSchnorr, ok := sig.(SignatureSchnorr)
+	if !ok {
+		return false
+	}
+	px, py, err := pubKey.point()
+	if err != nil {
+		return false
+	}
+	r, s, ok := sigSchnorr.parse()
+	if !ok {
+		return false
+	}
+	//R = s*G - e*P
+	e := challenge(sigSchnorr[:32], pubKey[:], msg)
+	sx, sy := curve.ScalarBaseMult(s.Bytes())
+	ex, ey := curve.ScalarMult(px, py, negScalar(e).Bytes())
+	rx, ry := addPoint(sx, sy, ex, ey)
+	if isInfinity(rx, ry) || ry.Bit(0) == 1 {
+		return false
+	}
+	return rx.Cmp(r) == 0
+}
+
+func (pubKey PubKeySchnorr) String() string {
+	return fmt.Sprintf("PubKeySchnorr{%X}", pubKey[:])
+}
+
+// Must return the full bytes in hex.
+// Used for map keying, etc.
+func (pubKey PubKeySchnorr) KeyString() string {
+	return fmt.Sprintf("%X", pubKey[:])
+}
+
+func (pubKey PubKeySchnorr) Equals(other crypto.PubKey) bool {
+	if otherSchnorr, ok := other.(PubKeySchnorr); ok {
+		return bytes.Equal(pubKey[:], otherSchnorr[:])
+	}
+	return false
+}
+
+func (pubKey PubKeySchnorr) point() (*big.Int, *big.Int, error) {
+	pub, err := secp256k1.ParsePubKey(pubKey[:], curve)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pub.X, pub.Y, nil
+}
+
+// Signature
+type SignatureSchnorr [signatureLen]byte
+
+func (sig SignatureSchnorr) Bytes() []byte {
+	s := make([]byte, signatureLen)
+	copy(s, sig[:])
+	return s
+}
+
+func (sig SignatureSchnorr) IsZero() bool { return len(sig) == 0 }
+
+func (sig SignatureSchnorr) String() string {
+	return fmt.Sprintf("/%X.../", sig[:])
+}
+
+func (sig SignatureSchnorr) Equals(other crypto.Signature) bool {
+	if otherSchnorr, ok := other.(SignatureSchnorr); ok {
+		return bytes.Equal(sig[:], otherSchnorr[:])
+	}
+	return false
+}
+
+//r必须小于域的大小p, s必须小于曲线的阶n
+func (sig SignatureSchnorr) parse() (*big.Int, *big.Int, bool) {
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if r.Cmp(curve.Params().P) >= 0 || s.Cmp(order) >= 0 {
+		return nil, nil, false
+	}
+	return r, s, true
+}
+
+//e = H(r || P || msg) mod n
+func challenge(r []byte, pub []byte, msg []byte) *big.Int {
+	e := new(big.Int).SetBytes(taggedHash(tagChallenge, r, pub, msg))
+	return e.Mod(e, order)
+}
+
+//带标签的hash: sha256(sha256(tag) || sha256(tag) || data), 不同用途的hash不会互相冲突
+func taggedHash(tag string, data ...[]byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+func randScalar() *big.Int {
+	for {
+		k := new(big.Int).SetBytes(crypto.CRandBytes(32))
+		k.Mod(k, order)
+		if k.Sign() != 0 {
+			return k
+		}
+	}
+}
+
+func negScalar(k *big.Int) *big.Int {
+	neg := new(big.Int).Mod(k, order)
+	if neg.Sign() == 0 {
+		return neg
+	}
+	return neg.Sub(order, neg)
+}
+
+//nil表示无穷远点
+func addPoint(x1, y1, x2, y2 *big.Int) (*big.Int, *big.Int) {
+	if x1 == nil {
+		return x2, y2
+	}
+	if x2 == nil {
+		return x1, y1
+	}
+	return curve.Add(x1, y1, x2, y2)
+}
+
+func isInfinity(x, y *big.Int) bool {
+	return x == nil || (x.Sign() == 0 && y.Sign() == 0)
+}
+
+//大端序补齐到32字节
+func scalarBytes(k *big.Int) []byte {
+	b := make([]byte, 32)
+	kb := k.Bytes()
+	copy(b[32-len(kb):], kb)
+	return b
+}
+
+const Name = "schnorr"
+const ID = 6
+
+func init() {
+	crypto.Register(Name, &Driver{})
+	crypto.RegisterType(Name, ID)
+}
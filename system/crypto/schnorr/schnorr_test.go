@@ -0,0 +1,173 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schnorr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func genKeys(t testing.TB, n int) ([]crypto.PrivKey, []crypto.PubKey) {
+	var d Driver
+	privs := make([]crypto.PrivKey, n)
+	pubs := make([]crypto.PubKey, n)
+	for i := 0; i < n; i++ {
+		priv, err := d.GenKey()
+		require.NoError(t, err)
+		privs[i] = priv
+		pubs[i] = priv.PubKey()
+	}
+	return privs, pubs
+}
+
+func TestSignVerify(t *testing.T) {
+	var d Driver
+	privs, pubs := genKeys(t, 2)
+	msg := []byte("hello schnorr")
+	sig := privs[0].Sign(msg)
+	assert.Equal(t, signatureLen, len(sig.Bytes()))
+	//确定性签名
+	assert.True(t, sig.Equals(privs[0].Sign(msg)))
+	assert.True(t, pubs[0].VerifyBytes(msg, sig))
+	assert.False(t, pubs[1].VerifyBytes(msg, sig))
+	assert.False(t, pubs[0].VerifyBytes([]byte("hello"), sig))
+
+	bad := sig.(SignatureSchnorr)
+	bad[63] ^= 1
+	assert.False(t, pubs[0].VerifyBytes(msg, bad))
+	//s >= n 的签名无效
+	var overflow SignatureSchnorr
+	copy(overflow[:32], sig.Bytes()[:32])
+	copy(overflow[32:], scalarBytes(order))
+	assert.False(t, pubs[0].VerifyBytes(msg, overflow))
+
+	_, err := d.SignatureFromBytes(sig.Bytes()[:63])
+	assert.NotNil(t, err)
+	_, err = d.PrivKeyFromBytes(make([]byte, 32))
+	assert.NotNil(t, err)
+	_, err = d.PubKeyFromBytes(make([]byte, 33))
+	assert.NotNil(t, err)
+
+	//和secp256k1使用相同格式的公钥
+	_, pub := secp256k1.PrivKeyFromBytes(curve, privs[0].Bytes())
+	assert.Equal(t, pub.SerializeCompressed(), pubs[0].Bytes())
+}
+
+func TestVerifyBatch(t *testing.T) {
+	var d Driver
+	n := 20
+	privs, pubs := genKeys(t, n)
+	msgs := make([][]byte, n)
+	sigs := make([]crypto.Signature, n)
+	for i := 0; i < n; i++ {
+		msgs[i] = []byte(fmt.Sprintf("msg %d", i))
+		sigs[i] = privs[i].Sign(msgs[i])
+	}
+	assert.True(t, d.VerifyBatch(pubs, msgs, sigs))
+	assert.True(t, d.VerifyBatch(pubs[:1], msgs[:1], sigs[:1]))
+	assert.True(t, d.VerifyBatch(nil, nil, nil))
+	assert.False(t, d.VerifyBatch(pubs, msgs[1:], sigs))
+
+	//任何一个签名错误都会导致批量验证失败
+	sigs[7], sigs[8] = sigs[8], sigs[7]
+	assert.False(t, d.VerifyBatch(pubs, msgs, sigs))
+	sigs[7], sigs[8] = sigs[8], sigs[7]
+	msgs[n-1] = []byte("changed")
+	assert.False(t, d.VerifyBatch(pubs, msgs, sigs))
+}
+
+func TestMuSig(t *testing.T) {
+	n := 3
+	privs, pubs := genKeys(t, n)
+	msg := []byte("musig message")
+	aggPub, err := AggregatePubKey(pubs)
+	require.NoError(t, err)
+
+	//多次签名验证, 覆盖R的y坐标是奇数的情况
+	for round := 0; round < 8; round++ {
+		nonces := make([]*Nonce, n)
+		pubNonces := make([][]byte, n)
+		for i := 0; i < n; i++ {
+			nonces[i] = NewNonce()
+			pubNonces[i] = nonces[i].Public()
+		}
+		aggNonce, err := AggregateNonces(pubNonces)
+		require.NoError(t, err)
+		parts := make([][]byte, n)
+		for i := 0; i < n; i++ {
+			parts[i], err = PartialSign(privs[i], nonces[i], aggNonce, pubs, msg)
+			require.NoError(t, err)
+		}
+		sig, err := AggregateSignatures(aggNonce, pubs, msg, parts)
+		require.NoError(t, err)
+		assert.True(t, aggPub.VerifyBytes(msg, sig))
+		for i := 0; i < n; i++ {
+			assert.False(t, pubs[i].VerifyBytes(msg, sig))
+		}
+
+		//nonce只能使用一次
+		_, err = PartialSign(privs[0], nonces[0], aggNonce, pubs, msg)
+		assert.Equal(t, ErrNonceUsed, err)
+		//缺少一个部分签名
+		sig, err = AggregateSignatures(aggNonce, pubs, msg, parts[1:])
+		require.NoError(t, err)
+		assert.False(t, aggPub.VerifyBytes(msg, sig))
+	}
+
+	//不在签名组中的私钥不能签名
+	others, _ := genKeys(t, 1)
+	nonce := NewNonce()
+	aggNonce, err := AggregateNonces([][]byte{nonce.Public()})
+	require.NoError(t, err)
+	_, err = PartialSign(others[0], nonce, aggNonce, pubs, msg)
+	assert.Equal(t, ErrPubKeyNotInGroup, err)
+
+	//公钥顺序不同, 聚合的公钥不同
+	aggPub2, err := AggregatePubKey([]crypto.PubKey{pubs[1], pubs[0], pubs[2]})
+	require.NoError(t, err)
+	assert.False(t, aggPub.Equals(aggPub2))
+	_, err = AggregateNonces([][]byte{make([]byte, pubNonceLen)})
+	assert.Equal(t, ErrInvalidNonce, err)
+}
+
+func benchSigs(b *testing.B, n int) ([]crypto.PubKey, [][]byte, []crypto.Signature) {
+	privs, pubs := genKeys(b, n)
+	msgs := make([][]byte, n)
+	sigs := make([]crypto.Signature, n)
+	for i := 0; i < n; i++ {
+		msgs[i] = []byte(fmt.Sprintf("msg %d", i))
+		sigs[i] = privs[i].Sign(msgs[i])
+	}
+	return pubs, msgs, sigs
+}
+
+//逐个验证 100 个签名, 和 BenchmarkVerifyBatch 比较
+func BenchmarkVerify(b *testing.B) {
+	pubs, msgs, sigs := benchSigs(b, 100)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range pubs {
+			if !pubs[j].VerifyBytes(msgs[j], sigs[j]) {
+				b.Fatal("verify failed")
+			}
+		}
+	}
+}
+
+func BenchmarkVerifyBatch(b *testing.B) {
+	var d Driver
+	pubs, msgs, sigs := benchSigs(b, 100)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !d.VerifyBatch(pubs, msgs, sigs) {
+			b.Fatal("verify batch failed")
+		}
+	}
+}
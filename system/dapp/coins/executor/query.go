@@ -24,6 +24,10 @@ func (c *Coins) Query_GetAddrTxsCount(in *types.ReqKey) (types.Message, error) {
 	return c.GetAddrTxsCount(in)
 }
 
+func (c *Coins) Query_GetLogs(in *types.ReqGetLogs) (types.Message, error) {
+	return c.GetLogs(in)
+}
+
 func (c *Coins) GetAddrReciver(addr *types.ReqAddr) (types.Message, error) {
 	reciver := types.Int64{}
 	db := c.GetLocalDB()
@@ -25,10 +25,14 @@ func AccountCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		DeriveAccountCmd(),
+		DiscoverAccountsCmd(),
 		DumpKeyCmd(),
+		ExtendedPubKeyCmd(),
 		GetAccountListCmd(),
 		GetBalanceCmd(),
 		ImportKeyCmd(),
+		ImportWatchOnlyCmd(),
 		NewAccountCmd(),
 		SetLabelCmd(),
 	)
@@ -93,7 +97,7 @@ func parseListAccountRes(arg interface{}) (interface{}, error) {
 			Balance:  balanceResult,
 			Frozen:   frozenResult,
 		}
-		result.Wallets = append(result.Wallets, &WalletResult{Acc: accResult, Label: r.Label})
+		result.Wallets = append(result.Wallets, &WalletResult{Acc: accResult, Label: r.Label, WatchOnly: r.WatchOnly})
 	}
 	return result, nil
 }
@@ -250,12 +254,51 @@ func parseImportKeyRes(arg interface{}) (interface{}, error) {
 	res := arg.(*types.WalletAccount)
 	accResult := DecodeAccount(res.GetAcc(), types.Coin)
 	result := WalletResult{
-		Acc:   accResult,
-		Label: res.GetLabel(),
+		Acc:       accResult,
+		Label:     res.GetLabel(),
+		WatchOnly: res.GetWatchOnly(),
 	}
 	return result, nil
 }
 
+// import watch-only account
+func ImportWatchOnlyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import_watch",
+		Short: "Import watch-only account by address or public key",
+		Run:   importWatchOnly,
+	}
+	addImportWatchOnlyFlags(cmd)
+	return cmd
+}
+
+func addImportWatchOnlyFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("addr", "a", "", "account address")
+	cmd.Flags().StringP("pubkey", "p", "", "public key of the account")
+	cmd.Flags().StringP("label", "l", "", "label for the account")
+	cmd.MarkFlagRequired("label")
+}
+
+func importWatchOnly(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	pubkey, _ := cmd.Flags().GetString("pubkey")
+	label, _ := cmd.Flags().GetString("label")
+	if addr == "" && pubkey == "" {
+		fmt.Fprintln(os.Stderr, "addr or pubkey is required")
+		return
+	}
+	params := types.ReqImportWatchOnly{
+		Addr:   addr,
+		Pubkey: pubkey,
+		Label:  label,
+	}
+	var res types.WalletAccount
+	ctx := jsonclient.NewRpcCtx(rpcLaddr, "Chain33.ImportWatchOnly", params, &res)
+	ctx.SetResultCb(parseImportKeyRes)
+	ctx.Run()
+}
+
 // create an account
 func NewAccountCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -336,3 +379,82 @@ func parseSetLabelRes(arg interface{}) (interface{}, error) {
 	}
 	return result, nil
 }
+
+// derive account by bip44 path
+func DeriveAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "derive",
+		Short: "Derive account from wallet seed by BIP44 path",
+		Run:   deriveAccount,
+	}
+	addDeriveAccountFlags(cmd)
+	return cmd
+}
+
+func addDeriveAccountFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("path", "p", "", "BIP44 path, e.g. m/44'/13107'/0'/0/1")
+	cmd.MarkFlagRequired("path")
+	cmd.Flags().StringP("label", "l", "", "account label, default is the path")
+}
+
+func deriveAccount(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	path, _ := cmd.Flags().GetString("path")
+	label, _ := cmd.Flags().GetString("label")
+	params := types.ReqDeriveAccount{
+		Label: label,
+		Path:  path,
+	}
+	var res types.WalletAccount
+	ctx := jsonclient.NewRpcCtx(rpcLaddr, "Chain33.DeriveAccount", params, &res)
+	ctx.SetResultCb(parseCreateAccountRes)
+	ctx.Run()
+}
+
+// export extended public key
+func ExtendedPubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "xpub",
+		Short: "Export extended public key of a BIP44 account for watch-only wallet",
+		Run:   extendedPubKey,
+	}
+	cmd.Flags().Uint32P("account", "n", 0, "BIP44 account number")
+	return cmd
+}
+
+func extendedPubKey(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	account, _ := cmd.Flags().GetUint32("account")
+	params := types.ReqExtendedPubKey{
+		Account: account,
+	}
+	var res types.ReplyString
+	ctx := jsonclient.NewRpcCtx(rpcLaddr, "Chain33.GetExtendedPubKey", params, &res)
+	ctx.Run()
+}
+
+// discover used accounts by scanning chain history
+func DiscoverAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "discover",
+		Short: "Discover used BIP44 accounts by scanning chain history",
+		Run:   discoverAccounts,
+	}
+	cmd.Flags().Uint32P("account", "n", 0, "BIP44 account number")
+	cmd.Flags().Int32P("gap", "g", 20, "stop after gap consecutive unused addresses")
+	return cmd
+}
+
+func discoverAccounts(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	account, _ := cmd.Flags().GetUint32("account")
+	gap, _ := cmd.Flags().GetInt32("gap")
+	params := types.ReqDiscoverAccounts{
+		Account:  account,
+		GapLimit: gap,
+	}
+	var res rpctypes.WalletAccounts
+	ctx := jsonclient.NewRpcCtx(rpcLaddr, "Chain33.DiscoverAccounts", params, &res)
+	ctx.SetResultCb(parseListAccountRes)
+	ctx.Run()
+}
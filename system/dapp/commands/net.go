@@ -9,6 +9,7 @@ import (
 
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
 )
 
 func NetCmd() *cobra.Command {
@@ -25,6 +26,9 @@ func NetCmd() *cobra.Command {
 		GetNetInfoCmd(),
 		GetFatalFailureCmd(),
 		GetTimeStausCmd(),
+		GetPeerScoresCmd(),
+		BanPeerCmd(),
+		UnbanPeerCmd(),
 	)
 
 	return cmd
@@ -131,3 +135,80 @@ func timestatus(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRpcCtx(rpcLaddr, "Chain33.GetTimeStatus", nil, &res)
 	ctx.Run()
 }
+
+// get peer scores
+func GetPeerScoresCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "peer_scores",
+		Short: "Get peer scores and ban status",
+		Run:   peerScores,
+	}
+	return cmd
+}
+
+func peerScores(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res types.PeerScoreList
+	ctx := jsonclient.NewRpcCtx(rpcLaddr, "Chain33.GetPeerScores", nil, &res)
+	ctx.Run()
+}
+
+// ban peer
+func BanPeerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ban",
+		Short: "Ban peer",
+		Run:   banPeer,
+	}
+	addBanPeerFlags(cmd)
+	return cmd
+}
+
+func addBanPeerFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("addr", "a", "", "peer address, ip:port")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().Int64P("duration", "d", 0, "ban duration in seconds, 0 means forever")
+	cmd.Flags().StringP("reason", "r", "", "ban reason")
+}
+
+func banPeer(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	duration, _ := cmd.Flags().GetInt64("duration")
+	reason, _ := cmd.Flags().GetString("reason")
+	params := types.ReqBanPeer{
+		Addr:     addr,
+		Duration: duration,
+		Reason:   reason,
+	}
+	var res rpctypes.Reply
+	ctx := jsonclient.NewRpcCtx(rpcLaddr, "Chain33.BanPeer", params, &res)
+	ctx.Run()
+}
+
+// unban peer
+func UnbanPeerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unban",
+		Short: "Unban peer",
+		Run:   unbanPeer,
+	}
+	addUnbanPeerFlags(cmd)
+	return cmd
+}
+
+func addUnbanPeerFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("addr", "a", "", "peer address, ip:port")
+	cmd.MarkFlagRequired("addr")
+}
+
+func unbanPeer(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	params := types.ReqString{
+		Data: addr,
+	}
+	var res rpctypes.Reply
+	ctx := jsonclient.NewRpcCtx(rpcLaddr, "Chain33.UnbanPeer", params, &res)
+	ctx.Run()
+}
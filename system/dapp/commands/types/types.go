@@ -13,8 +13,9 @@ type AccountsResult struct {
 }
 
 type WalletResult struct {
-	Acc   *AccountResult `json:"acc,omitempty"`
-	Label string         `json:"label,omitempty"`
+	Acc       *AccountResult `json:"acc,omitempty"`
+	Label     string         `json:"label,omitempty"`
+	WatchOnly bool           `json:"watchOnly,omitempty"`
 }
 
 type AccountResult struct {
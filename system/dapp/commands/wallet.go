@@ -5,7 +5,9 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strconv"
 	"time"
@@ -35,9 +37,12 @@ func WalletCmd() *cobra.Command {
 		MergeBalanceCmd(),
 		AutoMineCmd(),
 		SignRawTxCmd(),
+		CreateOfflineTxCmd(),
 		NoBalanceCmd(),
 		SetFeeCmd(),
 		SendTxCmd(),
+		ExportWalletCmd(),
+		ImportWalletCmd(),
 	)
 
 	return cmd
@@ -304,7 +309,7 @@ func SignRawTxCmd() *cobra.Command {
 
 func addSignRawTxFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("data", "d", "", "raw transaction data")
-	cmd.MarkFlagRequired("data")
+	cmd.Flags().StringP("offline", "o", "", "offline transaction file exported by create_offline (replace data and addr)")
 
 	cmd.Flags().Int32P("index", "i", 0, "transaction index to be signed")
 	cmd.Flags().StringP("key", "k", "", "private key (optional)")
@@ -400,6 +405,25 @@ func signRawTx(cmd *cobra.Command, args []string) {
 	addr, _ := cmd.Flags().GetString("addr")
 	index, _ := cmd.Flags().GetInt32("index")
 	expire, _ := cmd.Flags().GetString("expire")
+	offline, _ := cmd.Flags().GetString("offline")
+	if offline != "" {
+		tx, err := readOfflineTx(offline)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		data = tx.TxHex
+		if addr == "" && key == "" {
+			addr = tx.Addr
+		}
+		if !cmd.Flags().Changed("expire") && tx.Expire != "" {
+			expire = tx.Expire
+		}
+	}
+	if data == "" {
+		fmt.Fprintln(os.Stderr, "data or offline is required")
+		return
+	}
 	expire, err := parseExpireOpt(expire)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -417,6 +441,77 @@ func signRawTx(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+func readOfflineTx(file string) (*types.OfflineTx, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var tx types.OfflineTx
+	err = json.Unmarshal(data, &tx)
+	if err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+// create unsigned transfer tx for watch-only account
+func CreateOfflineTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create_offline",
+		Short: "Create unsigned transfer transaction to be signed on an offline node",
+		Run:   createOfflineTx,
+	}
+	addCreateOfflineTxFlags(cmd)
+	return cmd
+}
+
+func addCreateOfflineTxFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("from", "f", "", "sender account address in wallet")
+	cmd.MarkFlagRequired("from")
+	cmd.Flags().StringP("to", "t", "", "receiver account address")
+	cmd.MarkFlagRequired("to")
+	cmd.Flags().Float64P("amount", "a", 0, "transaction amount")
+	cmd.MarkFlagRequired("amount")
+	cmd.Flags().StringP("note", "n", "", "transaction note info")
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.Flags().StringP("out", "o", "", "write the offline transaction to file")
+}
+
+func createOfflineTx(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	note, _ := cmd.Flags().GetString("note")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	out, _ := cmd.Flags().GetString("out")
+	amountInt64 := int64(amount*1e4) * 1e4
+	params := types.ReqWalletSendToAddress{
+		From:        from,
+		To:          to,
+		Amount:      amountInt64,
+		Note:        note,
+		IsToken:     symbol != "",
+		TokenSymbol: symbol,
+	}
+	var res types.OfflineTx
+	ctx := jsonclient.NewRpcCtx(rpcLaddr, "Chain33.CreateOfflineTx", params, &res)
+	if out != "" {
+		ctx.SetResultCb(func(arg interface{}) (interface{}, error) {
+			data, err := json.MarshalIndent(arg, "", "    ")
+			if err != nil {
+				return nil, err
+			}
+			err = ioutil.WriteFile(out, data, 0600)
+			if err != nil {
+				return nil, err
+			}
+			return out, nil
+		})
+	}
+	ctx.Run()
+}
+
 // set tx fee
 func SetFeeCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -475,3 +570,77 @@ func sendTx(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRpcCtx(rpcLaddr, "Chain33.SendTransaction", params, nil)
 	ctx.RunWithoutMarshal()
 }
+
+// export encrypted wallet backup
+func ExportWalletCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export seed and accounts to an encrypted backup file",
+		Run:   exportWallet,
+	}
+	addExportWalletFlags(cmd)
+	return cmd
+}
+
+func addExportWalletFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("passwd", "p", "", "password to encrypt the backup")
+	cmd.MarkFlagRequired("passwd")
+	cmd.Flags().StringP("out", "o", "", "backup file")
+	cmd.MarkFlagRequired("out")
+}
+
+func exportWallet(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	passwd, _ := cmd.Flags().GetString("passwd")
+	out, _ := cmd.Flags().GetString("out")
+	params := types.ReqWalletExport{
+		Passwd: passwd,
+	}
+	var res types.ReplyString
+	ctx := jsonclient.NewRpcCtx(rpcLaddr, "Chain33.ExportWallet", params, &res)
+	ctx.SetResultCb(func(arg interface{}) (interface{}, error) {
+		err := ioutil.WriteFile(out, []byte(arg.(*types.ReplyString).Data), 0600)
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	})
+	ctx.Run()
+}
+
+// import encrypted wallet backup
+func ImportWalletCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Restore seed and accounts from an encrypted backup file",
+		Run:   importWallet,
+	}
+	addImportWalletFlags(cmd)
+	return cmd
+}
+
+func addImportWalletFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("passwd", "p", "", "password of the backup")
+	cmd.MarkFlagRequired("passwd")
+	cmd.Flags().StringP("file", "f", "", "backup file")
+	cmd.MarkFlagRequired("file")
+}
+
+func importWallet(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	passwd, _ := cmd.Flags().GetString("passwd")
+	file, _ := cmd.Flags().GetString("file")
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	params := types.ReqWalletImport{
+		Data:   string(data),
+		Passwd: passwd,
+	}
+	var res rpctypes.WalletAccounts
+	ctx := jsonclient.NewRpcCtx(rpcLaddr, "Chain33.ImportWallet", params, &res)
+	ctx.SetResultCb(parseListAccountRes)
+	ctx.Run()
+}
@@ -7,5 +7,6 @@ package init
 import (
 	_ "github.com/33cn/chain33/system/dapp/coins"
 	_ "github.com/33cn/chain33/system/dapp/manage"
+	_ "github.com/33cn/chain33/system/dapp/multisig"
 	_ "github.com/33cn/chain33/system/dapp/none"
 )
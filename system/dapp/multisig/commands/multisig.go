@@ -0,0 +1,395 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/spf13/cobra"
+)
+
+func MultiSigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig",
+		Short: "Multi-signature account management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		MultiSigAccountCmd(),
+		MultiSigTxCmd(),
+		MultiSigWalletCmd(),
+	)
+
+	return cmd
+}
+
+func MultiSigAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account",
+		Short: "Create and query multi-signature accounts",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		CreateAccountCmd(),
+		AccountInfoCmd(),
+		AccountsByOwnerCmd(),
+	)
+	return cmd
+}
+
+func MultiSigTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx",
+		Short: "Transfer and confirm on multi-signature accounts",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		TransferInCmd(),
+		TransferOutCmd(),
+		ConfirmTxCmd(),
+		RevokeTxCmd(),
+		TxInfoCmd(),
+		PendingTxsCmd(),
+	)
+	return cmd
+}
+
+func MultiSigWalletCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wallet",
+		Short: "Collect signatures with the owner keys in wallet",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		WalletPendingTxsCmd(),
+		WalletConfirmTxCmd(),
+	)
+	return cmd
+}
+
+func toAmount(amount float64) int64 {
+	return int64(amount*types.InputPrecision) * types.Multiple1E4 //支持4位小数输入，多余的输入将被截断
+}
+
+func createMultiSigTx(cmd *cobra.Command, action string, param types.Message) {
+	paraName, _ := cmd.Flags().GetString("paraName")
+	txHex, err := types.CallCreateTx(util.GetRealExecName(paraName, mty.MultiSigX), action, param)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(hex.EncodeToString(txHex))
+}
+
+func queryMultiSig(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params types.Query4Cli
+	params.Execer = util.GetRealExecName(paraName, mty.MultiSigX)
+	params.FuncName = funcName
+	params.Payload = req
+	ctx := jsonclient.NewRpcCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
+
+// create account
+func CreateAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a m-of-n multi-signature account",
+		Run:   createAccount,
+	}
+	addCreateAccountFlags(cmd)
+	return cmd
+}
+
+func addCreateAccountFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("owners", "o", "", "owner addresses, separated by ','")
+	cmd.MarkFlagRequired("owners")
+
+	cmd.Flags().Int64P("required", "r", 0, "confirmations required to execute a transfer")
+	cmd.MarkFlagRequired("required")
+
+	cmd.Flags().StringP("limits", "l", "", "daily limits as execer:symbol:amount, separated by ','")
+}
+
+func parseDailyLimits(limits string) ([]*mty.DailyLimit, error) {
+	var dailyLimits []*mty.DailyLimit
+	if limits == "" {
+		return nil, nil
+	}
+	for _, item := range strings.Split(limits, ",") {
+		fields := strings.Split(item, ":")
+		if len(fields) != 3 {
+			return nil, errors.New("daily limit should be execer:symbol:amount")
+		}
+		amount, err := strconv.ParseFloat(fields[2], 64)
+		if err != nil {
+			return nil, err
+		}
+		dailyLimits = append(dailyLimits, &mty.DailyLimit{Execer: fields[0], Symbol: fields[1], Limit: toAmount(amount)})
+	}
+	return dailyLimits, nil
+}
+
+func createAccount(cmd *cobra.Command, args []string) {
+	owners, _ := cmd.Flags().GetString("owners")
+	required, _ := cmd.Flags().GetInt64("required")
+	limits, _ := cmd.Flags().GetString("limits")
+	dailyLimits, err := parseDailyLimits(limits)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	create := &mty.MultiSigAccCreate{
+		Owners:      strings.Split(owners, ","),
+		Required:    required,
+		DailyLimits: dailyLimits,
+	}
+	createMultiSigTx(cmd, "AccCreate", create)
+}
+
+// account info
+func AccountInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "Query multi-signature account info",
+		Run:   accountInfo,
+	}
+	cmd.Flags().StringP("addr", "a", "", "multi-signature account address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func accountInfo(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	var res mty.MultiSigAccount
+	queryMultiSig(cmd, "MultiSigAccountInfo", &types.ReqString{Data: addr}, &res)
+}
+
+// accounts by owner
+func AccountsByOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner",
+		Short: "List multi-signature accounts of an owner",
+		Run:   accountsByOwner,
+	}
+	cmd.Flags().StringP("addr", "a", "", "owner address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func accountsByOwner(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	var res mty.ReplyMultiSigAccounts
+	queryMultiSig(cmd, "MultiSigAccountsByOwner", &types.ReqString{Data: addr}, &res)
+}
+
+// transfer in
+func TransferInCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer_in",
+		Short: "Transfer from your multisig exec balance into a multi-signature account",
+		Run:   transferIn,
+	}
+	addTransferFlags(cmd)
+	return cmd
+}
+
+func addTransferFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("addr", "a", "", "multi-signature account address")
+	cmd.MarkFlagRequired("addr")
+
+	cmd.Flags().StringP("execer", "e", "coins", "asset execer")
+	cmd.Flags().StringP("symbol", "s", "bty", "asset symbol")
+
+	cmd.Flags().Float64P("amount", "m", 0, "transaction amount")
+	cmd.MarkFlagRequired("amount")
+
+	cmd.Flags().StringP("note", "n", "", "transaction note info")
+}
+
+func transferIn(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	execer, _ := cmd.Flags().GetString("execer")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	note, _ := cmd.Flags().GetString("note")
+	transfer := &mty.MultiSigExecTransferTo{
+		Execer:       execer,
+		Symbol:       symbol,
+		Amount:       toAmount(amount),
+		MultiSigAddr: addr,
+		Note:         note,
+	}
+	createMultiSigTx(cmd, "ExecTransferTo", transfer)
+}
+
+// transfer out
+func TransferOutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer_out",
+		Short: "Submit a transfer out of a multi-signature account",
+		Run:   transferOut,
+	}
+	addTransferFlags(cmd)
+	cmd.Flags().StringP("to", "t", "", "receiver address")
+	cmd.MarkFlagRequired("to")
+	return cmd
+}
+
+func transferOut(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	execer, _ := cmd.Flags().GetString("execer")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	note, _ := cmd.Flags().GetString("note")
+	to, _ := cmd.Flags().GetString("to")
+	transfer := &mty.MultiSigExecTransferFrom{
+		MultiSigAddr: addr,
+		Execer:       execer,
+		Symbol:       symbol,
+		Amount:       toAmount(amount),
+		To:           to,
+		Note:         note,
+	}
+	createMultiSigTx(cmd, "ExecTransferFrom", transfer)
+}
+
+func addTxIDFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("addr", "a", "", "multi-signature account address")
+	cmd.MarkFlagRequired("addr")
+
+	cmd.Flags().Int64P("txid", "i", 0, "transfer id in the account")
+	cmd.MarkFlagRequired("txid")
+}
+
+// confirm
+func ConfirmTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm",
+		Short: "Confirm a pending transfer",
+		Run: func(cmd *cobra.Command, args []string) {
+			confirmTx(cmd, true)
+		},
+	}
+	addTxIDFlags(cmd)
+	return cmd
+}
+
+// revoke
+func RevokeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke your confirmation of a pending transfer",
+		Run: func(cmd *cobra.Command, args []string) {
+			confirmTx(cmd, false)
+		},
+	}
+	addTxIDFlags(cmd)
+	return cmd
+}
+
+func confirmTx(cmd *cobra.Command, confirm bool) {
+	addr, _ := cmd.Flags().GetString("addr")
+	txid, _ := cmd.Flags().GetInt64("txid")
+	createMultiSigTx(cmd, "ConfirmTx", &mty.MultiSigConfirmTx{MultiSigAddr: addr, Txid: txid, Confirm: confirm})
+}
+
+// tx info
+func TxInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "Query a transfer of a multi-signature account",
+		Run:   txInfo,
+	}
+	addTxIDFlags(cmd)
+	return cmd
+}
+
+func txInfo(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	txid, _ := cmd.Flags().GetInt64("txid")
+	var res mty.MultiSigTx
+	queryMultiSig(cmd, "MultiSigTxInfo", &mty.ReqMultiSigTx{MultiSigAddr: addr, Txid: txid}, &res)
+}
+
+// pending txs
+func PendingTxsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending",
+		Short: "List pending transfers of a multi-signature account",
+		Run:   pendingTxs,
+	}
+	cmd.Flags().StringP("addr", "a", "", "multi-signature account address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func pendingTxs(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	var res mty.ReplyMultiSigTxs
+	queryMultiSig(cmd, "MultiSigPendingTxs", &types.ReqString{Data: addr}, &res)
+}
+
+func execWallet(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	payload, err := types.PBToJson(req)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	params := rpctypes.ChainExecutor{
+		Driver:   mty.MultiSigX,
+		FuncName: funcName,
+		Payload:  payload,
+	}
+	ctx := jsonclient.NewRpcCtx(rpcLaddr, "Chain33.ExecWallet", params, res)
+	ctx.Run()
+}
+
+// wallet pending
+func WalletPendingTxsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending",
+		Short: "List pending transfers of accounts owned by the wallet",
+		Run:   walletPendingTxs,
+	}
+	return cmd
+}
+
+func walletPendingTxs(cmd *cobra.Command, args []string) {
+	var res mty.ReplyMultiSigTxs
+	execWallet(cmd, "MultiSigPendingTxs", &types.ReqNil{}, &res)
+}
+
+// wallet confirm
+func WalletConfirmTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm",
+		Short: "Confirm a pending transfer with all owner keys in the wallet",
+		Run:   walletConfirmTx,
+	}
+	addTxIDFlags(cmd)
+	return cmd
+}
+
+func walletConfirmTx(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	txid, _ := cmd.Flags().GetInt64("txid")
+	var res types.ReplyHashes
+	execWallet(cmd, "MultiSigConfirmTx", &mty.ReqMultiSigTx{MultiSigAddr: addr, Txid: txid}, &res)
+}
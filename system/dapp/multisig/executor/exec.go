@@ -0,0 +1,30 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+)
+
+func (m *MultiSig) Exec_AccCreate(payload *mty.MultiSigAccCreate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(m, tx)
+	return action.accCreate(payload)
+}
+
+func (m *MultiSig) Exec_ExecTransferTo(payload *mty.MultiSigExecTransferTo, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(m, tx)
+	return action.execTransferTo(payload)
+}
+
+func (m *MultiSig) Exec_ExecTransferFrom(payload *mty.MultiSigExecTransferFrom, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(m, tx)
+	return action.execTransferFrom(payload)
+}
+
+func (m *MultiSig) Exec_ConfirmTx(payload *mty.MultiSigConfirmTx, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(m, tx)
+	return action.confirmTx(payload)
+}
@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+)
+
+func (m *MultiSig) ExecDelLocal_AccCreate(payload *mty.MultiSigAccCreate, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return m.execLocal(receipt, true)
+}
+
+func (m *MultiSig) ExecDelLocal_ExecTransferTo(payload *mty.MultiSigExecTransferTo, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return m.execLocal(receipt, true)
+}
+
+func (m *MultiSig) ExecDelLocal_ExecTransferFrom(payload *mty.MultiSigExecTransferFrom, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return m.execLocal(receipt, true)
+}
+
+func (m *MultiSig) ExecDelLocal_ConfirmTx(payload *mty.MultiSigConfirmTx, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return m.execLocal(receipt, true)
+}
@@ -0,0 +1,92 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+)
+
+// owner -> 多重签名账户 的索引
+func ownerKey(owner, addr string) []byte {
+	return []byte(fmt.Sprintf("LODB-multisig-owner-%s-%s", owner, addr))
+}
+
+// 还没有执行的转出请求
+func pendingKey(addr string, txid int64) []byte {
+	return []byte(fmt.Sprintf("LODB-multisig-pending-%s-%020d", addr, txid))
+}
+
+func ownerPrefix(owner string) []byte {
+	return []byte(fmt.Sprintf("LODB-multisig-owner-%s-", owner))
+}
+
+func pendingPrefix(addr string) []byte {
+	return []byte(fmt.Sprintf("LODB-multisig-pending-%s-", addr))
+}
+
+func pendingValue(mtx *mty.MultiSigTx) []byte {
+	if mtx == nil || mtx.Executed {
+		return nil
+	}
+	return types.Encode(mtx)
+}
+
+func (m *MultiSig) execLocal(receipt *types.ReceiptData, isDel bool) (*types.LocalDBSet, error) {
+	set := &types.LocalDBSet{}
+	if receipt.GetTy() != types.ExecOk {
+		return set, nil
+	}
+	for _, item := range receipt.Logs {
+		switch item.Ty {
+		case mty.TyLogMultiSigAccount:
+			var log mty.ReceiptMultiSigAccount
+			err := types.Decode(item.Log, &log)
+			if err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
+			if log.Prev != nil {
+				continue
+			}
+			for _, owner := range log.Current.Owners {
+				var value []byte
+				if !isDel {
+					value = []byte(log.Current.MultiSigAddr)
+				}
+				set.KV = append(set.KV, &types.KeyValue{Key: ownerKey(owner, log.Current.MultiSigAddr), Value: value})
+			}
+		case mty.TyLogMultiSigTx:
+			var log mty.ReceiptMultiSigTx
+			err := types.Decode(item.Log, &log)
+			if err != nil {
+				panic(err)
+			}
+			value := pendingValue(log.Current)
+			if isDel {
+				value = pendingValue(log.Prev)
+			}
+			set.KV = append(set.KV, &types.KeyValue{Key: pendingKey(log.Current.MultiSigAddr, log.Current.Txid), Value: value})
+		}
+	}
+	return set, nil
+}
+
+func (m *MultiSig) ExecLocal_AccCreate(payload *mty.MultiSigAccCreate, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return m.execLocal(receipt, false)
+}
+
+func (m *MultiSig) ExecLocal_ExecTransferTo(payload *mty.MultiSigExecTransferTo, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return m.execLocal(receipt, false)
+}
+
+func (m *MultiSig) ExecLocal_ExecTransferFrom(payload *mty.MultiSigExecTransferFrom, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return m.execLocal(receipt, false)
+}
+
+func (m *MultiSig) ExecLocal_ConfirmTx(payload *mty.MultiSigConfirmTx, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return m.execLocal(receipt, false)
+}
@@ -0,0 +1,57 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+/*
+multisig 多重签名账户
+ 1. 任意地址可以创建一个 m-of-n 的多重签名账户
+ 1. 任意地址可以把自己在 multisig 合约中的余额转入多重签名账户
+ 1. owner 提交转出请求, 达到 required 个确认之后执行
+ 1. 在每日限额之内的转出, 只要一个 owner 提交就可以执行
+*/
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	_ "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	clog       = log.New("module", "execs.multisig")
+	driverName = "multisig"
+)
+
+func init() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&MultiSig{}))
+}
+
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newMultiSig, types.GetDappFork(driverName, "Enable"))
+}
+
+func GetName() string {
+	return newMultiSig().GetName()
+}
+
+type MultiSig struct {
+	drivers.DriverBase
+}
+
+func newMultiSig() drivers.Driver {
+	m := &MultiSig{}
+	m.SetChild(m)
+	m.SetExecutorType(types.LoadExecutorType(driverName))
+	return m
+}
+
+func (m *MultiSig) GetDriverName() string {
+	return driverName
+}
+
+func (m *MultiSig) CheckTx(tx *types.Transaction, index int) error {
+	return nil
+}
@@ -0,0 +1,208 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system"
+)
+
+func newMultiSigNode() *testnode.Chain33Mock {
+	//区块时间从 2018-01-01 开始每个区块加 1 秒, 保证所有区块在同一天
+	cfg, sub := testnode.GetDefaultConfig()
+	sub.Consensus["solo"] = []byte(`{"genesis":"14KEKbYtKKQm4wMthSK9J4La4nAiidGozt","genesisBlockTime":1514764800,"blockTimeStep":1}`)
+	return testnode.NewWithConfig(cfg, sub, nil)
+}
+
+func addrOf(priv crypto.PrivKey) string {
+	return address.PubKeyToAddress(priv.PubKey().Bytes()).String()
+}
+
+func waitTx(t *testing.T, mock33 *testnode.Chain33Mock, tx *types.Transaction) *types.TransactionDetail {
+	hash := mock33.SendTx(tx)
+	for i := 0; i < 100; i++ {
+		detail, err := mock33.GetAPI().QueryTx(&types.ReqHash{Hash: hash})
+		if err == nil {
+			return detail
+		}
+		time.Sleep(time.Second / 10)
+	}
+	t.Fatal("wait tx timeout")
+	return nil
+}
+
+func sendMultiSigTx(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, action string, param types.Message) *types.TransactionDetail {
+	txbytes, err := types.CallCreateTx(mty.MultiSigX, action, param)
+	assert.Nil(t, err)
+	var tx types.Transaction
+	assert.Nil(t, types.Decode(txbytes, &tx))
+	tx.Sign(types.SECP256K1, priv)
+	return waitTx(t, mock33, &tx)
+}
+
+func query(t *testing.T, mock33 *testnode.Chain33Mock, funcName string, param types.Message) types.Message {
+	msg, err := mock33.GetAPI().Query(mty.MultiSigX, funcName, param)
+	assert.Nil(t, err)
+	return msg
+}
+
+func execBalance(mock33 *testnode.Chain33Mock, addr string) int64 {
+	acc, err := account.NewCoinsAccount().LoadExecAccountQueue(mock33.GetAPI(), addr, address.ExecAddress(mty.MultiSigX))
+	if err != nil {
+		panic(err)
+	}
+	return acc.Balance
+}
+
+// genesis 先转入 multisig 合约, 再转入多重签名账户
+func deposit(t *testing.T, mock33 *testnode.Chain33Mock, priv crypto.PrivKey, msaddr string, amount int64) {
+	execaddr := address.ExecAddress(mty.MultiSigX)
+	tx, err := types.LoadExecutorType("coins").CreateTransaction("TransferToExec",
+		&types.AssetsTransferToExec{Cointoken: "bty", Amount: amount, ExecName: mty.MultiSigX, To: execaddr})
+	assert.Nil(t, err)
+	tx.To = execaddr
+	tx, err = types.FormatTx("coins", tx)
+	assert.Nil(t, err)
+	tx.Sign(types.SECP256K1, priv)
+	detail := waitTx(t, mock33, tx)
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	detail = sendMultiSigTx(t, mock33, priv, "ExecTransferTo",
+		&mty.MultiSigExecTransferTo{Execer: "coins", Symbol: "bty", Amount: amount, MultiSigAddr: msaddr})
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+}
+
+func TestMultiSig(t *testing.T) {
+	mock33 := newMultiSigNode()
+	defer mock33.Close()
+	genesis := mock33.GetGenesisKey()
+	owners := []crypto.PrivKey{util.TestPrivkeyList[2], util.TestPrivkeyList[3], util.TestPrivkeyList[4]}
+	var ownerAddrs []string
+	for _, owner := range owners {
+		ownerAddrs = append(ownerAddrs, addrOf(owner))
+		detail := waitTx(t, mock33, util.CreateCoinsTx(genesis, addrOf(owner), 10*types.Coin))
+		assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	}
+
+	//参数错误
+	create := &mty.MultiSigAccCreate{Owners: ownerAddrs, Required: 4}
+	detail := sendMultiSigTx(t, mock33, genesis, "AccCreate", create)
+	assert.Equal(t, int32(types.ExecPack), detail.Receipt.Ty)
+
+	//2-of-3, 每日限额 5 bty
+	create = &mty.MultiSigAccCreate{Owners: ownerAddrs, Required: 2,
+		DailyLimits: []*mty.DailyLimit{{Execer: "coins", Symbol: "bty", Limit: 5 * types.Coin}}}
+	detail = sendMultiSigTx(t, mock33, genesis, "AccCreate", create)
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	msaddr := mty.MultiSigAddress(detail.Tx.Hash())
+	acc := query(t, mock33, "MultiSigAccountInfo", &types.ReqString{Data: msaddr}).(*mty.MultiSigAccount)
+	assert.Equal(t, ownerAddrs, acc.Owners)
+	assert.Equal(t, int64(2), acc.Required)
+	for _, owner := range ownerAddrs {
+		reply := query(t, mock33, "MultiSigAccountsByOwner", &types.ReqString{Data: owner}).(*mty.ReplyMultiSigAccounts)
+		assert.Equal(t, []string{msaddr}, reply.Addrs)
+	}
+
+	deposit(t, mock33, genesis, msaddr, 50*types.Coin)
+	assert.Equal(t, 50*types.Coin, execBalance(mock33, msaddr))
+
+	//不是 owner 不能提交
+	to := addrOf(util.TestPrivkeyList[5])
+	detail = sendMultiSigTx(t, mock33, genesis, "ExecTransferFrom",
+		&mty.MultiSigExecTransferFrom{MultiSigAddr: msaddr, Execer: "coins", Symbol: "bty", Amount: types.Coin, To: to})
+	assert.Equal(t, int32(types.ExecPack), detail.Receipt.Ty)
+
+	//每日限额之内, 一个 owner 就可以转出
+	detail = sendMultiSigTx(t, mock33, owners[0], "ExecTransferFrom",
+		&mty.MultiSigExecTransferFrom{MultiSigAddr: msaddr, Execer: "coins", Symbol: "bty", Amount: 3 * types.Coin, To: to})
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	assert.Equal(t, 3*types.Coin, execBalance(mock33, to))
+	mtx := query(t, mock33, "MultiSigTxInfo", &mty.ReqMultiSigTx{MultiSigAddr: msaddr, Txid: 0}).(*mty.MultiSigTx)
+	assert.True(t, mtx.Executed)
+
+	//超过每日限额, 需要确认
+	detail = sendMultiSigTx(t, mock33, owners[0], "ExecTransferFrom",
+		&mty.MultiSigExecTransferFrom{MultiSigAddr: msaddr, Execer: "coins", Symbol: "bty", Amount: 3 * types.Coin, To: to})
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	assert.Equal(t, 3*types.Coin, execBalance(mock33, to))
+	pending := query(t, mock33, "MultiSigPendingTxs", &types.ReqString{Data: msaddr}).(*mty.ReplyMultiSigTxs)
+	assert.Equal(t, 1, len(pending.Txs))
+	assert.Equal(t, int64(1), pending.Txs[0].Txid)
+
+	//撤销确认之后, 需要两个新的确认
+	confirm := &mty.MultiSigConfirmTx{MultiSigAddr: msaddr, Txid: 1, Confirm: false}
+	detail = sendMultiSigTx(t, mock33, owners[0], "ConfirmTx", confirm)
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	detail = sendMultiSigTx(t, mock33, owners[0], "ConfirmTx", confirm)
+	assert.Equal(t, int32(types.ExecPack), detail.Receipt.Ty)
+	confirm.Confirm = true
+	detail = sendMultiSigTx(t, mock33, owners[1], "ConfirmTx", confirm)
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	detail = sendMultiSigTx(t, mock33, owners[1], "ConfirmTx", confirm)
+	assert.Equal(t, int32(types.ExecPack), detail.Receipt.Ty)
+	assert.Equal(t, 3*types.Coin, execBalance(mock33, to))
+	detail = sendMultiSigTx(t, mock33, owners[2], "ConfirmTx", confirm)
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	assert.Equal(t, 6*types.Coin, execBalance(mock33, to))
+	assert.Equal(t, 44*types.Coin, execBalance(mock33, msaddr))
+	pending = query(t, mock33, "MultiSigPendingTxs", &types.ReqString{Data: msaddr}).(*mty.ReplyMultiSigTxs)
+	assert.Equal(t, 0, len(pending.Txs))
+
+	//已经执行的请求不能再确认
+	detail = sendMultiSigTx(t, mock33, owners[0], "ConfirmTx", confirm)
+	assert.Equal(t, int32(types.ExecPack), detail.Receipt.Ty)
+}
+
+func TestMultiSigWalletConfirm(t *testing.T) {
+	mock33 := newMultiSigNode()
+	defer mock33.Close()
+	genesis := mock33.GetGenesisKey()
+	//owner 的私钥都在钱包里面
+	owners := []crypto.PrivKey{util.TestPrivkeyList[2], util.TestPrivkeyList[3], util.TestPrivkeyList[4]}
+	var ownerAddrs []string
+	for _, owner := range owners {
+		ownerAddrs = append(ownerAddrs, addrOf(owner))
+		waitTx(t, mock33, util.CreateCoinsTx(genesis, addrOf(owner), 10*types.Coin))
+	}
+	detail := sendMultiSigTx(t, mock33, genesis, "AccCreate", &mty.MultiSigAccCreate{Owners: ownerAddrs, Required: 3})
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	msaddr := mty.MultiSigAddress(detail.Tx.Hash())
+	deposit(t, mock33, genesis, msaddr, 10*types.Coin)
+	to := addrOf(util.TestPrivkeyList[5])
+	detail = sendMultiSigTx(t, mock33, owners[0], "ExecTransferFrom",
+		&mty.MultiSigExecTransferFrom{MultiSigAddr: msaddr, Execer: "coins", Symbol: "bty", Amount: types.Coin, To: to})
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+
+	msg, err := mock33.GetAPI().ExecWalletFunc(mty.MultiSigX, "MultiSigPendingTxs", &types.ReqNil{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(msg.(*mty.ReplyMultiSigTxs).Txs))
+
+	msg, err = mock33.GetAPI().ExecWalletFunc(mty.MultiSigX, "MultiSigConfirmTx", &mty.ReqMultiSigTx{MultiSigAddr: msaddr, Txid: 0})
+	assert.Nil(t, err)
+	hashes := msg.(*types.ReplyHashes).Hashes
+	assert.Equal(t, 2, len(hashes))
+	for _, hash := range hashes {
+		for {
+			if _, err := mock33.GetAPI().QueryTx(&types.ReqHash{Hash: hash}); err == nil {
+				break
+			}
+			time.Sleep(time.Second / 10)
+		}
+	}
+	mtx := query(t, mock33, "MultiSigTxInfo", &mty.ReqMultiSigTx{MultiSigAddr: msaddr, Txid: 0}).(*mty.MultiSigTx)
+	assert.Equal(t, 3, len(mtx.ConfirmedOwners))
+	assert.True(t, mtx.Executed)
+	assert.Equal(t, types.Coin, execBalance(mock33, to))
+}
@@ -0,0 +1,291 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+)
+
+func accountKey(addr string) []byte {
+	return []byte(fmt.Sprintf("mavl-multisig-account-%s", addr))
+}
+
+func txKey(addr string, txid int64) []byte {
+	return []byte(fmt.Sprintf("mavl-multisig-tx-%s-%020d", addr, txid))
+}
+
+func getMultiSigAccount(db dbm.KV, addr string) (*mty.MultiSigAccount, error) {
+	value, err := db.Get(accountKey(addr))
+	if err != nil || value == nil {
+		return nil, mty.ErrMultiSigAccNoExist
+	}
+	var acc mty.MultiSigAccount
+	err = types.Decode(value, &acc)
+	if err != nil {
+		return nil, err
+	}
+	return &acc, nil
+}
+
+func getMultiSigTx(db dbm.KV, addr string, txid int64) (*mty.MultiSigTx, error) {
+	value, err := db.Get(txKey(addr, txid))
+	if err != nil || value == nil {
+		return nil, mty.ErrMultiSigTxNoExist
+	}
+	var tx mty.MultiSigTx
+	err = types.Decode(value, &tx)
+	if err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+func isOwner(acc *mty.MultiSigAccount, addr string) bool {
+	for _, owner := range acc.Owners {
+		if owner == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func findDailyLimit(acc *mty.MultiSigAccount, execer, symbol string) *mty.DailyLimit {
+	for _, limit := range acc.DailyLimits {
+		if limit.Execer == execer && limit.Symbol == symbol {
+			return limit
+		}
+	}
+	return nil
+}
+
+type Action struct {
+	db        dbm.KV
+	fromaddr  string
+	txhash    []byte
+	blocktime int64
+	execaddr  string
+}
+
+func NewAction(m *MultiSig, tx *types.Transaction) *Action {
+	return &Action{db: m.GetStateDB(), fromaddr: tx.From(), txhash: tx.Hash(),
+		blocktime: m.GetBlockTime(), execaddr: dapp.ExecAddress(string(tx.Execer))}
+}
+
+func (a *Action) accountReceipt(prev, current *mty.MultiSigAccount) *types.Receipt {
+	key, value := accountKey(current.MultiSigAddr), types.Encode(current)
+	a.db.Set(key, value)
+	kv := []*types.KeyValue{{Key: key, Value: value}}
+	log := &types.ReceiptLog{Ty: mty.TyLogMultiSigAccount, Log: types.Encode(&mty.ReceiptMultiSigAccount{Prev: prev, Current: current})}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: []*types.ReceiptLog{log}}
+}
+
+func (a *Action) txReceipt(prev, current *mty.MultiSigTx) *types.Receipt {
+	key, value := txKey(current.MultiSigAddr, current.Txid), types.Encode(current)
+	a.db.Set(key, value)
+	kv := []*types.KeyValue{{Key: key, Value: value}}
+	log := &types.ReceiptLog{Ty: mty.TyLogMultiSigTx, Log: types.Encode(&mty.ReceiptMultiSigTx{Prev: prev, Current: current})}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: []*types.ReceiptLog{log}}
+}
+
+func mergeReceipt(receipt, receipt2 *types.Receipt) *types.Receipt {
+	receipt.KV = append(receipt.KV, receipt2.KV...)
+	receipt.Logs = append(receipt.Logs, receipt2.Logs...)
+	return receipt
+}
+
+func (a *Action) accCreate(create *mty.MultiSigAccCreate) (*types.Receipt, error) {
+	if len(create.Owners) == 0 || len(create.Owners) > mty.MaxOwners {
+		return nil, mty.ErrOwnersCount
+	}
+	owners := make(map[string]bool)
+	for _, owner := range create.Owners {
+		if err := address.CheckAddress(owner); err != nil {
+			return nil, err
+		}
+		if owners[owner] {
+			return nil, mty.ErrOwnerDuplicate
+		}
+		owners[owner] = true
+	}
+	if create.Required <= 0 || create.Required > int64(len(create.Owners)) {
+		return nil, mty.ErrRequiredCount
+	}
+	var limits []*mty.DailyLimit
+	assets := make(map[string]bool)
+	for _, limit := range create.DailyLimits {
+		if limit.Execer == "" || limit.Symbol == "" || limit.Limit < 0 {
+			return nil, mty.ErrDailyLimitValue
+		}
+		if _, err := account.NewAccountDB(limit.Execer, limit.Symbol, a.db); err != nil {
+			return nil, err
+		}
+		asset := account.SymbolPrefix(limit.Execer, limit.Symbol)
+		if assets[asset] {
+			return nil, mty.ErrDailyLimitDup
+		}
+		assets[asset] = true
+		limits = append(limits, &mty.DailyLimit{Execer: limit.Execer, Symbol: limit.Symbol, Limit: limit.Limit})
+	}
+	addr := mty.MultiSigAddress(a.txhash)
+	if _, err := getMultiSigAccount(a.db, addr); err == nil {
+		return nil, mty.ErrMultiSigAccExist
+	}
+	acc := &mty.MultiSigAccount{
+		CreateAddr:   a.fromaddr,
+		MultiSigAddr: addr,
+		Owners:       create.Owners,
+		Required:     create.Required,
+		DailyLimits:  limits,
+	}
+	clog.Info("multisig accCreate", "addr", addr, "owners", create.Owners, "required", create.Required)
+	return a.accountReceipt(nil, acc), nil
+}
+
+// 转入: 从 from 在 multisig 合约中的余额转到多重签名账户在 multisig 合约中的余额
+func (a *Action) execTransferTo(transfer *mty.MultiSigExecTransferTo) (*types.Receipt, error) {
+	if transfer.Amount <= 0 {
+		return nil, mty.ErrInvalidAmount
+	}
+	if _, err := getMultiSigAccount(a.db, transfer.MultiSigAddr); err != nil {
+		return nil, err
+	}
+	accDB, err := account.NewAccountDB(transfer.Execer, transfer.Symbol, a.db)
+	if err != nil {
+		return nil, err
+	}
+	return accDB.ExecTransfer(a.fromaddr, transfer.MultiSigAddr, a.execaddr, transfer.Amount)
+}
+
+// 转出: owner 提交请求, 确认数达到 required 或者在每日限额之内就立即执行
+func (a *Action) execTransferFrom(transfer *mty.MultiSigExecTransferFrom) (*types.Receipt, error) {
+	if transfer.Amount <= 0 {
+		return nil, mty.ErrInvalidAmount
+	}
+	if err := address.CheckAddress(transfer.To); err != nil {
+		return nil, err
+	}
+	acc, err := getMultiSigAccount(a.db, transfer.MultiSigAddr)
+	if err != nil {
+		return nil, err
+	}
+	if !isOwner(acc, a.fromaddr) {
+		return nil, mty.ErrNotOwner
+	}
+	accDB, err := account.NewAccountDB(transfer.Execer, transfer.Symbol, a.db)
+	if err != nil {
+		return nil, err
+	}
+	prev := proto.Clone(acc).(*mty.MultiSigAccount)
+	mtx := &mty.MultiSigTx{
+		MultiSigAddr:    acc.MultiSigAddr,
+		Txid:            acc.TxCount,
+		Execer:          transfer.Execer,
+		Symbol:          transfer.Symbol,
+		Amount:          transfer.Amount,
+		To:              transfer.To,
+		Note:            transfer.Note,
+		ConfirmedOwners: []string{a.fromaddr},
+		TxHash:          hex.EncodeToString(a.txhash),
+	}
+	acc.TxCount++
+
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	if int64(len(mtx.ConfirmedOwners)) >= acc.Required {
+		r, err := a.executeTx(accDB, mtx)
+		if err != nil {
+			return nil, err
+		}
+		mergeReceipt(receipt, r)
+	} else if limit := findDailyLimit(acc, mtx.Execer, mtx.Symbol); limit != nil {
+		day := mty.GetDay(a.blocktime)
+		spent := limit.Spent
+		if limit.LastDay != day {
+			spent = 0
+		}
+		if mtx.Amount <= limit.Limit-spent {
+			r, err := a.executeTx(accDB, mtx)
+			if err != nil {
+				return nil, err
+			}
+			mergeReceipt(receipt, r)
+			limit.Spent = spent + mtx.Amount
+			limit.LastDay = day
+		}
+	}
+	mergeReceipt(receipt, a.accountReceipt(prev, acc))
+	mergeReceipt(receipt, a.txReceipt(nil, mtx))
+	return receipt, nil
+}
+
+// 确认或者撤销确认, 确认数达到 required 的时候执行
+func (a *Action) confirmTx(confirm *mty.MultiSigConfirmTx) (*types.Receipt, error) {
+	acc, err := getMultiSigAccount(a.db, confirm.MultiSigAddr)
+	if err != nil {
+		return nil, err
+	}
+	if !isOwner(acc, a.fromaddr) {
+		return nil, mty.ErrNotOwner
+	}
+	mtx, err := getMultiSigTx(a.db, confirm.MultiSigAddr, confirm.Txid)
+	if err != nil {
+		return nil, err
+	}
+	if mtx.Executed {
+		return nil, mty.ErrTxExecuted
+	}
+	prev := proto.Clone(mtx).(*mty.MultiSigTx)
+	index := -1
+	for i, owner := range mtx.ConfirmedOwners {
+		if owner == a.fromaddr {
+			index = i
+			break
+		}
+	}
+
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	if confirm.Confirm {
+		if index >= 0 {
+			return nil, mty.ErrAlreadyConfirmed
+		}
+		mtx.ConfirmedOwners = append(mtx.ConfirmedOwners, a.fromaddr)
+		if int64(len(mtx.ConfirmedOwners)) >= acc.Required {
+			accDB, err := account.NewAccountDB(mtx.Execer, mtx.Symbol, a.db)
+			if err != nil {
+				return nil, err
+			}
+			r, err := a.executeTx(accDB, mtx)
+			if err != nil {
+				return nil, err
+			}
+			mergeReceipt(receipt, r)
+		}
+	} else {
+		if index < 0 {
+			return nil, mty.ErrNotConfirmed
+		}
+		mtx.ConfirmedOwners = append(mtx.ConfirmedOwners[:index:index], mtx.ConfirmedOwners[index+1:]...)
+	}
+	mergeReceipt(receipt, a.txReceipt(prev, mtx))
+	return receipt, nil
+}
+
+func (a *Action) executeTx(accDB *account.DB, mtx *mty.MultiSigTx) (*types.Receipt, error) {
+	receipt, err := accDB.ExecTransfer(mtx.MultiSigAddr, mtx.To, a.execaddr, mtx.Amount)
+	if err != nil {
+		clog.Error("multisig executeTx", "addr", mtx.MultiSigAddr, "txid", mtx.Txid, "err", err)
+		return nil, err
+	}
+	mtx.Executed = true
+	return receipt, nil
+}
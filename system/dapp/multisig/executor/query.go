@@ -0,0 +1,55 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	dbm "github.com/33cn/chain33/common/db"
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+)
+
+func (m *MultiSig) Query_MultiSigAccountInfo(in *types.ReqString) (types.Message, error) {
+	return getMultiSigAccount(m.GetStateDB(), in.Data)
+}
+
+func (m *MultiSig) Query_MultiSigTxInfo(in *mty.ReqMultiSigTx) (types.Message, error) {
+	return getMultiSigTx(m.GetStateDB(), in.MultiSigAddr, in.Txid)
+}
+
+func (m *MultiSig) Query_MultiSigAccountsByOwner(in *types.ReqString) (types.Message, error) {
+	values, err := m.GetLocalDB().List(ownerPrefix(in.Data), nil, 0, dbm.ListASC)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &mty.ReplyMultiSigAccounts{}
+	for _, value := range values {
+		//删除的记录在有些数据库中是空值
+		if len(value) == 0 {
+			continue
+		}
+		reply.Addrs = append(reply.Addrs, string(value))
+	}
+	return reply, nil
+}
+
+func (m *MultiSig) Query_MultiSigPendingTxs(in *types.ReqString) (types.Message, error) {
+	values, err := m.GetLocalDB().List(pendingPrefix(in.Data), nil, 0, dbm.ListASC)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &mty.ReplyMultiSigTxs{}
+	for _, value := range values {
+		if len(value) == 0 {
+			continue
+		}
+		var mtx mty.MultiSigTx
+		err = types.Decode(value, &mtx)
+		if err != nil {
+			return nil, err
+		}
+		reply.Txs = append(reply.Txs, &mtx)
+	}
+	return reply, nil
+}
@@ -0,0 +1,23 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package multisig
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/chain33/system/dapp/multisig/commands"
+	"github.com/33cn/chain33/system/dapp/multisig/executor"
+	"github.com/33cn/chain33/system/dapp/multisig/types"
+	_ "github.com/33cn/chain33/system/dapp/multisig/wallet"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.MultiSigX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.MultiSigCmd,
+		RPC:      nil,
+	})
+}
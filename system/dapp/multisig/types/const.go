@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// multisig action
+const (
+	MultiSigActionAccCreate = iota + 1
+	MultiSigActionExecTransferTo
+	MultiSigActionExecTransferFrom
+	MultiSigActionConfirmTx
+)
+
+// log
+const (
+	TyLogMultiSigAccount = 510
+	TyLogMultiSigTx      = 511
+)
+
+const (
+	//MaxOwners 一个多重签名账户最多的owner数目
+	MaxOwners = 20
+	//OneDaySecond 每日限额按照区块时间换算成天
+	OneDaySecond = 86400
+)
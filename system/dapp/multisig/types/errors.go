@@ -0,0 +1,23 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	ErrOwnersCount        = errors.New("ErrOwnersCount")
+	ErrOwnerDuplicate     = errors.New("ErrOwnerDuplicate")
+	ErrRequiredCount      = errors.New("ErrRequiredCount")
+	ErrDailyLimitDup      = errors.New("ErrDailyLimitDup")
+	ErrDailyLimitValue    = errors.New("ErrDailyLimitValue")
+	ErrMultiSigAccExist   = errors.New("ErrMultiSigAccExist")
+	ErrMultiSigAccNoExist = errors.New("ErrMultiSigAccNoExist")
+	ErrMultiSigTxNoExist  = errors.New("ErrMultiSigTxNoExist")
+	ErrNotOwner           = errors.New("ErrNotOwner")
+	ErrTxExecuted         = errors.New("ErrTxExecuted")
+	ErrAlreadyConfirmed   = errors.New("ErrAlreadyConfirmed")
+	ErrNotConfirmed       = errors.New("ErrNotConfirmed")
+	ErrInvalidAmount      = errors.New("ErrInvalidAmount")
+)
@@ -0,0 +1,771 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: multisig.proto
+
+/*
+Package types is a generated protocol buffer package.
+
+It is generated from these files:
+	multisig.proto
+
+It has these top-level messages:
+	MultiSigAction
+	DailyLimit
+	MultiSigAccount
+	MultiSigAccCreate
+	MultiSigExecTransferTo
+	MultiSigExecTransferFrom
+	MultiSigConfirmTx
+	MultiSigTx
+	ReceiptMultiSigAccount
+	ReceiptMultiSigTx
+	ReqMultiSigTx
+	ReplyMultiSigTxs
+	ReplyMultiSigAccounts
+*/
+package types
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type MultiSigAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*MultiSigAction_AccCreate
+	//	*MultiSigAction_ExecTransferTo
+	//	*MultiSigAction_ExecTransferFrom
+	//	*MultiSigAction_ConfirmTx
+	Value isMultiSigAction_Value `protobuf_oneof:"value"`
+	Ty    int32                  `protobuf:"varint,5,opt,name=ty" json:"ty,omitempty"`
+}
+
+func (m *MultiSigAction) Reset()                    { *m = MultiSigAction{} }
+func (m *MultiSigAction) String() string            { return proto.CompactTextString(m) }
+func (*MultiSigAction) ProtoMessage()               {}
+func (*MultiSigAction) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type isMultiSigAction_Value interface {
+	isMultiSigAction_Value()
+}
+
+type MultiSigAction_AccCreate struct {
+	AccCreate *MultiSigAccCreate `protobuf:"bytes,1,opt,name=accCreate,oneof"`
+}
+type MultiSigAction_ExecTransferTo struct {
+	ExecTransferTo *MultiSigExecTransferTo `protobuf:"bytes,2,opt,name=execTransferTo,oneof"`
+}
+type MultiSigAction_ExecTransferFrom struct {
+	ExecTransferFrom *MultiSigExecTransferFrom `protobuf:"bytes,3,opt,name=execTransferFrom,oneof"`
+}
+type MultiSigAction_ConfirmTx struct {
+	ConfirmTx *MultiSigConfirmTx `protobuf:"bytes,4,opt,name=confirmTx,oneof"`
+}
+
+func (*MultiSigAction_AccCreate) isMultiSigAction_Value()        {}
+func (*MultiSigAction_ExecTransferTo) isMultiSigAction_Value()   {}
+func (*MultiSigAction_ExecTransferFrom) isMultiSigAction_Value() {}
+func (*MultiSigAction_ConfirmTx) isMultiSigAction_Value()        {}
+
+func (m *MultiSigAction) GetValue() isMultiSigAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetAccCreate() *MultiSigAccCreate {
+	if x, ok := m.GetValue().(*MultiSigAction_AccCreate); ok {
+		return x.AccCreate
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetExecTransferTo() *MultiSigExecTransferTo {
+	if x, ok := m.GetValue().(*MultiSigAction_ExecTransferTo); ok {
+		return x.ExecTransferTo
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetExecTransferFrom() *MultiSigExecTransferFrom {
+	if x, ok := m.GetValue().(*MultiSigAction_ExecTransferFrom); ok {
+		return x.ExecTransferFrom
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetConfirmTx() *MultiSigConfirmTx {
+	if x, ok := m.GetValue().(*MultiSigAction_ConfirmTx); ok {
+		return x.ConfirmTx
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*MultiSigAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _MultiSigAction_OneofMarshaler, _MultiSigAction_OneofUnmarshaler, _MultiSigAction_OneofSizer, []interface{}{
+		(*MultiSigAction_AccCreate)(nil),
+		(*MultiSigAction_ExecTransferTo)(nil),
+		(*MultiSigAction_ExecTransferFrom)(nil),
+		(*MultiSigAction_ConfirmTx)(nil),
+	}
+}
+
+func _MultiSigAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*MultiSigAction)
+	// value
+	switch x := m.Value.(type) {
+	case *MultiSigAction_AccCreate:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.AccCreate); err != nil {
+			return err
+		}
+	case *MultiSigAction_ExecTransferTo:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ExecTransferTo); err != nil {
+			return err
+		}
+	case *MultiSigAction_ExecTransferFrom:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ExecTransferFrom); err != nil {
+			return err
+		}
+	case *MultiSigAction_ConfirmTx:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ConfirmTx); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("MultiSigAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _MultiSigAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*MultiSigAction)
+	switch tag {
+	case 1: // value.accCreate
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MultiSigAccCreate)
+		err := b.DecodeMessage(msg)
+		m.Value = &MultiSigAction_AccCreate{msg}
+		return true, err
+	case 2: // value.execTransferTo
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MultiSigExecTransferTo)
+		err := b.DecodeMessage(msg)
+		m.Value = &MultiSigAction_ExecTransferTo{msg}
+		return true, err
+	case 3: // value.execTransferFrom
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MultiSigExecTransferFrom)
+		err := b.DecodeMessage(msg)
+		m.Value = &MultiSigAction_ExecTransferFrom{msg}
+		return true, err
+	case 4: // value.confirmTx
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MultiSigConfirmTx)
+		err := b.DecodeMessage(msg)
+		m.Value = &MultiSigAction_ConfirmTx{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _MultiSigAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*MultiSigAction)
+	// value
+	switch x := m.Value.(type) {
+	case *MultiSigAction_AccCreate:
+		s := proto.Size(x.AccCreate)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *MultiSigAction_ExecTransferTo:
+		s := proto.Size(x.ExecTransferTo)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *MultiSigAction_ExecTransferFrom:
+		s := proto.Size(x.ExecTransferFrom)
+		n += proto.SizeVarint(3<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *MultiSigAction_ConfirmTx:
+		s := proto.Size(x.ConfirmTx)
+		n += proto.SizeVarint(4<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// 每日限额, 以 blocktime/86400 作为天数
+type DailyLimit struct {
+	Execer  string `protobuf:"bytes,1,opt,name=execer" json:"execer,omitempty"`
+	Symbol  string `protobuf:"bytes,2,opt,name=symbol" json:"symbol,omitempty"`
+	Limit   int64  `protobuf:"varint,3,opt,name=limit" json:"limit,omitempty"`
+	Spent   int64  `protobuf:"varint,4,opt,name=spent" json:"spent,omitempty"`
+	LastDay int64  `protobuf:"varint,5,opt,name=lastDay" json:"lastDay,omitempty"`
+}
+
+func (m *DailyLimit) Reset()                    { *m = DailyLimit{} }
+func (m *DailyLimit) String() string            { return proto.CompactTextString(m) }
+func (*DailyLimit) ProtoMessage()               {}
+func (*DailyLimit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *DailyLimit) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *DailyLimit) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *DailyLimit) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *DailyLimit) GetSpent() int64 {
+	if m != nil {
+		return m.Spent
+	}
+	return 0
+}
+
+func (m *DailyLimit) GetLastDay() int64 {
+	if m != nil {
+		return m.LastDay
+	}
+	return 0
+}
+
+// 多重签名账户
+type MultiSigAccount struct {
+	CreateAddr   string        `protobuf:"bytes,1,opt,name=createAddr" json:"createAddr,omitempty"`
+	MultiSigAddr string        `protobuf:"bytes,2,opt,name=multiSigAddr" json:"multiSigAddr,omitempty"`
+	Owners       []string      `protobuf:"bytes,3,rep,name=owners" json:"owners,omitempty"`
+	Required     int64         `protobuf:"varint,4,opt,name=required" json:"required,omitempty"`
+	DailyLimits  []*DailyLimit `protobuf:"bytes,5,rep,name=dailyLimits" json:"dailyLimits,omitempty"`
+	TxCount      int64         `protobuf:"varint,6,opt,name=txCount" json:"txCount,omitempty"`
+}
+
+func (m *MultiSigAccount) Reset()                    { *m = MultiSigAccount{} }
+func (m *MultiSigAccount) String() string            { return proto.CompactTextString(m) }
+func (*MultiSigAccount) ProtoMessage()               {}
+func (*MultiSigAccount) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *MultiSigAccount) GetCreateAddr() string {
+	if m != nil {
+		return m.CreateAddr
+	}
+	return ""
+}
+
+func (m *MultiSigAccount) GetMultiSigAddr() string {
+	if m != nil {
+		return m.MultiSigAddr
+	}
+	return ""
+}
+
+func (m *MultiSigAccount) GetOwners() []string {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *MultiSigAccount) GetRequired() int64 {
+	if m != nil {
+		return m.Required
+	}
+	return 0
+}
+
+func (m *MultiSigAccount) GetDailyLimits() []*DailyLimit {
+	if m != nil {
+		return m.DailyLimits
+	}
+	return nil
+}
+
+func (m *MultiSigAccount) GetTxCount() int64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+// 创建多重签名账户, 账户地址由创建交易的hash生成
+type MultiSigAccCreate struct {
+	Owners      []string      `protobuf:"bytes,1,rep,name=owners" json:"owners,omitempty"`
+	Required    int64         `protobuf:"varint,2,opt,name=required" json:"required,omitempty"`
+	DailyLimits []*DailyLimit `protobuf:"bytes,3,rep,name=dailyLimits" json:"dailyLimits,omitempty"`
+}
+
+func (m *MultiSigAccCreate) Reset()                    { *m = MultiSigAccCreate{} }
+func (m *MultiSigAccCreate) String() string            { return proto.CompactTextString(m) }
+func (*MultiSigAccCreate) ProtoMessage()               {}
+func (*MultiSigAccCreate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *MultiSigAccCreate) GetOwners() []string {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *MultiSigAccCreate) GetRequired() int64 {
+	if m != nil {
+		return m.Required
+	}
+	return 0
+}
+
+func (m *MultiSigAccCreate) GetDailyLimits() []*DailyLimit {
+	if m != nil {
+		return m.DailyLimits
+	}
+	return nil
+}
+
+// 从自己在multisig合约中的余额转入多重签名账户
+type MultiSigExecTransferTo struct {
+	Execer       string `protobuf:"bytes,1,opt,name=execer" json:"execer,omitempty"`
+	Symbol       string `protobuf:"bytes,2,opt,name=symbol" json:"symbol,omitempty"`
+	Amount       int64  `protobuf:"varint,3,opt,name=amount" json:"amount,omitempty"`
+	MultiSigAddr string `protobuf:"bytes,4,opt,name=multiSigAddr" json:"multiSigAddr,omitempty"`
+	Note         string `protobuf:"bytes,5,opt,name=note" json:"note,omitempty"`
+}
+
+func (m *MultiSigExecTransferTo) Reset()                    { *m = MultiSigExecTransferTo{} }
+func (m *MultiSigExecTransferTo) String() string            { return proto.CompactTextString(m) }
+func (*MultiSigExecTransferTo) ProtoMessage()               {}
+func (*MultiSigExecTransferTo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *MultiSigExecTransferTo) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *MultiSigExecTransferTo) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *MultiSigExecTransferTo) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *MultiSigExecTransferTo) GetMultiSigAddr() string {
+	if m != nil {
+		return m.MultiSigAddr
+	}
+	return ""
+}
+
+func (m *MultiSigExecTransferTo) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+// 由owner提交从多重签名账户转出的请求
+type MultiSigExecTransferFrom struct {
+	MultiSigAddr string `protobuf:"bytes,1,opt,name=multiSigAddr" json:"multiSigAddr,omitempty"`
+	Execer       string `protobuf:"bytes,2,opt,name=execer" json:"execer,omitempty"`
+	Symbol       string `protobuf:"bytes,3,opt,name=symbol" json:"symbol,omitempty"`
+	Amount       int64  `protobuf:"varint,4,opt,name=amount" json:"amount,omitempty"`
+	To           string `protobuf:"bytes,5,opt,name=to" json:"to,omitempty"`
+	Note         string `protobuf:"bytes,6,opt,name=note" json:"note,omitempty"`
+}
+
+func (m *MultiSigExecTransferFrom) Reset()                    { *m = MultiSigExecTransferFrom{} }
+func (m *MultiSigExecTransferFrom) String() string            { return proto.CompactTextString(m) }
+func (*MultiSigExecTransferFrom) ProtoMessage()               {}
+func (*MultiSigExecTransferFrom) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *MultiSigExecTransferFrom) GetMultiSigAddr() string {
+	if m != nil {
+		return m.MultiSigAddr
+	}
+	return ""
+}
+
+func (m *MultiSigExecTransferFrom) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *MultiSigExecTransferFrom) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *MultiSigExecTransferFrom) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *MultiSigExecTransferFrom) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *MultiSigExecTransferFrom) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+// owner确认或者撤销对某个请求的确认
+type MultiSigConfirmTx struct {
+	MultiSigAddr string `protobuf:"bytes,1,opt,name=multiSigAddr" json:"multiSigAddr,omitempty"`
+	Txid         int64  `protobuf:"varint,2,opt,name=txid" json:"txid,omitempty"`
+	Confirm      bool   `protobuf:"varint,3,opt,name=confirm" json:"confirm,omitempty"`
+}
+
+func (m *MultiSigConfirmTx) Reset()                    { *m = MultiSigConfirmTx{} }
+func (m *MultiSigConfirmTx) String() string            { return proto.CompactTextString(m) }
+func (*MultiSigConfirmTx) ProtoMessage()               {}
+func (*MultiSigConfirmTx) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *MultiSigConfirmTx) GetMultiSigAddr() string {
+	if m != nil {
+		return m.MultiSigAddr
+	}
+	return ""
+}
+
+func (m *MultiSigConfirmTx) GetTxid() int64 {
+	if m != nil {
+		return m.Txid
+	}
+	return 0
+}
+
+func (m *MultiSigConfirmTx) GetConfirm() bool {
+	if m != nil {
+		return m.Confirm
+	}
+	return false
+}
+
+// 多重签名账户上的转出请求
+type MultiSigTx struct {
+	MultiSigAddr    string   `protobuf:"bytes,1,opt,name=multiSigAddr" json:"multiSigAddr,omitempty"`
+	Txid            int64    `protobuf:"varint,2,opt,name=txid" json:"txid,omitempty"`
+	Execer          string   `protobuf:"bytes,3,opt,name=execer" json:"execer,omitempty"`
+	Symbol          string   `protobuf:"bytes,4,opt,name=symbol" json:"symbol,omitempty"`
+	Amount          int64    `protobuf:"varint,5,opt,name=amount" json:"amount,omitempty"`
+	To              string   `protobuf:"bytes,6,opt,name=to" json:"to,omitempty"`
+	Note            string   `protobuf:"bytes,7,opt,name=note" json:"note,omitempty"`
+	ConfirmedOwners []string `protobuf:"bytes,8,rep,name=confirmedOwners" json:"confirmedOwners,omitempty"`
+	Executed        bool     `protobuf:"varint,9,opt,name=executed" json:"executed,omitempty"`
+	TxHash          string   `protobuf:"bytes,10,opt,name=txHash" json:"txHash,omitempty"`
+}
+
+func (m *MultiSigTx) Reset()                    { *m = MultiSigTx{} }
+func (m *MultiSigTx) String() string            { return proto.CompactTextString(m) }
+func (*MultiSigTx) ProtoMessage()               {}
+func (*MultiSigTx) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *MultiSigTx) GetMultiSigAddr() string {
+	if m != nil {
+		return m.MultiSigAddr
+	}
+	return ""
+}
+
+func (m *MultiSigTx) GetTxid() int64 {
+	if m != nil {
+		return m.Txid
+	}
+	return 0
+}
+
+func (m *MultiSigTx) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *MultiSigTx) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *MultiSigTx) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *MultiSigTx) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *MultiSigTx) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *MultiSigTx) GetConfirmedOwners() []string {
+	if m != nil {
+		return m.ConfirmedOwners
+	}
+	return nil
+}
+
+func (m *MultiSigTx) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+func (m *MultiSigTx) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+type ReceiptMultiSigAccount struct {
+	Prev    *MultiSigAccount `protobuf:"bytes,1,opt,name=prev" json:"prev,omitempty"`
+	Current *MultiSigAccount `protobuf:"bytes,2,opt,name=current" json:"current,omitempty"`
+}
+
+func (m *ReceiptMultiSigAccount) Reset()                    { *m = ReceiptMultiSigAccount{} }
+func (m *ReceiptMultiSigAccount) String() string            { return proto.CompactTextString(m) }
+func (*ReceiptMultiSigAccount) ProtoMessage()               {}
+func (*ReceiptMultiSigAccount) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *ReceiptMultiSigAccount) GetPrev() *MultiSigAccount {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptMultiSigAccount) GetCurrent() *MultiSigAccount {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptMultiSigTx struct {
+	Prev    *MultiSigTx `protobuf:"bytes,1,opt,name=prev" json:"prev,omitempty"`
+	Current *MultiSigTx `protobuf:"bytes,2,opt,name=current" json:"current,omitempty"`
+}
+
+func (m *ReceiptMultiSigTx) Reset()                    { *m = ReceiptMultiSigTx{} }
+func (m *ReceiptMultiSigTx) String() string            { return proto.CompactTextString(m) }
+func (*ReceiptMultiSigTx) ProtoMessage()               {}
+func (*ReceiptMultiSigTx) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *ReceiptMultiSigTx) GetPrev() *MultiSigTx {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptMultiSigTx) GetCurrent() *MultiSigTx {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReqMultiSigTx struct {
+	MultiSigAddr string `protobuf:"bytes,1,opt,name=multiSigAddr" json:"multiSigAddr,omitempty"`
+	Txid         int64  `protobuf:"varint,2,opt,name=txid" json:"txid,omitempty"`
+}
+
+func (m *ReqMultiSigTx) Reset()                    { *m = ReqMultiSigTx{} }
+func (m *ReqMultiSigTx) String() string            { return proto.CompactTextString(m) }
+func (*ReqMultiSigTx) ProtoMessage()               {}
+func (*ReqMultiSigTx) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *ReqMultiSigTx) GetMultiSigAddr() string {
+	if m != nil {
+		return m.MultiSigAddr
+	}
+	return ""
+}
+
+func (m *ReqMultiSigTx) GetTxid() int64 {
+	if m != nil {
+		return m.Txid
+	}
+	return 0
+}
+
+type ReplyMultiSigTxs struct {
+	Txs []*MultiSigTx `protobuf:"bytes,1,rep,name=txs" json:"txs,omitempty"`
+}
+
+func (m *ReplyMultiSigTxs) Reset()                    { *m = ReplyMultiSigTxs{} }
+func (m *ReplyMultiSigTxs) String() string            { return proto.CompactTextString(m) }
+func (*ReplyMultiSigTxs) ProtoMessage()               {}
+func (*ReplyMultiSigTxs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *ReplyMultiSigTxs) GetTxs() []*MultiSigTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+type ReplyMultiSigAccounts struct {
+	Addrs []string `protobuf:"bytes,1,rep,name=addrs" json:"addrs,omitempty"`
+}
+
+func (m *ReplyMultiSigAccounts) Reset()                    { *m = ReplyMultiSigAccounts{} }
+func (m *ReplyMultiSigAccounts) String() string            { return proto.CompactTextString(m) }
+func (*ReplyMultiSigAccounts) ProtoMessage()               {}
+func (*ReplyMultiSigAccounts) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *ReplyMultiSigAccounts) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MultiSigAction)(nil), "types.MultiSigAction")
+	proto.RegisterType((*DailyLimit)(nil), "types.DailyLimit")
+	proto.RegisterType((*MultiSigAccount)(nil), "types.MultiSigAccount")
+	proto.RegisterType((*MultiSigAccCreate)(nil), "types.MultiSigAccCreate")
+	proto.RegisterType((*MultiSigExecTransferTo)(nil), "types.MultiSigExecTransferTo")
+	proto.RegisterType((*MultiSigExecTransferFrom)(nil), "types.MultiSigExecTransferFrom")
+	proto.RegisterType((*MultiSigConfirmTx)(nil), "types.MultiSigConfirmTx")
+	proto.RegisterType((*MultiSigTx)(nil), "types.MultiSigTx")
+	proto.RegisterType((*ReceiptMultiSigAccount)(nil), "types.ReceiptMultiSigAccount")
+	proto.RegisterType((*ReceiptMultiSigTx)(nil), "types.ReceiptMultiSigTx")
+	proto.RegisterType((*ReqMultiSigTx)(nil), "types.ReqMultiSigTx")
+	proto.RegisterType((*ReplyMultiSigTxs)(nil), "types.ReplyMultiSigTxs")
+	proto.RegisterType((*ReplyMultiSigAccounts)(nil), "types.ReplyMultiSigAccounts")
+}
+
+func init() { proto.RegisterFile("multisig.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 673 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xad, 0xff, 0x92, 0xe6, 0xf6, 0xfb, 0xd2, 0x66, 0x04, 0xd1, 0x08, 0x09, 0x88, 0x8c, 0x90,
+	0x22, 0x10, 0x15, 0x6a, 0x17, 0xb0, 0x2d, 0x2d, 0x34, 0x0b, 0x2a, 0xa4, 0x21, 0x2f, 0xe0, 0xda,
+	0xd3, 0x62, 0xc9, 0xf6, 0xa4, 0xe3, 0x49, 0x71, 0x24, 0x56, 0x3c, 0x00, 0x6b, 0x9e, 0x81, 0x3d,
+	0xef, 0xc2, 0xe3, 0xa0, 0xf9, 0xb1, 0x93, 0xd8, 0x4e, 0x54, 0xd4, 0x5d, 0xce, 0xcc, 0xb9, 0x73,
+	0xcf, 0x3d, 0xf7, 0xfa, 0x06, 0xfa, 0xe9, 0x3c, 0x11, 0x71, 0x1e, 0x5f, 0x1f, 0xce, 0x38, 0x13,
+	0x0c, 0x79, 0x62, 0x31, 0xa3, 0xb9, 0xff, 0xdb, 0x86, 0xfe, 0x85, 0xbc, 0xf9, 0x1c, 0x5f, 0x9f,
+	0x84, 0x22, 0x66, 0x19, 0x7a, 0x0b, 0xbd, 0x20, 0x0c, 0x4f, 0x39, 0x0d, 0x04, 0xc5, 0xd6, 0xc8,
+	0x1a, 0xef, 0x1d, 0xe1, 0x43, 0xc5, 0x3e, 0x5c, 0x32, 0xcd, 0xfd, 0x64, 0x87, 0x2c, 0xc9, 0xe8,
+	0x1c, 0xfa, 0xb4, 0xa0, 0xe1, 0x94, 0x07, 0x59, 0x7e, 0x45, 0xf9, 0x94, 0x61, 0x5b, 0x85, 0x3f,
+	0xae, 0x85, 0xbf, 0x5f, 0x23, 0x4d, 0x76, 0x48, 0x2d, 0x0c, 0x5d, 0xc0, 0xc1, 0xea, 0xc9, 0x07,
+	0xce, 0x52, 0xec, 0xa8, 0xa7, 0x9e, 0x6e, 0x79, 0x4a, 0xd2, 0x26, 0x3b, 0xa4, 0x11, 0x2a, 0x2b,
+	0x0a, 0x59, 0x76, 0x15, 0xf3, 0x74, 0x5a, 0x60, 0xb7, 0xb5, 0xa2, 0xd3, 0xf2, 0x5e, 0x56, 0x54,
+	0x91, 0x51, 0x1f, 0x6c, 0xb1, 0xc0, 0xde, 0xc8, 0x1a, 0x7b, 0xc4, 0x16, 0x8b, 0x77, 0x5d, 0xf0,
+	0x6e, 0x83, 0x64, 0x4e, 0xfd, 0xef, 0x16, 0xc0, 0x59, 0x10, 0x27, 0x8b, 0x8f, 0x71, 0x1a, 0x0b,
+	0x34, 0x84, 0x8e, 0xcc, 0x4a, 0xb9, 0x32, 0xac, 0x47, 0x0c, 0x92, 0xe7, 0xf9, 0x22, 0xbd, 0x64,
+	0x89, 0x72, 0xa2, 0x47, 0x0c, 0x42, 0x0f, 0xc0, 0x4b, 0x64, 0xa0, 0xaa, 0xca, 0x21, 0x1a, 0xc8,
+	0xd3, 0x7c, 0x46, 0x33, 0xa1, 0x34, 0x3a, 0x44, 0x03, 0x84, 0xa1, 0x9b, 0x04, 0xb9, 0x38, 0x0b,
+	0xb4, 0x10, 0x87, 0x94, 0xd0, 0xff, 0x63, 0xc1, 0xfe, 0x4a, 0x4b, 0xd8, 0x3c, 0x13, 0xe8, 0x09,
+	0x40, 0xa8, 0xba, 0x71, 0x12, 0x45, 0xa5, 0x9a, 0x95, 0x13, 0xe4, 0xc3, 0x7f, 0x69, 0x19, 0x22,
+	0x19, 0x5a, 0xd7, 0xda, 0x99, 0x54, 0xcd, 0xbe, 0x66, 0x94, 0xe7, 0xd8, 0x19, 0x39, 0x52, 0xb5,
+	0x46, 0xe8, 0x11, 0xec, 0x72, 0x7a, 0x33, 0x8f, 0x39, 0x8d, 0x8c, 0xc4, 0x0a, 0xa3, 0x63, 0xd8,
+	0x8b, 0x2a, 0x3f, 0x72, 0xec, 0x8d, 0x9c, 0xf1, 0xde, 0xd1, 0xc0, 0xb8, 0xbc, 0x74, 0x8a, 0xac,
+	0xb2, 0x64, 0x69, 0xa2, 0x38, 0x95, 0xba, 0x71, 0x47, 0x97, 0x66, 0xa0, 0xff, 0x0d, 0x06, 0x8d,
+	0x61, 0x5b, 0xd1, 0x65, 0x6d, 0xd4, 0x65, 0x6f, 0xd7, 0xe5, 0xdc, 0x45, 0x97, 0xff, 0xd3, 0x82,
+	0x61, 0xfb, 0xb0, 0xfe, 0x73, 0xa7, 0x87, 0xd0, 0x09, 0x52, 0x55, 0xa1, 0x6e, 0xb5, 0x41, 0x8d,
+	0x3e, 0xb8, 0x2d, 0x7d, 0x40, 0xe0, 0x66, 0x4c, 0x50, 0xd5, 0xf6, 0x1e, 0x51, 0xbf, 0xfd, 0x5f,
+	0x16, 0xe0, 0x4d, 0xc3, 0xdf, 0x78, 0xd4, 0x6a, 0x6f, 0xae, 0x29, 0xc0, 0xde, 0x50, 0x80, 0xb3,
+	0xa1, 0x00, 0x77, 0xad, 0x00, 0xf9, 0x69, 0x30, 0x23, 0xcd, 0x16, 0xac, 0x12, 0xdb, 0x59, 0x11,
+	0x4b, 0x61, 0xd0, 0xf8, 0xc0, 0xee, 0x24, 0x12, 0x81, 0x2b, 0x8a, 0xb8, 0xec, 0xa6, 0xfa, 0x2d,
+	0x87, 0xc5, 0x7c, 0x98, 0x4a, 0xe1, 0x2e, 0x29, 0xa1, 0xff, 0xc3, 0x06, 0x28, 0xf3, 0xdc, 0x23,
+	0xc1, 0xd2, 0x19, 0x67, 0x83, 0x33, 0xee, 0x06, 0x67, 0xbc, 0x16, 0x67, 0x3a, 0x0d, 0x67, 0xba,
+	0x4b, 0x67, 0xd0, 0x18, 0xf6, 0x8d, 0x7a, 0x1a, 0x7d, 0xd2, 0x33, 0xbd, 0xab, 0x66, 0xba, 0x7e,
+	0x2c, 0x87, 0x5b, 0xea, 0x98, 0x0b, 0x1a, 0xe1, 0x9e, 0xaa, 0xbb, 0xc2, 0x52, 0x81, 0x28, 0x26,
+	0x41, 0xfe, 0x05, 0x83, 0x56, 0xa6, 0x91, 0x7f, 0x0b, 0x43, 0x42, 0x43, 0x1a, 0xcf, 0x44, 0x7d,
+	0x3d, 0xbc, 0x00, 0x77, 0xc6, 0xe9, 0xad, 0xd9, 0xeb, 0xc3, 0xe6, 0x5e, 0x97, 0x2c, 0xa2, 0x38,
+	0xe8, 0x35, 0x74, 0xc3, 0x39, 0xe7, 0x72, 0x21, 0xd9, 0x5b, 0xe9, 0x25, 0xcd, 0xbf, 0x86, 0x41,
+	0x2d, 0xef, 0xb4, 0x40, 0xcf, 0xd7, 0x52, 0x0e, 0x6a, 0x6f, 0x4c, 0x0b, 0x93, 0xed, 0x65, 0x3d,
+	0x5b, 0x0b, 0xb3, 0x4a, 0x74, 0x0e, 0xff, 0x13, 0x7a, 0x73, 0xff, 0x9e, 0xfb, 0x6f, 0xe0, 0x80,
+	0xd0, 0x59, 0xb2, 0x58, 0x3e, 0x95, 0xa3, 0x67, 0xe0, 0x88, 0x42, 0xef, 0x98, 0x56, 0x15, 0xf2,
+	0xd6, 0x7f, 0x05, 0x0f, 0xd7, 0x02, 0x8d, 0x17, 0xb9, 0x5c, 0xe2, 0x41, 0x14, 0x55, 0x3b, 0x4a,
+	0x83, 0xcb, 0x8e, 0xfa, 0xd7, 0x3d, 0xfe, 0x3b, 0x00, 0x3d, 0x84, 0x74, 0x63, 0x87, 0x07, 0x00,
+	0x00,
+}
@@ -0,0 +1,70 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+)
+
+var (
+	MultiSigX  = "multisig"
+	actionName = map[string]int32{
+		"AccCreate":        MultiSigActionAccCreate,
+		"ExecTransferTo":   MultiSigActionExecTransferTo,
+		"ExecTransferFrom": MultiSigActionExecTransferFrom,
+		"ConfirmTx":        MultiSigActionConfirmTx,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogMultiSigAccount: {reflect.TypeOf(ReceiptMultiSigAccount{}), "LogMultiSigAccount"},
+		TyLogMultiSigTx:      {reflect.TypeOf(ReceiptMultiSigTx{}), "LogMultiSigTx"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(MultiSigX))
+	types.RegistorExecutor(MultiSigX, NewType())
+
+	//新的执行器在已经运行的链上默认不开启, 需要开启的链在 [fork.sub.multisig] 中配置一个未来的高度
+	types.RegisterDappFork(MultiSigX, "Enable", types.MaxHeight)
+}
+
+type MultiSigType struct {
+	types.ExecTypeBase
+}
+
+func NewType() *MultiSigType {
+	c := &MultiSigType{}
+	c.SetChild(c)
+	return c
+}
+
+func (m *MultiSigType) GetPayload() types.Message {
+	return &MultiSigAction{}
+}
+
+func (m *MultiSigType) GetName() string {
+	return MultiSigX
+}
+
+func (m *MultiSigType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+func (m *MultiSigType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+//MultiSigAddress 多重签名账户的地址由创建交易的hash生成, 没有对应的私钥
+func MultiSigAddress(txhash []byte) string {
+	return address.PubKeyToAddress(txhash).String()
+}
+
+//GetDay 区块时间对应的天数, 用于每日限额的计算
+func GetDay(blocktime int64) int64 {
+	return blocktime / OneDaySecond
+}
@@ -0,0 +1,198 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+/*
+multisig 钱包插件
+ 1. 列出钱包中的地址作为 owner 的多重签名账户上还没有执行的请求
+ 1. 用钱包中所有还没有确认的 owner 私钥对某个请求发送确认交易
+*/
+
+import (
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/db"
+	log "github.com/33cn/chain33/common/log/log15"
+	mty "github.com/33cn/chain33/system/dapp/multisig/types"
+	"github.com/33cn/chain33/types"
+	wcom "github.com/33cn/chain33/wallet/common"
+)
+
+var bizlog = log.New("module", "wallet.multisig")
+
+func init() {
+	wcom.RegisterPolicy(mty.MultiSigX, New())
+}
+
+func New() wcom.WalletBizPolicy {
+	return &multisigPolicy{}
+}
+
+type multisigPolicy struct {
+	walletOperate wcom.WalletOperate
+}
+
+func (policy *multisigPolicy) Init(walletOperate wcom.WalletOperate, sub []byte) {
+	policy.walletOperate = walletOperate
+}
+
+//OnAddBlockTx 和默认的处理一样, 记录钱包中地址发出的交易
+func (policy *multisigPolicy) OnAddBlockTx(block *types.BlockDetail, tx *types.Transaction, index int32, dbbatch db.Batch) *types.WalletTxDetail {
+	return policy.walletTxDetail(block, tx, index)
+}
+
+//OnDeleteBlockTx 返回的 Fromaddr 不为空的时候, 钱包会删除对应的记录
+func (policy *multisigPolicy) OnDeleteBlockTx(block *types.BlockDetail, tx *types.Transaction, index int32, dbbatch db.Batch) *types.WalletTxDetail {
+	return policy.walletTxDetail(block, tx, index)
+}
+
+func (policy *multisigPolicy) walletTxDetail(block *types.BlockDetail, tx *types.Transaction, index int32) *types.WalletTxDetail {
+	from := tx.From()
+	if !policy.walletOperate.AddrInWallet(from) {
+		return nil
+	}
+	amount, _ := tx.Amount()
+	return &types.WalletTxDetail{
+		Tx:         tx,
+		Receipt:    block.Receipts[index],
+		Height:     block.Block.Height,
+		Index:      int64(index),
+		Blocktime:  block.Block.BlockTime,
+		Amount:     amount,
+		Fromaddr:   from,
+		Txhash:     tx.Hash(),
+		ActionName: tx.ActionName(),
+	}
+}
+
+func (policy *multisigPolicy) SignTransaction(key crypto.PrivKey, req *types.ReqSignRawTx) (needSysSign bool, signtx string, err error) {
+	return true, "", nil
+}
+
+func (policy *multisigPolicy) OnCreateNewAccount(acc *types.Account) {
+}
+
+func (policy *multisigPolicy) OnImportPrivateKey(acc *types.Account) {
+}
+
+func (policy *multisigPolicy) OnWalletLocked() {
+}
+
+func (policy *multisigPolicy) OnWalletUnlocked(WalletUnLock *types.WalletUnLock) {
+}
+
+func (policy *multisigPolicy) OnAddBlockFinish(block *types.BlockDetail) {
+}
+
+func (policy *multisigPolicy) OnDeleteBlockFinish(block *types.BlockDetail) {
+}
+
+func (policy *multisigPolicy) OnClose() {
+}
+
+func (policy *multisigPolicy) OnSetQueueClient() {
+}
+
+func (policy *multisigPolicy) Call(funName string, in types.Message) (ret types.Message, err error) {
+	return nil, types.ErrNotSupport
+}
+
+func (policy *multisigPolicy) queryAccount(addr string) (*mty.MultiSigAccount, error) {
+	msg, err := policy.walletOperate.GetAPI().Query(types.ExecName(mty.MultiSigX), "MultiSigAccountInfo", &types.ReqString{Data: addr})
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*mty.MultiSigAccount), nil
+}
+
+func hasConfirmed(mtx *mty.MultiSigTx, owner string) bool {
+	for _, addr := range mtx.ConfirmedOwners {
+		if addr == owner {
+			return true
+		}
+	}
+	return false
+}
+
+//On_MultiSigPendingTxs 钱包中的地址作为 owner 的多重签名账户上还没有执行的请求
+func (policy *multisigPolicy) On_MultiSigPendingTxs(req *types.ReqNil) (types.Message, error) {
+	accounts, err := policy.walletOperate.GetWalletAccounts()
+	if err != nil {
+		return nil, err
+	}
+	api := policy.walletOperate.GetAPI()
+	execer := types.ExecName(mty.MultiSigX)
+	reply := &mty.ReplyMultiSigTxs{}
+	seen := make(map[string]bool)
+	for _, acc := range accounts {
+		msg, err := api.Query(execer, "MultiSigAccountsByOwner", &types.ReqString{Data: acc.Addr})
+		if err != nil {
+			return nil, err
+		}
+		for _, addr := range msg.(*mty.ReplyMultiSigAccounts).Addrs {
+			if seen[addr] {
+				continue
+			}
+			seen[addr] = true
+			msg, err := api.Query(execer, "MultiSigPendingTxs", &types.ReqString{Data: addr})
+			if err != nil {
+				return nil, err
+			}
+			reply.Txs = append(reply.Txs, msg.(*mty.ReplyMultiSigTxs).Txs...)
+		}
+	}
+	return reply, nil
+}
+
+//On_MultiSigConfirmTx 用钱包中还没有确认的 owner 私钥发送确认交易, 最多发送到确认数达到 required 为止
+func (policy *multisigPolicy) On_MultiSigConfirmTx(req *mty.ReqMultiSigTx) (types.Message, error) {
+	acc, err := policy.queryAccount(req.MultiSigAddr)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := policy.walletOperate.GetAPI().Query(types.ExecName(mty.MultiSigX), "MultiSigTxInfo", req)
+	if err != nil {
+		return nil, err
+	}
+	mtx := msg.(*mty.MultiSigTx)
+	if mtx.Executed {
+		return nil, mty.ErrTxExecuted
+	}
+
+	mutex := policy.walletOperate.GetMutex()
+	mutex.Lock()
+	defer mutex.Unlock()
+	ok, err := policy.walletOperate.CheckWalletStatus()
+	if !ok {
+		return nil, err
+	}
+	need := acc.Required - int64(len(mtx.ConfirmedOwners))
+	reply := &types.ReplyHashes{}
+	for _, owner := range acc.Owners {
+		if int64(len(reply.Hashes)) >= need {
+			break
+		}
+		if hasConfirmed(mtx, owner) || !policy.walletOperate.AddrInWallet(owner) {
+			continue
+		}
+		priv, err := policy.walletOperate.GetPrivKeyByAddr(owner)
+		if err != nil {
+			return nil, err
+		}
+		action := &mty.MultiSigAction{
+			Ty:    mty.MultiSigActionConfirmTx,
+			Value: &mty.MultiSigAction_ConfirmTx{ConfirmTx: &mty.MultiSigConfirmTx{MultiSigAddr: req.MultiSigAddr, Txid: req.Txid, Confirm: true}},
+		}
+		hash, err := policy.walletOperate.SendTransaction(action, []byte(types.ExecName(mty.MultiSigX)), priv, "")
+		if err != nil {
+			bizlog.Error("On_MultiSigConfirmTx", "owner", owner, "err", err)
+			return nil, err
+		}
+		reply.Hashes = append(reply.Hashes, hash)
+	}
+	if len(reply.Hashes) == 0 {
+		return nil, mty.ErrNotOwner
+	}
+	return reply, nil
+}
@@ -9,9 +9,13 @@ import (
 	"reflect"
 
 	"github.com/golang/protobuf/proto"
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
 )
 
+//GetLogs 一次最多返回的 log 数量
+const MaxLogsPerQuery = 1000
+
 //通过addr前缀查找本地址参与的所有交易
 //查询交易默认放到：coins 中查询
 func (d *DriverBase) GetTxsByAddr(addr *types.ReqAddr) (types.Message, error) {
@@ -95,6 +99,122 @@ func (d *DriverBase) GetAddrTxsCount(reqkey *types.ReqKey) (types.Message, error
 	return &counts, nil
 }
 
+//查询 receipt log, 需要开启 exec.enableLogIndex
+//优先使用条件最少的索引, 其他的条件在查询结果中过滤
+func (d *DriverBase) GetLogs(req *types.ReqGetLogs) (types.Message, error) {
+	if req.Count <= 0 || req.Count > MaxLogsPerQuery {
+		return nil, types.ErrInvalidParam
+	}
+	if req.Direction != dbm.ListDESC && req.Direction != dbm.ListASC {
+		return nil, types.ErrInvalidParam
+	}
+	if req.FromHeight < 0 || (req.ToHeight >= 0 && req.ToHeight < req.FromHeight) {
+		return nil, types.ErrInvalidParam
+	}
+	var prefix []byte
+	primary := false
+	if req.Addr != "" {
+		prefix = types.CalcLogAddrKey(req.Addr, "")
+	} else if req.Execer != "" && len(req.Tys) == 1 {
+		prefix = types.CalcLogTyKey(req.Execer, req.Tys[0], "")
+	} else if req.Execer != "" {
+		prefix = types.CalcLogExecKey(req.Execer, "")
+	} else {
+		prefix = types.CalcLogIndexKey("")
+		primary = true
+	}
+	db := d.GetLocalDB()
+	//计算开始查询的key, List 不会返回这个key本身
+	var key []byte
+	if req.PrimaryKey != "" {
+		key = logKey(prefix, req.PrimaryKey)
+	} else if req.Direction == dbm.ListASC && req.FromHeight > 0 {
+		//定位到 fromHeight 之前的最后一个 log
+		values, err := db.List(prefix, logKey(prefix, HeightIndexStr(req.FromHeight, -1)+":99999"), 1, dbm.ListSeek)
+		if err == nil && len(values) == 2 {
+			key = values[0]
+		}
+	} else if req.Direction == dbm.ListDESC && req.ToHeight >= 0 {
+		key = logKey(prefix, LogIndexStr(req.ToHeight+1, 0, 0))
+	}
+	tys := make(map[int32]bool)
+	for _, ty := range req.Tys {
+		tys[ty] = true
+	}
+	var reply types.ReplyReceiptLogs
+	first := true
+	for int32(len(reply.Logs)) < req.Count {
+		values, err := db.List(prefix, key, req.Count, req.Direction)
+		if err != nil && err != types.ErrNotFound {
+			return nil, err
+		}
+		//toHeight 超过了最大的高度, 从最后一个开始查询
+		if len(values) == 0 && first && key != nil && req.PrimaryKey == "" && req.Direction == dbm.ListDESC {
+			first = false
+			key = nil
+			continue
+		}
+		first = false
+		done := int32(len(values)) < req.Count
+		for _, value := range values {
+			info, err := getLogInfo(db, primary, value)
+			if err != nil {
+				return nil, err
+			}
+			key = logKey(prefix, LogIndexStr(info.Height, info.Index, info.LogIndex))
+			if req.ToHeight >= 0 && info.Height > req.ToHeight {
+				if req.Direction == dbm.ListASC {
+					done = true
+					break
+				}
+				continue
+			}
+			if info.Height < req.FromHeight {
+				if req.Direction == dbm.ListDESC {
+					done = true
+					break
+				}
+				continue
+			}
+			if (req.Execer != "" && info.Execer != req.Execer) || (len(tys) > 0 && !tys[info.Ty]) {
+				continue
+			}
+			reply.Logs = append(reply.Logs, info)
+			reply.PrimaryKey = LogIndexStr(info.Height, info.Index, info.LogIndex)
+			if int32(len(reply.Logs)) == req.Count {
+				break
+			}
+		}
+		if done {
+			break
+		}
+	}
+	return &reply, nil
+}
+
+//log 只保存在 all 索引中, 其他索引的 value 是 log 在 all 索引中的位置
+func getLogInfo(db dbm.KVDB, primary bool, value []byte) (*types.ReceiptLogInfo, error) {
+	if !primary {
+		var err error
+		value, err = db.Get(types.CalcLogIndexKey(string(value)))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var info types.ReceiptLogInfo
+	err := types.Decode(value, &info)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func logKey(prefix []byte, heightindex string) []byte {
+	key := make([]byte, 0, len(prefix)+len(heightindex))
+	key = append(key, prefix...)
+	return append(key, heightindex...)
+}
+
 func (d *DriverBase) Query(funcname string, params []byte) (msg types.Message, err error) {
 	funcmap := d.child.GetFuncMap()
 	funcname = "Query_" + funcname
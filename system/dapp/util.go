@@ -14,3 +14,8 @@ func HeightIndexStr(height, index int64) string {
 	v := height*types.MaxTxsPerBlock + index
 	return fmt.Sprintf("%018d", v)
 }
+
+//receipt log 索引中的位置: height*100000+index:logindex
+func LogIndexStr(height, index, logIndex int64) string {
+	return fmt.Sprintf("%s:%05d", HeightIndexStr(height, index), logIndex)
+}
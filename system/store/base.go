@@ -47,6 +47,18 @@ type SubStore interface {
 	ProcEvent(msg queue.Message)
 }
 
+//SnapshotStore 支持状态快照导出和导入的 store 实现这个接口
+type SnapshotStore interface {
+	ExportState(statehash []byte, fn func(key, value []byte) error) error
+	NewStateImporter(statehash []byte) StateImporter
+}
+
+//StateImporter 按照导出的顺序导入 ExportState 导出的数据, 全部导入以后调用 Commit
+type StateImporter interface {
+	Add(key, value []byte) error
+	Commit() error
+}
+
 type BaseStore struct {
 	db      dbm.DB
 	qclient queue.Client
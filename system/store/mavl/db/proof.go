@@ -64,6 +64,116 @@ func ReadProof(roothash []byte, leafhash []byte, data []byte) (*Proof, error) {
 	return &merkleAvlProof, nil
 }
 
+//VerifyStateProof 校验节点返回的状态数据以及默克尔证明, stateHash 来自可信的区块头
+func VerifyStateProof(stateHash []byte, proof *types.StateProof) error {
+	if proof == nil || !bytes.Equal(stateHash, proof.StateHash) {
+		return types.ErrStateProof
+	}
+	leafNode := types.LeafNode{Key: proof.Key, Value: proof.Value, Height: 0, Size: 1}
+	mavlproof, err := ReadProof(stateHash, leafNode.Hash(), proof.Proof)
+	if err != nil {
+		return err
+	}
+	if !mavlproof.Verify(proof.Key, proof.Value, stateHash) {
+		return types.ErrStateProof
+	}
+	return nil
+}
+
+//VerifyAbsenceProof 校验 key 不存在的证明: left 和 right 都在 stateHash 对应的树中,
+//left.Key < key < right.Key, 并且 left 和 right 是树中相邻的两个叶子节点
+func VerifyAbsenceProof(stateHash []byte, key []byte, proof *types.StateProof) error {
+	if proof == nil || !bytes.Equal(stateHash, proof.StateHash) || len(proof.Proof) != 0 {
+		return types.ErrStateProof
+	}
+	if proof.Left == nil && proof.Right == nil {
+		return types.ErrStateProof
+	}
+	var leftPath, rightPath []*types.InnerNode
+	var err error
+	if proof.Left != nil {
+		if bytes.Compare(proof.Left.Key, key) >= 0 {
+			return types.ErrStateProof
+		}
+		leftPath, err = readLeafPath(stateHash, proof.Left)
+		if err != nil {
+			return err
+		}
+	}
+	if proof.Right != nil {
+		if bytes.Compare(proof.Right.Key, key) <= 0 {
+			return types.ErrStateProof
+		}
+		rightPath, err = readLeafPath(stateHash, proof.Right)
+		if err != nil {
+			return err
+		}
+	}
+	if !isAdjacent(proof.Left != nil, leftPath, proof.Right != nil, rightPath) {
+		return types.ErrStateProof
+	}
+	return nil
+}
+
+//校验叶子节点的证明, 返回从叶子节点到根节点的路径
+func readLeafPath(stateHash []byte, proof *types.StateProof) ([]*types.InnerNode, error) {
+	err := VerifyStateProof(stateHash, proof)
+	if err != nil {
+		return nil, err
+	}
+	var mavlproof types.MAVLProof
+	err = proto.Unmarshal(proof.Proof, &mavlproof)
+	if err != nil {
+		return nil, err
+	}
+	return mavlproof.InnerNodes, nil
+}
+
+//LeftHash 为空表示路径上的节点是左子节点
+func isLeftChild(branch *types.InnerNode) bool {
+	return len(branch.LeftHash) == 0
+}
+
+func isSameBranch(a, b *types.InnerNode) bool {
+	return a.Height == b.Height && a.Size == b.Size &&
+		bytes.Equal(a.LeftHash, b.LeftHash) && bytes.Equal(a.RightHash, b.RightHash)
+}
+
+//两个叶子节点相邻: 从根节点开始两条路径相同, 在分叉的节点处 left 走左子树, right 走右子树,
+//分叉以后 left 一直走右子节点(左子树中最大的叶子), right 一直走左子节点(右子树中最小的叶子)
+//只有一边的时候, 这个叶子节点必须是整个树中最大(最小)的叶子
+func isAdjacent(hasLeft bool, leftPath []*types.InnerNode, hasRight bool, rightPath []*types.InnerNode) bool {
+	l, r := len(leftPath), len(rightPath)
+	if hasLeft && hasRight {
+		for l > 0 && r > 0 && isSameBranch(leftPath[l-1], rightPath[r-1]) {
+			l--
+			r--
+		}
+		if l == 0 || r == 0 {
+			return false
+		}
+		l--
+		r--
+		if !isLeftChild(leftPath[l]) || isLeftChild(rightPath[r]) {
+			return false
+		}
+		if leftPath[l].Height != rightPath[r].Height || leftPath[l].Size != rightPath[r].Size {
+			return false
+		}
+	}
+	for i := 0; hasLeft && i < l; i++ {
+		if isLeftChild(leftPath[i]) {
+			return false
+		}
+	}
+	for i := 0; hasRight && i < r; i++ {
+		if !isLeftChild(rightPath[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 //计算inner节点的hash
 func InnerNodeProofHash(childHash []byte, branch *types.InnerNode) []byte {
 	var innernode types.InnerNode
@@ -0,0 +1,127 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mavl
+
+import (
+	"bytes"
+	"errors"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+)
+
+//状态快照:
+//mavl 树的 roothash 和叶子节点插入的顺序有关, 只导出叶子节点的 key/value 没有办法在导入的时候得到相同的 roothash
+//所以快照按照先序遍历导出树的全部节点, 导入的时候重新计算每个节点的 hash, 并且检查节点都是从 roothash 开始可以访问到的
+
+var (
+	ErrNodeHash       = errors.New("ErrNodeHash")
+	ErrNodeUnexpected = errors.New("ErrNodeUnexpected")
+)
+
+const importBatchCount = 10000
+
+//ExportNodes 按照先序遍历导出 roothash 对应的树的全部节点, fn 的参数是节点在数据库中的 key 和 value
+func ExportNodes(db dbm.DB, roothash []byte, fn func(key, value []byte) error) error {
+	//开启 MVCC 以后叶子节点不保存 value
+	if enableMvcc {
+		return types.ErrNotSupport
+	}
+	if roothash == nil || bytes.Equal(roothash, emptyRoot[:]) {
+		return nil
+	}
+	stack := [][]byte{roothash}
+	for len(stack) > 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		buf, err := db.Get(hash)
+		if len(buf) == 0 || err != nil {
+			treelog.Error("ExportNodes", "hash", hash, "err", err)
+			return ErrNodeNotExist
+		}
+		var node types.StoreNode
+		err = proto.Unmarshal(buf, &node)
+		if err != nil {
+			return err
+		}
+		err = fn(hash, buf)
+		if err != nil {
+			return err
+		}
+		if node.Height > 0 {
+			stack = append(stack, node.RightHash, node.LeftHash)
+		}
+	}
+	return nil
+}
+
+//NodeImporter 导入 ExportNodes 导出的节点
+type NodeImporter struct {
+	db      dbm.DB
+	batch   dbm.Batch
+	pending map[string]bool
+	count   int
+}
+
+func NewNodeImporter(db dbm.DB, roothash []byte) *NodeImporter {
+	imp := &NodeImporter{
+		db:      db,
+		batch:   db.NewBatch(true),
+		pending: make(map[string]bool),
+	}
+	if roothash != nil && !bytes.Equal(roothash, emptyRoot[:]) {
+		imp.pending[string(roothash)] = true
+	}
+	return imp
+}
+
+func storeNodeHash(node *types.StoreNode) []byte {
+	if node.Height == 0 {
+		leafnode := &types.LeafNode{Height: node.Height, Key: node.Key, Size: node.Size, Value: node.Value}
+		return leafnode.Hash()
+	}
+	innernode := &types.InnerNode{Height: node.Height, Size: node.Size, LeftHash: node.LeftHash, RightHash: node.RightHash}
+	return innernode.Hash()
+}
+
+//Add 检查节点是否是已经导入的节点的子节点(或者根节点), 以及节点的 hash 是否正确
+//开启 mavlPrefix 的时候, key 是 前缀 + hash
+func (imp *NodeImporter) Add(key, value []byte) error {
+	if !imp.pending[string(key)] {
+		return ErrNodeUnexpected
+	}
+	var node types.StoreNode
+	err := proto.Unmarshal(value, &node)
+	if err != nil {
+		return err
+	}
+	if !bytes.HasSuffix(key, storeNodeHash(&node)) {
+		return ErrNodeHash
+	}
+	delete(imp.pending, string(key))
+	if node.Height > 0 {
+		imp.pending[string(node.LeftHash)] = true
+		imp.pending[string(node.RightHash)] = true
+	}
+	imp.batch.Set(key, value)
+	imp.count++
+	if imp.count%importBatchCount == 0 {
+		err = imp.batch.Write()
+		if err != nil {
+			return err
+		}
+		imp.batch = imp.db.NewBatch(true)
+	}
+	return nil
+}
+
+//Commit 所有的节点都导入以后, 写入剩下的节点
+func (imp *NodeImporter) Commit() error {
+	if len(imp.pending) > 0 {
+		return ErrNodeNotExist
+	}
+	return imp.batch.Write()
+}
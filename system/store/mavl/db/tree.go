@@ -193,6 +193,32 @@ func (t *Tree) Proof(key []byte) (value []byte, proofBytes []byte, exists bool)
 	return value, proofBytes, true
 }
 
+//AbsenceProof key 不存在的时候, 返回和 key 相邻的两个叶子节点的证明
+//key 比所有的叶子节点都小(大)的时候, left(right) 为 nil
+func (t *Tree) AbsenceProof(key []byte) (left, right *types.StateProof, exists bool) {
+	if t.root == nil {
+		return nil, nil, false
+	}
+	index, _, exists := t.Get(key)
+	if exists {
+		return nil, nil, true
+	}
+	if index > 0 {
+		leftKey, _ := t.GetByIndex(index - 1)
+		left = t.leafProof(leftKey)
+	}
+	if index < t.Size() {
+		rightKey, _ := t.GetByIndex(index)
+		right = t.leafProof(rightKey)
+	}
+	return left, right, false
+}
+
+func (t *Tree) leafProof(key []byte) *types.StateProof {
+	value, proof, _ := t.Proof(key)
+	return &types.StateProof{StateHash: t.Hash(), Key: key, Value: value, Proof: proof}
+}
+
 //删除key对应的节点
 func (t *Tree) Remove(key []byte) (value []byte, removed bool) {
 	if t.root == nil {
@@ -1148,3 +1148,143 @@ func saveBlock(dbm db.DB, height int64, hash []byte, txN int64, mvcc bool) (newH
 	}
 	return newHash, nil
 }
+
+func TestExportAndImportNodes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	srcdb := db.NewDB("mavltree", "leveldb", dir, 100)
+	defer srcdb.Close()
+
+	records := make(map[string]string)
+	t1 := NewTree(srcdb, true)
+	for i := 0; i < 100; i++ {
+		key, value := randstr(20), randstr(20)
+		records[key] = value
+		t1.Set([]byte(key), []byte(value))
+	}
+	roothash := t1.Save()
+
+	var keys, values [][]byte
+	err = ExportNodes(srcdb, roothash, func(key, value []byte) error {
+		keys = append(keys, key)
+		values = append(values, value)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 199, len(keys))
+	assert.Equal(t, roothash, keys[0])
+
+	//节点被修改
+	imp := NewNodeImporter(db.NewDB("mavltree", "memdb", "", 100), roothash)
+	var node types.StoreNode
+	require.NoError(t, types.Decode(values[1], &node))
+	node.Size++
+	assert.Nil(t, imp.Add(keys[0], values[0]))
+	assert.Equal(t, ErrNodeUnexpected, imp.Add(keys[0], values[0]))
+	assert.Equal(t, ErrNodeUnexpected, imp.Add(keys[len(keys)-1], values[len(values)-1]))
+	assert.Equal(t, ErrNodeHash, imp.Add(keys[1], types.Encode(&node)))
+	assert.Equal(t, ErrNodeNotExist, imp.Commit())
+
+	dstdb := db.NewDB("mavltree", "memdb", "", 100)
+	imp = NewNodeImporter(dstdb, roothash)
+	for i := range keys {
+		require.NoError(t, imp.Add(keys[i], values[i]))
+	}
+	require.NoError(t, imp.Commit())
+	t2 := NewTree(dstdb, true)
+	require.NoError(t, t2.Load(roothash))
+	assert.Equal(t, int32(100), t2.Size())
+	for key, value := range records {
+		_, v, exists := t2.Get([]byte(key))
+		assert.True(t, exists)
+		assert.Equal(t, value, string(v))
+	}
+}
+
+func TestVerifyStateProof(t *testing.T) {
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db := db.NewDB("mavltree", "leveldb", dir, 100)
+	defer db.Close()
+
+	tree := NewTree(db, true)
+	for i := 0; i < 20; i++ {
+		tree.Set([]byte(fmt.Sprintf("key:%d", i)), []byte(fmt.Sprintf("value:%d", i)))
+	}
+	roothash := tree.Save()
+
+	tree = NewTree(db, true)
+	require.NoError(t, tree.Load(roothash))
+	key := []byte("key:7")
+	value, proofbyte, exists := tree.Proof(key)
+	require.True(t, exists)
+	assert.Equal(t, []byte("value:7"), value)
+	proof := &types.StateProof{StateHash: roothash, Key: key, Value: value, Proof: proofbyte}
+	assert.Nil(t, VerifyStateProof(roothash, proof))
+
+	//值被修改或者 stateHash 不一致的时候校验失败
+	bad := *proof
+	bad.Value = []byte("value:8")
+	assert.Equal(t, types.ErrStateProof, VerifyStateProof(roothash, &bad))
+	bad = *proof
+	bad.Key = []byte("key:8")
+	assert.Equal(t, types.ErrStateProof, VerifyStateProof(roothash, &bad))
+	assert.Equal(t, types.ErrStateProof, VerifyStateProof([]byte("roothash"), proof))
+	assert.Equal(t, types.ErrStateProof, VerifyStateProof(roothash, nil))
+}
+
+func TestVerifyAbsenceProof(t *testing.T) {
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db := db.NewDB("mavltree", "leveldb", dir, 100)
+	defer db.Close()
+
+	tree := NewTree(db, true)
+	for i := 0; i < 40; i += 2 {
+		tree.Set([]byte(fmt.Sprintf("key:%02d", i)), []byte(fmt.Sprintf("value:%d", i)))
+	}
+	roothash := tree.Save()
+
+	tree = NewTree(db, true)
+	require.NoError(t, tree.Load(roothash))
+	absence := func(key string) *types.StateProof {
+		left, right, exists := tree.AbsenceProof([]byte(key))
+		require.False(t, exists)
+		return &types.StateProof{StateHash: roothash, Key: []byte(key), Left: left, Right: right}
+	}
+	for i := 1; i < 38; i += 2 {
+		key := fmt.Sprintf("key:%02d", i)
+		proof := absence(key)
+		assert.Equal(t, []byte(fmt.Sprintf("key:%02d", i-1)), proof.Left.Key)
+		assert.Equal(t, []byte(fmt.Sprintf("key:%02d", i+1)), proof.Right.Key)
+		assert.Nil(t, VerifyAbsenceProof(roothash, []byte(key), proof))
+	}
+	//比所有的 key 都小或者都大
+	proof := absence("key:")
+	assert.Nil(t, proof.Left)
+	assert.Nil(t, VerifyAbsenceProof(roothash, []byte("key:"), proof))
+	proof = absence("key:99")
+	assert.Nil(t, proof.Right)
+	assert.Nil(t, VerifyAbsenceProof(roothash, []byte("key:99"), proof))
+	_, _, exists := tree.AbsenceProof([]byte("key:02"))
+	assert.True(t, exists)
+
+	//不相邻的两个叶子节点不能证明中间的 key 不存在
+	left := absence("key:03").Left
+	right := absence("key:09").Right
+	bad := &types.StateProof{StateHash: roothash, Key: []byte("key:06"), Left: left, Right: right}
+	assert.Equal(t, types.ErrStateProof, VerifyAbsenceProof(roothash, []byte("key:06"), bad))
+	//只有一边的时候必须是最小或者最大的叶子节点
+	bad = &types.StateProof{StateHash: roothash, Key: []byte("key:07"), Right: absence("key:07").Right}
+	assert.Equal(t, types.ErrStateProof, VerifyAbsenceProof(roothash, []byte("key:07"), bad))
+	bad = &types.StateProof{StateHash: roothash, Key: []byte("key:07"), Left: absence("key:07").Left}
+	assert.Equal(t, types.ErrStateProof, VerifyAbsenceProof(roothash, []byte("key:07"), bad))
+	//key 不在两个叶子节点之间
+	proof = absence("key:07")
+	assert.Equal(t, types.ErrStateProof, VerifyAbsenceProof(roothash, []byte("key:08"), proof))
+	assert.Equal(t, types.ErrStateProof, VerifyAbsenceProof([]byte("roothash"), []byte("key:07"), proof))
+	assert.Equal(t, types.ErrStateProof, VerifyAbsenceProof(roothash, []byte("key:07"), &types.StateProof{StateHash: roothash}))
+}
@@ -167,10 +167,51 @@ func (mavls *Store) IterateRangeByStateHash(statehash []byte, start []byte, end
 	mavl.IterateRangeByStateHash(mavls.GetDB(), statehash, start, end, ascending, fn)
 }
 
+//快照导出的是树的节点, 而不是叶子节点的 key/value, 保证导入以后的 roothash 不变
+func (mavls *Store) ExportState(statehash []byte, fn func(key, value []byte) error) error {
+	return mavl.ExportNodes(mavls.GetDB(), statehash, fn)
+}
+
+func (mavls *Store) NewStateImporter(statehash []byte) drivers.StateImporter {
+	return mavl.NewNodeImporter(mavls.GetDB(), statehash)
+}
+
 func (mavls *Store) ProcEvent(msg queue.Message) {
+	if msg.Ty == types.EventStoreGetProof {
+		req := msg.GetData().(*types.ReqStateProof)
+		proof, err := mavls.GetProof(req)
+		if err != nil {
+			msg.Reply(queue.NewMessage(0, "", types.EventStoreGetProofReply, err))
+			return
+		}
+		msg.Reply(queue.NewMessage(0, "", types.EventStoreGetProofReply, proof))
+		return
+	}
 	msg.ReplyErr("Store", types.ErrActionNotSupport)
 }
 
+//获取 key 在 stateHash 对应的状态树中的值以及默克尔证明, key 不存在的时候返回 ErrNotFound
+//请求 absence 的时候, key 不存在返回相邻叶子节点的证明
+func (mavls *Store) GetProof(req *types.ReqStateProof) (*types.StateProof, error) {
+	tree := mavl.NewTree(mavls.GetDB(), true)
+	err := tree.Load(req.StateHash)
+	if err != nil {
+		return nil, err
+	}
+	value, proof, exists := tree.Proof(req.Key)
+	if exists {
+		return &types.StateProof{StateHash: req.StateHash, Key: req.Key, Value: value, Proof: proof}, nil
+	}
+	if !req.Absence {
+		return nil, types.ErrNotFound
+	}
+	left, right, _ := tree.AbsenceProof(req.Key)
+	if left == nil && right == nil {
+		return nil, types.ErrNotFound
+	}
+	return &types.StateProof{StateHash: req.StateHash, Key: req.Key, Left: left, Right: right}, nil
+}
+
 func (mavls *Store) Del(req *types.StoreDel) ([]byte, error) {
 	//not support
 	return nil, nil
@@ -655,3 +655,34 @@ func BenchmarkStoreCommit(b *testing.B) {
 	fmt.Println("mavl BenchmarkCommit cost time is", end.Sub(start), "num is", b.N)
 	b.StopTimer()
 }
+
+func TestGetProof(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+	var store_cfg = newStoreCfg(dir)
+	store := New(store_cfg, nil).(*Store)
+	assert.NotNil(t, store)
+	defer store.Close()
+
+	var kv []*types.KeyValue
+	kv = append(kv, &types.KeyValue{Key: []byte("k1"), Value: []byte("v1")})
+	kv = append(kv, &types.KeyValue{Key: []byte("k2"), Value: []byte("v2")})
+	datas := &types.StoreSet{StateHash: drivers.EmptyRoot[:], KV: kv}
+	hash, err := store.Set(datas, true)
+	assert.Nil(t, err)
+
+	proof, err := store.GetProof(&types.ReqStateProof{StateHash: hash, Key: []byte("k2")})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("v2"), proof.Value)
+	assert.Nil(t, mavldb.VerifyStateProof(hash, proof))
+
+	_, err = store.GetProof(&types.ReqStateProof{StateHash: hash, Key: []byte("k3")})
+	assert.Equal(t, types.ErrNotFound, err)
+	proof, err = store.GetProof(&types.ReqStateProof{StateHash: hash, Key: []byte("k3"), Absence: true})
+	assert.Nil(t, err)
+	assert.Nil(t, proof.Value)
+	assert.Nil(t, mavldb.VerifyAbsenceProof(hash, []byte("k3"), proof))
+	_, err = store.GetProof(&types.ReqStateProof{StateHash: []byte("hash"), Key: []byte("k1")})
+	assert.NotNil(t, err)
+}
@@ -16,7 +16,9 @@ It is generated from these files:
 	executor.proto
 	p2p.proto
 	pbft.proto
+	raft.proto
 	rpc.proto
+	signer.proto
 	statistic.proto
 	transaction.proto
 	wallet.proto
@@ -29,6 +31,8 @@ It has these top-level messages:
 	Accounts
 	ExecAccount
 	AllExecBalance
+	ReqAccountProof
+	AccountProof
 	Header
 	Block
 	Blocks
@@ -54,6 +58,12 @@ It has these top-level messages:
 	BlockSequence
 	BlockSequences
 	ParaChainBlockDetail
+	ReqSubscribe
+	PushLog
+	PushEvent
+	SnapshotMeta
+	SnapshotBlock
+	ReceiptProof
 	Reply
 	ReqString
 	ReplyString
@@ -69,6 +79,10 @@ It has these top-level messages:
 	TxHash
 	TimeStatus
 	ReqKey
+	TopicStat
+	QueueStats
+	QueueMessage
+	SignVerifyStats
 	LeafNode
 	InnerNode
 	MAVLProof
@@ -84,6 +98,8 @@ It has these top-level messages:
 	StoreReplyValue
 	PruneData
 	StoreValuePool
+	ReqStateProof
+	StateProof
 	Genesis
 	ExecTxList
 	Query
@@ -96,6 +112,9 @@ It has these top-level messages:
 	ReceiptConfig
 	ReplyConfig
 	HistoryCertStore
+	ReceiptLogInfo
+	ReqGetLogs
+	ReplyReceiptLogs
 	P2PGetPeerInfo
 	P2PPeerInfo
 	P2PVersion
@@ -113,9 +132,20 @@ It has these top-level messages:
 	P2PGetData
 	P2PTx
 	P2PBlock
+	P2PCompactBlock
+	P2PPrefilledTx
+	P2PGetBlockTxs
+	P2PBlockTxs
+	P2PTxInv
+	P2PGetTxs
+	PeerScore
+	PeerScoreList
+	ReqBanPeer
+	PeerMisbehave
 	Versions
 	BroadCastData
 	P2PGetHeaders
+	P2PGetStateProof
 	P2PHeaders
 	InvData
 	InvDatas
@@ -139,7 +169,17 @@ It has these top-level messages:
 	RequestViewChange
 	RequestAck
 	RequestNewView
+	RequestFetch
+	RequestBlocks
 	ClientReply
+	PbftMessage
+	RaftEntry
+	RaftMessage
+	ReqSignerImportKey
+	ReqSignerPubKey
+	ReplySignerPubKey
+	ReqSignerSign
+	ReplySignerSign
 	TotalFee
 	ReqGetTotalCoins
 	ReplyGetTotalCoins
@@ -169,6 +209,7 @@ It has these top-level messages:
 	ReplyTxInfo
 	ReqTxList
 	ReplyTxList
+	ReqTxsByShortIDs
 	TxHashList
 	ReplyTxInfos
 	ReceiptLog
@@ -211,6 +252,14 @@ It has these top-level messages:
 	Int32
 	ReqCreateTransaction
 	ReqAccountList
+	ReqDeriveAccount
+	ReqExtendedPubKey
+	ReqDiscoverAccounts
+	ReqImportWatchOnly
+	OfflineTx
+	ReqWalletExport
+	ReqWalletImport
+	WalletBackup
 */
 package types
 
@@ -437,6 +486,73 @@ func (m *AllExecBalance) GetExecAccount() []*ExecAccount {
 	return nil
 }
 
+// 获取账户以及账户的默克尔证明
+// 	 execer,symbol : 资产所在的执行器和资产的符号, execer 为空表示 coins 的 bty
+// 	 stateHash : 为空的时候使用最新区块的 stateHash
+type ReqAccountProof struct {
+	Addr      string `protobuf:"bytes,1,opt,name=addr" json:"addr,omitempty"`
+	Execer    string `protobuf:"bytes,2,opt,name=execer" json:"execer,omitempty"`
+	Symbol    string `protobuf:"bytes,3,opt,name=symbol" json:"symbol,omitempty"`
+	StateHash []byte `protobuf:"bytes,4,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+}
+
+func (m *ReqAccountProof) Reset()                    { *m = ReqAccountProof{} }
+func (m *ReqAccountProof) String() string            { return proto.CompactTextString(m) }
+func (*ReqAccountProof) ProtoMessage()               {}
+func (*ReqAccountProof) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *ReqAccountProof) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqAccountProof) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *ReqAccountProof) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReqAccountProof) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+type AccountProof struct {
+	Account *Account    `protobuf:"bytes,1,opt,name=account" json:"account,omitempty"`
+	Proof   *StateProof `protobuf:"bytes,2,opt,name=proof" json:"proof,omitempty"`
+}
+
+func (m *AccountProof) Reset()                    { *m = AccountProof{} }
+func (m *AccountProof) String() string            { return proto.CompactTextString(m) }
+func (*AccountProof) ProtoMessage()               {}
+func (*AccountProof) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *AccountProof) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *AccountProof) GetProof() *StateProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Account)(nil), "types.Account")
 	proto.RegisterType((*ReceiptExecAccountTransfer)(nil), "types.ReceiptExecAccountTransfer")
@@ -445,33 +561,39 @@ func init() {
 	proto.RegisterType((*Accounts)(nil), "types.Accounts")
 	proto.RegisterType((*ExecAccount)(nil), "types.ExecAccount")
 	proto.RegisterType((*AllExecBalance)(nil), "types.AllExecBalance")
+	proto.RegisterType((*ReqAccountProof)(nil), "types.ReqAccountProof")
+	proto.RegisterType((*AccountProof)(nil), "types.AccountProof")
 }
 
 func init() { proto.RegisterFile("account.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x4f, 0xc2, 0x30,
-	0x14, 0x4e, 0x19, 0x30, 0xf6, 0x88, 0x1c, 0x7a, 0x20, 0x0b, 0xf1, 0xb0, 0x34, 0x1e, 0x76, 0x30,
-	0x33, 0x71, 0xfe, 0x01, 0x48, 0x4c, 0xbc, 0x99, 0x34, 0x9e, 0x38, 0x59, 0xca, 0x43, 0x88, 0xcb,
-	0x36, 0xdb, 0x62, 0xc4, 0x1f, 0xe0, 0xef, 0x36, 0x2d, 0x1d, 0x0c, 0x89, 0xc6, 0xd3, 0xf6, 0xbd,
-	0xaf, 0xef, 0xfb, 0xbe, 0xf7, 0x5a, 0xb8, 0x10, 0x52, 0x56, 0xdb, 0xd2, 0x64, 0xb5, 0xaa, 0x4c,
-	0x45, 0x7b, 0x66, 0x57, 0xa3, 0x66, 0xaf, 0x10, 0x4e, 0xf7, 0x75, 0x3a, 0x81, 0x81, 0xdc, 0x2a,
-	0x85, 0xa5, 0xdc, 0xc5, 0x24, 0x21, 0x69, 0x8f, 0x1f, 0x30, 0x8d, 0x21, 0x5c, 0x88, 0x42, 0x94,
-	0x12, 0xe3, 0x4e, 0x42, 0xd2, 0x80, 0x37, 0x90, 0x8e, 0xa1, 0xbf, 0x52, 0xd5, 0x27, 0x96, 0x71,
-	0xe0, 0x08, 0x8f, 0x28, 0x85, 0xae, 0x58, 0x2e, 0x55, 0xdc, 0x4d, 0x48, 0x1a, 0x71, 0xf7, 0xcf,
-	0xbe, 0x08, 0x4c, 0x38, 0x4a, 0xdc, 0xd4, 0xe6, 0xfe, 0x03, 0xa5, 0x37, 0x7e, 0x52, 0xa2, 0xd4,
-	0x2b, 0x54, 0x36, 0x00, 0xda, 0xb2, 0x6d, 0x23, 0xae, 0xed, 0x80, 0x29, 0x83, 0x6e, 0xad, 0xf0,
-	0xdd, 0xb9, 0x0f, 0x6f, 0x47, 0x99, 0x4b, 0x9f, 0x79, 0x05, 0xee, 0x38, 0x9a, 0x42, 0xb8, 0x0f,
-	0x6c, 0x5c, 0x96, 0xf3, 0x63, 0x0d, 0xcd, 0x56, 0x30, 0xf6, 0x39, 0x7e, 0x66, 0x68, 0x7c, 0xc8,
-	0xff, 0x7c, 0x3a, 0x7f, 0xfb, 0x3c, 0x03, 0x70, 0x7c, 0x9b, 0xf9, 0x55, 0x5d, 0x42, 0x64, 0xd7,
-	0x80, 0x5a, 0xa3, 0x8e, 0x49, 0x12, 0xa4, 0x11, 0x3f, 0x16, 0xec, 0x22, 0xed, 0xb4, 0xa8, 0x9c,
-	0x68, 0xc4, 0x3d, 0xb2, 0x5d, 0xda, 0x08, 0x83, 0x0f, 0x42, 0xaf, 0xdd, 0x5c, 0x11, 0x3f, 0x16,
-	0xd8, 0x35, 0x0c, 0xbc, 0xab, 0xa6, 0x09, 0x04, 0x42, 0x4a, 0xa7, 0x7c, 0x9e, 0xc9, 0x52, 0xec,
-	0x11, 0x86, 0xad, 0xc5, 0xb7, 0x2c, 0xc9, 0x89, 0x65, 0x0a, 0xa1, 0x7f, 0x2c, 0xbf, 0x0d, 0xe8,
-	0x69, 0x36, 0x87, 0xd1, 0xb4, 0x28, 0xac, 0x66, 0x33, 0x64, 0x73, 0xef, 0xe4, 0x78, 0xef, 0xf4,
-	0xee, 0xc4, 0x36, 0xee, 0xb8, 0x80, 0xd4, 0x6b, 0xb6, 0x18, 0xde, 0x3e, 0x36, 0xbb, 0x9a, 0xb3,
-	0x97, 0x8d, 0x29, 0xc4, 0x22, 0xcb, 0xf3, 0x4c, 0x96, 0x37, 0x72, 0x2d, 0x36, 0x65, 0x9e, 0x1f,
-	0xbe, 0xae, 0x7d, 0xd1, 0x77, 0xcf, 0x39, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x2c, 0xad,
-	0xbb, 0xdf, 0x02, 0x00, 0x00,
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x8f, 0xd3, 0x30,
+	0x10, 0x95, 0x9b, 0x7e, 0x65, 0x76, 0x59, 0x84, 0x0f, 0x95, 0x55, 0x81, 0x88, 0x7c, 0x21, 0x07,
+	0xd4, 0x4a, 0x84, 0x3f, 0xb0, 0x2b, 0x21, 0x71, 0x03, 0x19, 0x4e, 0x7b, 0xc2, 0x71, 0xa7, 0xb4,
+	0x22, 0x1b, 0x67, 0x6d, 0x17, 0x51, 0x7e, 0x00, 0xbf, 0x1b, 0xd9, 0x71, 0xb6, 0x09, 0xec, 0xae,
+	0xf6, 0xd6, 0x37, 0x6f, 0xe6, 0xbd, 0x99, 0x17, 0x17, 0x9e, 0x49, 0xa5, 0xf4, 0xa1, 0x76, 0xab,
+	0xc6, 0x68, 0xa7, 0xe9, 0xc4, 0x1d, 0x1b, 0xb4, 0xcb, 0xf9, 0xa6, 0x6c, 0x0b, 0xfc, 0x07, 0xcc,
+	0x2e, 0xdb, 0x0e, 0xba, 0x84, 0xb9, 0x3a, 0x18, 0x83, 0xb5, 0x3a, 0x32, 0x92, 0x91, 0x7c, 0x22,
+	0xee, 0x30, 0x65, 0x30, 0x2b, 0x65, 0x25, 0x6b, 0x85, 0x6c, 0x94, 0x91, 0x3c, 0x11, 0x1d, 0xa4,
+	0x0b, 0x98, 0x6e, 0x8d, 0xfe, 0x8d, 0x35, 0x4b, 0x02, 0x11, 0x11, 0xa5, 0x30, 0x96, 0x9b, 0x8d,
+	0x61, 0xe3, 0x8c, 0xe4, 0xa9, 0x08, 0xbf, 0xf9, 0x1f, 0x02, 0x4b, 0x81, 0x0a, 0xf7, 0x8d, 0xfb,
+	0xf0, 0x0b, 0x55, 0x34, 0xfe, 0x6a, 0x64, 0x6d, 0xb7, 0x68, 0xfc, 0x02, 0xe8, 0xcb, 0x7e, 0x8c,
+	0x84, 0xb1, 0x3b, 0x4c, 0x39, 0x8c, 0x1b, 0x83, 0x3f, 0x83, 0xfb, 0xd9, 0xbb, 0x8b, 0x55, 0xb8,
+	0x63, 0x15, 0x15, 0x44, 0xe0, 0x68, 0x0e, 0xb3, 0x76, 0x61, 0xc7, 0x92, 0x7b, 0xdb, 0x3a, 0x9a,
+	0x6f, 0x61, 0x11, 0xf7, 0xf8, 0x77, 0x87, 0xce, 0x87, 0x3c, 0xcd, 0x67, 0xf4, 0xb8, 0xcf, 0x37,
+	0x00, 0x81, 0xb7, 0x57, 0x31, 0xaa, 0x97, 0x90, 0xfa, 0x18, 0xd0, 0x5a, 0xb4, 0x8c, 0x64, 0x49,
+	0x9e, 0x8a, 0x53, 0xc1, 0x07, 0xe9, 0xaf, 0x45, 0x13, 0x44, 0x53, 0x11, 0x91, 0x9f, 0xb2, 0x4e,
+	0x3a, 0xfc, 0x28, 0xed, 0x2e, 0xdc, 0x95, 0x8a, 0x53, 0x81, 0xbf, 0x85, 0x79, 0x74, 0xb5, 0x34,
+	0x83, 0x44, 0x2a, 0x15, 0x94, 0xff, 0xdf, 0xc9, 0x53, 0xfc, 0x13, 0x9c, 0xf5, 0x82, 0xef, 0x59,
+	0x92, 0x81, 0x65, 0x0e, 0xb3, 0xf8, 0x6c, 0x1e, 0x3a, 0x30, 0xd2, 0xfc, 0x1a, 0x2e, 0x2e, 0xab,
+	0xca, 0x6b, 0x76, 0x47, 0x76, 0xdf, 0x9d, 0x9c, 0xbe, 0x3b, 0x7d, 0x3f, 0xb0, 0x65, 0xa3, 0xb0,
+	0x20, 0x8d, 0x9a, 0x3d, 0x46, 0xf4, 0xdb, 0xb8, 0x85, 0xe7, 0x02, 0x6f, 0x23, 0xfa, 0x6c, 0xb4,
+	0xde, 0xde, 0x2b, 0xfe, 0x50, 0x6e, 0x0b, 0x98, 0xda, 0xe3, 0x4d, 0xa9, 0xab, 0x18, 0x5a, 0x44,
+	0xc3, 0x3c, 0xfd, 0xeb, 0x3c, 0xef, 0xe7, 0x29, 0xe1, 0x7c, 0xe0, 0xd8, 0x8b, 0x82, 0x3c, 0x1a,
+	0x05, 0x7d, 0x03, 0x93, 0xc6, 0x8f, 0xc4, 0xc8, 0x5e, 0xc4, 0xbe, 0x2f, 0x5e, 0x3a, 0x68, 0x89,
+	0x96, 0xbf, 0x7a, 0x7d, 0xfd, 0xea, 0xfb, 0xde, 0xed, 0x0e, 0xe5, 0x4a, 0xe9, 0x9b, 0x75, 0x51,
+	0xa8, 0x7a, 0xad, 0x76, 0x72, 0x5f, 0x17, 0xc5, 0x3a, 0x8c, 0x94, 0xd3, 0xf0, 0xd7, 0x2c, 0xfe,
+	0x0e, 0x00, 0x93, 0x07, 0xe1, 0x03, 0xbc, 0x03, 0x00, 0x00,
 }
@@ -5,7 +5,6 @@
 package types
 
 import (
-	"runtime"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
@@ -37,9 +36,36 @@ func (block *Block) GetHeader() *Header {
 		head.StateHash = block.StateHash
 		head.TxCount = int64(len(block.Txs))
 	}
+	if IsFork(head.Height, "ForkReceiptHash") {
+		head.ReceiptHash = block.ReceiptHash
+	}
 	return head
 }
 
+//CalcHash 通过区块头计算区块的hash, 计算的方法和 Block.Hash 一致
+//轻节点只有区块头, 交易的个数使用区块头中的 TxCount
+func (header *Header) CalcHash() []byte {
+	head := &Header{}
+	head.Version = header.Version
+	head.ParentHash = header.ParentHash
+	head.TxHash = header.TxHash
+	head.BlockTime = header.BlockTime
+	head.Height = header.Height
+	if IsFork(head.Height, "ForkBlockHash") {
+		head.Difficulty = header.Difficulty
+		head.StateHash = header.StateHash
+		head.TxCount = header.TxCount
+	}
+	if IsFork(head.Height, "ForkReceiptHash") {
+		head.ReceiptHash = header.ReceiptHash
+	}
+	data, err := proto.Marshal(head)
+	if err != nil {
+		panic(err)
+	}
+	return common.Sha256(data)
+}
+
 func (block *Block) CheckSign() bool {
 	//检查区块的签名
 	if block.Signature != nil {
@@ -49,10 +75,8 @@ func (block *Block) CheckSign() bool {
 			return false
 		}
 	}
-	//检查交易的签名
-	cpu := runtime.NumCPU()
-	ok := checkAll(block.Txs, cpu)
-	return ok
+	//检查交易的签名, mempool中已经验证过的交易直接使用缓存的结果
+	return signVerifier.VerifyTxs(block.Txs, IsFork(block.Height, "ForkSchnorr"))
 }
 
 func gen(done <-chan struct{}, task []*Transaction) <-chan *Transaction {
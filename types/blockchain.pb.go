@@ -26,17 +26,19 @@ var _ = math.Inf
 // 	 txCount : 区块上所有交易个数
 // 	 difficulty :区块难度系数，
 // 	 signature :交易签名
+// 	 receiptHash : 交易执行结果(receipt)的根哈希, ForkReceiptHash 以后有效
 type Header struct {
-	Version    int64      `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
-	ParentHash []byte     `protobuf:"bytes,2,opt,name=parentHash,proto3" json:"parentHash,omitempty"`
-	TxHash     []byte     `protobuf:"bytes,3,opt,name=txHash,proto3" json:"txHash,omitempty"`
-	StateHash  []byte     `protobuf:"bytes,4,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
-	Height     int64      `protobuf:"varint,5,opt,name=height" json:"height,omitempty"`
-	BlockTime  int64      `protobuf:"varint,6,opt,name=blockTime" json:"blockTime,omitempty"`
-	TxCount    int64      `protobuf:"varint,9,opt,name=txCount" json:"txCount,omitempty"`
-	Hash       []byte     `protobuf:"bytes,10,opt,name=hash,proto3" json:"hash,omitempty"`
-	Difficulty uint32     `protobuf:"varint,11,opt,name=difficulty" json:"difficulty,omitempty"`
-	Signature  *Signature `protobuf:"bytes,8,opt,name=signature" json:"signature,omitempty"`
+	Version     int64      `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	ParentHash  []byte     `protobuf:"bytes,2,opt,name=parentHash,proto3" json:"parentHash,omitempty"`
+	TxHash      []byte     `protobuf:"bytes,3,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	StateHash   []byte     `protobuf:"bytes,4,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Height      int64      `protobuf:"varint,5,opt,name=height" json:"height,omitempty"`
+	BlockTime   int64      `protobuf:"varint,6,opt,name=blockTime" json:"blockTime,omitempty"`
+	TxCount     int64      `protobuf:"varint,9,opt,name=txCount" json:"txCount,omitempty"`
+	Hash        []byte     `protobuf:"bytes,10,opt,name=hash,proto3" json:"hash,omitempty"`
+	Difficulty  uint32     `protobuf:"varint,11,opt,name=difficulty" json:"difficulty,omitempty"`
+	Signature   *Signature `protobuf:"bytes,8,opt,name=signature" json:"signature,omitempty"`
+	ReceiptHash []byte     `protobuf:"bytes,12,opt,name=receiptHash,proto3" json:"receiptHash,omitempty"`
 }
 
 func (m *Header) Reset()                    { *m = Header{} }
@@ -114,17 +116,25 @@ func (m *Header) GetSignature() *Signature {
 	return nil
 }
 
+func (m *Header) GetReceiptHash() []byte {
+	if m != nil {
+		return m.ReceiptHash
+	}
+	return nil
+}
+
 //  参考Header解释
 type Block struct {
-	Version    int64          `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
-	ParentHash []byte         `protobuf:"bytes,2,opt,name=parentHash,proto3" json:"parentHash,omitempty"`
-	TxHash     []byte         `protobuf:"bytes,3,opt,name=txHash,proto3" json:"txHash,omitempty"`
-	StateHash  []byte         `protobuf:"bytes,4,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
-	Height     int64          `protobuf:"varint,5,opt,name=height" json:"height,omitempty"`
-	BlockTime  int64          `protobuf:"varint,6,opt,name=blockTime" json:"blockTime,omitempty"`
-	Difficulty uint32         `protobuf:"varint,11,opt,name=difficulty" json:"difficulty,omitempty"`
-	Signature  *Signature     `protobuf:"bytes,8,opt,name=signature" json:"signature,omitempty"`
-	Txs        []*Transaction `protobuf:"bytes,7,rep,name=txs" json:"txs,omitempty"`
+	Version     int64          `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	ParentHash  []byte         `protobuf:"bytes,2,opt,name=parentHash,proto3" json:"parentHash,omitempty"`
+	TxHash      []byte         `protobuf:"bytes,3,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	StateHash   []byte         `protobuf:"bytes,4,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Height      int64          `protobuf:"varint,5,opt,name=height" json:"height,omitempty"`
+	BlockTime   int64          `protobuf:"varint,6,opt,name=blockTime" json:"blockTime,omitempty"`
+	Difficulty  uint32         `protobuf:"varint,11,opt,name=difficulty" json:"difficulty,omitempty"`
+	Signature   *Signature     `protobuf:"bytes,8,opt,name=signature" json:"signature,omitempty"`
+	Txs         []*Transaction `protobuf:"bytes,7,rep,name=txs" json:"txs,omitempty"`
+	ReceiptHash []byte         `protobuf:"bytes,12,opt,name=receiptHash,proto3" json:"receiptHash,omitempty"`
 }
 
 func (m *Block) Reset()                    { *m = Block{} }
@@ -195,6 +205,13 @@ func (m *Block) GetTxs() []*Transaction {
 	return nil
 }
 
+func (m *Block) GetReceiptHash() []byte {
+	if m != nil {
+		return m.ReceiptHash
+	}
+	return nil
+}
+
 type Blocks struct {
 	Items []*Block `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
 }
@@ -777,6 +794,281 @@ func (m *ParaChainBlockDetail) GetSequence() int64 {
 	return 0
 }
 
+// 订阅推送的事件
+// 	 header : 新区块的区块头
+// 	 delHeader : 回滚区块的区块头
+// 	 tx : 进入mempool的交易
+// 	 log : 区块中交易的receipt log
+// 	 execer : 只推送指定执行器的交易和log，为空表示不过滤
+// 	 addr : 只推送和指定地址相关的交易和log，为空表示不过滤
+type ReqSubscribe struct {
+	Header    bool     `protobuf:"varint,1,opt,name=header" json:"header,omitempty"`
+	DelHeader bool     `protobuf:"varint,2,opt,name=delHeader" json:"delHeader,omitempty"`
+	Tx        bool     `protobuf:"varint,3,opt,name=tx" json:"tx,omitempty"`
+	Log       bool     `protobuf:"varint,4,opt,name=log" json:"log,omitempty"`
+	Execer    []string `protobuf:"bytes,5,rep,name=execer" json:"execer,omitempty"`
+	Addr      []string `protobuf:"bytes,6,rep,name=addr" json:"addr,omitempty"`
+}
+
+func (m *ReqSubscribe) Reset()                    { *m = ReqSubscribe{} }
+func (m *ReqSubscribe) String() string            { return proto.CompactTextString(m) }
+func (*ReqSubscribe) ProtoMessage()               {}
+func (*ReqSubscribe) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{25} }
+
+func (m *ReqSubscribe) GetHeader() bool {
+	if m != nil {
+		return m.Header
+	}
+	return false
+}
+
+func (m *ReqSubscribe) GetDelHeader() bool {
+	if m != nil {
+		return m.DelHeader
+	}
+	return false
+}
+
+func (m *ReqSubscribe) GetTx() bool {
+	if m != nil {
+		return m.Tx
+	}
+	return false
+}
+
+func (m *ReqSubscribe) GetLog() bool {
+	if m != nil {
+		return m.Log
+	}
+	return false
+}
+
+func (m *ReqSubscribe) GetExecer() []string {
+	if m != nil {
+		return m.Execer
+	}
+	return nil
+}
+
+func (m *ReqSubscribe) GetAddr() []string {
+	if m != nil {
+		return m.Addr
+	}
+	return nil
+}
+
+// 推送的receipt log
+type PushLog struct {
+	Height int64       `protobuf:"varint,1,opt,name=height" json:"height,omitempty"`
+	TxHash []byte      `protobuf:"bytes,2,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Index  int32       `protobuf:"varint,3,opt,name=index" json:"index,omitempty"`
+	Execer string      `protobuf:"bytes,4,opt,name=execer" json:"execer,omitempty"`
+	Log    *ReceiptLog `protobuf:"bytes,5,opt,name=log" json:"log,omitempty"`
+}
+
+func (m *PushLog) Reset()                    { *m = PushLog{} }
+func (m *PushLog) String() string            { return proto.CompactTextString(m) }
+func (*PushLog) ProtoMessage()               {}
+func (*PushLog) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{26} }
+
+func (m *PushLog) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PushLog) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *PushLog) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *PushLog) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *PushLog) GetLog() *ReceiptLog {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+// 推送给订阅者的事件, ty 对应 TyPushBlock/TyPushDelBlock/TyPushTx/TyPushLog
+type PushEvent struct {
+	Ty     int32        `protobuf:"varint,1,opt,name=ty" json:"ty,omitempty"`
+	Header *Header      `protobuf:"bytes,2,opt,name=header" json:"header,omitempty"`
+	Tx     *Transaction `protobuf:"bytes,3,opt,name=tx" json:"tx,omitempty"`
+	Log    *PushLog     `protobuf:"bytes,4,opt,name=log" json:"log,omitempty"`
+}
+
+func (m *PushEvent) Reset()                    { *m = PushEvent{} }
+func (m *PushEvent) String() string            { return proto.CompactTextString(m) }
+func (*PushEvent) ProtoMessage()               {}
+func (*PushEvent) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{27} }
+
+func (m *PushEvent) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+func (m *PushEvent) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *PushEvent) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *PushEvent) GetLog() *PushLog {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+// 状态快照文件的描述信息
+// 	 version : 快照文件格式的版本号
+// 	 title : 导出快照的链的title
+// 	 height,hash,stateHash : 导出快照时最新区块的高度、hash以及状态树的根hash
+type SnapshotMeta struct {
+	Version   int32  `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	Title     string `protobuf:"bytes,2,opt,name=title" json:"title,omitempty"`
+	Height    int64  `protobuf:"varint,3,opt,name=height" json:"height,omitempty"`
+	Hash      []byte `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	StateHash []byte `protobuf:"bytes,5,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+}
+
+func (m *SnapshotMeta) Reset()                    { *m = SnapshotMeta{} }
+func (m *SnapshotMeta) String() string            { return proto.CompactTextString(m) }
+func (*SnapshotMeta) ProtoMessage()               {}
+func (*SnapshotMeta) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{28} }
+
+func (m *SnapshotMeta) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SnapshotMeta) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SnapshotMeta) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SnapshotMeta) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *SnapshotMeta) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+// 快照中保存的区块以及区块的总难度
+type SnapshotBlock struct {
+	Detail *BlockDetail `protobuf:"bytes,1,opt,name=detail" json:"detail,omitempty"`
+	Td     []byte       `protobuf:"bytes,2,opt,name=td,proto3" json:"td,omitempty"`
+}
+
+func (m *SnapshotBlock) Reset()                    { *m = SnapshotBlock{} }
+func (m *SnapshotBlock) String() string            { return proto.CompactTextString(m) }
+func (*SnapshotBlock) ProtoMessage()               {}
+func (*SnapshotBlock) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{29} }
+
+func (m *SnapshotBlock) GetDetail() *BlockDetail {
+	if m != nil {
+		return m.Detail
+	}
+	return nil
+}
+
+func (m *SnapshotBlock) GetTd() []byte {
+	if m != nil {
+		return m.Td
+	}
+	return nil
+}
+
+// 交易执行结果(receipt)的默克尔证明
+// 	 receipt : 交易的执行结果
+// 	 index : 交易在区块中的序号
+// 	 proofs : receipt 到区块头 receiptHash 的默克尔路径
+// 	 header : 交易所在区块的区块头
+type ReceiptProof struct {
+	Receipt *ReceiptData `protobuf:"bytes,1,opt,name=receipt" json:"receipt,omitempty"`
+	Index   int64        `protobuf:"varint,2,opt,name=index" json:"index,omitempty"`
+	Proofs  [][]byte     `protobuf:"bytes,3,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	Header  *Header      `protobuf:"bytes,4,opt,name=header" json:"header,omitempty"`
+}
+
+func (m *ReceiptProof) Reset()                    { *m = ReceiptProof{} }
+func (m *ReceiptProof) String() string            { return proto.CompactTextString(m) }
+func (*ReceiptProof) ProtoMessage()               {}
+func (*ReceiptProof) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{30} }
+
+func (m *ReceiptProof) GetReceipt() *ReceiptData {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+func (m *ReceiptProof) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ReceiptProof) GetProofs() [][]byte {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+func (m *ReceiptProof) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Header)(nil), "types.Header")
 	proto.RegisterType((*Block)(nil), "types.Block")
@@ -803,74 +1095,98 @@ func init() {
 	proto.RegisterType((*BlockSequence)(nil), "types.BlockSequence")
 	proto.RegisterType((*BlockSequences)(nil), "types.BlockSequences")
 	proto.RegisterType((*ParaChainBlockDetail)(nil), "types.ParaChainBlockDetail")
+	proto.RegisterType((*ReqSubscribe)(nil), "types.ReqSubscribe")
+	proto.RegisterType((*PushLog)(nil), "types.PushLog")
+	proto.RegisterType((*PushEvent)(nil), "types.PushEvent")
+	proto.RegisterType((*SnapshotMeta)(nil), "types.SnapshotMeta")
+	proto.RegisterType((*SnapshotBlock)(nil), "types.SnapshotBlock")
+	proto.RegisterType((*ReceiptProof)(nil), "types.ReceiptProof")
 }
 
 func init() { proto.RegisterFile("blockchain.proto", fileDescriptor1) }
 
 var fileDescriptor1 = []byte{
-	// 1010 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x5d, 0x6f, 0x23, 0x35,
-	0x17, 0xd6, 0xe4, 0xa3, 0x4d, 0x4e, 0xd2, 0xbc, 0x7d, 0xad, 0x80, 0x46, 0x15, 0x82, 0xac, 0xa9,
-	0x50, 0xb4, 0xac, 0x82, 0xd4, 0x22, 0xd8, 0x0b, 0x90, 0xa0, 0x5d, 0xa4, 0x96, 0xc2, 0x52, 0xdc,
-	0xd2, 0x0b, 0xee, 0xdc, 0x19, 0x37, 0x63, 0x35, 0x99, 0x99, 0xb5, 0x3d, 0x21, 0xc3, 0x7f, 0xe0,
-	0x57, 0x70, 0x87, 0xf8, 0x91, 0xc8, 0xc7, 0x9e, 0x64, 0x26, 0xbb, 0x8b, 0x84, 0xc4, 0x0d, 0x57,
-	0xf1, 0x73, 0xbe, 0xcf, 0xf1, 0xf1, 0x33, 0x81, 0xc3, 0xfb, 0x45, 0x16, 0x3d, 0x46, 0x09, 0x97,
-	0xe9, 0x2c, 0x57, 0x99, 0xc9, 0x48, 0xd7, 0x94, 0xb9, 0xd0, 0x47, 0xff, 0x37, 0x8a, 0xa7, 0x9a,
-	0x47, 0x46, 0x66, 0x5e, 0x73, 0x34, 0x8c, 0xb2, 0xe5, 0xb2, 0x42, 0xf4, 0xcf, 0x16, 0xec, 0x5d,
-	0x08, 0x1e, 0x0b, 0x45, 0x42, 0xd8, 0x5f, 0x09, 0xa5, 0x65, 0x96, 0x86, 0xc1, 0x24, 0x98, 0xb6,
-	0x59, 0x05, 0xc9, 0xfb, 0x00, 0x39, 0x57, 0x22, 0x35, 0x17, 0x5c, 0x27, 0x61, 0x6b, 0x12, 0x4c,
-	0x87, 0xac, 0x26, 0x21, 0xef, 0xc2, 0x9e, 0x59, 0xa3, 0xae, 0x8d, 0x3a, 0x8f, 0xc8, 0x7b, 0xd0,
-	0xd7, 0x86, 0x1b, 0x81, 0xaa, 0x0e, 0xaa, 0xb6, 0x02, 0xeb, 0x95, 0x08, 0x39, 0x4f, 0x4c, 0xd8,
-	0xc5, 0x74, 0x1e, 0x59, 0x2f, 0x6c, 0xe7, 0x56, 0x2e, 0x45, 0xb8, 0x87, 0xaa, 0xad, 0xc0, 0x56,
-	0x69, 0xd6, 0xe7, 0x59, 0x91, 0x9a, 0xb0, 0xef, 0xaa, 0xf4, 0x90, 0x10, 0xe8, 0x24, 0x36, 0x11,
-	0x60, 0x22, 0x3c, 0xdb, 0xca, 0x63, 0xf9, 0xf0, 0x20, 0xa3, 0x62, 0x61, 0xca, 0x70, 0x30, 0x09,
-	0xa6, 0x07, 0xac, 0x26, 0x21, 0x33, 0xe8, 0x6b, 0x39, 0x4f, 0xb9, 0x29, 0x94, 0x08, 0x7b, 0x93,
-	0x60, 0x3a, 0x38, 0x39, 0x9c, 0xe1, 0xe8, 0x66, 0x37, 0x95, 0x9c, 0x6d, 0x4d, 0xe8, 0xef, 0x2d,
-	0xe8, 0x9e, 0xd9, 0x5a, 0xfe, 0x23, 0xd3, 0xfa, 0x97, 0xfb, 0x27, 0xc7, 0xd0, 0x36, 0x6b, 0x1d,
-	0xee, 0x4f, 0xda, 0xd3, 0xc1, 0x09, 0xf1, 0x96, 0xb7, 0xdb, 0x1d, 0x63, 0x56, 0x4d, 0x9f, 0xc1,
-	0x1e, 0x0e, 0x49, 0x13, 0x0a, 0x5d, 0x69, 0xc4, 0x52, 0x87, 0x01, 0x7a, 0x0c, 0xbd, 0x07, 0x6a,
-	0x99, 0x53, 0xd1, 0xaf, 0xa0, 0x87, 0xf8, 0x5a, 0xc6, 0xe4, 0x10, 0xda, 0xb9, 0x8c, 0x71, 0xa2,
-	0x7d, 0x66, 0x8f, 0x36, 0x02, 0xb6, 0x83, 0x83, 0x7c, 0x2d, 0x02, 0xaa, 0xe8, 0x73, 0x18, 0x22,
-	0x7e, 0x21, 0x0c, 0x97, 0x0b, 0x4d, 0xa6, 0xcd, 0xac, 0xa4, 0xee, 0xe3, 0x6c, 0xaa, 0xdc, 0x33,
-	0xd8, 0x77, 0xdb, 0xaf, 0xc9, 0x87, 0x4d, 0xa7, 0x03, 0xef, 0xe4, 0xd4, 0x95, 0xfd, 0x05, 0x80,
-	0xb7, 0x7f, 0x73, 0xb5, 0x53, 0xd8, 0x4f, 0x9c, 0xde, 0xd7, 0x3b, 0x6a, 0x84, 0xd1, 0xac, 0x52,
-	0xd3, 0x04, 0x0e, 0xb0, 0x9e, 0x1f, 0x56, 0x42, 0xad, 0xa4, 0xf8, 0x85, 0x3c, 0x81, 0x8e, 0xd5,
-	0x61, 0xb4, 0xd7, 0xd2, 0xa3, 0xaa, 0xbe, 0xfb, 0xad, 0xe6, 0xee, 0x1f, 0x41, 0xcf, 0x6d, 0x91,
-	0xd0, 0x61, 0x7b, 0xd2, 0x9e, 0x0e, 0xd9, 0x06, 0xd3, 0x3f, 0x02, 0x18, 0xd4, 0x5a, 0xdf, 0x4e,
-	0x34, 0x78, 0xeb, 0x44, 0xc9, 0x0c, 0x7a, 0x4a, 0x44, 0x42, 0xe6, 0xc6, 0x36, 0x52, 0x1f, 0x22,
-	0x73, 0xe2, 0x17, 0xdc, 0x70, 0xb6, 0xb1, 0x21, 0x1f, 0x40, 0xeb, 0xea, 0x0e, 0x33, 0x0f, 0x4e,
-	0xfe, 0xe7, 0x2d, 0xaf, 0x44, 0x79, 0xc7, 0x17, 0x85, 0x60, 0xad, 0xab, 0x3b, 0xf2, 0x11, 0x8c,
-	0x72, 0x25, 0x56, 0x37, 0x86, 0x9b, 0x42, 0xd7, 0x36, 0x7c, 0x47, 0x4a, 0x3f, 0x83, 0x1e, 0xab,
-	0x82, 0x3e, 0xad, 0x15, 0xe1, 0x2e, 0x65, 0xd4, 0x2c, 0x62, 0x5b, 0x00, 0xfd, 0x16, 0xfa, 0xd7,
-	0x4a, 0xae, 0x78, 0x54, 0x5e, 0xdd, 0x91, 0x2f, 0x6d, 0x32, 0x0f, 0x6e, 0xb3, 0x47, 0x91, 0x7a,
-	0xf7, 0x77, 0xbc, 0xfb, 0x75, 0x43, 0xc9, 0x76, 0x8c, 0x69, 0x09, 0xa3, 0xa6, 0x05, 0x19, 0x43,
-	0xd7, 0xf8, 0x38, 0xf6, 0xaa, 0x1d, 0x70, 0xd7, 0x71, 0x99, 0xc6, 0x62, 0x8d, 0xd7, 0xd1, 0x65,
-	0x15, 0x74, 0x4f, 0x3c, 0x69, 0x3c, 0x71, 0xa4, 0x23, 0x37, 0xa6, 0xce, 0x5b, 0xc7, 0x44, 0x35,
-	0x8c, 0xab, 0xf6, 0xbf, 0x4e, 0xe3, 0x6d, 0x47, 0x1f, 0x37, 0x46, 0x11, 0xd4, 0xdc, 0x2b, 0xf3,
-	0xda, 0x65, 0xcc, 0xa0, 0xbf, 0xe9, 0xc8, 0xaf, 0xe1, 0xe1, 0x6e, 0xe7, 0x6c, 0x6b, 0x42, 0xa7,
-	0x40, 0x7c, 0x94, 0xf3, 0x44, 0x44, 0x8f, 0xb7, 0xeb, 0xef, 0xa4, 0x46, 0x3a, 0x15, 0x4a, 0xb9,
-	0xc9, 0xf7, 0x19, 0x9e, 0x69, 0x09, 0x83, 0x73, 0xfb, 0x91, 0x71, 0x17, 0x46, 0x8e, 0xe1, 0x20,
-	0x2a, 0x14, 0x12, 0x9b, 0xa3, 0x26, 0xc7, 0x84, 0x4d, 0x21, 0x99, 0xc0, 0x60, 0x29, 0x96, 0x79,
-	0x96, 0x2d, 0x6e, 0xe4, 0xaf, 0xc2, 0x6f, 0x6e, 0x5d, 0x44, 0x28, 0x0c, 0x97, 0x7a, 0xfe, 0x63,
-	0x21, 0x0a, 0x81, 0x26, 0x6d, 0x34, 0x69, 0xc8, 0x28, 0x87, 0x3e, 0x13, 0xaf, 0x3c, 0xad, 0x8c,
-	0xa1, 0xab, 0x0d, 0x57, 0x55, 0x42, 0x07, 0xec, 0x73, 0x14, 0x69, 0xec, 0x13, 0xd8, 0xa3, 0x7d,
-	0x16, 0x52, 0xbb, 0xb5, 0xc7, 0xa0, 0x3d, 0xb6, 0xc1, 0xd5, 0xe3, 0xed, 0x60, 0x7b, 0xf6, 0x48,
-	0x9f, 0xc0, 0xe0, 0xfb, 0x5a, 0x55, 0x04, 0x3a, 0xda, 0x56, 0xe3, 0x72, 0xe0, 0x99, 0x3e, 0x85,
-	0x43, 0x26, 0xf2, 0x45, 0x89, 0x75, 0xf8, 0xfe, 0xb6, 0xcc, 0x1c, 0xd4, 0x99, 0xd9, 0x56, 0x8c,
-	0x66, 0x67, 0x59, 0x5c, 0x56, 0xc4, 0x19, 0xfc, 0x2d, 0x71, 0xfe, 0xd3, 0x67, 0x47, 0x9f, 0x01,
-	0x5c, 0xea, 0x73, 0x5e, 0xcc, 0x13, 0xf3, 0x53, 0x6e, 0xc9, 0xfe, 0x52, 0x47, 0x88, 0x8a, 0x1c,
-	0x8b, 0xe9, 0xb1, 0x9a, 0x84, 0x3e, 0x87, 0xd1, 0xa5, 0x7e, 0x69, 0xf2, 0x73, 0x5b, 0xd5, 0x4d,
-	0x99, 0x46, 0xf6, 0x55, 0x4a, 0x9d, 0x9a, 0x3c, 0xc2, 0xb1, 0x96, 0x69, 0xe4, 0xbd, 0x76, 0xa4,
-	0xf4, 0xb7, 0x00, 0x0e, 0xf0, 0xe2, 0xbf, 0x59, 0x8b, 0xa8, 0x30, 0x99, 0xb2, 0x4d, 0xc7, 0x4a,
-	0xae, 0x84, 0xf2, 0x4f, 0xc2, 0x23, 0x3b, 0xf1, 0x87, 0x22, 0x8d, 0x5e, 0xf2, 0xa5, 0xbb, 0xe9,
-	0x3e, 0xdb, 0xe0, 0xe6, 0x07, 0xae, 0xbd, 0xfb, 0x81, 0x1b, 0x43, 0x37, 0xe7, 0x8a, 0x2f, 0x3d,
-	0x31, 0x38, 0x60, 0xa5, 0x62, 0x6d, 0x14, 0xc7, 0xaf, 0xde, 0x90, 0x39, 0x40, 0x3f, 0xf7, 0xe4,
-	0x79, 0x23, 0x5e, 0x15, 0x22, 0x8d, 0xf0, 0xae, 0x30, 0x6a, 0xe0, 0xbe, 0xfd, 0x18, 0x90, 0x40,
-	0xe7, 0xb6, 0xcc, 0xab, 0x85, 0xc3, 0x33, 0xfd, 0x02, 0x46, 0x0d, 0x47, 0x4b, 0x32, 0x0d, 0xda,
-	0x1f, 0xd7, 0xd9, 0xb0, 0xb2, 0xaa, 0xd8, 0x3f, 0x81, 0xf1, 0x35, 0x57, 0x1c, 0x27, 0x51, 0x67,
-	0xd4, 0x4f, 0x61, 0x80, 0xb4, 0x19, 0xbb, 0x4d, 0x73, 0x0f, 0xf4, 0x4d, 0x5f, 0x9d, 0xba, 0x99,
-	0x1d, 0x95, 0xf6, 0x09, 0x7c, 0x8d, 0x1b, 0x7c, 0x76, 0xfc, 0x33, 0x9d, 0x4b, 0xb3, 0xe0, 0xf7,
-	0xb3, 0xd3, 0xd3, 0x59, 0x94, 0x7e, 0x82, 0x7f, 0xed, 0x4e, 0x4f, 0x37, 0xbf, 0x18, 0xfa, 0x7e,
-	0x0f, 0xff, 0xc3, 0x9d, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0x35, 0xbe, 0xcc, 0x13, 0xff, 0x09,
-	0x00, 0x00,
+	// 1300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x51, 0x8f, 0xdb, 0xc4,
+	0x13, 0x97, 0x93, 0x38, 0x97, 0x4c, 0x72, 0xf9, 0x5f, 0x57, 0xf9, 0x23, 0xab, 0x02, 0x9a, 0x6e,
+	0x0b, 0x8a, 0x4a, 0x95, 0x4a, 0x3d, 0x04, 0x7d, 0x00, 0x09, 0x7a, 0xad, 0xd4, 0xe3, 0xda, 0x72,
+	0x6c, 0x8e, 0x7b, 0xe0, 0x6d, 0xcf, 0xde, 0x8b, 0xad, 0x26, 0xb6, 0xcf, 0xbb, 0x0e, 0x31, 0x9f,
+	0x80, 0x07, 0x78, 0x80, 0x8f, 0xc1, 0xf7, 0xe0, 0x95, 0xcf, 0x84, 0x76, 0x76, 0x1d, 0xdb, 0xe9,
+	0xb5, 0x80, 0xc4, 0x0b, 0x6f, 0xfe, 0xcd, 0xcc, 0xce, 0xcc, 0xfe, 0x66, 0x67, 0x76, 0x0d, 0x07,
+	0x17, 0xcb, 0xc4, 0x7f, 0xe5, 0x87, 0x3c, 0x8a, 0x67, 0x69, 0x96, 0xa8, 0x84, 0xb8, 0xaa, 0x48,
+	0x85, 0xbc, 0x79, 0x43, 0x65, 0x3c, 0x96, 0xdc, 0x57, 0x51, 0x62, 0x35, 0x37, 0x87, 0x7e, 0xb2,
+	0x5a, 0x95, 0x88, 0xfe, 0xd1, 0x82, 0xee, 0x33, 0xc1, 0x03, 0x91, 0x11, 0x0f, 0xf6, 0xd6, 0x22,
+	0x93, 0x51, 0x12, 0x7b, 0xce, 0xc4, 0x99, 0xb6, 0x59, 0x09, 0xc9, 0xfb, 0x00, 0x29, 0xcf, 0x44,
+	0xac, 0x9e, 0x71, 0x19, 0x7a, 0xad, 0x89, 0x33, 0x1d, 0xb2, 0x9a, 0x84, 0xbc, 0x03, 0x5d, 0xb5,
+	0x41, 0x5d, 0x1b, 0x75, 0x16, 0x91, 0x77, 0xa1, 0x2f, 0x15, 0x57, 0x02, 0x55, 0x1d, 0x54, 0x55,
+	0x02, 0xbd, 0x2a, 0x14, 0xd1, 0x22, 0x54, 0x9e, 0x8b, 0xe1, 0x2c, 0xd2, 0xab, 0x70, 0x3b, 0x67,
+	0xd1, 0x4a, 0x78, 0x5d, 0x54, 0x55, 0x02, 0x9d, 0xa5, 0xda, 0x1c, 0x25, 0x79, 0xac, 0xbc, 0xbe,
+	0xc9, 0xd2, 0x42, 0x42, 0xa0, 0x13, 0xea, 0x40, 0x80, 0x81, 0xf0, 0x5b, 0x67, 0x1e, 0x44, 0x97,
+	0x97, 0x91, 0x9f, 0x2f, 0x55, 0xe1, 0x0d, 0x26, 0xce, 0x74, 0x9f, 0xd5, 0x24, 0x64, 0x06, 0x7d,
+	0x19, 0x2d, 0x62, 0xae, 0xf2, 0x4c, 0x78, 0xbd, 0x89, 0x33, 0x1d, 0x3c, 0x3c, 0x98, 0x21, 0x75,
+	0xb3, 0x79, 0x29, 0x67, 0x95, 0x09, 0x99, 0xc0, 0x20, 0x13, 0xbe, 0x88, 0x52, 0x43, 0xc5, 0x10,
+	0x43, 0xd5, 0x45, 0xf4, 0xf7, 0x16, 0xb8, 0x8f, 0x75, 0xb6, 0xff, 0x11, 0x3e, 0xff, 0x6d, 0x86,
+	0xee, 0x42, 0x5b, 0x6d, 0xa4, 0xb7, 0x37, 0x69, 0x4f, 0x07, 0x0f, 0x89, 0xb5, 0x3c, 0xab, 0x4e,
+	0x21, 0xd3, 0xea, 0xbf, 0xc1, 0xe3, 0x7d, 0xe8, 0x22, 0x8d, 0x92, 0x50, 0x70, 0x23, 0x25, 0x56,
+	0xd2, 0x73, 0xd0, 0xe7, 0xd0, 0xfa, 0x44, 0x2d, 0x33, 0x2a, 0xfa, 0x05, 0xf4, 0x10, 0x9f, 0x46,
+	0x01, 0x39, 0x80, 0x76, 0x1a, 0x05, 0xc8, 0x79, 0x9f, 0xe9, 0x4f, 0xed, 0x01, 0x37, 0x8c, 0x54,
+	0xbf, 0xe6, 0x01, 0x55, 0xf4, 0x11, 0x0c, 0x11, 0x3f, 0x11, 0x8a, 0x47, 0x4b, 0x49, 0xa6, 0xcd,
+	0xa8, 0xa4, 0xbe, 0xc6, 0xd8, 0x94, 0xb1, 0x67, 0xb0, 0x67, 0x3a, 0x48, 0x92, 0x3b, 0xcd, 0x45,
+	0xfb, 0x76, 0x91, 0x51, 0x97, 0xf6, 0xcf, 0x00, 0xac, 0xfd, 0xf5, 0xd9, 0x4e, 0x61, 0x2f, 0x34,
+	0x7a, 0x9b, 0xef, 0xa8, 0xe1, 0x46, 0xb2, 0x52, 0x4d, 0x43, 0xd8, 0xc7, 0x7c, 0xbe, 0x5e, 0x8b,
+	0x6c, 0x1d, 0x89, 0xef, 0xc9, 0x6d, 0xe8, 0x68, 0x1d, 0x7a, 0x7b, 0x2d, 0x3c, 0xaa, 0xea, 0xfd,
+	0xd3, 0x6a, 0xf6, 0xcf, 0x4d, 0xe8, 0x99, 0x73, 0x26, 0xa4, 0xd7, 0x9e, 0xb4, 0xa7, 0x43, 0xb6,
+	0xc5, 0xf4, 0x37, 0x07, 0x06, 0xb5, 0xad, 0x57, 0x8c, 0x3a, 0x6f, 0x64, 0x94, 0xcc, 0xa0, 0x67,
+	0x0b, 0xaa, 0x37, 0x52, 0x27, 0x91, 0x19, 0xf1, 0x13, 0xae, 0x38, 0xdb, 0xda, 0x90, 0x5b, 0xd0,
+	0x3a, 0x39, 0xc7, 0xc8, 0x83, 0x87, 0xff, 0xb3, 0x96, 0x27, 0xa2, 0x38, 0xe7, 0xcb, 0x5c, 0xb0,
+	0xd6, 0xc9, 0x39, 0xf9, 0x10, 0x46, 0x69, 0x26, 0xd6, 0x73, 0xc5, 0x55, 0x2e, 0x6b, 0x3d, 0xb0,
+	0x23, 0xa5, 0x9f, 0x40, 0x8f, 0x95, 0x4e, 0xef, 0xd5, 0x92, 0x30, 0x45, 0x19, 0x35, 0x93, 0xa8,
+	0x12, 0xa0, 0x5f, 0x41, 0xff, 0x34, 0x8b, 0xd6, 0xdc, 0x2f, 0x4e, 0xce, 0xc9, 0xe7, 0x3a, 0x98,
+	0x05, 0x67, 0xc9, 0x2b, 0x11, 0xdb, 0xe5, 0xff, 0xb7, 0xcb, 0x4f, 0x1b, 0x4a, 0xb6, 0x63, 0x4c,
+	0x0b, 0x18, 0x35, 0x2d, 0xc8, 0x18, 0x5c, 0x65, 0xfd, 0xe8, 0x52, 0x1b, 0x60, 0xca, 0x71, 0x1c,
+	0x07, 0x62, 0x83, 0xe5, 0x70, 0x59, 0x09, 0xcd, 0x10, 0x08, 0x1b, 0x43, 0x40, 0x23, 0x4b, 0x53,
+	0xe7, 0x8d, 0x34, 0x51, 0x09, 0xe3, 0x72, 0xfb, 0x5f, 0xc6, 0x41, 0xb5, 0xa3, 0x8f, 0x1a, 0x54,
+	0x38, 0xb5, 0xe5, 0xa5, 0x79, 0xad, 0x18, 0x33, 0xe8, 0x6f, 0x77, 0xe4, 0xb5, 0x1a, 0x6d, 0xbf,
+	0xf5, 0xc8, 0x2a, 0x13, 0x3a, 0x05, 0x62, 0xbd, 0x1c, 0x85, 0xc2, 0x7f, 0x75, 0xb6, 0x79, 0x1e,
+	0x49, 0x1c, 0xc9, 0x22, 0xcb, 0x0c, 0xf3, 0x7d, 0x86, 0xdf, 0xb4, 0x80, 0xc1, 0x91, 0xbe, 0xa8,
+	0x4c, 0xc1, 0xc8, 0x5d, 0xd8, 0xf7, 0xf3, 0x0c, 0x47, 0x9f, 0x19, 0x5e, 0x66, 0x56, 0x36, 0x85,
+	0x7a, 0x5e, 0xac, 0xc4, 0x2a, 0x4d, 0x92, 0xe5, 0x3c, 0xfa, 0x41, 0xd8, 0x93, 0x5b, 0x17, 0x11,
+	0x0a, 0xc3, 0x95, 0x5c, 0x7c, 0x93, 0x8b, 0x5c, 0xa0, 0x49, 0x1b, 0x4d, 0x1a, 0x32, 0xca, 0xa1,
+	0xcf, 0xc4, 0x95, 0x1d, 0x2b, 0x63, 0x70, 0xa5, 0xe2, 0x59, 0x19, 0xd0, 0x00, 0xdd, 0x8e, 0x22,
+	0x0e, 0x6c, 0x00, 0xfd, 0xa9, 0xdb, 0x22, 0x92, 0xe6, 0xd8, 0xa3, 0xd3, 0x1e, 0xdb, 0xe2, 0xb2,
+	0x79, 0x3b, 0xb8, 0x3d, 0xfd, 0x49, 0x6f, 0xc3, 0xe0, 0x45, 0x2d, 0x2b, 0x02, 0x1d, 0xa9, 0xb3,
+	0x31, 0x31, 0xf0, 0x9b, 0xde, 0x83, 0x03, 0x26, 0xd2, 0x65, 0x81, 0x79, 0xd8, 0xfd, 0x55, 0xb3,
+	0xdb, 0xa9, 0xcf, 0x6e, 0x9d, 0x31, 0x9a, 0x3d, 0x4e, 0x82, 0xa2, 0x1c, 0xad, 0xce, 0xdb, 0x47,
+	0xeb, 0x3f, 0x6c, 0x3b, 0x7a, 0x1f, 0xe0, 0x58, 0x1e, 0xf1, 0x7c, 0x11, 0xaa, 0x6f, 0x53, 0x7d,
+	0x1d, 0x1c, 0x4b, 0x1f, 0x51, 0x9e, 0x62, 0x32, 0x3d, 0x56, 0x93, 0xd0, 0x47, 0x30, 0x3a, 0x96,
+	0x2f, 0x55, 0x7a, 0xa4, 0xb3, 0x9a, 0x17, 0xb1, 0xaf, 0xbb, 0x32, 0x92, 0xb1, 0x4a, 0x7d, 0x2d,
+	0x91, 0x45, 0xec, 0xdb, 0x55, 0x3b, 0x52, 0xfa, 0xb3, 0x03, 0xfb, 0x58, 0xf8, 0xa7, 0x1b, 0xe1,
+	0xe7, 0x2a, 0xc9, 0xf4, 0xa6, 0x83, 0x2c, 0x5a, 0x8b, 0xcc, 0xb6, 0x84, 0x45, 0x9a, 0xf1, 0xcb,
+	0x3c, 0xf6, 0x5f, 0xf2, 0x95, 0xa9, 0x74, 0x9f, 0x6d, 0x71, 0xf3, 0x0a, 0x6c, 0xef, 0x5e, 0x81,
+	0x63, 0x70, 0x53, 0x9e, 0xf1, 0x95, 0x1d, 0x0c, 0x06, 0x68, 0xa9, 0xd8, 0xa8, 0x8c, 0xe3, 0xbd,
+	0x38, 0x64, 0x06, 0xd0, 0x4f, 0xed, 0xf0, 0x9c, 0x8b, 0xab, 0x5c, 0xc4, 0x3e, 0xd6, 0x0a, 0xbd,
+	0x3a, 0xe6, 0xfd, 0x80, 0x0e, 0x09, 0x74, 0xce, 0x8a, 0xb4, 0x3c, 0x70, 0xf8, 0x4d, 0x3f, 0x83,
+	0x51, 0x63, 0xa1, 0x1e, 0x32, 0x8d, 0xb1, 0x3f, 0xae, 0x4f, 0xc3, 0xd2, 0xaa, 0x9c, 0xfe, 0x21,
+	0x8c, 0x4f, 0x79, 0xc6, 0x91, 0x89, 0xfa, 0x44, 0xfd, 0x18, 0x06, 0x38, 0x36, 0x03, 0x84, 0xb6,
+	0x41, 0xaf, 0xbb, 0x75, 0xea, 0x66, 0x9a, 0x2a, 0x69, 0x03, 0xd8, 0x1c, 0xb7, 0x98, 0xfe, 0xea,
+	0xc0, 0x90, 0x89, 0xab, 0x79, 0x7e, 0x21, 0xfd, 0x2c, 0xba, 0x10, 0xe6, 0x90, 0xe9, 0xab, 0xc0,
+	0x56, 0xc8, 0x22, 0xcd, 0x69, 0x20, 0x96, 0xe6, 0x96, 0x40, 0x2f, 0x3d, 0x56, 0x09, 0xc8, 0x08,
+	0x5a, 0x6a, 0x63, 0x4f, 0x7e, 0x4b, 0x6d, 0xf4, 0x99, 0x5f, 0x26, 0x0b, 0x64, 0xb8, 0xc7, 0xf4,
+	0xa7, 0xf6, 0x2b, 0x36, 0xc2, 0x17, 0x99, 0xe7, 0x62, 0x23, 0x58, 0xa4, 0xc9, 0xe3, 0x41, 0x90,
+	0x79, 0x5d, 0xd3, 0xfd, 0xfa, 0x9b, 0xfe, 0xe4, 0xc0, 0xde, 0x69, 0x2e, 0xc3, 0xe7, 0x66, 0xdd,
+	0x75, 0x87, 0xbe, 0xf6, 0xfc, 0x69, 0x35, 0x9e, 0x3f, 0x63, 0x70, 0x23, 0x9c, 0x94, 0x6d, 0x9c,
+	0x94, 0x06, 0xd4, 0xa2, 0x77, 0xcc, 0x29, 0xb2, 0xd1, 0xef, 0x98, 0x3c, 0x5d, 0x24, 0xf2, 0x46,
+	0xb3, 0x05, 0x9e, 0x27, 0x0b, 0x4c, 0x9d, 0xfe, 0xe8, 0x40, 0x5f, 0xa7, 0xf3, 0x74, 0x2d, 0x62,
+	0x85, 0x5b, 0x2d, 0x30, 0x19, 0x97, 0xb5, 0x54, 0x41, 0x3e, 0xd8, 0x12, 0xd6, 0xba, 0xee, 0x42,
+	0x2d, 0xf9, 0xa3, 0x5b, 0x86, 0xae, 0x6f, 0x4b, 0xcd, 0xda, 0xa4, 0x62, 0xad, 0xba, 0x82, 0x2c,
+	0x11, 0xdb, 0x54, 0x86, 0xf3, 0x98, 0xa7, 0x32, 0x4c, 0xd4, 0x0b, 0xa1, 0xf8, 0xee, 0xfb, 0xd1,
+	0xad, 0xde, 0x8f, 0xfa, 0x2a, 0x89, 0xd4, 0xb2, 0xec, 0x0e, 0x03, 0x6a, 0x74, 0xb6, 0x1b, 0x74,
+	0x96, 0xef, 0xe2, 0x4e, 0xed, 0x5d, 0xdc, 0x68, 0x23, 0x77, 0xa7, 0x8d, 0xe8, 0x09, 0xec, 0x97,
+	0x99, 0x98, 0xa7, 0xec, 0x3d, 0xe8, 0xfe, 0xe5, 0xb9, 0xb4, 0x16, 0x48, 0x62, 0x60, 0x2b, 0xd7,
+	0x52, 0x01, 0xfd, 0x05, 0x8f, 0x21, 0xd2, 0x7e, 0x9a, 0x25, 0xc9, 0x25, 0xb9, 0x0f, 0x7b, 0x76,
+	0xf8, 0xec, 0x78, 0xab, 0xcf, 0xa7, 0xd2, 0xa4, 0x2a, 0xba, 0x39, 0xde, 0x55, 0xd1, 0x53, 0xed,
+	0xac, 0x7c, 0xa9, 0x58, 0x54, 0xab, 0x58, 0xe7, 0x2d, 0x15, 0x7b, 0x7c, 0xeb, 0xbb, 0xf7, 0x16,
+	0x91, 0x0a, 0xf3, 0x8b, 0x99, 0x9f, 0xac, 0x1e, 0x1c, 0x1e, 0xfa, 0xf1, 0x03, 0xfc, 0x79, 0x3a,
+	0x3c, 0x7c, 0x80, 0xf6, 0x17, 0x5d, 0xfc, 0x3b, 0x3a, 0xfc, 0x73, 0x00, 0x53, 0x4a, 0xd9, 0x3c,
+	0x59, 0x0d, 0x00, 0x00,
 }
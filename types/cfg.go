@@ -19,6 +19,7 @@ type Config struct {
 	FixTime    bool        `protobuf:"varint,13,opt,name=fixTime" json:"fixTime,omitempty"`
 	Pprof      *Pprof      `protobuf:"bytes,14,opt,name=pprof" json:"pprof,omitempty"`
 	Fork       *ForkList   `protobuf:"bytes,15,opt,name=fork" json:"fork,omitempty"`
+	Queue      *Queue      `protobuf:"bytes,16,opt,name=queue" json:"queue,omitempty"`
 }
 
 type ForkList struct {
@@ -49,10 +50,14 @@ type Log struct {
 }
 
 type MemPool struct {
-	PoolCacheSize      int64 `protobuf:"varint,1,opt,name=poolCacheSize" json:"poolCacheSize,omitempty"`
-	MinTxFee           int64 `protobuf:"varint,2,opt,name=minTxFee" json:"minTxFee,omitempty"`
-	ForceAccept        bool  `protobuf:"varint,3,opt,name=forceAccept" json:"forceAccept,omitempty"`
-	MaxTxNumPerAccount int64 `protobuf:"varint,4,opt,name=maxTxNumPerAccount" json:"maxTxNumPerAccount,omitempty"`
+	PoolCacheSize      int64  `protobuf:"varint,1,opt,name=poolCacheSize" json:"poolCacheSize,omitempty"`
+	MinTxFee           int64  `protobuf:"varint,2,opt,name=minTxFee" json:"minTxFee,omitempty"`
+	ForceAccept        bool   `protobuf:"varint,3,opt,name=forceAccept" json:"forceAccept,omitempty"`
+	MaxTxNumPerAccount int64  `protobuf:"varint,4,opt,name=maxTxNumPerAccount" json:"maxTxNumPerAccount,omitempty"`
+	PriorityMode       bool   `protobuf:"varint,5,opt,name=priorityMode" json:"priorityMode,omitempty"`
+	Driver             string `protobuf:"bytes,6,opt,name=driver" json:"driver,omitempty"`
+	DbPath             string `protobuf:"bytes,7,opt,name=dbPath" json:"dbPath,omitempty"`
+	DbCache            int32  `protobuf:"varint,8,opt,name=dbCache" json:"dbCache,omitempty"`
 }
 
 type Consensus struct {
@@ -76,6 +81,7 @@ type Wallet struct {
 	DbPath   string `protobuf:"bytes,3,opt,name=dbPath" json:"dbPath,omitempty"`
 	DbCache  int32  `protobuf:"varint,4,opt,name=dbCache" json:"dbCache,omitempty"`
 	SignType string `protobuf:"bytes,5,opt,name=signType" json:"signType,omitempty"`
+	Signer   string `protobuf:"bytes,6,opt,name=signer" json:"signer,omitempty"`
 }
 
 type Store struct {
@@ -86,19 +92,24 @@ type Store struct {
 }
 
 type BlockChain struct {
-	DefCacheSize          int64  `protobuf:"varint,1,opt,name=defCacheSize" json:"defCacheSize,omitempty"`
-	MaxFetchBlockNum      int64  `protobuf:"varint,2,opt,name=maxFetchBlockNum" json:"maxFetchBlockNum,omitempty"`
-	TimeoutSeconds        int64  `protobuf:"varint,3,opt,name=timeoutSeconds" json:"timeoutSeconds,omitempty"`
-	BatchBlockNum         int64  `protobuf:"varint,4,opt,name=batchBlockNum" json:"batchBlockNum,omitempty"`
-	Driver                string `protobuf:"bytes,5,opt,name=driver" json:"driver,omitempty"`
-	DbPath                string `protobuf:"bytes,6,opt,name=dbPath" json:"dbPath,omitempty"`
-	DbCache               int32  `protobuf:"varint,7,opt,name=dbCache" json:"dbCache,omitempty"`
-	IsStrongConsistency   bool   `protobuf:"varint,8,opt,name=isStrongConsistency" json:"isStrongConsistency,omitempty"`
-	SingleMode            bool   `protobuf:"varint,9,opt,name=singleMode" json:"singleMode,omitempty"`
-	Batchsync             bool   `protobuf:"varint,10,opt,name=batchsync" json:"batchsync,omitempty"`
-	IsRecordBlockSequence bool   `protobuf:"varint,11,opt,name=isRecordBlockSequence" json:"isRecordBlockSequence,omitempty"`
-	IsParaChain           bool   `protobuf:"varint,12,opt,name=isParaChain" json:"isParaChain,omitempty"`
-	EnableTxQuickIndex    bool   `protobuf:"varint,13,opt,name=enableTxQuickIndex" json:"enableTxQuickIndex,omitempty"`
+	DefCacheSize          int64    `protobuf:"varint,1,opt,name=defCacheSize" json:"defCacheSize,omitempty"`
+	MaxFetchBlockNum      int64    `protobuf:"varint,2,opt,name=maxFetchBlockNum" json:"maxFetchBlockNum,omitempty"`
+	TimeoutSeconds        int64    `protobuf:"varint,3,opt,name=timeoutSeconds" json:"timeoutSeconds,omitempty"`
+	BatchBlockNum         int64    `protobuf:"varint,4,opt,name=batchBlockNum" json:"batchBlockNum,omitempty"`
+	Driver                string   `protobuf:"bytes,5,opt,name=driver" json:"driver,omitempty"`
+	DbPath                string   `protobuf:"bytes,6,opt,name=dbPath" json:"dbPath,omitempty"`
+	DbCache               int32    `protobuf:"varint,7,opt,name=dbCache" json:"dbCache,omitempty"`
+	IsStrongConsistency   bool     `protobuf:"varint,8,opt,name=isStrongConsistency" json:"isStrongConsistency,omitempty"`
+	SingleMode            bool     `protobuf:"varint,9,opt,name=singleMode" json:"singleMode,omitempty"`
+	Batchsync             bool     `protobuf:"varint,10,opt,name=batchsync" json:"batchsync,omitempty"`
+	IsRecordBlockSequence bool     `protobuf:"varint,11,opt,name=isRecordBlockSequence" json:"isRecordBlockSequence,omitempty"`
+	IsParaChain           bool     `protobuf:"varint,12,opt,name=isParaChain" json:"isParaChain,omitempty"`
+	EnableTxQuickIndex    bool     `protobuf:"varint,13,opt,name=enableTxQuickIndex" json:"enableTxQuickIndex,omitempty"`
+	LightMode             bool     `protobuf:"varint,14,opt,name=lightMode" json:"lightMode,omitempty"`
+	LightGenesisHash      string   `protobuf:"bytes,15,opt,name=lightGenesisHash" json:"lightGenesisHash,omitempty"`
+	LightSigners          []string `protobuf:"bytes,16,rep,name=lightSigners" json:"lightSigners,omitempty"`
+	SnapshotHash          string   `protobuf:"bytes,17,opt,name=snapshotHash" json:"snapshotHash,omitempty"`
+	BlockSignKey          string   `protobuf:"bytes,18,opt,name=blockSignKey" json:"blockSignKey,omitempty"`
 }
 
 type P2P struct {
@@ -118,6 +129,12 @@ type P2P struct {
 	InnerSeedEnable bool     `protobuf:"varint,14,opt,name=innerSeedEnable" json:"innerSeedEnable,omitempty"`
 	InnerBounds     int32    `protobuf:"varint,15,opt,name=innerBounds" json:"innerBounds,omitempty"`
 	UseGithub       bool     `protobuf:"varint,16,opt,name=useGithub" json:"useGithub,omitempty"`
+	EnableEncrypt   bool     `protobuf:"varint,17,opt,name=enableEncrypt" json:"enableEncrypt,omitempty"`
+	AllowPubkeys    []string `protobuf:"bytes,18,rep,name=allowPubkeys" json:"allowPubkeys,omitempty"`
+	SeedFile        string   `protobuf:"bytes,19,opt,name=seedFile" json:"seedFile,omitempty"`
+	DnsSeeds        []string `protobuf:"bytes,20,rep,name=dnsSeeds" json:"dnsSeeds,omitempty"`
+	SeedUrls        []string `protobuf:"bytes,21,rep,name=seedUrls" json:"seedUrls,omitempty"`
+	EnableDiscovery bool     `protobuf:"varint,22,opt,name=enableDiscovery" json:"enableDiscovery,omitempty"`
 }
 
 type Rpc struct {
@@ -130,6 +147,7 @@ type Rpc struct {
 	JrpcFuncBlacklist []string `protobuf:"bytes,7,rep,name=jrpcFuncBlacklist" json:"jrpcFuncBlacklist,omitempty"`
 	GrpcFuncBlacklist []string `protobuf:"bytes,8,rep,name=grpcFuncBlacklist" json:"grpcFuncBlacklist,omitempty"`
 	MainnetJrpcAddr   string   `protobuf:"bytes,9,opt,name=mainnetJrpcAddr" json:"mainnetJrpcAddr,omitempty"`
+	EnableSubscribe   bool     `protobuf:"varint,10,opt,name=enableSubscribe" json:"enableSubscribe,omitempty"`
 }
 
 type Exec struct {
@@ -140,8 +158,30 @@ type Exec struct {
 	DisableAddrIndex bool     `protobuf:"varint,7,opt,name=disableAddrIndex" json:"disableAddrIndex,omitempty"`
 	Alias            []string `protobuf:"bytes,5,rep,name=alias" json:"alias,omitempty"`
 	SaveTokenTxList  bool     `protobuf:"varint,6,opt,name=saveTokenTxList" json:"saveTokenTxList,omitempty"`
+	EnableParallel   bool     `protobuf:"varint,8,opt,name=enableParallel" json:"enableParallel,omitempty"`
+	EnableLogIndex   bool     `protobuf:"varint,9,opt,name=enableLogIndex" json:"enableLogIndex,omitempty"`
 }
 
 type Pprof struct {
 	ListenAddr string `protobuf:"bytes,1,opt,name=listenAddr" json:"listenAddr,omitempty"`
 }
+
+type Queue struct {
+	// 其他进程中的模块连接消息队列的地址，例如 unix:/tmp/chain33.sock 或者 tcp:127.0.0.1:8805，为空的时候不监听
+	// 连接没有认证，tcp 只能监听本机回环地址
+	ListenAddr string `protobuf:"bytes,1,opt,name=listenAddr" json:"listenAddr,omitempty"`
+	// 在其他进程中运行的模块，本进程不加载，目前支持 execs 和 wallet
+	RemoteModules []string `protobuf:"bytes,2,rep,name=remoteModules" json:"remoteModules,omitempty"`
+	// 单独设置队列长度的 topic
+	Topics []*QueueTopic `protobuf:"bytes,3,rep,name=topics" json:"topics,omitempty"`
+}
+
+type QueueTopic struct {
+	Topic string `protobuf:"bytes,1,opt,name=topic" json:"topic,omitempty"`
+	// 高优先级消息的队列长度，0 的时候使用默认值
+	HighBuffer int32 `protobuf:"varint,2,opt,name=highBuffer" json:"highBuffer,omitempty"`
+	// 低优先级消息的队列长度，0 的时候使用默认值
+	LowBuffer int32 `protobuf:"varint,3,opt,name=lowBuffer" json:"lowBuffer,omitempty"`
+	// 队列积压超过 3/4 以后，新的消息直接返回 ErrChannelFull，不阻塞发送方
+	RejectWhenBusy bool `protobuf:"varint,4,opt,name=rejectWhenBusy" json:"rejectWhenBusy,omitempty"`
+}
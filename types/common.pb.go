@@ -280,6 +280,284 @@ func (m *ReqKey) GetKey() []byte {
 	return nil
 }
 
+// 消息队列中一个 topic 的统计信息, 时间的单位是微秒
+type TopicStat struct {
+	Topic        string `protobuf:"bytes,1,opt,name=topic" json:"topic,omitempty"`
+	HighLen      int32  `protobuf:"varint,2,opt,name=highLen" json:"highLen,omitempty"`
+	HighCap      int32  `protobuf:"varint,3,opt,name=highCap" json:"highCap,omitempty"`
+	LowLen       int32  `protobuf:"varint,4,opt,name=lowLen" json:"lowLen,omitempty"`
+	LowCap       int32  `protobuf:"varint,5,opt,name=lowCap" json:"lowCap,omitempty"`
+	SendCount    int64  `protobuf:"varint,6,opt,name=sendCount" json:"sendCount,omitempty"`
+	RecvCount    int64  `protobuf:"varint,7,opt,name=recvCount" json:"recvCount,omitempty"`
+	TimeoutCount int64  `protobuf:"varint,8,opt,name=timeoutCount" json:"timeoutCount,omitempty"`
+	FullCount    int64  `protobuf:"varint,9,opt,name=fullCount" json:"fullCount,omitempty"`
+	AvgLatency   int64  `protobuf:"varint,10,opt,name=avgLatency" json:"avgLatency,omitempty"`
+	MaxLatency   int64  `protobuf:"varint,11,opt,name=maxLatency" json:"maxLatency,omitempty"`
+	Busy         bool   `protobuf:"varint,12,opt,name=busy" json:"busy,omitempty"`
+}
+
+func (m *TopicStat) Reset()                    { *m = TopicStat{} }
+func (m *TopicStat) String() string            { return proto.CompactTextString(m) }
+func (*TopicStat) ProtoMessage()               {}
+func (*TopicStat) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{15} }
+
+func (m *TopicStat) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *TopicStat) GetHighLen() int32 {
+	if m != nil {
+		return m.HighLen
+	}
+	return 0
+}
+
+func (m *TopicStat) GetHighCap() int32 {
+	if m != nil {
+		return m.HighCap
+	}
+	return 0
+}
+
+func (m *TopicStat) GetLowLen() int32 {
+	if m != nil {
+		return m.LowLen
+	}
+	return 0
+}
+
+func (m *TopicStat) GetLowCap() int32 {
+	if m != nil {
+		return m.LowCap
+	}
+	return 0
+}
+
+func (m *TopicStat) GetSendCount() int64 {
+	if m != nil {
+		return m.SendCount
+	}
+	return 0
+}
+
+func (m *TopicStat) GetRecvCount() int64 {
+	if m != nil {
+		return m.RecvCount
+	}
+	return 0
+}
+
+func (m *TopicStat) GetTimeoutCount() int64 {
+	if m != nil {
+		return m.TimeoutCount
+	}
+	return 0
+}
+
+func (m *TopicStat) GetFullCount() int64 {
+	if m != nil {
+		return m.FullCount
+	}
+	return 0
+}
+
+func (m *TopicStat) GetAvgLatency() int64 {
+	if m != nil {
+		return m.AvgLatency
+	}
+	return 0
+}
+
+func (m *TopicStat) GetMaxLatency() int64 {
+	if m != nil {
+		return m.MaxLatency
+	}
+	return 0
+}
+
+func (m *TopicStat) GetBusy() bool {
+	if m != nil {
+		return m.Busy
+	}
+	return false
+}
+
+type QueueStats struct {
+	Topics []*TopicStat `protobuf:"bytes,1,rep,name=topics" json:"topics,omitempty"`
+}
+
+func (m *QueueStats) Reset()                    { *m = QueueStats{} }
+func (m *QueueStats) String() string            { return proto.CompactTextString(m) }
+func (*QueueStats) ProtoMessage()               {}
+func (*QueueStats) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{16} }
+
+func (m *QueueStats) GetTopics() []*TopicStat {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+// 进程之间传输的队列消息
+// cmd: 0 发送消息 1 回复消息 2 订阅 topic
+type QueueMessage struct {
+	Cmd       int32  `protobuf:"varint,1,opt,name=cmd" json:"cmd,omitempty"`
+	Topic     string `protobuf:"bytes,2,opt,name=topic" json:"topic,omitempty"`
+	Ty        int64  `protobuf:"varint,3,opt,name=ty" json:"ty,omitempty"`
+	Id        int64  `protobuf:"varint,4,opt,name=id" json:"id,omitempty"`
+	WaitReply bool   `protobuf:"varint,5,opt,name=waitReply" json:"waitReply,omitempty"`
+	Timeout   int64  `protobuf:"varint,6,opt,name=timeout" json:"timeout,omitempty"`
+	DataType  string `protobuf:"bytes,7,opt,name=dataType" json:"dataType,omitempty"`
+	Data      []byte `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	Err       string `protobuf:"bytes,9,opt,name=err" json:"err,omitempty"`
+}
+
+func (m *QueueMessage) Reset()                    { *m = QueueMessage{} }
+func (m *QueueMessage) String() string            { return proto.CompactTextString(m) }
+func (*QueueMessage) ProtoMessage()               {}
+func (*QueueMessage) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{17} }
+
+func (m *QueueMessage) GetCmd() int32 {
+	if m != nil {
+		return m.Cmd
+	}
+	return 0
+}
+
+func (m *QueueMessage) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *QueueMessage) GetTy() int64 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+func (m *QueueMessage) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueueMessage) GetWaitReply() bool {
+	if m != nil {
+		return m.WaitReply
+	}
+	return false
+}
+
+func (m *QueueMessage) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *QueueMessage) GetDataType() string {
+	if m != nil {
+		return m.DataType
+	}
+	return ""
+}
+
+func (m *QueueMessage) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *QueueMessage) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+// 交易签名验证的统计信息, 时间的单位是微秒
+// verifyCount: 实际验证的签名数量, batchCount: 其中批量验证的数量, cacheHits: 使用缓存结果的数量
+// throughput: 每秒验证的签名数量
+type SignVerifyStats struct {
+	VerifyCount int64 `protobuf:"varint,1,opt,name=verifyCount" json:"verifyCount,omitempty"`
+	BatchCount  int64 `protobuf:"varint,2,opt,name=batchCount" json:"batchCount,omitempty"`
+	CacheHits   int64 `protobuf:"varint,3,opt,name=cacheHits" json:"cacheHits,omitempty"`
+	FailCount   int64 `protobuf:"varint,4,opt,name=failCount" json:"failCount,omitempty"`
+	TotalTime   int64 `protobuf:"varint,5,opt,name=totalTime" json:"totalTime,omitempty"`
+	Throughput  int64 `protobuf:"varint,6,opt,name=throughput" json:"throughput,omitempty"`
+	CacheSize   int32 `protobuf:"varint,7,opt,name=cacheSize" json:"cacheSize,omitempty"`
+	Workers     int32 `protobuf:"varint,8,opt,name=workers" json:"workers,omitempty"`
+}
+
+func (m *SignVerifyStats) Reset()                    { *m = SignVerifyStats{} }
+func (m *SignVerifyStats) String() string            { return proto.CompactTextString(m) }
+func (*SignVerifyStats) ProtoMessage()               {}
+func (*SignVerifyStats) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{18} }
+
+func (m *SignVerifyStats) GetVerifyCount() int64 {
+	if m != nil {
+		return m.VerifyCount
+	}
+	return 0
+}
+
+func (m *SignVerifyStats) GetBatchCount() int64 {
+	if m != nil {
+		return m.BatchCount
+	}
+	return 0
+}
+
+func (m *SignVerifyStats) GetCacheHits() int64 {
+	if m != nil {
+		return m.CacheHits
+	}
+	return 0
+}
+
+func (m *SignVerifyStats) GetFailCount() int64 {
+	if m != nil {
+		return m.FailCount
+	}
+	return 0
+}
+
+func (m *SignVerifyStats) GetTotalTime() int64 {
+	if m != nil {
+		return m.TotalTime
+	}
+	return 0
+}
+
+func (m *SignVerifyStats) GetThroughput() int64 {
+	if m != nil {
+		return m.Throughput
+	}
+	return 0
+}
+
+func (m *SignVerifyStats) GetCacheSize() int32 {
+	if m != nil {
+		return m.CacheSize
+	}
+	return 0
+}
+
+func (m *SignVerifyStats) GetWorkers() int32 {
+	if m != nil {
+		return m.Workers
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Reply)(nil), "types.Reply")
 	proto.RegisterType((*ReqString)(nil), "types.ReqString")
@@ -296,33 +574,59 @@ func init() {
 	proto.RegisterType((*TxHash)(nil), "types.TxHash")
 	proto.RegisterType((*TimeStatus)(nil), "types.TimeStatus")
 	proto.RegisterType((*ReqKey)(nil), "types.ReqKey")
+	proto.RegisterType((*TopicStat)(nil), "types.TopicStat")
+	proto.RegisterType((*QueueStats)(nil), "types.QueueStats")
+	proto.RegisterType((*QueueMessage)(nil), "types.QueueMessage")
+	proto.RegisterType((*SignVerifyStats)(nil), "types.SignVerifyStats")
 }
 
 func init() { proto.RegisterFile("common.proto", fileDescriptor2) }
 
 var fileDescriptor2 = []byte{
-	// 355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x4d, 0x6b, 0xe3, 0x30,
-	0x10, 0xc5, 0xf1, 0xda, 0x89, 0x67, 0x7d, 0x58, 0xc4, 0xb2, 0x98, 0xdd, 0x2c, 0x75, 0xd5, 0x14,
-	0x72, 0x69, 0x0a, 0x75, 0xe9, 0x0f, 0xe8, 0xa9, 0x21, 0xd0, 0x82, 0x12, 0x4a, 0xe9, 0x4d, 0x71,
-	0x65, 0x5b, 0xc4, 0x5f, 0x89, 0x94, 0x52, 0xff, 0xfb, 0xa2, 0x89, 0xfa, 0x45, 0x68, 0x7b, 0xd2,
-	0x9b, 0x79, 0x4f, 0x9a, 0x37, 0x0f, 0x41, 0x98, 0x36, 0x55, 0xd5, 0xd4, 0x93, 0x76, 0xd3, 0xe8,
-	0x86, 0x78, 0xba, 0x6b, 0x85, 0xa2, 0x27, 0xe0, 0x31, 0xd1, 0x96, 0x1d, 0x21, 0xf0, 0x43, 0xaa,
-	0x9b, 0x55, 0xe4, 0xc4, 0xce, 0x78, 0xc0, 0x10, 0x93, 0x5f, 0xe0, 0x56, 0x2a, 0x8f, 0x7a, 0xb1,
-	0x33, 0x0e, 0x99, 0x81, 0xf4, 0x00, 0x02, 0x26, 0xd6, 0x73, 0xbd, 0x91, 0x75, 0x6e, 0xae, 0x3c,
-	0x70, 0xcd, 0xf1, 0x4a, 0xc0, 0x10, 0xd3, 0x43, 0xf8, 0x89, 0xef, 0x7d, 0x21, 0x19, 0x41, 0xf8,
-	0x4e, 0xa2, 0xc8, 0x6f, 0xf0, 0x4c, 0x5f, 0x45, 0x4e, 0xec, 0x8e, 0x03, 0xb6, 0x2b, 0x68, 0x0c,
-	0x3e, 0x13, 0xeb, 0x69, 0xad, 0xc9, 0x1f, 0xf0, 0x0b, 0x21, 0xf3, 0x42, 0xe3, 0x2b, 0x2e, 0xb3,
-	0x15, 0xfd, 0x07, 0xde, 0xb4, 0xd6, 0x17, 0xe7, 0x1f, 0x86, 0xb8, 0x76, 0xc8, 0x7f, 0xe8, 0x33,
-	0xb1, 0xbe, 0xe2, 0xaa, 0x30, 0x74, 0xc1, 0x55, 0x81, 0x74, 0xc8, 0x10, 0xef, 0xf6, 0x68, 0xcb,
-	0xee, 0x53, 0xc1, 0x00, 0xc7, 0x5f, 0xcb, 0x92, 0x1e, 0xe1, 0xca, 0x46, 0x28, 0x14, 0x7a, 0x41,
-	0x84, 0x66, 0x43, 0x66, 0x2b, 0x7a, 0x6c, 0xd7, 0xfe, 0x46, 0x76, 0x06, 0x83, 0x99, 0xe8, 0x6e,
-	0x79, 0xb9, 0x15, 0x26, 0xdc, 0x95, 0xe8, 0xec, 0x50, 0x03, 0x4d, 0x10, 0x8f, 0x86, 0xb2, 0x81,
-	0xef, 0x0a, 0x3a, 0x04, 0x7f, 0xf1, 0xb4, 0xe7, 0x33, 0xb0, 0x3e, 0xef, 0x00, 0x16, 0xb2, 0x12,
-	0x73, 0xcd, 0xf5, 0x56, 0x91, 0x08, 0xfa, 0xb5, 0x6e, 0x4d, 0xc3, 0x8a, 0x5e, 0x4a, 0x32, 0x84,
-	0xa0, 0x6c, 0x52, 0x5e, 0x22, 0xd7, 0x43, 0xee, 0xad, 0x81, 0x09, 0xca, 0x2c, 0x8b, 0x5c, 0x9b,
-	0xa0, 0xcc, 0x32, 0xfa, 0x17, 0x13, 0x98, 0x89, 0x6e, 0xdf, 0xe9, 0xe5, 0xe8, 0x9e, 0xe6, 0x52,
-	0x97, 0x7c, 0x39, 0x49, 0x92, 0x49, 0x5a, 0x9f, 0xa6, 0x05, 0x97, 0x75, 0x92, 0xbc, 0x9e, 0xf8,
-	0xb7, 0x96, 0x3e, 0xfe, 0xb4, 0xe4, 0x39, 0x00, 0x00, 0xff, 0xff, 0xab, 0x6e, 0x0d, 0x32, 0x79,
-	0x02, 0x00, 0x00,
+	// 718 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x5d, 0x6f, 0xeb, 0x44,
+	0x10, 0x55, 0x9c, 0x3a, 0x8d, 0x27, 0x16, 0x54, 0x16, 0x42, 0x56, 0x69, 0x69, 0x30, 0x20, 0xe5,
+	0x85, 0x54, 0x6a, 0x50, 0x7f, 0x00, 0x7d, 0x69, 0xd5, 0x02, 0x62, 0x13, 0x55, 0x88, 0xb7, 0x8d,
+	0xb3, 0xb1, 0x57, 0xf5, 0x57, 0xbd, 0xeb, 0xb4, 0xe6, 0x9d, 0xff, 0xc7, 0xdb, 0xfd, 0x3b, 0x57,
+	0x33, 0xbb, 0xb1, 0x53, 0x55, 0xf7, 0xde, 0xb7, 0x39, 0xe7, 0xcc, 0x7a, 0x66, 0xcf, 0xec, 0x18,
+	0xfc, 0xb8, 0xcc, 0xf3, 0xb2, 0x98, 0x57, 0x75, 0xa9, 0xcb, 0xc0, 0xd5, 0x6d, 0x25, 0x54, 0xf4,
+	0x0b, 0xb8, 0x4c, 0x54, 0x59, 0x1b, 0x04, 0x70, 0x24, 0xd5, 0x9f, 0x4f, 0xe1, 0x60, 0x3a, 0x98,
+	0x8d, 0x19, 0xc5, 0xc1, 0x09, 0x0c, 0x73, 0x95, 0x84, 0xce, 0x74, 0x30, 0xf3, 0x19, 0x86, 0xd1,
+	0x05, 0x78, 0x4c, 0x3c, 0x2f, 0x75, 0x2d, 0x8b, 0x04, 0x8f, 0x6c, 0xb8, 0xe6, 0x74, 0xc4, 0x63,
+	0x14, 0x47, 0x3f, 0xc0, 0x84, 0xbe, 0xf7, 0x99, 0x94, 0x9f, 0xc0, 0x3f, 0x48, 0x51, 0xc1, 0x37,
+	0xe0, 0x22, 0xaf, 0xc2, 0xc1, 0x74, 0x38, 0xf3, 0x98, 0x01, 0xd1, 0x14, 0x46, 0x4c, 0x3c, 0xdf,
+	0x15, 0x3a, 0xf8, 0x16, 0x46, 0xa9, 0x90, 0x49, 0xaa, 0xe9, 0x2b, 0x43, 0x66, 0x51, 0xf4, 0x1d,
+	0xb8, 0x77, 0x85, 0xbe, 0xfe, 0xf5, 0x4d, 0x91, 0xa1, 0x2d, 0x72, 0x0e, 0xc7, 0x4c, 0x3c, 0xdf,
+	0x72, 0x95, 0xa2, 0x9c, 0x72, 0x95, 0x92, 0xec, 0x33, 0x8a, 0xcd, 0x3d, 0xaa, 0xac, 0xfd, 0x64,
+	0xc2, 0x98, 0xca, 0xff, 0x21, 0xb3, 0xe8, 0x47, 0xba, 0x32, 0x26, 0x0a, 0x45, 0xbd, 0x50, 0x44,
+	0xcd, 0xfa, 0xcc, 0xa2, 0xe8, 0x67, 0x7b, 0xed, 0x2f, 0xa4, 0x5d, 0xc1, 0xf8, 0x5e, 0xb4, 0x8f,
+	0x3c, 0x6b, 0x04, 0x9a, 0xfb, 0x24, 0x5a, 0x5b, 0x14, 0x43, 0x34, 0x62, 0x87, 0x92, 0x35, 0xdc,
+	0x80, 0xe8, 0x0c, 0x46, 0xab, 0xd7, 0x77, 0x7d, 0x7a, 0xb6, 0xcf, 0xbf, 0x01, 0x56, 0x32, 0x17,
+	0x4b, 0xcd, 0x75, 0xa3, 0x82, 0x10, 0x8e, 0x0b, 0x5d, 0x21, 0x61, 0x93, 0xf6, 0x30, 0x38, 0x03,
+	0x2f, 0x2b, 0x63, 0x9e, 0x91, 0xe6, 0x90, 0xd6, 0x13, 0xe4, 0xa0, 0xdc, 0x6e, 0xc3, 0xa1, 0x75,
+	0x50, 0x6e, 0xb7, 0xd1, 0x29, 0x39, 0x70, 0x2f, 0xda, 0xf7, 0x9d, 0x46, 0x1f, 0x1c, 0xf0, 0x56,
+	0x65, 0x25, 0x63, 0xac, 0x8b, 0x7d, 0x6b, 0x04, 0xb6, 0xa6, 0x01, 0xd8, 0x4b, 0x2a, 0x93, 0xf4,
+	0x41, 0x14, 0x54, 0xcf, 0x65, 0x7b, 0xb8, 0x57, 0x6e, 0x78, 0x15, 0x0e, 0x7b, 0xe5, 0x86, 0x57,
+	0xe8, 0x5b, 0x56, 0xbe, 0xe0, 0x91, 0x23, 0x12, 0x2c, 0xb2, 0x3c, 0x1e, 0x70, 0x3b, 0x1e, 0xf3,
+	0xcf, 0xc0, 0x53, 0xa2, 0xd8, 0xdc, 0x94, 0x4d, 0xa1, 0xc3, 0x11, 0x35, 0xdf, 0x13, 0xa8, 0xd6,
+	0x22, 0xde, 0x19, 0xf5, 0xd8, 0xa8, 0x1d, 0x11, 0x44, 0xe0, 0x6b, 0x99, 0x8b, 0xb2, 0xd1, 0x26,
+	0x61, 0x4c, 0x09, 0x6f, 0x38, 0xfc, 0xc2, 0xb6, 0xc9, 0x32, 0x93, 0xe0, 0x99, 0x2f, 0x74, 0x44,
+	0xf0, 0x3d, 0x00, 0xdf, 0x25, 0x0f, 0x5c, 0x8b, 0x22, 0x6e, 0x43, 0x20, 0xf9, 0x80, 0x41, 0x3d,
+	0xe7, 0xaf, 0x7b, 0x7d, 0x62, 0xf4, 0x9e, 0x41, 0xd7, 0xd7, 0x8d, 0x6a, 0x43, 0xdf, 0xac, 0x1c,
+	0xc6, 0xd1, 0x35, 0xc0, 0x5f, 0x8d, 0x68, 0x68, 0xa0, 0x2a, 0x98, 0xc1, 0x88, 0xcc, 0x34, 0xef,
+	0x68, 0x72, 0x75, 0x32, 0xa7, 0xad, 0x9d, 0x77, 0xde, 0x33, 0xab, 0x47, 0xff, 0x0f, 0xc0, 0xa7,
+	0x83, 0xbf, 0x0b, 0xa5, 0x78, 0x42, 0xcf, 0x2b, 0xce, 0x37, 0x34, 0x12, 0x97, 0x61, 0xd8, 0x8f,
+	0xc9, 0x39, 0x1c, 0xd3, 0x57, 0xe0, 0xe8, 0xd6, 0x0e, 0xde, 0xd1, 0x2d, 0x62, 0xb9, 0x21, 0xfb,
+	0x87, 0xcc, 0x91, 0x1b, 0xb4, 0xe0, 0x85, 0x4b, 0x4d, 0xaf, 0x9b, 0xdc, 0x1f, 0xb3, 0x9e, 0xc0,
+	0x51, 0x5a, 0xc3, 0xac, 0xfd, 0x7b, 0x18, 0x9c, 0xc2, 0x18, 0x17, 0x71, 0xd5, 0x56, 0x82, 0xbc,
+	0xf7, 0x58, 0x87, 0xbb, 0x85, 0x1d, 0x9b, 0x85, 0xc3, 0x18, 0xfb, 0x15, 0x75, 0x4d, 0x26, 0x7b,
+	0x0c, 0xc3, 0xe8, 0x3f, 0x07, 0xbe, 0x5e, 0xca, 0xa4, 0x78, 0x14, 0xb5, 0xdc, 0xb6, 0xc6, 0x90,
+	0x29, 0x4c, 0x76, 0x04, 0xcd, 0x48, 0xcc, 0xc6, 0x1f, 0x52, 0x68, 0xfa, 0x9a, 0xeb, 0x38, 0x35,
+	0x09, 0x8e, 0x31, 0xbd, 0x67, 0xf0, 0x3e, 0x31, 0x8f, 0x53, 0x71, 0x2b, 0xb5, 0xb2, 0xd7, 0xee,
+	0x09, 0x1a, 0x38, 0x97, 0x76, 0xe0, 0x47, 0x76, 0xe0, 0x7b, 0x02, 0x55, 0x5d, 0x6a, 0xbb, 0x44,
+	0xae, 0x51, 0x3b, 0x02, 0x2b, 0xeb, 0xb4, 0x2e, 0x9b, 0x24, 0xad, 0x3a, 0x3b, 0x0e, 0x98, 0xae,
+	0xf2, 0x52, 0xfe, 0x6b, 0x2c, 0x71, 0x59, 0x4f, 0xa0, 0x93, 0x2f, 0x65, 0xfd, 0x24, 0x6a, 0x45,
+	0xb6, 0xb8, 0x6c, 0x0f, 0x7f, 0xbb, 0xf8, 0xe7, 0x3c, 0x91, 0x3a, 0x6d, 0xd6, 0xf3, 0xb8, 0xcc,
+	0x2f, 0x17, 0x8b, 0xb8, 0xb8, 0x8c, 0x53, 0x2e, 0x8b, 0xc5, 0xe2, 0x92, 0x5e, 0xc3, 0x7a, 0x44,
+	0x7f, 0xf4, 0xc5, 0xc7, 0x01, 0x00, 0x2a, 0xcc, 0x08, 0x15, 0xe1, 0x05, 0x00, 0x00,
 }
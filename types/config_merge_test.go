@@ -216,6 +216,8 @@ ForkCheckBlockTime=1200000
 ForkTxHeight= -1
 ForkTxGroupPara= -1
 ForkChainParamV2= -1
+ForkReceiptHash= -1
+ForkSchnorr= -1
 
 [fork.sub.coins]
 Enable=0
@@ -56,6 +56,7 @@ var (
 //ty = 3 -> sm2
 //ty = 4 -> onetimeed25519
 //ty = 5 -> RingBaseonED25519
+//ty = 6 -> schnorr
 //ty = 1+offset(1<<8) ->auth_ecdsa
 //ty = 2+offset(1<<8) -> auth_sm2
 const (
@@ -63,6 +64,7 @@ const (
 	SECP256K1 = 1
 	ED25519   = 2
 	SM2       = 3
+	SCHNORR   = 6
 )
 
 // 创建隐私交易的类型定义
@@ -105,6 +107,14 @@ var SystemLog = map[int64]*LogInfo{
 	TyLogGenesisDeposit:  {reflect.TypeOf(ReceiptAccountTransfer{}), "LogGenesisDeposit"},
 }
 
+//push event type
+const (
+	TyPushBlock    = 1
+	TyPushDelBlock = 2
+	TyPushTx       = 3
+	TyPushLog      = 4
+)
+
 //exec type
 const (
 	ExecErr  = 0
@@ -112,6 +122,15 @@ const (
 	ExecOk   = 2
 )
 
+//p2p 节点的不良行为, 降低节点的信誉分数
+const (
+	MisbehaveInvalidBlock = 1
+	MisbehaveInvalidTx    = 2
+	MisbehaveTimeout      = 3
+	MisbehaveSpam         = 4
+	MisbehaveProtocol     = 5
+)
+
 func init() {
 	S("TxHeight", false)
 }
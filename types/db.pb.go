@@ -444,6 +444,98 @@ func (m *StoreValuePool) GetValues() [][]byte {
 	return nil
 }
 
+// 获取状态数据以及默克尔证明
+// absence: key 不存在的时候返回不存在的证明, 而不是 ErrNotFound
+type ReqStateProof struct {
+	StateHash []byte `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Key       []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Absence   bool   `protobuf:"varint,3,opt,name=absence" json:"absence,omitempty"`
+}
+
+func (m *ReqStateProof) Reset()                    { *m = ReqStateProof{} }
+func (m *ReqStateProof) String() string            { return proto.CompactTextString(m) }
+func (*ReqStateProof) ProtoMessage()               {}
+func (*ReqStateProof) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{15} }
+
+func (m *ReqStateProof) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *ReqStateProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *ReqStateProof) GetAbsence() bool {
+	if m != nil {
+		return m.Absence
+	}
+	return false
+}
+
+// 状态数据以及默克尔证明, proof 是序列化以后的 MAVLProof
+// key 不存在的时候 proof 为空, left 和 right 是和 key 相邻的两个叶子节点的证明
+type StateProof struct {
+	StateHash []byte      `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Key       []byte      `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value     []byte      `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Proof     []byte      `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+	Left      *StateProof `protobuf:"bytes,5,opt,name=left" json:"left,omitempty"`
+	Right     *StateProof `protobuf:"bytes,6,opt,name=right" json:"right,omitempty"`
+}
+
+func (m *StateProof) Reset()                    { *m = StateProof{} }
+func (m *StateProof) String() string            { return proto.CompactTextString(m) }
+func (*StateProof) ProtoMessage()               {}
+func (*StateProof) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{16} }
+
+func (m *StateProof) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *StateProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StateProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StateProof) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *StateProof) GetLeft() *StateProof {
+	if m != nil {
+		return m.Left
+	}
+	return nil
+}
+
+func (m *StateProof) GetRight() *StateProof {
+	if m != nil {
+		return m.Right
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*LeafNode)(nil), "types.LeafNode")
 	proto.RegisterType((*InnerNode)(nil), "types.InnerNode")
@@ -460,44 +552,51 @@ func init() {
 	proto.RegisterType((*StoreReplyValue)(nil), "types.StoreReplyValue")
 	proto.RegisterType((*PruneData)(nil), "types.PruneData")
 	proto.RegisterType((*StoreValuePool)(nil), "types.StoreValuePool")
+	proto.RegisterType((*ReqStateProof)(nil), "types.ReqStateProof")
+	proto.RegisterType((*StateProof)(nil), "types.StateProof")
 }
 
 func init() { proto.RegisterFile("db.proto", fileDescriptor3) }
 
 var fileDescriptor3 = []byte{
-	// 536 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xdf, 0x8b, 0xd3, 0x40,
-	0x10, 0xc7, 0x49, 0x72, 0xad, 0xc9, 0x5c, 0xf1, 0xca, 0x22, 0x52, 0x8e, 0xa2, 0x65, 0xf1, 0xa1,
-	0x22, 0x56, 0xb1, 0x4f, 0x82, 0x0f, 0x7a, 0x14, 0x4e, 0x69, 0x95, 0x92, 0x42, 0x05, 0x1f, 0x84,
-	0x6d, 0x3a, 0xbd, 0x86, 0xe6, 0x76, 0x6b, 0xb2, 0x15, 0xe3, 0x7f, 0xe6, 0x7f, 0x27, 0x99, 0xdd,
-	0xfc, 0x38, 0xf1, 0xac, 0xf7, 0xd4, 0x9d, 0xe9, 0x64, 0xbe, 0xdf, 0xf9, 0xcc, 0x26, 0xe0, 0xaf,
-	0x57, 0xa3, 0x7d, 0xaa, 0xb4, 0x62, 0x2d, 0x9d, 0xef, 0x31, 0x3b, 0xef, 0x44, 0xea, 0xfa, 0x5a,
-	0x49, 0x93, 0xe4, 0x5f, 0xc1, 0x9f, 0xa1, 0xd8, 0x7c, 0x52, 0x6b, 0x64, 0x5d, 0xf0, 0x76, 0x98,
-	0xf7, 0x9c, 0x81, 0x33, 0xec, 0x84, 0xc5, 0x91, 0x3d, 0x80, 0xd6, 0x77, 0x91, 0x1c, 0xb0, 0xe7,
-	0x52, 0xce, 0x04, 0xec, 0x21, 0xb4, 0xb7, 0x18, 0x5f, 0x6d, 0x75, 0xcf, 0x1b, 0x38, 0xc3, 0x56,
-	0x68, 0x23, 0xc6, 0xe0, 0x24, 0x8b, 0x7f, 0x62, 0xef, 0x84, 0xb2, 0x74, 0xe6, 0xdf, 0x20, 0xf8,
-	0x20, 0x25, 0xa6, 0x24, 0x70, 0x0e, 0x7e, 0x82, 0x1b, 0xfd, 0x5e, 0x64, 0x5b, 0xab, 0x52, 0xc5,
-	0xac, 0x0f, 0x41, 0x5a, 0x74, 0xa1, 0x3f, 0x8d, 0x5c, 0x9d, 0xb8, 0x93, 0xe4, 0x01, 0x82, 0x8f,
-	0xef, 0x96, 0xb3, 0x79, 0xaa, 0xd4, 0xc6, 0x48, 0x8a, 0xcd, 0x4d, 0x49, 0x13, 0xb3, 0x97, 0x00,
-	0x71, 0xe9, 0x2d, 0xeb, 0xb9, 0x03, 0x6f, 0x78, 0xfa, 0xaa, 0x3b, 0x22, 0x4a, 0xa3, 0xca, 0x74,
-	0xd8, 0xa8, 0x29, 0xba, 0xa5, 0x4a, 0x19, 0x8f, 0x9e, 0xe9, 0x56, 0xc6, 0xfc, 0x97, 0x03, 0xc1,
-	0x42, 0xab, 0x14, 0xef, 0xc4, 0xb2, 0x89, 0xc4, 0xfb, 0x17, 0x92, 0x93, 0xdb, 0x91, 0xb4, 0xfe,
-	0x8a, 0xa4, 0x5d, 0x23, 0x61, 0x8f, 0x00, 0xf6, 0x22, 0x45, 0x69, 0x5a, 0xdd, 0xa3, 0x56, 0x8d,
-	0x0c, 0x7f, 0x0e, 0x30, 0x53, 0x91, 0x48, 0x26, 0x17, 0x0b, 0xd4, 0xec, 0x31, 0xb8, 0xd3, 0xa5,
-	0xe5, 0x71, 0x66, 0x79, 0x4c, 0x31, 0x5f, 0x16, 0x86, 0x43, 0x77, 0xba, 0xe4, 0x3b, 0x38, 0xb5,
-	0xe5, 0xb3, 0x38, 0xd3, 0x85, 0x93, 0x7d, 0x8a, 0x9b, 0xf8, 0x87, 0x1d, 0xd7, 0x46, 0x25, 0x03,
-	0xb7, 0x66, 0xd0, 0x87, 0x60, 0x1d, 0xa7, 0x18, 0xe9, 0x58, 0x49, 0xbb, 0xc9, 0x3a, 0x51, 0x10,
-	0x8a, 0xd4, 0x41, 0x6a, 0xbb, 0x4d, 0x13, 0xf0, 0x41, 0xe5, 0xed, 0x12, 0x69, 0xba, 0x1d, 0xe6,
-	0x66, 0x5b, 0x9d, 0x90, 0xce, 0xfc, 0x29, 0x9c, 0x51, 0x45, 0x88, 0xfb, 0xc4, 0xb8, 0x2c, 0x2c,
-	0x11, 0xdf, 0xb2, 0xd0, 0x46, 0x5c, 0x80, 0x4f, 0x3b, 0x2a, 0xc6, 0xec, 0x43, 0x90, 0x69, 0xa1,
-	0xb1, 0x71, 0x37, 0xea, 0xc4, 0x51, 0x08, 0x7f, 0x5c, 0x49, 0xaf, 0xe4, 0xcf, 0xdf, 0x5a, 0x89,
-	0x09, 0x26, 0x47, 0x24, 0xea, 0x0e, 0xee, 0x8d, 0x0e, 0x0b, 0xe8, 0x96, 0x26, 0x3f, 0xc7, 0x7a,
-	0xbb, 0xc8, 0x65, 0xc4, 0x9e, 0x81, 0x9f, 0x15, 0xb9, 0x0c, 0x35, 0x35, 0xaa, 0x4d, 0x95, 0xa5,
-	0x61, 0x55, 0x40, 0x57, 0x20, 0x97, 0x11, 0xb5, 0xf5, 0x43, 0x3a, 0xf3, 0x37, 0xd6, 0xd6, 0xe5,
-	0xd1, 0xc9, 0x6f, 0x41, 0x4c, 0x4f, 0xff, 0x07, 0xe2, 0xd7, 0x10, 0xcc, 0xd3, 0x83, 0xc4, 0x89,
-	0xd0, 0xa2, 0x31, 0xa2, 0xd3, 0x1c, 0xb1, 0x58, 0x75, 0x82, 0x52, 0x9b, 0x37, 0xbd, 0x15, 0x9a,
-	0x80, 0x0f, 0xe1, 0x3e, 0xa9, 0x90, 0xc0, 0x5c, 0xa9, 0xa4, 0x21, 0xe2, 0x34, 0x45, 0x2e, 0x9e,
-	0x7c, 0xe1, 0x57, 0xb1, 0x4e, 0xc4, 0x6a, 0x34, 0x1e, 0x8f, 0x22, 0xf9, 0x22, 0xda, 0x8a, 0x58,
-	0x8e, 0xc7, 0xd5, 0x2f, 0xa1, 0x59, 0xb5, 0xe9, 0x1b, 0x37, 0xfe, 0x1d, 0x00, 0x00, 0xff, 0xff,
-	0xe1, 0x54, 0x5d, 0xd9, 0x04, 0x05, 0x00, 0x00,
+	// 615 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x6f, 0x6b, 0x13, 0x4f,
+	0x10, 0xe6, 0x72, 0xb9, 0xf4, 0x6e, 0xda, 0xdf, 0xaf, 0x75, 0x29, 0x72, 0x94, 0x6a, 0xc3, 0x81,
+	0x18, 0x11, 0x53, 0x69, 0x5e, 0x09, 0xbe, 0xd0, 0x52, 0xa8, 0xd2, 0x28, 0xe5, 0x02, 0x11, 0x7d,
+	0x21, 0x6c, 0xae, 0x93, 0xe6, 0xe8, 0x75, 0x37, 0xbd, 0xdb, 0x88, 0xf1, 0x93, 0xe9, 0xb7, 0x93,
+	0x9d, 0xdd, 0xfb, 0x53, 0xb5, 0x8d, 0xc5, 0x77, 0x3b, 0xb3, 0x73, 0xcf, 0xf3, 0xcc, 0x33, 0x3b,
+	0x07, 0xfe, 0xd9, 0xa4, 0x3f, 0xcf, 0xa5, 0x92, 0xcc, 0x53, 0xcb, 0x39, 0x16, 0x3b, 0x1b, 0x89,
+	0xbc, 0xbc, 0x94, 0xc2, 0x24, 0xa3, 0xcf, 0xe0, 0x0f, 0x91, 0x4f, 0xdf, 0xcb, 0x33, 0x64, 0x5b,
+	0xe0, 0x5e, 0xe0, 0x32, 0x74, 0xba, 0x4e, 0x6f, 0x23, 0xd6, 0x47, 0xb6, 0x0d, 0xde, 0x17, 0x9e,
+	0x2d, 0x30, 0x6c, 0x51, 0xce, 0x04, 0xec, 0x3e, 0x74, 0x66, 0x98, 0x9e, 0xcf, 0x54, 0xe8, 0x76,
+	0x9d, 0x9e, 0x17, 0xdb, 0x88, 0x31, 0x68, 0x17, 0xe9, 0x37, 0x0c, 0xdb, 0x94, 0xa5, 0x73, 0x74,
+	0x05, 0xc1, 0x5b, 0x21, 0x30, 0x27, 0x82, 0x1d, 0xf0, 0x33, 0x9c, 0xaa, 0x37, 0xbc, 0x98, 0x59,
+	0x96, 0x2a, 0x66, 0xbb, 0x10, 0xe4, 0x1a, 0x85, 0x2e, 0x0d, 0x5d, 0x9d, 0xb8, 0x13, 0xe5, 0x02,
+	0x82, 0x77, 0xaf, 0xc7, 0xc3, 0xd3, 0x5c, 0xca, 0xa9, 0xa1, 0xe4, 0xd3, 0xeb, 0x94, 0x26, 0x66,
+	0xcf, 0x01, 0xd2, 0x52, 0x5b, 0x11, 0xb6, 0xba, 0x6e, 0x6f, 0xfd, 0x60, 0xab, 0x4f, 0x2e, 0xf5,
+	0x2b, 0xd1, 0x71, 0xa3, 0x46, 0xa3, 0xe5, 0x52, 0x1a, 0x8d, 0xae, 0x41, 0x2b, 0xe3, 0xe8, 0x87,
+	0x03, 0xc1, 0x48, 0xc9, 0x1c, 0xef, 0xe4, 0x65, 0xd3, 0x12, 0xf7, 0x36, 0x4b, 0xda, 0x37, 0x5b,
+	0xe2, 0xfd, 0xd1, 0x92, 0x4e, 0x6d, 0x09, 0x7b, 0x08, 0x30, 0xe7, 0x39, 0x0a, 0x03, 0xb5, 0x46,
+	0x50, 0x8d, 0x4c, 0xf4, 0x0c, 0x60, 0x28, 0x13, 0x9e, 0x1d, 0x1d, 0x8e, 0x50, 0xb1, 0x3d, 0x68,
+	0x9d, 0x8c, 0xad, 0x1f, 0x9b, 0xd6, 0x8f, 0x13, 0x5c, 0x8e, 0xb5, 0xe0, 0xb8, 0x75, 0x32, 0x8e,
+	0x2e, 0x60, 0xdd, 0x96, 0x0f, 0xd3, 0x42, 0x69, 0x25, 0xf3, 0x1c, 0xa7, 0xe9, 0x57, 0xdb, 0xae,
+	0x8d, 0x4a, 0x0f, 0x5a, 0xb5, 0x07, 0xbb, 0x10, 0x9c, 0xa5, 0x39, 0x26, 0x2a, 0x95, 0xc2, 0x4e,
+	0xb2, 0x4e, 0x68, 0x87, 0x12, 0xb9, 0x10, 0xca, 0x4e, 0xd3, 0x04, 0x51, 0xb7, 0xd2, 0x76, 0x8c,
+	0xd4, 0xdd, 0x05, 0x2e, 0xcd, 0xb4, 0x36, 0x62, 0x3a, 0x47, 0x4f, 0x60, 0x93, 0x2a, 0x62, 0x9c,
+	0x67, 0x46, 0xa5, 0x96, 0x44, 0xfe, 0x96, 0x85, 0x36, 0x8a, 0x38, 0xf8, 0x34, 0x23, 0xdd, 0xe6,
+	0x2e, 0x04, 0x85, 0xe2, 0x0a, 0x1b, 0x6f, 0xa3, 0x4e, 0xac, 0x34, 0xe1, 0x97, 0x27, 0xe9, 0x96,
+	0xfe, 0x47, 0xaf, 0x2c, 0xc5, 0x11, 0x66, 0x2b, 0x28, 0x6a, 0x84, 0xd6, 0x35, 0x84, 0x11, 0x6c,
+	0x95, 0x22, 0x3f, 0xa4, 0x6a, 0x36, 0x5a, 0x8a, 0x84, 0x3d, 0x05, 0xbf, 0xd0, 0xb9, 0x02, 0x15,
+	0x01, 0xd5, 0xa2, 0xca, 0xd2, 0xb8, 0x2a, 0xa0, 0x27, 0xb0, 0x14, 0x09, 0xc1, 0xfa, 0x31, 0x9d,
+	0xa3, 0x97, 0x56, 0xd6, 0xf1, 0xca, 0xce, 0x6f, 0xb0, 0x98, 0xbe, 0xfe, 0x0b, 0x8b, 0x5f, 0x40,
+	0x70, 0x9a, 0x2f, 0x04, 0x1e, 0x71, 0xc5, 0x1b, 0x2d, 0x3a, 0xcd, 0x16, 0xf5, 0xa8, 0x33, 0x14,
+	0xca, 0x6c, 0xba, 0x17, 0x9b, 0x20, 0xea, 0xc1, 0xff, 0xc4, 0x42, 0x04, 0xa7, 0x52, 0x66, 0x0d,
+	0x12, 0xe7, 0x1a, 0xc9, 0x47, 0xf8, 0x2f, 0xc6, 0xab, 0x91, 0xd6, 0x6c, 0xf6, 0xfc, 0xf6, 0x96,
+	0x7e, 0x7f, 0x89, 0x21, 0xac, 0xf1, 0x49, 0x81, 0x22, 0x41, 0x1a, 0x9f, 0x1f, 0x97, 0x61, 0xf4,
+	0xdd, 0x01, 0xf8, 0x07, 0xe0, 0x6a, 0xcd, 0xdd, 0xe6, 0x9a, 0x6f, 0x83, 0x37, 0xd7, 0x70, 0x76,
+	0x8d, 0x4d, 0xc0, 0x1e, 0x41, 0x5b, 0x2f, 0x3b, 0x2d, 0xf0, 0xfa, 0xc1, 0xbd, 0x6a, 0xa0, 0x25,
+	0x79, 0x4c, 0xd7, 0xec, 0x31, 0x78, 0xb4, 0xf6, 0x61, 0xe7, 0xa6, 0x3a, 0x73, 0x7f, 0xb8, 0xf7,
+	0xe9, 0xc1, 0x79, 0xaa, 0x66, 0x8b, 0x49, 0x3f, 0x91, 0x97, 0xfb, 0x83, 0x41, 0x22, 0xf6, 0x93,
+	0x19, 0x4f, 0xc5, 0x60, 0xb0, 0x4f, 0x9f, 0x4c, 0x3a, 0xf4, 0xd3, 0x1f, 0xfc, 0x1c, 0x00, 0x5f,
+	0x6f, 0xe1, 0x1d, 0x15, 0x06, 0x00, 0x00,
 }
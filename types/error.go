@@ -21,6 +21,7 @@ var (
 	ErrBlockExec               = errors.New("ErrBlockExec")
 	ErrCheckStateHash          = errors.New("ErrCheckStateHash")
 	ErrCheckTxHash             = errors.New("ErrCheckTxHash")
+	ErrCheckReceiptHash        = errors.New("ErrCheckReceiptHash")
 	ErrReRunGenesis            = errors.New("ErrReRunGenesis")
 	ErrActionNotSupport        = errors.New("ErrActionNotSupport")
 	ErrQueryNotSupport         = errors.New("ErrQueryNotSupport")
@@ -77,6 +78,7 @@ var (
 	ErrTxDup                      = errors.New("ErrTxDup")
 	ErrNotSync                    = errors.New("ErrNotSync")
 	ErrSize                       = errors.New("ErrSize")
+	ErrReplaceFeeTooLow           = errors.New("ErrReplaceFeeTooLow")
 
 	// BlockChain Error Types
 	ErrHashNotExist           = errors.New("ErrHashNotExist")
@@ -94,10 +96,17 @@ var (
 	ErrBlockHeightNoMatch     = errors.New("ErrBlockHeightNoEqual")
 	ErrParentTdNoExist        = errors.New("ErrParentTdNoExist")
 	ErrBlockHashNoMatch       = errors.New("ErrBlockHashNoMatch")
+	ErrForkTooDeep            = errors.New("ErrForkTooDeep")
 	ErrIsClosed               = errors.New("ErrIsClosed")
 	ErrDecode                 = errors.New("ErrDecode")
 	ErrNotRollBack            = errors.New("ErrNotRollBack")
 	ErrPeerInfoIsNil          = errors.New("ErrPeerInfoIsNil")
+	ErrSnapshotFormat         = errors.New("ErrSnapshotFormat")
+	ErrSnapshotVersion        = errors.New("ErrSnapshotVersion")
+	ErrSnapshotTitle          = errors.New("ErrSnapshotTitle")
+	ErrSnapshotDBNotEmpty     = errors.New("ErrSnapshotDBNotEmpty")
+	ErrSnapshotHash           = errors.New("ErrSnapshotHash")
+	ErrSnapshotSequence       = errors.New("ErrSnapshotSequence")
 	//wallet
 	ErrWalletIsLocked       = errors.New("ErrWalletIsLocked")
 	ErrSaveSeedFirst        = errors.New("ErrSaveSeedFirst")
@@ -115,6 +124,7 @@ var (
 	ErrSubPubKeyVerifyFail  = errors.New("ErrSubPubKeyVerifyFail")
 	ErrLabelNotExist        = errors.New("ErrLabelNotExist")
 	ErrAccountNotExist      = errors.New("ErrAccountNotExist")
+	ErrStateProof           = errors.New("ErrStateProof")
 	ErrSeedExist            = errors.New("ErrSeedExist")
 	ErrNotSupport           = errors.New("ErrNotSupport")
 	ErrSeedWordNum          = errors.New("ErrSeedWordNum")
@@ -125,6 +135,8 @@ var (
 	ErrNewWalletFromSeed    = errors.New("ErrNewWalletFromSeed")
 	ErrNewKeyPair           = errors.New("ErrNewKeyPair")
 	ErrPrivkeyToPub         = errors.New("ErrPrivkeyToPub")
+	ErrWatchOnlyAccount     = errors.New("ErrWatchOnlyAccount")
+	ErrAccountAddrExist     = errors.New("ErrAccountAddrExist")
 
 	ErrOnlyTicketUnLocked = errors.New("ErrOnlyTicketUnLocked")
 	ErrNewCrypto          = errors.New("ErrNewCrypto")
@@ -133,10 +145,13 @@ var (
 	ErrParentHash         = errors.New("ErrParentHash")
 
 	//p2p
-	ErrPing       = errors.New("ErrPingSignature")
-	ErrVersion    = errors.New("ErrVersionNoSupport")
-	ErrStreamPing = errors.New("ErrStreamPing")
-	ErrPeerStop   = errors.New("ErrPeerStop")
+	ErrPing          = errors.New("ErrPingSignature")
+	ErrVersion       = errors.New("ErrVersionNoSupport")
+	ErrStreamPing    = errors.New("ErrStreamPing")
+	ErrPeerStop      = errors.New("ErrPeerStop")
+	ErrPeerIdentity  = errors.New("ErrPeerIdentity")
+	ErrPeerBanned    = errors.New("ErrPeerBanned")
+	ErrPeerNotBanned = errors.New("ErrPeerNotBanned")
 
 	ErrBlockSize                  = errors.New("ErrBlockSize")
 	ErrTxGroupIndex               = errors.New("ErrTxGroupIndex")
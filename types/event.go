@@ -135,6 +135,32 @@ const (
 	EventGetSeqByHash            = 127
 	EventLocalPrefixCount        = 128
 	EventWalletCreateTx          = 129
+	//mempool 推送新交易给rpc订阅者
+	EventPushTx = 130
+	//store 获取状态数据的默克尔证明
+	EventStoreGetProof      = 131
+	EventStoreGetProofReply = 132
+	//blockchain 获取交易执行结果的默克尔证明
+	EventGetReceiptProof   = 133
+	EventReplyReceiptProof = 134
+	//轻节点从其他节点获取状态数据的默克尔证明
+	EventFetchStateProof = 135
+	//wallet HD钱包路径推导, 扩展公钥导出和账户发现
+	EventDeriveAccount     = 136
+	EventGetExtendedPubKey = 137
+	EventDiscoverAccounts  = 138
+	//wallet 只读账户和离线签名
+	EventWalletImportWatchOnly = 139
+	EventWalletCreateOfflineTx = 140
+	EventWalletExport          = 141
+	EventWalletImport          = 142
+	//p2p 节点信誉分数和封禁
+	EventPeerMisbehave = 143
+	EventGetPeerScores = 144
+	EventBanPeer       = 145
+	EventUnbanPeer     = 146
+	//p2p 按压缩区块的短id查找mempool中的交易
+	EventGetTxsByShortIDs = 147
 	//exec
 	EventBlockChainQuery = 212
 	EventConsensusQuery  = 213
@@ -266,7 +292,25 @@ var eventName = map[int]string{
 	127: "EventGetSeqByHash",
 	128: "EventLocalPrefixCount",
 	//todo: 这个可能后面会删除
-	EventWalletCreateTx: "EventWalletCreateTx",
+	EventWalletCreateTx:        "EventWalletCreateTx",
+	EventPushTx:                "EventPushTx",
+	EventStoreGetProof:         "EventStoreGetProof",
+	EventStoreGetProofReply:    "EventStoreGetProofReply",
+	EventGetReceiptProof:       "EventGetReceiptProof",
+	EventReplyReceiptProof:     "EventReplyReceiptProof",
+	EventFetchStateProof:       "EventFetchStateProof",
+	EventDeriveAccount:         "EventDeriveAccount",
+	EventGetExtendedPubKey:     "EventGetExtendedPubKey",
+	EventDiscoverAccounts:      "EventDiscoverAccounts",
+	EventWalletImportWatchOnly: "EventWalletImportWatchOnly",
+	EventWalletCreateOfflineTx: "EventWalletCreateOfflineTx",
+	EventWalletExport:          "EventWalletExport",
+	EventWalletImport:          "EventWalletImport",
+	EventPeerMisbehave:         "EventPeerMisbehave",
+	EventGetPeerScores:         "EventGetPeerScores",
+	EventBanPeer:               "EventBanPeer",
+	EventUnbanPeer:             "EventUnbanPeer",
+	EventGetTxsByShortIDs:      "EventGetTxsByShortIDs",
 	// Token
 	EventBlockChainQuery: "EventBlockChainQuery",
 	EventConsensusQuery:  "EventConsensusQuery",
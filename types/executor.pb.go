@@ -513,6 +513,195 @@ func (m *HistoryCertStore) GetNxtHeight() int64 {
 	return 0
 }
 
+// receipt log 索引中保存的信息
+// 	 height : 区块高度
+// 	 index : 交易在区块中的序号
+// 	 logIndex : log在交易receipt中的序号
+// 	 from, to : 交易的from和to地址
+type ReceiptLogInfo struct {
+	Height   int64  `protobuf:"varint,1,opt,name=height" json:"height,omitempty"`
+	Index    int64  `protobuf:"varint,2,opt,name=index" json:"index,omitempty"`
+	LogIndex int64  `protobuf:"varint,3,opt,name=logIndex" json:"logIndex,omitempty"`
+	TxHash   []byte `protobuf:"bytes,4,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Execer   string `protobuf:"bytes,5,opt,name=execer" json:"execer,omitempty"`
+	From     string `protobuf:"bytes,6,opt,name=from" json:"from,omitempty"`
+	To       string `protobuf:"bytes,7,opt,name=to" json:"to,omitempty"`
+	Ty       int32  `protobuf:"varint,8,opt,name=ty" json:"ty,omitempty"`
+	Log      []byte `protobuf:"bytes,9,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (m *ReceiptLogInfo) Reset()                    { *m = ReceiptLogInfo{} }
+func (m *ReceiptLogInfo) String() string            { return proto.CompactTextString(m) }
+func (*ReceiptLogInfo) ProtoMessage()               {}
+func (*ReceiptLogInfo) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{12} }
+
+func (m *ReceiptLogInfo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReceiptLogInfo) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ReceiptLogInfo) GetLogIndex() int64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *ReceiptLogInfo) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *ReceiptLogInfo) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *ReceiptLogInfo) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ReceiptLogInfo) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *ReceiptLogInfo) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+func (m *ReceiptLogInfo) GetLog() []byte {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+// 查询receipt log
+// 	 execer : 执行器名称，为空表示所有执行器
+// 	 tys : log类型，为空表示所有类型
+// 	 addr : 交易的from或者to地址，为空表示所有地址
+// 	 fromHeight, toHeight : 区块高度范围，toHeight 小于0表示不限制
+// 	 count : 返回的最大数量
+// 	 direction : 0 按高度从高到低，1 按高度从低到高
+// 	 primaryKey : 上次查询返回的primaryKey，用于翻页
+type ReqGetLogs struct {
+	Execer     string  `protobuf:"bytes,1,opt,name=execer" json:"execer,omitempty"`
+	Tys        []int32 `protobuf:"varint,2,rep,packed,name=tys" json:"tys,omitempty"`
+	Addr       string  `protobuf:"bytes,3,opt,name=addr" json:"addr,omitempty"`
+	FromHeight int64   `protobuf:"varint,4,opt,name=fromHeight" json:"fromHeight,omitempty"`
+	ToHeight   int64   `protobuf:"varint,5,opt,name=toHeight" json:"toHeight,omitempty"`
+	Count      int32   `protobuf:"varint,6,opt,name=count" json:"count,omitempty"`
+	Direction  int32   `protobuf:"varint,7,opt,name=direction" json:"direction,omitempty"`
+	PrimaryKey string  `protobuf:"bytes,8,opt,name=primaryKey" json:"primaryKey,omitempty"`
+}
+
+func (m *ReqGetLogs) Reset()                    { *m = ReqGetLogs{} }
+func (m *ReqGetLogs) String() string            { return proto.CompactTextString(m) }
+func (*ReqGetLogs) ProtoMessage()               {}
+func (*ReqGetLogs) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{13} }
+
+func (m *ReqGetLogs) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *ReqGetLogs) GetTys() []int32 {
+	if m != nil {
+		return m.Tys
+	}
+	return nil
+}
+
+func (m *ReqGetLogs) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqGetLogs) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *ReqGetLogs) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *ReqGetLogs) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqGetLogs) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+func (m *ReqGetLogs) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+type ReplyReceiptLogs struct {
+	Logs       []*ReceiptLogInfo `protobuf:"bytes,1,rep,name=logs" json:"logs,omitempty"`
+	PrimaryKey string            `protobuf:"bytes,2,opt,name=primaryKey" json:"primaryKey,omitempty"`
+}
+
+func (m *ReplyReceiptLogs) Reset()                    { *m = ReplyReceiptLogs{} }
+func (m *ReplyReceiptLogs) String() string            { return proto.CompactTextString(m) }
+func (*ReplyReceiptLogs) ProtoMessage()               {}
+func (*ReplyReceiptLogs) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{14} }
+
+func (m *ReplyReceiptLogs) GetLogs() []*ReceiptLogInfo {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *ReplyReceiptLogs) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Genesis)(nil), "types.Genesis")
 	proto.RegisterType((*ExecTxList)(nil), "types.ExecTxList")
@@ -526,50 +715,66 @@ func init() {
 	proto.RegisterType((*ReceiptConfig)(nil), "types.ReceiptConfig")
 	proto.RegisterType((*ReplyConfig)(nil), "types.ReplyConfig")
 	proto.RegisterType((*HistoryCertStore)(nil), "types.HistoryCertStore")
+	proto.RegisterType((*ReceiptLogInfo)(nil), "types.ReceiptLogInfo")
+	proto.RegisterType((*ReqGetLogs)(nil), "types.ReqGetLogs")
+	proto.RegisterType((*ReplyReceiptLogs)(nil), "types.ReplyReceiptLogs")
 }
 
 func init() { proto.RegisterFile("executor.proto", fileDescriptor4) }
 
 var fileDescriptor4 = []byte{
-	// 628 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x61, 0x6b, 0x13, 0x41,
-	0x10, 0xf5, 0x72, 0x49, 0xd3, 0x4c, 0x62, 0x69, 0x57, 0x91, 0xa3, 0x48, 0x0d, 0xd7, 0x5a, 0x03,
-	0x4a, 0x84, 0x04, 0x7f, 0x80, 0x0d, 0x62, 0x0a, 0x56, 0xf0, 0x1a, 0xbf, 0xf4, 0x83, 0xb0, 0xdd,
-	0x4c, 0x92, 0xa5, 0xc9, 0xee, 0xb1, 0x3b, 0x57, 0x72, 0x7f, 0x4f, 0xfc, 0x61, 0xb2, 0x9b, 0x4b,
-	0xee, 0xb0, 0x55, 0xf0, 0xd3, 0xdd, 0xbc, 0x79, 0xbc, 0x99, 0x37, 0x73, 0x73, 0x70, 0x80, 0x6b,
-	0x14, 0x19, 0x69, 0xd3, 0x4f, 0x8d, 0x26, 0xcd, 0x1a, 0x94, 0xa7, 0x68, 0x8f, 0x8f, 0xc8, 0x70,
-	0x65, 0xb9, 0x20, 0xa9, 0xd5, 0x26, 0x13, 0xbf, 0x82, 0xe6, 0x67, 0x54, 0x68, 0xa5, 0x65, 0xcf,
-	0xa1, 0x21, 0xad, 0xc9, 0x54, 0x14, 0x74, 0x83, 0xde, 0x7e, 0xb2, 0x09, 0xe2, 0x5f, 0x01, 0xc0,
-	0xa7, 0x35, 0x8a, 0xc9, 0xfa, 0x8b, 0xb4, 0xc4, 0x5e, 0x42, 0xcb, 0x12, 0x27, 0x1c, 0x73, 0xbb,
-	0xf0, 0xc4, 0x4e, 0x52, 0x02, 0xec, 0x0c, 0x42, 0x5a, 0xdb, 0xa8, 0xd6, 0x0d, 0x7b, 0xed, 0x01,
-	0xeb, 0xfb, 0xaa, 0xfd, 0x49, 0x59, 0x34, 0x71, 0x69, 0xa7, 0x71, 0xbb, 0xd4, 0xe2, 0x6e, 0x22,
-	0x57, 0x18, 0x85, 0xdd, 0xa0, 0x17, 0x26, 0x25, 0xc0, 0x5e, 0xc0, 0xde, 0x02, 0xe5, 0x7c, 0x41,
-	0x51, 0xdd, 0xa7, 0x8a, 0x88, 0x9d, 0x00, 0x4c, 0xe5, 0x6c, 0x26, 0x45, 0xb6, 0xa4, 0x3c, 0x6a,
-	0x74, 0x83, 0x5e, 0x3d, 0xa9, 0x20, 0x4e, 0x55, 0xda, 0x2b, 0x5c, 0xa5, 0x5a, 0x2f, 0xa3, 0x3d,
-	0x6f, 0xa1, 0x04, 0xe2, 0xef, 0xd0, 0xf8, 0x96, 0xa1, 0xc9, 0x9d, 0xbc, 0x1b, 0x0e, 0x9a, 0xa2,
-	0xfb, 0x22, 0x62, 0xc7, 0xb0, 0x3f, 0xcb, 0x94, 0xf8, 0xca, 0x57, 0x18, 0xd5, 0xba, 0x41, 0xaf,
-	0x95, 0xec, 0x62, 0x16, 0x41, 0x33, 0xe5, 0xf9, 0x52, 0xf3, 0xa9, 0x6f, 0xb7, 0x93, 0x6c, 0xc3,
-	0xf8, 0x07, 0xc0, 0xc8, 0x20, 0x27, 0x9c, 0xac, 0x2f, 0xd5, 0x5f, 0xb5, 0x4f, 0x00, 0x36, 0xfe,
-	0x2b, 0xea, 0x15, 0xe4, 0x1f, 0xfa, 0xa7, 0xd0, 0xfe, 0x68, 0x0c, 0xcf, 0x47, 0x5a, 0xcd, 0xe4,
-	0xdc, 0xad, 0xe8, 0x9e, 0x2f, 0x33, 0x37, 0xb5, 0xb0, 0xd7, 0x4a, 0x36, 0x41, 0x7c, 0x06, 0x9d,
-	0x6b, 0x32, 0x52, 0xcd, 0x1f, 0xb2, 0x82, 0x92, 0x75, 0x0a, 0xed, 0x4b, 0x45, 0xc3, 0xc1, 0x63,
-	0xa4, 0xc6, 0x96, 0xe4, 0xb6, 0xbd, 0x21, 0x5c, 0x12, 0xae, 0xd8, 0x21, 0x84, 0x77, 0x98, 0x7b,
-	0x37, 0xad, 0xc4, 0xbd, 0x32, 0x06, 0x75, 0x3e, 0x9d, 0x9a, 0xc2, 0x84, 0x7f, 0x67, 0xe7, 0x10,
-	0x72, 0x63, 0xbc, 0x50, 0xb9, 0xf5, 0x4a, 0xdb, 0xe3, 0x27, 0x89, 0x23, 0xb0, 0x37, 0x10, 0x5a,
-	0x32, 0x7e, 0xad, 0xed, 0xc1, 0xb3, 0x82, 0x57, 0xed, 0xdc, 0x11, 0x2d, 0x79, 0x41, 0xa9, 0xc8,
-	0xef, 0xb8, 0x14, 0xac, 0x34, 0xef, 0x78, 0x52, 0x11, 0x3b, 0x80, 0xda, 0x24, 0x8f, 0xda, 0xde,
-	0x40, 0x6d, 0x92, 0x5f, 0x34, 0x0b, 0x4f, 0xf1, 0x0d, 0x74, 0xae, 0xf4, 0x54, 0xce, 0xb6, 0x73,
-	0x7b, 0xe8, 0x63, 0x67, 0xbf, 0x56, 0x99, 0x91, 0x13, 0xd4, 0x69, 0x31, 0xb6, 0x9a, 0x4e, 0x77,
-	0x6e, 0xeb, 0xa5, 0xdb, 0x58, 0xc0, 0xd3, 0x04, 0x05, 0xca, 0x94, 0x0a, 0xf1, 0xd7, 0x50, 0x4f,
-	0x0d, 0xde, 0x7b, 0xf5, 0xf6, 0xe0, 0xa8, 0x68, 0xb7, 0x9c, 0x62, 0xe2, 0xd3, 0xec, 0x2d, 0x34,
-	0x45, 0x66, 0x0c, 0x2a, 0xf2, 0x35, 0x1f, 0x65, 0x6e, 0x19, 0xf1, 0x07, 0x68, 0x27, 0x98, 0x2e,
-	0xff, 0xb3, 0xff, 0xf8, 0x67, 0x00, 0x87, 0x63, 0x69, 0x49, 0x9b, 0x7c, 0x84, 0x86, 0xae, 0x49,
-	0x1b, 0x74, 0x87, 0x61, 0xb4, 0x26, 0x81, 0x86, 0x6c, 0x14, 0x74, 0x43, 0x77, 0xb2, 0x3b, 0x80,
-	0xbd, 0x83, 0x23, 0xa9, 0x08, 0xcd, 0x0a, 0xa7, 0x92, 0x13, 0x8e, 0x3c, 0xab, 0xe6, 0x59, 0x0f,
-	0x13, 0xec, 0x1c, 0x0e, 0x0c, 0xde, 0x6b, 0xc1, 0xdd, 0xb7, 0xeb, 0x7e, 0x08, 0xfe, 0x4b, 0xec,
-	0x24, 0x7f, 0xa0, 0xae, 0xa6, 0xc8, 0xcc, 0x18, 0xe5, 0x9c, 0x16, 0xc5, 0x1d, 0x97, 0x80, 0xcb,
-	0xaa, 0x35, 0x8d, 0x37, 0x57, 0xde, 0xd8, 0x64, 0x77, 0xc0, 0xc5, 0xd9, 0x4d, 0x3c, 0x97, 0xb4,
-	0xe4, 0xb7, 0xfd, 0xe1, 0xb0, 0x2f, 0xd4, 0x7b, 0xb1, 0xe0, 0x52, 0x0d, 0x87, 0xbb, 0xa7, 0x9f,
-	0xda, 0xed, 0x9e, 0xff, 0x7f, 0x0d, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x8a, 0x71, 0x62,
-	0xeb, 0x04, 0x00, 0x00,
+	// 837 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x6d, 0x6f, 0xe3, 0x44,
+	0x10, 0xc6, 0x76, 0xdc, 0x34, 0x93, 0x50, 0xb5, 0xcb, 0x8b, 0xac, 0x13, 0xf4, 0x22, 0xdf, 0x71,
+	0x04, 0x81, 0x5a, 0x29, 0x11, 0x3f, 0x80, 0xab, 0xd0, 0xa5, 0xe2, 0x0e, 0x89, 0xbd, 0xf0, 0xe5,
+	0x24, 0x90, 0x5c, 0x67, 0x92, 0xac, 0xce, 0xd9, 0x35, 0xeb, 0x71, 0x15, 0xff, 0x3d, 0xc4, 0x0f,
+	0xe0, 0x33, 0xbf, 0x06, 0xcd, 0xda, 0x8d, 0xdd, 0x94, 0x22, 0xdd, 0x37, 0xcf, 0xcc, 0x93, 0x67,
+	0xe7, 0x99, 0xb7, 0xc0, 0x09, 0xee, 0x30, 0x2d, 0xc9, 0xd8, 0x8b, 0xdc, 0x1a, 0x32, 0x22, 0xa4,
+	0x2a, 0xc7, 0xe2, 0xc9, 0x19, 0xd9, 0x44, 0x17, 0x49, 0x4a, 0xca, 0xe8, 0x3a, 0x12, 0x3f, 0x85,
+	0xfe, 0x2b, 0xd4, 0x58, 0xa8, 0x42, 0x7c, 0x0a, 0xa1, 0x2a, 0x6c, 0xa9, 0x23, 0x6f, 0xec, 0x4d,
+	0x8e, 0x65, 0x6d, 0xc4, 0x7f, 0x79, 0x00, 0x3f, 0xee, 0x30, 0x5d, 0xec, 0x5e, 0xab, 0x82, 0xc4,
+	0x17, 0x30, 0x28, 0x28, 0x21, 0x9c, 0x27, 0xc5, 0xc6, 0x01, 0x47, 0xb2, 0x75, 0x88, 0xe7, 0x10,
+	0xd0, 0xae, 0x88, 0xfc, 0x71, 0x30, 0x19, 0x4e, 0xc5, 0x85, 0x7b, 0xf5, 0x62, 0xd1, 0x3e, 0x2a,
+	0x39, 0xcc, 0x1c, 0x37, 0x99, 0x49, 0xdf, 0x2f, 0xd4, 0x16, 0xa3, 0x60, 0xec, 0x4d, 0x02, 0xd9,
+	0x3a, 0xc4, 0xe7, 0x70, 0xb4, 0x41, 0xb5, 0xde, 0x50, 0xd4, 0x73, 0xa1, 0xc6, 0x12, 0xe7, 0x00,
+	0x4b, 0xb5, 0x5a, 0xa9, 0xb4, 0xcc, 0xa8, 0x8a, 0xc2, 0xb1, 0x37, 0xe9, 0xc9, 0x8e, 0x87, 0x59,
+	0x55, 0xf1, 0x06, 0xb7, 0xb9, 0x31, 0x59, 0x74, 0xe4, 0x24, 0xb4, 0x8e, 0xf8, 0x57, 0x08, 0x7f,
+	0x29, 0xd1, 0x56, 0x4c, 0xcf, 0xc5, 0x41, 0xdb, 0x64, 0xdf, 0x58, 0xe2, 0x09, 0x1c, 0xaf, 0x4a,
+	0x9d, 0xfe, 0x9c, 0x6c, 0x31, 0xf2, 0xc7, 0xde, 0x64, 0x20, 0xf7, 0xb6, 0x88, 0xa0, 0x9f, 0x27,
+	0x55, 0x66, 0x92, 0xa5, 0x4b, 0x77, 0x24, 0xef, 0xcc, 0xf8, 0x77, 0x80, 0x2b, 0x8b, 0x09, 0xe1,
+	0x62, 0x77, 0xad, 0x1f, 0xe5, 0x3e, 0x07, 0xa8, 0xf5, 0x77, 0xd8, 0x3b, 0x9e, 0xff, 0xe1, 0x7f,
+	0x06, 0xc3, 0x1f, 0xac, 0x4d, 0xaa, 0x2b, 0xa3, 0x57, 0x6a, 0xcd, 0x2d, 0xba, 0x4d, 0xb2, 0x92,
+	0xab, 0x16, 0x4c, 0x06, 0xb2, 0x36, 0xe2, 0xe7, 0x30, 0x7a, 0x4b, 0x56, 0xe9, 0xf5, 0x43, 0x94,
+	0xd7, 0xa2, 0x9e, 0xc1, 0xf0, 0x5a, 0xd3, 0x6c, 0xfa, 0x5f, 0xa0, 0xf0, 0x0e, 0xc4, 0xdd, 0xae,
+	0x01, 0xd7, 0x84, 0x5b, 0x71, 0x0a, 0xc1, 0x7b, 0xac, 0x9c, 0x9a, 0x81, 0xe4, 0x4f, 0x21, 0xa0,
+	0x97, 0x2c, 0x97, 0xb6, 0x11, 0xe1, 0xbe, 0xc5, 0x0b, 0x08, 0x12, 0x6b, 0x1d, 0x51, 0xdb, 0xf5,
+	0x4e, 0xda, 0xf3, 0x8f, 0x24, 0x03, 0xc4, 0xd7, 0x10, 0x14, 0x64, 0x5d, 0x5b, 0x87, 0xd3, 0x4f,
+	0x1a, 0x5c, 0x37, 0x73, 0x06, 0x16, 0xe4, 0x08, 0x95, 0xa6, 0x28, 0xbc, 0x47, 0xd8, 0x49, 0x9e,
+	0x71, 0x4a, 0x93, 0x38, 0x01, 0x7f, 0x51, 0x45, 0x43, 0x27, 0xc0, 0x5f, 0x54, 0x2f, 0xfb, 0x8d,
+	0xa6, 0xf8, 0x1d, 0x8c, 0xde, 0x98, 0xa5, 0x5a, 0xdd, 0xd5, 0xed, 0xa1, 0x8e, 0xbd, 0x7c, 0xbf,
+	0x53, 0x23, 0x26, 0x34, 0x79, 0x53, 0x36, 0xdf, 0xe4, 0x7b, 0xb5, 0xbd, 0x56, 0x6d, 0x9c, 0xc2,
+	0xc7, 0x12, 0x53, 0x54, 0x39, 0x35, 0xe4, 0x5f, 0x41, 0x2f, 0xb7, 0x78, 0xeb, 0xd8, 0x87, 0xd3,
+	0xb3, 0x26, 0xdd, 0xb6, 0x8a, 0xd2, 0x85, 0xc5, 0xb7, 0xd0, 0x4f, 0x4b, 0x6b, 0x51, 0x53, 0xe4,
+	0x3f, 0x86, 0xbc, 0x43, 0xc4, 0xdf, 0xc3, 0x50, 0x62, 0x9e, 0x7d, 0x60, 0xfe, 0xf1, 0x9f, 0x1e,
+	0x9c, 0xce, 0x55, 0x41, 0xc6, 0x56, 0x57, 0x68, 0xe9, 0x2d, 0x19, 0x8b, 0xbc, 0x18, 0xd6, 0x18,
+	0x4a, 0xd1, 0x52, 0x11, 0x79, 0xe3, 0x80, 0x57, 0x76, 0xef, 0x10, 0xdf, 0xc1, 0x99, 0xd2, 0x84,
+	0x76, 0x8b, 0x4b, 0x95, 0x10, 0x5e, 0x39, 0x94, 0xef, 0x50, 0x0f, 0x03, 0xe2, 0x05, 0x9c, 0x58,
+	0xbc, 0x35, 0x69, 0xc2, 0xb3, 0xcb, 0x07, 0xc1, 0x4d, 0xe2, 0x48, 0x1e, 0x78, 0xf9, 0xcd, 0xb4,
+	0xb4, 0x73, 0x54, 0x6b, 0xda, 0x34, 0x7b, 0xdc, 0x3a, 0x38, 0xaa, 0x77, 0x34, 0xaf, 0xb7, 0x3c,
+	0xac, 0xa3, 0x7b, 0x47, 0xfc, 0xb7, 0x07, 0x27, 0x4d, 0x85, 0x5f, 0x9b, 0xf5, 0xb5, 0x5e, 0x99,
+	0xce, 0x4d, 0xf0, 0xee, 0xdd, 0x04, 0x3e, 0x59, 0x7a, 0x89, 0x3b, 0x57, 0x85, 0x40, 0xd6, 0x06,
+	0xaf, 0x72, 0xc6, 0x3f, 0xe4, 0x40, 0x7d, 0x5e, 0xf6, 0x36, 0x33, 0xd1, 0xce, 0x1d, 0xaf, 0x5e,
+	0xbd, 0xa2, 0xb5, 0xd5, 0x59, 0xdd, 0xd0, 0x15, 0xb4, 0xb1, 0x78, 0x02, 0x56, 0xd6, 0x6c, 0xdd,
+	0x41, 0x19, 0x48, 0xf7, 0xcd, 0x53, 0x42, 0x26, 0xea, 0xd7, 0x53, 0x42, 0xc6, 0xd9, 0x55, 0x74,
+	0x5c, 0x8f, 0x21, 0x55, 0xdc, 0xad, 0xcc, 0xac, 0xa3, 0x81, 0x7b, 0x80, 0x3f, 0xe3, 0x7f, 0x3c,
+	0x00, 0x89, 0x7f, 0xbc, 0x42, 0x56, 0x54, 0x1c, 0xdc, 0x89, 0xf6, 0xb1, 0x53, 0x08, 0xa8, 0xaa,
+	0xab, 0x1f, 0x4a, 0xfe, 0xdc, 0x0f, 0x60, 0xd0, 0x59, 0xb7, 0x73, 0x00, 0x4e, 0x63, 0xde, 0x3d,
+	0x92, 0x1d, 0x0f, 0xcb, 0x27, 0x73, 0xaf, 0xb8, 0x7b, 0x9b, 0x0b, 0x96, 0x9a, 0x52, 0x93, 0xd3,
+	0x13, 0xca, 0xda, 0xe0, 0x7e, 0x2c, 0x95, 0x45, 0x77, 0x90, 0x9c, 0xae, 0x50, 0xb6, 0x0e, 0x7e,
+	0x2f, 0xb7, 0x6a, 0x9b, 0xd8, 0xea, 0x27, 0xac, 0x65, 0x0e, 0x64, 0xc7, 0x13, 0xff, 0x06, 0xa7,
+	0x6e, 0x56, 0xdb, 0x9e, 0x15, 0xe2, 0x1b, 0xe8, 0x65, 0x66, 0x5d, 0x8f, 0xdb, 0x70, 0xfa, 0x59,
+	0x33, 0xe9, 0xf7, 0xbb, 0x2a, 0x1d, 0xe4, 0x80, 0xde, 0x3f, 0xa4, 0x7f, 0xf9, 0xf4, 0xdd, 0x97,
+	0x6b, 0x45, 0x9b, 0xf2, 0xe6, 0x22, 0x35, 0xdb, 0xcb, 0xd9, 0x2c, 0xd5, 0x97, 0xe9, 0x26, 0x51,
+	0x7a, 0x36, 0xbb, 0x74, 0xac, 0x37, 0x47, 0xee, 0x9f, 0x6c, 0xf6, 0xef, 0x00, 0x5b, 0xdc, 0x90,
+	0xf5, 0xf5, 0x06, 0x00, 0x00,
 }
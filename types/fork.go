@@ -191,6 +191,8 @@ func SetTestNetFork() {
 	systemFork.SetFork("chain33", "ForkTxHeight", 806578)
 	systemFork.SetFork("chain33", "ForkTxGroupPara", 806578)
 	systemFork.SetFork("chain33", "ForkCheckBlockTime", 1200000)
+	systemFork.SetFork("chain33", "ForkReceiptHash", MaxHeight)
+	systemFork.SetFork("chain33", "ForkSchnorr", MaxHeight)
 }
 
 func setLocalFork() {
@@ -199,12 +201,19 @@ func setLocalFork() {
 		panic(err)
 	}
 	systemFork.ReplaceFork("local", "ForkBlockHash", 1)
+	systemFork.ReplaceFork("local", "ForkReceiptHash", 1)
+	systemFork.ReplaceFork("local", "ForkSchnorr", 0)
 }
 
 //paraName not used currently
 func setForkForPara(paraName string) {
 	systemFork.CloneZero("chain33", paraName)
 	systemFork.ReplaceFork(paraName, "ForkBlockHash", 1)
+	//ForkReceiptHash 会改变区块哈希, 已经运行的平行链不能默认开启
+	//需要开启的平行链在 [fork.system] 中配置一个未来的高度
+	systemFork.ReplaceFork(paraName, "ForkReceiptHash", MaxHeight)
+	//ForkSchnorr 之前 schnorr 签名的交易是无效的, 同样不能在已经运行的平行链上默认开启
+	systemFork.ReplaceFork(paraName, "ForkSchnorr", MaxHeight)
 }
 
 func IsFork(height int64, fork string) bool {
@@ -19,3 +19,11 @@ func TestForks(t *testing.T) {
 	assert.Equal(t, systemFork.IsFork("local", 1, "ForkBlockHash"), true)
 	assert.Equal(t, systemFork.IsFork("local", 1, "ForkTransferExec"), true)
 }
+
+func TestParaForks(t *testing.T) {
+	setForkForPara("user.p.forktest.")
+	assert.Equal(t, systemFork.IsFork("user.p.forktest.", 1, "ForkBlockHash"), true)
+	assert.Equal(t, systemFork.IsFork("user.p.forktest.", 1, "ForkTransferExec"), true)
+	assert.Equal(t, systemFork.IsFork("user.p.forktest.", 100000, "ForkReceiptHash"), false)
+	assert.Equal(t, systemFork.IsFork("user.p.forktest.", 100000, "ForkSchnorr"), false)
+}
@@ -55,6 +55,31 @@ func CalcAddrTxsCountKey(addr string) []byte {
 	return append(AddrTxsCount, []byte(addr)...)
 }
 
+//receipt log 索引, key=LogIndex:类别:条件:height*100000+index:logindex
+//所有的log
+func CalcLogIndexKey(heightindex string) []byte {
+	return []byte(fmt.Sprintf("LogIndex:all:%s", heightindex))
+}
+
+//某个执行器的log
+func CalcLogExecKey(execer string, heightindex string) []byte {
+	return []byte(fmt.Sprintf("LogIndex:exec:%s:%s", execer, heightindex))
+}
+
+//某个执行器某种类型的log
+func CalcLogTyKey(execer string, ty int32, heightindex string) []byte {
+	return []byte(fmt.Sprintf("LogIndex:ty:%s:%d:%s", execer, ty, heightindex))
+}
+
+//地址相关交易的log
+func CalcLogAddrKey(addr string, heightindex string) []byte {
+	return []byte(fmt.Sprintf("LogIndex:addr:%s:%s", addr, heightindex))
+}
+
+func LogIndexFlag() []byte {
+	return []byte("LogIndex:Flag")
+}
+
 func StatisticFlag() []byte {
 	return []byte("Statistics:Flag")
 }
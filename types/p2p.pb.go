@@ -114,6 +114,8 @@ type P2PVersion struct {
 	UserAgent string `protobuf:"bytes,7,opt,name=userAgent" json:"userAgent,omitempty"`
 	// /当前节点的高度
 	StartHeight int64 `protobuf:"varint,8,opt,name=startHeight" json:"startHeight,omitempty"`
+	// /区块广播协议的版本, 0: 完整区块, 1: 压缩区块
+	RelayVersion int32 `protobuf:"varint,9,opt,name=relayVersion" json:"relayVersion,omitempty"`
 }
 
 func (m *P2PVersion) Reset()                    { *m = P2PVersion{} }
@@ -177,6 +179,13 @@ func (m *P2PVersion) GetStartHeight() int64 {
 	return 0
 }
 
+func (m *P2PVersion) GetRelayVersion() int32 {
+	if m != nil {
+		return m.RelayVersion
+	}
+	return 0
+}
+
 // *
 // P2P 版本返回
 type P2PVerAck struct {
@@ -540,6 +549,319 @@ func (m *P2PBlock) GetBlock() *Block {
 	return nil
 }
 
+// *
+// p2p 压缩区块广播协议, 只发送区块头和交易的短id, 接收方从mempool中恢复交易
+type P2PCompactBlock struct {
+	// 不包含交易的区块
+	Header *Block `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// 区块中所有交易的短id, 和交易的顺序一致
+	ShortIDs []uint64 `protobuf:"fixed64,2,rep,packed,name=shortIDs" json:"shortIDs,omitempty"`
+	// 接收方mempool中没有的交易, 比如挖矿交易
+	Prefilled []*P2PPrefilledTx `protobuf:"bytes,3,rep,name=prefilled" json:"prefilled,omitempty"`
+}
+
+func (m *P2PCompactBlock) Reset()                    { *m = P2PCompactBlock{} }
+func (m *P2PCompactBlock) String() string            { return proto.CompactTextString(m) }
+func (*P2PCompactBlock) ProtoMessage()               {}
+func (*P2PCompactBlock) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{17} }
+
+func (m *P2PCompactBlock) GetHeader() *Block {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *P2PCompactBlock) GetShortIDs() []uint64 {
+	if m != nil {
+		return m.ShortIDs
+	}
+	return nil
+}
+
+func (m *P2PCompactBlock) GetPrefilled() []*P2PPrefilledTx {
+	if m != nil {
+		return m.Prefilled
+	}
+	return nil
+}
+
+type P2PPrefilledTx struct {
+	Index int32        `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
+	Tx    *Transaction `protobuf:"bytes,2,opt,name=tx" json:"tx,omitempty"`
+}
+
+func (m *P2PPrefilledTx) Reset()                    { *m = P2PPrefilledTx{} }
+func (m *P2PPrefilledTx) String() string            { return proto.CompactTextString(m) }
+func (*P2PPrefilledTx) ProtoMessage()               {}
+func (*P2PPrefilledTx) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{18} }
+
+func (m *P2PPrefilledTx) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *P2PPrefilledTx) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+// *
+// p2p 获取压缩区块中缺失的交易, indexes为空的时候获取区块中的所有交易
+type P2PGetBlockTxs struct {
+	Version   int32   `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	BlockHash []byte  `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Indexes   []int32 `protobuf:"varint,3,rep,packed,name=indexes" json:"indexes,omitempty"`
+}
+
+func (m *P2PGetBlockTxs) Reset()                    { *m = P2PGetBlockTxs{} }
+func (m *P2PGetBlockTxs) String() string            { return proto.CompactTextString(m) }
+func (*P2PGetBlockTxs) ProtoMessage()               {}
+func (*P2PGetBlockTxs) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{19} }
+
+func (m *P2PGetBlockTxs) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *P2PGetBlockTxs) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *P2PGetBlockTxs) GetIndexes() []int32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+type P2PBlockTxs struct {
+	BlockHash []byte         `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Txs       []*Transaction `protobuf:"bytes,2,rep,name=txs" json:"txs,omitempty"`
+}
+
+func (m *P2PBlockTxs) Reset()                    { *m = P2PBlockTxs{} }
+func (m *P2PBlockTxs) String() string            { return proto.CompactTextString(m) }
+func (*P2PBlockTxs) ProtoMessage()               {}
+func (*P2PBlockTxs) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{20} }
+
+func (m *P2PBlockTxs) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *P2PBlockTxs) GetTxs() []*Transaction {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+// *
+// p2p 交易清单, 支持交易清单的节点之间只广播交易hash
+type P2PTxInv struct {
+	Hashes [][]byte `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+}
+
+func (m *P2PTxInv) Reset()                    { *m = P2PTxInv{} }
+func (m *P2PTxInv) String() string            { return proto.CompactTextString(m) }
+func (*P2PTxInv) ProtoMessage()               {}
+func (*P2PTxInv) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{21} }
+
+func (m *P2PTxInv) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+// *
+// p2p 请求交易清单中本地没有的交易
+type P2PGetTxs struct {
+	Hashes [][]byte `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+}
+
+func (m *P2PGetTxs) Reset()                    { *m = P2PGetTxs{} }
+func (m *P2PGetTxs) String() string            { return proto.CompactTextString(m) }
+func (*P2PGetTxs) ProtoMessage()               {}
+func (*P2PGetTxs) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{22} }
+
+func (m *P2PGetTxs) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+// *
+// p2p 节点的信誉分数, banUntil为0并且banned为true表示永久封禁
+type PeerScore struct {
+	Addr     string `protobuf:"bytes,1,opt,name=addr" json:"addr,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Score    int64  `protobuf:"varint,3,opt,name=score" json:"score,omitempty"`
+	BanCount int32  `protobuf:"varint,4,opt,name=banCount" json:"banCount,omitempty"`
+	Banned   bool   `protobuf:"varint,5,opt,name=banned" json:"banned,omitempty"`
+	BanUntil int64  `protobuf:"varint,6,opt,name=banUntil" json:"banUntil,omitempty"`
+	Reason   string `protobuf:"bytes,7,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *PeerScore) Reset()                    { *m = PeerScore{} }
+func (m *PeerScore) String() string            { return proto.CompactTextString(m) }
+func (*PeerScore) ProtoMessage()               {}
+func (*PeerScore) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{23} }
+
+func (m *PeerScore) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *PeerScore) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PeerScore) GetScore() int64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *PeerScore) GetBanCount() int32 {
+	if m != nil {
+		return m.BanCount
+	}
+	return 0
+}
+
+func (m *PeerScore) GetBanned() bool {
+	if m != nil {
+		return m.Banned
+	}
+	return false
+}
+
+func (m *PeerScore) GetBanUntil() int64 {
+	if m != nil {
+		return m.BanUntil
+	}
+	return 0
+}
+
+func (m *PeerScore) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type PeerScoreList struct {
+	Peers []*PeerScore `protobuf:"bytes,1,rep,name=peers" json:"peers,omitempty"`
+}
+
+func (m *PeerScoreList) Reset()                    { *m = PeerScoreList{} }
+func (m *PeerScoreList) String() string            { return proto.CompactTextString(m) }
+func (*PeerScoreList) ProtoMessage()               {}
+func (*PeerScoreList) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{24} }
+
+func (m *PeerScoreList) GetPeers() []*PeerScore {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+// *
+// 封禁节点, duration单位秒, 为0的时候永久封禁
+type ReqBanPeer struct {
+	Addr     string `protobuf:"bytes,1,opt,name=addr" json:"addr,omitempty"`
+	Duration int64  `protobuf:"varint,2,opt,name=duration" json:"duration,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *ReqBanPeer) Reset()                    { *m = ReqBanPeer{} }
+func (m *ReqBanPeer) String() string            { return proto.CompactTextString(m) }
+func (*ReqBanPeer) ProtoMessage()               {}
+func (*ReqBanPeer) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{25} }
+
+func (m *ReqBanPeer) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqBanPeer) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *ReqBanPeer) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// *
+// 其他模块报告节点的不良行为, pid是节点的名称, addr为空的时候通过pid查找节点地址
+type PeerMisbehave struct {
+	Pid    string `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
+	Addr   string `protobuf:"bytes,2,opt,name=addr" json:"addr,omitempty"`
+	Reason int32  `protobuf:"varint,3,opt,name=reason" json:"reason,omitempty"`
+	Info   string `protobuf:"bytes,4,opt,name=info" json:"info,omitempty"`
+}
+
+func (m *PeerMisbehave) Reset()                    { *m = PeerMisbehave{} }
+func (m *PeerMisbehave) String() string            { return proto.CompactTextString(m) }
+func (*PeerMisbehave) ProtoMessage()               {}
+func (*PeerMisbehave) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{26} }
+
+func (m *PeerMisbehave) GetPid() string {
+	if m != nil {
+		return m.Pid
+	}
+	return ""
+}
+
+func (m *PeerMisbehave) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *PeerMisbehave) GetReason() int32 {
+	if m != nil {
+		return m.Reason
+	}
+	return 0
+}
+
+func (m *PeerMisbehave) GetInfo() string {
+	if m != nil {
+		return m.Info
+	}
+	return ""
+}
+
 // *
 // p2p 协议和软件版本
 type Versions struct {
@@ -551,7 +873,7 @@ type Versions struct {
 func (m *Versions) Reset()                    { *m = Versions{} }
 func (m *Versions) String() string            { return proto.CompactTextString(m) }
 func (*Versions) ProtoMessage()               {}
-func (*Versions) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{17} }
+func (*Versions) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{27} }
 
 func (m *Versions) GetP2Pversion() int32 {
 	if m != nil {
@@ -582,13 +904,16 @@ type BroadCastData struct {
 	//	*BroadCastData_Block
 	//	*BroadCastData_Ping
 	//	*BroadCastData_Version
+	//	*BroadCastData_CompactBlock
+	//	*BroadCastData_TxInv
+	//	*BroadCastData_GetTxs
 	Value isBroadCastData_Value `protobuf_oneof:"value"`
 }
 
 func (m *BroadCastData) Reset()                    { *m = BroadCastData{} }
 func (m *BroadCastData) String() string            { return proto.CompactTextString(m) }
 func (*BroadCastData) ProtoMessage()               {}
-func (*BroadCastData) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{18} }
+func (*BroadCastData) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{28} }
 
 type isBroadCastData_Value interface {
 	isBroadCastData_Value()
@@ -606,11 +931,23 @@ type BroadCastData_Ping struct {
 type BroadCastData_Version struct {
 	Version *Versions `protobuf:"bytes,4,opt,name=version,oneof"`
 }
+type BroadCastData_CompactBlock struct {
+	CompactBlock *P2PCompactBlock `protobuf:"bytes,5,opt,name=compactBlock,oneof"`
+}
+type BroadCastData_TxInv struct {
+	TxInv *P2PTxInv `protobuf:"bytes,6,opt,name=txInv,oneof"`
+}
+type BroadCastData_GetTxs struct {
+	GetTxs *P2PGetTxs `protobuf:"bytes,7,opt,name=getTxs,oneof"`
+}
 
-func (*BroadCastData_Tx) isBroadCastData_Value()      {}
-func (*BroadCastData_Block) isBroadCastData_Value()   {}
-func (*BroadCastData_Ping) isBroadCastData_Value()    {}
-func (*BroadCastData_Version) isBroadCastData_Value() {}
+func (*BroadCastData_Tx) isBroadCastData_Value()           {}
+func (*BroadCastData_Block) isBroadCastData_Value()        {}
+func (*BroadCastData_Ping) isBroadCastData_Value()         {}
+func (*BroadCastData_Version) isBroadCastData_Value()      {}
+func (*BroadCastData_CompactBlock) isBroadCastData_Value() {}
+func (*BroadCastData_TxInv) isBroadCastData_Value()        {}
+func (*BroadCastData_GetTxs) isBroadCastData_Value()       {}
 
 func (m *BroadCastData) GetValue() isBroadCastData_Value {
 	if m != nil {
@@ -647,6 +984,27 @@ func (m *BroadCastData) GetVersion() *Versions {
 	return nil
 }
 
+func (m *BroadCastData) GetCompactBlock() *P2PCompactBlock {
+	if x, ok := m.GetValue().(*BroadCastData_CompactBlock); ok {
+		return x.CompactBlock
+	}
+	return nil
+}
+
+func (m *BroadCastData) GetTxInv() *P2PTxInv {
+	if x, ok := m.GetValue().(*BroadCastData_TxInv); ok {
+		return x.TxInv
+	}
+	return nil
+}
+
+func (m *BroadCastData) GetGetTxs() *P2PGetTxs {
+	if x, ok := m.GetValue().(*BroadCastData_GetTxs); ok {
+		return x.GetTxs
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*BroadCastData) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _BroadCastData_OneofMarshaler, _BroadCastData_OneofUnmarshaler, _BroadCastData_OneofSizer, []interface{}{
@@ -654,6 +1012,9 @@ func (*BroadCastData) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer)
 		(*BroadCastData_Block)(nil),
 		(*BroadCastData_Ping)(nil),
 		(*BroadCastData_Version)(nil),
+		(*BroadCastData_CompactBlock)(nil),
+		(*BroadCastData_TxInv)(nil),
+		(*BroadCastData_GetTxs)(nil),
 	}
 }
 
@@ -681,6 +1042,21 @@ func _BroadCastData_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Version); err != nil {
 			return err
 		}
+	case *BroadCastData_CompactBlock:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CompactBlock); err != nil {
+			return err
+		}
+	case *BroadCastData_TxInv:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TxInv); err != nil {
+			return err
+		}
+	case *BroadCastData_GetTxs:
+		b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GetTxs); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("BroadCastData.Value has unexpected type %T", x)
@@ -723,6 +1099,30 @@ func _BroadCastData_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.
 		err := b.DecodeMessage(msg)
 		m.Value = &BroadCastData_Version{msg}
 		return true, err
+	case 5: // value.compactBlock
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(P2PCompactBlock)
+		err := b.DecodeMessage(msg)
+		m.Value = &BroadCastData_CompactBlock{msg}
+		return true, err
+	case 6: // value.txInv
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(P2PTxInv)
+		err := b.DecodeMessage(msg)
+		m.Value = &BroadCastData_TxInv{msg}
+		return true, err
+	case 7: // value.getTxs
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(P2PGetTxs)
+		err := b.DecodeMessage(msg)
+		m.Value = &BroadCastData_GetTxs{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -752,6 +1152,21 @@ func _BroadCastData_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(4<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *BroadCastData_CompactBlock:
+		s := proto.Size(x.CompactBlock)
+		n += proto.SizeVarint(5<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BroadCastData_TxInv:
+		s := proto.Size(x.TxInv)
+		n += proto.SizeVarint(6<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BroadCastData_GetTxs:
+		s := proto.Size(x.GetTxs)
+		n += proto.SizeVarint(7<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -770,7 +1185,7 @@ type P2PGetHeaders struct {
 func (m *P2PGetHeaders) Reset()                    { *m = P2PGetHeaders{} }
 func (m *P2PGetHeaders) String() string            { return proto.CompactTextString(m) }
 func (*P2PGetHeaders) ProtoMessage()               {}
-func (*P2PGetHeaders) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{19} }
+func (*P2PGetHeaders) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{29} }
 
 func (m *P2PGetHeaders) GetVersion() int32 {
 	if m != nil {
@@ -793,6 +1208,40 @@ func (m *P2PGetHeaders) GetEndHeight() int64 {
 	return 0
 }
 
+// *
+// p2p 获取状态数据的默克尔证明协议, 轻节点通过这个协议查询状态数据
+type P2PGetStateProof struct {
+	Version   int32  `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	StateHash []byte `protobuf:"bytes,2,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Key       []byte `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *P2PGetStateProof) Reset()                    { *m = P2PGetStateProof{} }
+func (m *P2PGetStateProof) String() string            { return proto.CompactTextString(m) }
+func (*P2PGetStateProof) ProtoMessage()               {}
+func (*P2PGetStateProof) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{30} }
+
+func (m *P2PGetStateProof) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *P2PGetStateProof) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *P2PGetStateProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
 // *
 // p2p 区块头传输协议
 type P2PHeaders struct {
@@ -802,7 +1251,7 @@ type P2PHeaders struct {
 func (m *P2PHeaders) Reset()                    { *m = P2PHeaders{} }
 func (m *P2PHeaders) String() string            { return proto.CompactTextString(m) }
 func (*P2PHeaders) ProtoMessage()               {}
-func (*P2PHeaders) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{20} }
+func (*P2PHeaders) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{31} }
 
 func (m *P2PHeaders) GetHeaders() []*Header {
 	if m != nil {
@@ -824,7 +1273,7 @@ type InvData struct {
 func (m *InvData) Reset()                    { *m = InvData{} }
 func (m *InvData) String() string            { return proto.CompactTextString(m) }
 func (*InvData) ProtoMessage()               {}
-func (*InvData) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{21} }
+func (*InvData) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{32} }
 
 type isInvData_Value interface {
 	isInvData_Value()
@@ -951,7 +1400,7 @@ type InvDatas struct {
 func (m *InvDatas) Reset()                    { *m = InvDatas{} }
 func (m *InvDatas) String() string            { return proto.CompactTextString(m) }
 func (*InvDatas) ProtoMessage()               {}
-func (*InvDatas) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{22} }
+func (*InvDatas) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{33} }
 
 func (m *InvDatas) GetItems() []*InvData {
 	if m != nil {
@@ -974,7 +1423,7 @@ type Peer struct {
 func (m *Peer) Reset()                    { *m = Peer{} }
 func (m *Peer) String() string            { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()               {}
-func (*Peer) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{23} }
+func (*Peer) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{34} }
 
 func (m *Peer) GetAddr() string {
 	if m != nil {
@@ -1027,7 +1476,7 @@ type PeerList struct {
 func (m *PeerList) Reset()                    { *m = PeerList{} }
 func (m *PeerList) String() string            { return proto.CompactTextString(m) }
 func (*PeerList) ProtoMessage()               {}
-func (*PeerList) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{24} }
+func (*PeerList) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{35} }
 
 func (m *PeerList) GetPeers() []*Peer {
 	if m != nil {
@@ -1049,7 +1498,7 @@ type NodeNetInfo struct {
 func (m *NodeNetInfo) Reset()                    { *m = NodeNetInfo{} }
 func (m *NodeNetInfo) String() string            { return proto.CompactTextString(m) }
 func (*NodeNetInfo) ProtoMessage()               {}
-func (*NodeNetInfo) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{25} }
+func (*NodeNetInfo) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{36} }
 
 func (m *NodeNetInfo) GetExternaladdr() string {
 	if m != nil {
@@ -1093,7 +1542,7 @@ type PeersReply struct {
 func (m *PeersReply) Reset()                    { *m = PeersReply{} }
 func (m *PeersReply) String() string            { return proto.CompactTextString(m) }
 func (*PeersReply) ProtoMessage()               {}
-func (*PeersReply) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{26} }
+func (*PeersReply) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{37} }
 
 func (m *PeersReply) GetPeers() []*PeersInfo {
 	if m != nil {
@@ -1113,7 +1562,7 @@ type PeersInfo struct {
 func (m *PeersInfo) Reset()                    { *m = PeersInfo{} }
 func (m *PeersInfo) String() string            { return proto.CompactTextString(m) }
 func (*PeersInfo) ProtoMessage()               {}
-func (*PeersInfo) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{27} }
+func (*PeersInfo) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{38} }
 
 func (m *PeersInfo) GetName() string {
 	if m != nil {
@@ -1168,9 +1617,20 @@ func init() {
 	proto.RegisterType((*P2PGetData)(nil), "types.P2PGetData")
 	proto.RegisterType((*P2PTx)(nil), "types.P2PTx")
 	proto.RegisterType((*P2PBlock)(nil), "types.P2PBlock")
+	proto.RegisterType((*P2PCompactBlock)(nil), "types.P2PCompactBlock")
+	proto.RegisterType((*P2PPrefilledTx)(nil), "types.P2PPrefilledTx")
+	proto.RegisterType((*P2PGetBlockTxs)(nil), "types.P2PGetBlockTxs")
+	proto.RegisterType((*P2PBlockTxs)(nil), "types.P2PBlockTxs")
+	proto.RegisterType((*P2PTxInv)(nil), "types.P2PTxInv")
+	proto.RegisterType((*P2PGetTxs)(nil), "types.P2PGetTxs")
+	proto.RegisterType((*PeerScore)(nil), "types.PeerScore")
+	proto.RegisterType((*PeerScoreList)(nil), "types.PeerScoreList")
+	proto.RegisterType((*ReqBanPeer)(nil), "types.ReqBanPeer")
+	proto.RegisterType((*PeerMisbehave)(nil), "types.PeerMisbehave")
 	proto.RegisterType((*Versions)(nil), "types.Versions")
 	proto.RegisterType((*BroadCastData)(nil), "types.BroadCastData")
 	proto.RegisterType((*P2PGetHeaders)(nil), "types.P2PGetHeaders")
+	proto.RegisterType((*P2PGetStateProof)(nil), "types.P2PGetStateProof")
 	proto.RegisterType((*P2PHeaders)(nil), "types.P2PHeaders")
 	proto.RegisterType((*InvData)(nil), "types.InvData")
 	proto.RegisterType((*InvDatas)(nil), "types.InvDatas")
@@ -1217,6 +1677,10 @@ type P2PgserviceClient interface {
 	GetHeaders(ctx context.Context, in *P2PGetHeaders, opts ...grpc.CallOption) (*P2PHeaders, error)
 	// 获取 peerinfo
 	GetPeerInfo(ctx context.Context, in *P2PGetPeerInfo, opts ...grpc.CallOption) (*P2PPeerInfo, error)
+	// 获取状态数据的默克尔证明
+	GetStateProof(ctx context.Context, in *P2PGetStateProof, opts ...grpc.CallOption) (*StateProof, error)
+	// 获取压缩区块中缺失的交易
+	GetBlockTxs(ctx context.Context, in *P2PGetBlockTxs, opts ...grpc.CallOption) (*P2PBlockTxs, error)
 	// grpc server 读客户端发送来的数据
 	ServerStreamRead(ctx context.Context, opts ...grpc.CallOption) (P2Pgservice_ServerStreamReadClient, error)
 	// grpc server 发送数据给客户端
@@ -1374,6 +1838,24 @@ func (c *p2PgserviceClient) GetPeerInfo(ctx context.Context, in *P2PGetPeerInfo,
 	return out, nil
 }
 
+func (c *p2PgserviceClient) GetStateProof(ctx context.Context, in *P2PGetStateProof, opts ...grpc.CallOption) (*StateProof, error) {
+	out := new(StateProof)
+	err := grpc.Invoke(ctx, "/types.p2pgservice/GetStateProof", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *p2PgserviceClient) GetBlockTxs(ctx context.Context, in *P2PGetBlockTxs, opts ...grpc.CallOption) (*P2PBlockTxs, error) {
+	out := new(P2PBlockTxs)
+	err := grpc.Invoke(ctx, "/types.p2pgservice/GetBlockTxs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *p2PgserviceClient) ServerStreamRead(ctx context.Context, opts ...grpc.CallOption) (P2Pgservice_ServerStreamReadClient, error) {
 	stream, err := grpc.NewClientStream(ctx, &_P2Pgservice_serviceDesc.Streams[1], c.cc, "/types.p2pgservice/ServerStreamRead", opts...)
 	if err != nil {
@@ -1486,6 +1968,10 @@ type P2PgserviceServer interface {
 	GetHeaders(context.Context, *P2PGetHeaders) (*P2PHeaders, error)
 	// 获取 peerinfo
 	GetPeerInfo(context.Context, *P2PGetPeerInfo) (*P2PPeerInfo, error)
+	// 获取状态数据的默克尔证明
+	GetStateProof(context.Context, *P2PGetStateProof) (*StateProof, error)
+	// 获取压缩区块中缺失的交易
+	GetBlockTxs(context.Context, *P2PGetBlockTxs) (*P2PBlockTxs, error)
 	// grpc server 读客户端发送来的数据
 	ServerStreamRead(P2Pgservice_ServerStreamReadServer) error
 	// grpc server 发送数据给客户端
@@ -1736,6 +2222,42 @@ func _P2Pgservice_GetPeerInfo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _P2Pgservice_GetStateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(P2PGetStateProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(P2PgserviceServer).GetStateProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.p2pgservice/GetStateProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(P2PgserviceServer).GetStateProof(ctx, req.(*P2PGetStateProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _P2Pgservice_GetBlockTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(P2PGetBlockTxs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(P2PgserviceServer).GetBlockTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.p2pgservice/GetBlockTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(P2PgserviceServer).GetBlockTxs(ctx, req.(*P2PGetBlockTxs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _P2Pgservice_ServerStreamRead_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(P2PgserviceServer).ServerStreamRead(&p2PgserviceServerStreamReadServer{stream})
 }
@@ -1871,6 +2393,14 @@ var _P2Pgservice_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPeerInfo",
 			Handler:    _P2Pgservice_GetPeerInfo_Handler,
 		},
+		{
+			MethodName: "GetStateProof",
+			Handler:    _P2Pgservice_GetStateProof_Handler,
+		},
+		{
+			MethodName: "GetBlockTxs",
+			Handler:    _P2Pgservice_GetBlockTxs_Handler,
+		},
 		{
 			MethodName: "CollectInPeers",
 			Handler:    _P2Pgservice_CollectInPeers_Handler,
@@ -1903,86 +2433,114 @@ var _P2Pgservice_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("p2p.proto", fileDescriptor5) }
 
 var fileDescriptor5 = []byte{
-	// 1291 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x72, 0x1b, 0xc5,
-	0x13, 0xdf, 0xd5, 0x87, 0x25, 0xb5, 0x6c, 0xd9, 0x99, 0x7f, 0xfe, 0x94, 0x4a, 0x95, 0x0a, 0x61,
-	0xca, 0x10, 0x43, 0x2a, 0x22, 0x59, 0x41, 0xa8, 0x22, 0x5c, 0xec, 0x00, 0xb1, 0xab, 0x42, 0x6a,
-	0x6b, 0x65, 0x38, 0x70, 0x5b, 0x4b, 0x63, 0x69, 0x2a, 0xab, 0x99, 0x65, 0x77, 0xac, 0x92, 0xb9,
-	0x73, 0xe3, 0xc4, 0x0b, 0x70, 0xe0, 0x15, 0x78, 0x2c, 0x1e, 0x82, 0x9a, 0xde, 0x99, 0xfd, 0x90,
-	0x64, 0x1d, 0xa0, 0x38, 0x79, 0xfb, 0xd7, 0xdd, 0xd3, 0xdf, 0xdd, 0x32, 0x74, 0x62, 0x2f, 0x1e,
-	0xc6, 0x89, 0x54, 0x92, 0x34, 0xd5, 0x6d, 0xcc, 0xd2, 0xc1, 0x3d, 0x95, 0x84, 0x22, 0x0d, 0x27,
-	0x8a, 0x4b, 0x91, 0x71, 0x06, 0xfb, 0x13, 0xb9, 0x58, 0xe4, 0xd4, 0xd1, 0x55, 0x24, 0x27, 0xef,
-	0x26, 0xf3, 0x90, 0x1b, 0x84, 0x7e, 0x02, 0x3d, 0xdf, 0xf3, 0x5f, 0x33, 0xe5, 0x33, 0x96, 0x5c,
-	0x88, 0x6b, 0x49, 0xfa, 0xd0, 0x5a, 0xb2, 0x24, 0xe5, 0x52, 0xf4, 0xdd, 0x47, 0xee, 0x49, 0x33,
-	0xb0, 0x24, 0xfd, 0xcd, 0x85, 0xae, 0xef, 0xf9, 0xb9, 0x24, 0x81, 0x46, 0x38, 0x9d, 0x26, 0x28,
-	0xd6, 0x09, 0xf0, 0x5b, 0x63, 0xb1, 0x4c, 0x54, 0xbf, 0x86, 0xaa, 0xf8, 0xad, 0x31, 0x11, 0x2e,
-	0x58, 0xbf, 0x9e, 0xc9, 0xe9, 0x6f, 0xf2, 0x08, 0xba, 0x0b, 0xb6, 0x88, 0xa5, 0x8c, 0xc6, 0xfc,
-	0x67, 0xd6, 0x6f, 0xa0, 0x78, 0x19, 0x22, 0x1f, 0xc2, 0xde, 0x9c, 0x85, 0x53, 0x96, 0xf4, 0x9b,
-	0x8f, 0xdc, 0x93, 0xae, 0x77, 0x30, 0xc4, 0x20, 0x87, 0xe7, 0x08, 0x06, 0x86, 0x49, 0xff, 0x72,
-	0x01, 0x7c, 0xcf, 0xff, 0x21, 0xf3, 0xf1, 0x6e, 0xef, 0x35, 0x27, 0x65, 0xc9, 0x92, 0x4f, 0x18,
-	0x3a, 0x57, 0x0f, 0x2c, 0x49, 0x1e, 0x40, 0x47, 0xf1, 0x05, 0x4b, 0x55, 0xb8, 0x88, 0xd1, 0xc9,
-	0x7a, 0x50, 0x00, 0x64, 0x00, 0x6d, 0x1d, 0x59, 0xc0, 0x26, 0x4b, 0x74, 0xb3, 0x13, 0xe4, 0xb4,
-	0xe5, 0x7d, 0x9b, 0xc8, 0x05, 0x7a, 0x69, 0x78, 0x9a, 0x26, 0xf7, 0xa1, 0x29, 0xa4, 0x98, 0xb0,
-	0xfe, 0x1e, 0xbe, 0x98, 0x11, 0xda, 0xd6, 0x4d, 0xca, 0x92, 0xd3, 0x19, 0x13, 0xaa, 0xdf, 0x42,
-	0x95, 0x02, 0xd0, 0x59, 0x49, 0x55, 0x98, 0xa8, 0x73, 0xc6, 0x67, 0x73, 0xd5, 0x6f, 0xa3, 0x66,
-	0x19, 0xa2, 0xdf, 0x43, 0x27, 0x8b, 0xf6, 0x74, 0xf2, 0xee, 0x1f, 0x05, 0x9b, 0xbb, 0x55, 0x2f,
-	0xb9, 0x45, 0x17, 0xd0, 0xd2, 0x95, 0xe5, 0x62, 0x56, 0x08, 0xb8, 0x65, 0xbf, 0x6d, 0xad, 0x6b,
-	0x5b, 0x6a, 0x5d, 0x2f, 0xd5, 0xfa, 0x18, 0x1a, 0x29, 0x9f, 0x09, 0xcc, 0x54, 0xd7, 0x3b, 0x32,
-	0x35, 0x1b, 0xf3, 0x99, 0x08, 0xd5, 0x4d, 0xc2, 0x02, 0xe4, 0xd2, 0xf7, 0x33, 0x73, 0xf2, 0x2e,
-	0x73, 0x94, 0x62, 0x51, 0x5f, 0x33, 0x75, 0xaa, 0x0d, 0x6d, 0x97, 0x79, 0x89, 0x8f, 0xdc, 0x2d,
-	0x60, 0xab, 0x13, 0xf1, 0x54, 0xf7, 0x63, 0xdd, 0x56, 0x47, 0xd3, 0x74, 0x8c, 0xad, 0xac, 0x95,
-	0xdf, 0xf0, 0x54, 0xdd, 0xf1, 0xc0, 0x10, 0xda, 0x31, 0x63, 0x09, 0x17, 0xd7, 0x12, 0x1f, 0xe8,
-	0x7a, 0xc4, 0x04, 0x54, 0x1a, 0x83, 0x20, 0x97, 0xa1, 0xaf, 0xe0, 0xd0, 0xf7, 0xfc, 0x6f, 0x56,
-	0x8a, 0x25, 0x22, 0x8c, 0xee, 0x9c, 0x91, 0x07, 0xd0, 0xe1, 0xa9, 0xbc, 0x51, 0x29, 0x9f, 0x66,
-	0xe5, 0x69, 0x07, 0x05, 0x40, 0xe7, 0xb0, 0x9f, 0x85, 0x7e, 0xa6, 0x67, 0x35, 0xdd, 0x51, 0xe4,
-	0xb5, 0x6e, 0xa9, 0x6d, 0x74, 0x8b, 0xb6, 0xc4, 0xc4, 0xd4, 0xf0, 0x4d, 0x67, 0xe7, 0x00, 0xfd,
-	0x18, 0x0e, 0x32, 0x4b, 0xdf, 0x65, 0x63, 0xb7, 0x63, 0xf4, 0x87, 0xb0, 0xe7, 0x7b, 0xfe, 0x85,
-	0x58, 0xea, 0x02, 0x73, 0xb1, 0x4c, 0xfb, 0x2e, 0xe6, 0xc3, 0x16, 0xf8, 0x42, 0x2c, 0x99, 0x50,
-	0x32, 0xb9, 0x0d, 0x90, 0x4b, 0x5f, 0x43, 0x27, 0x87, 0x48, 0x0f, 0x6a, 0xea, 0xd6, 0xbc, 0x58,
-	0x53, 0xb7, 0x3a, 0x27, 0xf3, 0x30, 0x9d, 0xa3, 0xc3, 0xfb, 0x01, 0x7e, 0x93, 0xf7, 0xf4, 0xb4,
-	0x97, 0xdc, 0x34, 0x14, 0x7d, 0x63, 0x1b, 0xe1, 0xeb, 0x50, 0x85, 0x3b, 0x72, 0x61, 0xdd, 0xaa,
-	0xed, 0x74, 0xeb, 0x09, 0x34, 0x7d, 0xcf, 0xbf, 0x5c, 0x11, 0x0a, 0x35, 0xb5, 0xc2, 0x37, 0x8a,
-	0x9a, 0x5e, 0x16, 0xcb, 0x33, 0xa8, 0xa9, 0x15, 0x1d, 0x42, 0xdb, 0xf7, 0x7c, 0xac, 0x02, 0xa1,
-	0xd0, 0xc4, 0xd5, 0x69, 0x54, 0xf6, 0x8d, 0x0a, 0x32, 0x83, 0x8c, 0x45, 0xe7, 0xd0, 0x36, 0x5b,
-	0x28, 0x25, 0x0f, 0x01, 0x62, 0x2f, 0xae, 0xfa, 0x5a, 0x42, 0xb0, 0x74, 0xf2, 0x5a, 0x59, 0x81,
-	0x6c, 0xaa, 0xca, 0x90, 0x6e, 0x5e, 0xdd, 0x57, 0xa5, 0xc5, 0x99, 0xd3, 0xf4, 0x4f, 0x17, 0x0e,
-	0xce, 0x12, 0x19, 0x4e, 0x5f, 0x85, 0x69, 0x96, 0x98, 0x87, 0xa5, 0x78, 0xf6, 0x8b, 0x1e, 0xbd,
-	0x5c, 0x9d, 0x3b, 0x3a, 0x16, 0xf2, 0xd8, 0xfa, 0x5f, 0x43, 0x91, 0xc3, 0x42, 0x04, 0x43, 0x38,
-	0x77, 0x4c, 0x10, 0x3a, 0x8f, 0x31, 0x17, 0x33, 0x34, 0xd9, 0xf5, 0x7a, 0xa5, 0x76, 0xe7, 0x62,
-	0x76, 0xee, 0x04, 0xc8, 0x25, 0x4f, 0x8a, 0x3a, 0x34, 0x2a, 0x0f, 0xda, 0x04, 0x9c, 0x3b, 0x79,
-	0x69, 0xce, 0x5a, 0xd0, 0x5c, 0x86, 0xd1, 0x0d, 0xa3, 0xdc, 0xf6, 0x5b, 0xb6, 0xc2, 0xff, 0xcb,
-	0xd6, 0xfe, 0x1c, 0xdb, 0xc6, 0xda, 0x79, 0x0c, 0xad, 0xec, 0x5a, 0xd8, 0xb6, 0x5d, 0xbb, 0x25,
-	0x96, 0x4b, 0x05, 0xb4, 0x2e, 0xc4, 0x12, 0x33, 0x7a, 0xbc, 0xbb, 0x43, 0x4c, 0x5e, 0x8f, 0xab,
-	0x79, 0xad, 0xf4, 0x45, 0x91, 0xd4, 0x6c, 0x00, 0xea, 0x76, 0x00, 0x8a, 0x8c, 0x3c, 0x83, 0xb6,
-	0xb1, 0x97, 0xea, 0xa7, 0xb8, 0x62, 0x0b, 0xeb, 0x62, 0xaf, 0x68, 0x61, 0xcd, 0x0f, 0x32, 0x26,
-	0xfd, 0xdd, 0x85, 0x86, 0xde, 0x3c, 0xff, 0xea, 0xf8, 0x12, 0x68, 0xa4, 0x2c, 0xba, 0xc6, 0xda,
-	0xb5, 0x03, 0xfc, 0x5e, 0x3f, 0xc8, 0xcd, 0x5d, 0x07, 0x79, 0x6f, 0xd7, 0x41, 0x7e, 0x0a, 0x6d,
-	0xed, 0x20, 0xae, 0xd5, 0x0f, 0xa0, 0xa9, 0x9b, 0xd6, 0xc6, 0xd4, 0xb5, 0xed, 0xc4, 0x58, 0x12,
-	0x64, 0x1c, 0xfa, 0x87, 0x0b, 0xdd, 0xb7, 0x72, 0xca, 0xde, 0x32, 0x85, 0x0b, 0x93, 0xc2, 0x3e,
-	0x33, 0x0b, 0xb4, 0x14, 0x5f, 0x05, 0xd3, 0xb5, 0x8f, 0xe4, 0xc4, 0x08, 0x64, 0xb3, 0x53, 0x00,
-	0xe5, 0xdb, 0x57, 0xc7, 0x00, 0xcb, 0x87, 0x5e, 0xde, 0xa8, 0x2b, 0x79, 0x23, 0xa6, 0xa9, 0xf9,
-	0xc9, 0x51, 0x00, 0x7a, 0xe2, 0xb8, 0x30, 0xcc, 0x2c, 0xfc, 0x9c, 0xa6, 0x9f, 0x01, 0x68, 0xa7,
-	0xd3, 0x80, 0xc5, 0xd1, 0x2d, 0xf9, 0xa8, 0x1a, 0xd6, 0x51, 0x29, 0xac, 0x14, 0x4f, 0x82, 0x89,
-	0xed, 0x17, 0x17, 0x3a, 0x39, 0x98, 0x57, 0xc2, 0x2d, 0x55, 0xa2, 0x07, 0x35, 0x1e, 0x9b, 0x10,
-	0x6a, 0x3c, 0xde, 0x7a, 0x52, 0xd7, 0x76, 0x45, 0x63, 0x73, 0x57, 0x54, 0xb7, 0x4d, 0x73, 0x7d,
-	0xdb, 0x78, 0xbf, 0xb6, 0xa0, 0x1b, 0x7b, 0xf1, 0xcc, 0xe6, 0xe1, 0x09, 0x74, 0xf3, 0xf5, 0x71,
-	0xb9, 0x22, 0x95, 0x85, 0x31, 0xb0, 0x14, 0x86, 0x4a, 0x1d, 0xf2, 0x1c, 0x7a, 0xb9, 0x70, 0xb6,
-	0x0c, 0xd7, 0xb7, 0xc7, 0x86, 0xca, 0x09, 0x34, 0xf0, 0xa7, 0xc4, 0xda, 0xfa, 0x18, 0x94, 0x69,
-	0x29, 0x66, 0xd4, 0x21, 0x43, 0x68, 0xd9, 0x23, 0x7f, 0xaf, 0x60, 0x1a, 0xa8, 0x2c, 0xaf, 0x69,
-	0xea, 0x90, 0x17, 0xd0, 0x35, 0x4c, 0xec, 0xaf, 0x2d, 0x3a, 0xa4, 0xaa, 0xa3, 0xc5, 0xa8, 0x43,
-	0x9e, 0x41, 0xcb, 0xfe, 0x42, 0x2c, 0xe9, 0x18, 0x68, 0x70, 0x54, 0x81, 0x4e, 0x27, 0xef, 0xa8,
-	0x43, 0xbc, 0x7c, 0x9b, 0x7b, 0xdb, 0x54, 0x36, 0x21, 0xea, 0x90, 0xa7, 0xd0, 0x1d, 0xcb, 0x6b,
-	0x65, 0x2d, 0xad, 0x87, 0xbf, 0x99, 0xd9, 0x4e, 0x71, 0xe6, 0xff, 0x57, 0x09, 0x25, 0x03, 0x07,
-	0x07, 0x05, 0x78, 0x21, 0x96, 0xd4, 0x21, 0x23, 0x80, 0xec, 0x5e, 0xfb, 0xfa, 0x5e, 0xdf, 0xaf,
-	0xe8, 0x98, 0x2b, 0xbe, 0xa9, 0xf4, 0x1c, 0x93, 0x8c, 0x5b, 0xad, 0x9a, 0x30, 0x0d, 0x0d, 0x0e,
-	0xab, 0x8b, 0x26, 0xa5, 0xce, 0x33, 0x97, 0x7c, 0x81, 0x76, 0xec, 0xfe, 0xac, 0xda, 0x31, 0x68,
-	0x39, 0x05, 0x06, 0xa2, 0x0e, 0xf9, 0x12, 0x0b, 0x94, 0xff, 0x8b, 0xf0, 0xff, 0x8a, 0xa6, 0x85,
-	0x07, 0x5b, 0x7e, 0x46, 0x51, 0x87, 0xbc, 0x84, 0xa3, 0x31, 0x4b, 0x96, 0x2c, 0x19, 0xab, 0x84,
-	0x85, 0x8b, 0x80, 0x85, 0xd3, 0xdc, 0x74, 0xe5, 0xdc, 0xe5, 0x21, 0x06, 0xec, 0xa7, 0xb7, 0x3c,
-	0xa2, 0xce, 0x89, 0x4b, 0xbe, 0xaa, 0x2a, 0x8f, 0x99, 0x98, 0x6e, 0x14, 0x60, 0xeb, 0x63, 0x18,
-	0xef, 0x08, 0x7a, 0xaf, 0x64, 0x14, 0xb1, 0x89, 0xba, 0x10, 0x38, 0xb1, 0x1b, 0xba, 0x87, 0xa5,
-	0x21, 0x37, 0x4d, 0xf5, 0x02, 0x0e, 0xab, 0x4a, 0xde, 0x86, 0xd6, 0xbd, 0xf2, 0x6a, 0x30, 0x75,
-	0x3f, 0x3b, 0xfe, 0x91, 0xce, 0xb8, 0x8a, 0xc2, 0xab, 0xe1, 0x68, 0x34, 0x9c, 0x88, 0x4f, 0xf1,
-	0xff, 0xb1, 0xd1, 0x28, 0xff, 0x8b, 0x2a, 0x57, 0x7b, 0xf8, 0x0f, 0xda, 0xe8, 0xef, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x76, 0xd5, 0xf8, 0xe8, 0xe7, 0x0d, 0x00, 0x00,
+	// 1732 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdd, 0x8e, 0x23, 0x47,
+	0x15, 0x6e, 0xdb, 0xe3, 0x19, 0xfb, 0xd8, 0xf3, 0xb3, 0xc5, 0x6e, 0xb0, 0xac, 0x25, 0x59, 0x8a,
+	0x81, 0x2c, 0x59, 0x65, 0x76, 0xd3, 0x03, 0x89, 0x44, 0xc2, 0xc5, 0xce, 0x04, 0xd6, 0x83, 0x92,
+	0x55, 0xd3, 0x76, 0xb8, 0x40, 0xdc, 0xb4, 0xdd, 0x35, 0x76, 0x6b, 0xed, 0xaa, 0xde, 0xae, 0xb2,
+	0xe5, 0xe1, 0x1e, 0xc1, 0x25, 0xe2, 0x05, 0xb8, 0xe0, 0x09, 0x78, 0x0a, 0x5e, 0x0b, 0xd5, 0xe9,
+	0xaa, 0xee, 0x6a, 0xff, 0x09, 0x21, 0xe5, 0xae, 0xcf, 0x5f, 0x9d, 0x9f, 0x3a, 0xf5, 0x9d, 0xaa,
+	0x86, 0x76, 0xea, 0xa7, 0x57, 0x69, 0x26, 0x94, 0x20, 0x4d, 0xf5, 0x90, 0x32, 0xd9, 0x7f, 0xa4,
+	0xb2, 0x88, 0xcb, 0x68, 0xa2, 0x12, 0xc1, 0x73, 0x49, 0xbf, 0x3b, 0x11, 0x8b, 0x45, 0x41, 0x5d,
+	0x8c, 0xe7, 0x62, 0xf2, 0x6e, 0x32, 0x8b, 0x12, 0xcb, 0x69, 0xc5, 0xe3, 0xfc, 0x8b, 0x7e, 0x02,
+	0x67, 0x81, 0x1f, 0xbc, 0x61, 0x2a, 0x60, 0x2c, 0xbb, 0xe3, 0xf7, 0x82, 0xf4, 0xe0, 0x64, 0xc5,
+	0x32, 0x99, 0x08, 0xde, 0xab, 0x3d, 0xab, 0x3d, 0x6f, 0x86, 0x96, 0xa4, 0xff, 0xa8, 0x41, 0x27,
+	0xf0, 0x83, 0x42, 0x93, 0xc0, 0x51, 0x14, 0xc7, 0x19, 0xaa, 0xb5, 0x43, 0xfc, 0xd6, 0xbc, 0x54,
+	0x64, 0xaa, 0x57, 0x47, 0x53, 0xfc, 0xd6, 0x3c, 0x1e, 0x2d, 0x58, 0xaf, 0x91, 0xeb, 0xe9, 0x6f,
+	0xf2, 0x0c, 0x3a, 0x0b, 0xb6, 0x48, 0x85, 0x98, 0x0f, 0x93, 0x3f, 0xb3, 0xde, 0x11, 0xaa, 0xbb,
+	0x2c, 0xf2, 0x53, 0x38, 0x9e, 0xb1, 0x28, 0x66, 0x59, 0xaf, 0xf9, 0xac, 0xf6, 0xbc, 0xe3, 0x9f,
+	0x5e, 0x61, 0xba, 0x57, 0x03, 0x64, 0x86, 0x46, 0x48, 0xff, 0x5e, 0x07, 0x08, 0xfc, 0xe0, 0x0f,
+	0x79, 0x8c, 0xfb, 0xa3, 0xd7, 0x12, 0xc9, 0xb2, 0x55, 0x32, 0x61, 0x18, 0x5c, 0x23, 0xb4, 0x24,
+	0x79, 0x0a, 0x6d, 0x95, 0x2c, 0x98, 0x54, 0xd1, 0x22, 0xc5, 0x20, 0x1b, 0x61, 0xc9, 0x20, 0x7d,
+	0x68, 0xe9, 0xcc, 0x42, 0x36, 0x59, 0x61, 0x98, 0xed, 0xb0, 0xa0, 0xad, 0xec, 0xb7, 0x99, 0x58,
+	0xf4, 0x9a, 0xa5, 0x4c, 0xd3, 0xe4, 0x31, 0x34, 0xb9, 0xe0, 0x13, 0xd6, 0x3b, 0xc6, 0x15, 0x73,
+	0x42, 0xfb, 0x5a, 0x4a, 0x96, 0xbd, 0x9e, 0x32, 0xae, 0x7a, 0x27, 0x68, 0x52, 0x32, 0x74, 0x55,
+	0xa4, 0x8a, 0x32, 0x35, 0x60, 0xc9, 0x74, 0xa6, 0x7a, 0x2d, 0xb4, 0x74, 0x59, 0x84, 0x42, 0x37,
+	0x63, 0xf3, 0xe8, 0xc1, 0xe4, 0xdb, 0x6b, 0x63, 0x92, 0x15, 0x1e, 0xfd, 0x0e, 0xda, 0x79, 0x45,
+	0x5e, 0x4f, 0xde, 0xfd, 0x5f, 0x05, 0x29, 0x42, 0x6f, 0x38, 0xa1, 0xd3, 0x05, 0x9c, 0xe8, 0xdd,
+	0x4f, 0xf8, 0xb4, 0x54, 0xa8, 0xb9, 0xb9, 0xd9, 0x7e, 0xa8, 0xef, 0xe8, 0x87, 0x86, 0xd3, 0x0f,
+	0x97, 0x70, 0x24, 0x93, 0x29, 0xc7, 0x6a, 0x76, 0xfc, 0x0b, 0xb3, 0xaf, 0xc3, 0x64, 0xca, 0x23,
+	0xb5, 0xcc, 0x58, 0x88, 0x52, 0xfa, 0x51, 0xee, 0x4e, 0xec, 0x73, 0x47, 0x29, 0x6e, 0xfc, 0x1b,
+	0xa6, 0x5e, 0x6b, 0x47, 0xbb, 0x75, 0xbe, 0xc4, 0x45, 0xf6, 0x2b, 0xd8, 0x1d, 0x9c, 0x27, 0x52,
+	0xf7, 0x6c, 0xc3, 0xee, 0xa0, 0xa6, 0xe9, 0x10, 0x3a, 0xc6, 0xf8, 0x9b, 0x44, 0xaa, 0x3d, 0x0b,
+	0x5c, 0x41, 0x2b, 0x65, 0x2c, 0x4b, 0xf8, 0xbd, 0xc0, 0x05, 0x3a, 0x3e, 0x31, 0x09, 0x39, 0x47,
+	0x25, 0x2c, 0x74, 0xe8, 0x2d, 0x9c, 0x07, 0x7e, 0xf0, 0x9b, 0xb5, 0x62, 0x19, 0x8f, 0xe6, 0x7b,
+	0xcf, 0xd1, 0x53, 0x68, 0x27, 0x52, 0x2c, 0x95, 0x4c, 0xe2, 0x7c, 0x7b, 0x5a, 0x61, 0xc9, 0xa0,
+	0x33, 0xe8, 0xe6, 0xa9, 0xdf, 0xe8, 0x93, 0x2d, 0x0f, 0x6c, 0xf2, 0x46, 0x47, 0xd5, 0xb7, 0x3b,
+	0xea, 0x29, 0xb4, 0x19, 0x8f, 0x8d, 0xdc, 0x74, 0x7f, 0xc1, 0xa0, 0x3f, 0x87, 0xd3, 0xdc, 0xd3,
+	0xb7, 0xf9, 0xd1, 0x3c, 0x00, 0x0f, 0x57, 0x70, 0x1c, 0xf8, 0xc1, 0x1d, 0x5f, 0xe9, 0x0d, 0x4e,
+	0xf8, 0x4a, 0xf6, 0x6a, 0xcf, 0x1a, 0xce, 0x06, 0xdf, 0xf1, 0x15, 0xe3, 0x4a, 0x64, 0x0f, 0x21,
+	0x4a, 0xe9, 0x1b, 0x68, 0x17, 0x2c, 0x72, 0x06, 0x75, 0xf5, 0x60, 0x56, 0xac, 0xab, 0x07, 0x5d,
+	0x93, 0x59, 0x24, 0x67, 0x18, 0x70, 0x37, 0xc4, 0x6f, 0xf2, 0x81, 0x46, 0x04, 0x27, 0x4c, 0x43,
+	0xd1, 0x6f, 0x6c, 0x23, 0x7c, 0x1d, 0xa9, 0xe8, 0x40, 0x2d, 0x6c, 0x58, 0xf5, 0x83, 0x61, 0xbd,
+	0x80, 0x66, 0xe0, 0x07, 0xa3, 0x35, 0xa1, 0x50, 0x57, 0x6b, 0x5c, 0xa3, 0xdc, 0xd3, 0x51, 0x09,
+	0xb5, 0x61, 0x5d, 0xad, 0xe9, 0x15, 0xb4, 0x02, 0x3f, 0xc0, 0x5d, 0x20, 0x14, 0x9a, 0x08, 0xb4,
+	0xc6, 0xa4, 0x6b, 0x4c, 0x50, 0x18, 0xe6, 0x22, 0xfa, 0xb7, 0x1a, 0x6e, 0xff, 0xad, 0x58, 0xa4,
+	0xd1, 0x24, 0xdf, 0x3d, 0x72, 0x59, 0x00, 0xdd, 0x2e, 0x43, 0x23, 0xd3, 0x8d, 0x2a, 0x67, 0x22,
+	0x53, 0x77, 0x5f, 0xe7, 0x09, 0x1c, 0x87, 0x05, 0x4d, 0xae, 0xa1, 0x9d, 0x66, 0xec, 0x3e, 0x99,
+	0xcf, 0x59, 0xdc, 0x6b, 0x60, 0x76, 0x4f, 0x9c, 0x26, 0xb4, 0xa2, 0xd1, 0x3a, 0x2c, 0xf5, 0xe8,
+	0xef, 0xe0, 0xac, 0x2a, 0xd4, 0x0d, 0x9e, 0xf0, 0x98, 0xad, 0x4d, 0xdd, 0x72, 0xc2, 0x94, 0xa1,
+	0x7e, 0xb0, 0x0c, 0x63, 0x3b, 0x45, 0x30, 0xe6, 0xd1, 0xfa, 0x50, 0x47, 0x3e, 0x85, 0x36, 0xd6,
+	0x62, 0x50, 0x6e, 0x6f, 0xc9, 0xd0, 0x76, 0xe8, 0x96, 0x49, 0x4c, 0xa4, 0x19, 0x5a, 0x92, 0xfe,
+	0x1e, 0x4f, 0x63, 0xe1, 0xa0, 0xb2, 0x4c, 0x6d, 0x73, 0x99, 0x4b, 0x68, 0xa8, 0xb5, 0xdc, 0x38,
+	0x90, 0x6e, 0xd4, 0x5a, 0x4c, 0x29, 0xee, 0xde, 0x68, 0xad, 0x7b, 0x56, 0x37, 0x57, 0x24, 0x67,
+	0x2c, 0xef, 0xda, 0x6e, 0x68, 0x28, 0xfa, 0x13, 0x04, 0xd3, 0x37, 0x4c, 0x69, 0xa7, 0xfb, 0x94,
+	0xfe, 0x5d, 0x83, 0xb6, 0x3e, 0xeb, 0xc3, 0x89, 0xc8, 0xd8, 0xbe, 0xb9, 0x88, 0x33, 0xb0, 0xee,
+	0xcc, 0xc0, 0xc7, 0xd0, 0x94, 0xda, 0xc0, 0xc2, 0x2c, 0x12, 0x7a, 0xa3, 0xc7, 0x11, 0xbf, 0x15,
+	0x4b, 0xae, 0xcc, 0x58, 0x2c, 0x68, 0xed, 0x7f, 0x1c, 0x71, 0xce, 0x62, 0x9c, 0x36, 0xad, 0xd0,
+	0x50, 0xc6, 0xe6, 0x3b, 0xae, 0x92, 0xb9, 0x19, 0x37, 0x05, 0xad, 0x6d, 0x32, 0x16, 0x49, 0xc1,
+	0xcd, 0xb8, 0x31, 0x14, 0xfd, 0x02, 0x4e, 0x8b, 0x90, 0x11, 0xdf, 0x7e, 0x06, 0x4d, 0x8d, 0x52,
+	0x9b, 0xc7, 0xb6, 0x50, 0x0a, 0x73, 0x31, 0x1d, 0x01, 0x84, 0xec, 0xfd, 0x4d, 0xc4, 0xb5, 0x64,
+	0x67, 0xb2, 0x7d, 0x68, 0xc5, 0xcb, 0x2c, 0xd2, 0x85, 0x36, 0x88, 0x53, 0xd0, 0x4e, 0x38, 0x8d,
+	0x4a, 0x38, 0x51, 0x1e, 0xce, 0xb7, 0x89, 0x1c, 0xb3, 0x59, 0xb4, 0x62, 0xe4, 0x02, 0x1a, 0x69,
+	0x12, 0x9b, 0x75, 0xf5, 0xe7, 0xce, 0xf9, 0x52, 0x5d, 0xae, 0x69, 0x97, 0xd3, 0xba, 0x08, 0xc9,
+	0xf9, 0xc4, 0xc6, 0x6f, 0x3a, 0x83, 0x96, 0x19, 0x91, 0x92, 0x7c, 0x08, 0x90, 0xfa, 0x69, 0xb5,
+	0x45, 0x1d, 0x0e, 0xe2, 0xa6, 0xb8, 0x57, 0x56, 0x21, 0x77, 0xe9, 0xb2, 0x74, 0x92, 0xba, 0x1e,
+	0xce, 0xcd, 0xa6, 0xa0, 0xe9, 0x7f, 0xea, 0x70, 0x7a, 0x93, 0x89, 0x28, 0xbe, 0x8d, 0x64, 0x8e,
+	0x4a, 0x1f, 0x3a, 0x60, 0xd2, 0x2d, 0xcf, 0xe6, 0x68, 0x3d, 0xf0, 0xf4, 0x09, 0x22, 0x1f, 0x5b,
+	0xf0, 0xc8, 0x0f, 0xda, 0x79, 0xa9, 0x82, 0x1d, 0x3f, 0xf0, 0x0c, 0x82, 0x68, 0x10, 0x4b, 0x13,
+	0x3e, 0x45, 0x97, 0x1d, 0xff, 0xac, 0xd4, 0xd3, 0x83, 0x79, 0xe0, 0x85, 0x28, 0x25, 0x2f, 0xca,
+	0xe3, 0x77, 0x54, 0x59, 0xd0, 0x16, 0x60, 0xe0, 0x95, 0x27, 0xf2, 0x2b, 0xe8, 0x4e, 0x1c, 0x40,
+	0x32, 0xf7, 0xad, 0x0f, 0xca, 0xa5, 0x5d, 0xb8, 0x1a, 0x78, 0x61, 0x45, 0x5b, 0x47, 0xae, 0xf4,
+	0x09, 0xea, 0x1d, 0x57, 0x1c, 0xd9, 0x83, 0xa5, 0x23, 0x47, 0x39, 0xf9, 0x04, 0x8e, 0xa7, 0x78,
+	0x8c, 0xb0, 0x11, 0x9d, 0x06, 0xb3, 0xc7, 0x6b, 0xe0, 0x85, 0x46, 0xe3, 0xe6, 0x04, 0x9a, 0xab,
+	0x68, 0xbe, 0x64, 0x34, 0xb1, 0xf3, 0x27, 0xbf, 0xf6, 0x7d, 0x9f, 0xa3, 0xee, 0x4f, 0x70, 0x91,
+	0xbb, 0x1a, 0xaa, 0x48, 0xb1, 0x20, 0x13, 0xe2, 0xfe, 0x30, 0x8c, 0x49, 0xad, 0xe7, 0xc2, 0x58,
+	0xc1, 0xd0, 0xcd, 0xfb, 0x8e, 0x3d, 0xa0, 0x8f, 0x6e, 0xa8, 0x3f, 0xe9, 0x2f, 0x71, 0x48, 0xd9,
+	0x2c, 0x3e, 0x86, 0x93, 0x1c, 0xd7, 0xed, 0x69, 0xdb, 0xb8, 0xdd, 0x5a, 0x29, 0xe5, 0x70, 0x72,
+	0xc7, 0x57, 0xd8, 0x42, 0x97, 0x87, 0xe7, 0x91, 0x69, 0xa4, 0xcb, 0x6a, 0x23, 0x55, 0x86, 0x49,
+	0xd9, 0x45, 0xf9, 0xb8, 0x6d, 0xd8, 0x71, 0x5b, 0xd6, 0xfb, 0x15, 0xb4, 0x8c, 0x3f, 0xa9, 0x97,
+	0x4a, 0x14, 0x5b, 0xd8, 0x10, 0xcf, 0xca, 0x81, 0xa9, 0xe5, 0x61, 0x2e, 0xa4, 0xff, 0xac, 0xc1,
+	0xd1, 0x5e, 0x24, 0xf8, 0x5f, 0x9f, 0x03, 0x04, 0x8e, 0x24, 0x9b, 0xdf, 0x63, 0xb3, 0xb6, 0x42,
+	0xfc, 0xde, 0x7c, 0x22, 0x34, 0x0f, 0x3d, 0x11, 0x8e, 0x0f, 0x3d, 0x11, 0x3e, 0x85, 0x96, 0x0e,
+	0x10, 0x41, 0xee, 0xc7, 0x55, 0x90, 0xeb, 0x38, 0x20, 0x67, 0xf1, 0xed, 0x5f, 0x35, 0xe8, 0xbc,
+	0x15, 0x31, 0x7b, 0xcb, 0x14, 0x5e, 0xcf, 0x28, 0x74, 0x99, 0xb9, 0xae, 0x39, 0xf9, 0x55, 0x78,
+	0xba, 0x1b, 0xe6, 0x62, 0x62, 0x14, 0x72, 0xb0, 0x28, 0x19, 0xee, 0x4d, 0xbb, 0x81, 0x09, 0xba,
+	0x4f, 0x0f, 0xb1, 0x54, 0x63, 0xb1, 0xe4, 0xb1, 0x34, 0x68, 0x5f, 0x32, 0x34, 0xc4, 0x24, 0xdc,
+	0x08, 0xf3, 0xf4, 0x0b, 0x9a, 0xfe, 0x02, 0x40, 0x07, 0x2d, 0x43, 0x96, 0xce, 0x1f, 0x0e, 0x61,
+	0xb7, 0xc4, 0x0b, 0xa8, 0xc9, 0xed, 0x2f, 0x66, 0x50, 0x49, 0x7b, 0xf1, 0xc4, 0x9d, 0xa8, 0x39,
+	0x3b, 0x71, 0x06, 0xf5, 0x24, 0x35, 0x29, 0xd4, 0x93, 0x74, 0xe7, 0x05, 0x7e, 0x03, 0x1c, 0x8f,
+	0xb6, 0xc1, 0xb1, 0x0a, 0xaf, 0xcd, 0x4d, 0x78, 0xf5, 0xff, 0xda, 0x82, 0x4e, 0xea, 0xa7, 0x53,
+	0x5b, 0x87, 0x17, 0xd0, 0x29, 0xf0, 0x72, 0xb4, 0x26, 0x15, 0x84, 0xec, 0x5b, 0x0a, 0x53, 0xa5,
+	0x1e, 0xf9, 0x0c, 0xce, 0x0a, 0xe5, 0x1c, 0x83, 0x36, 0xe1, 0x72, 0xcb, 0xe4, 0x39, 0x1c, 0xe1,
+	0xc3, 0x65, 0x03, 0x2f, 0xfb, 0x2e, 0x2d, 0xf8, 0x94, 0x7a, 0xe4, 0x0a, 0x4e, 0xec, 0x93, 0xe2,
+	0x51, 0x05, 0xa0, 0x34, 0xcb, 0xd5, 0xd7, 0x34, 0xf5, 0xc8, 0xe7, 0xd0, 0x31, 0x42, 0xec, 0xaf,
+	0x1d, 0x36, 0xa4, 0x6a, 0xa3, 0xd5, 0xa8, 0x47, 0x5e, 0xc1, 0x89, 0x7d, 0xb3, 0x3a, 0x36, 0x86,
+	0xd5, 0xbf, 0xa8, 0xb0, 0x5e, 0x4f, 0xde, 0x51, 0x8f, 0xf8, 0xc5, 0xf8, 0xf2, 0x77, 0x99, 0x6c,
+	0xb3, 0xa8, 0x47, 0x3e, 0x85, 0xce, 0x50, 0xdc, 0x2b, 0xeb, 0x69, 0x33, 0xfd, 0xed, 0xca, 0xb6,
+	0xcb, 0x47, 0xc5, 0x0f, 0x2a, 0xa9, 0xe4, 0xcc, 0xfe, 0x69, 0xc9, 0xbc, 0xe3, 0x2b, 0xea, 0x91,
+	0x6b, 0x80, 0xfc, 0x75, 0x10, 0xe8, 0xd7, 0xc1, 0xe3, 0x8a, 0x8d, 0x79, 0x33, 0x6c, 0x1b, 0x7d,
+	0x86, 0x45, 0x46, 0x54, 0xab, 0x16, 0x4c, 0xb3, 0xfa, 0xe7, 0x55, 0xa0, 0x91, 0xd4, 0x7b, 0x55,
+	0x23, 0x5f, 0xa0, 0x1f, 0x8b, 0x9f, 0x55, 0x3f, 0x86, 0xeb, 0x96, 0xc0, 0xb0, 0xa8, 0x47, 0x7e,
+	0x85, 0x1b, 0x54, 0xfc, 0xb4, 0x78, 0x52, 0xb1, 0xb4, 0xec, 0xfe, 0x8e, 0x47, 0x1b, 0xf5, 0xc8,
+	0xaf, 0xe1, 0xb4, 0x3a, 0x0f, 0x7e, 0x58, 0xb1, 0x2e, 0x05, 0x85, 0xeb, 0x92, 0x55, 0xb8, 0x2e,
+	0xae, 0xac, 0x4f, 0xb6, 0x0b, 0x3a, 0x5a, 0x4b, 0xd7, 0xb5, 0xe5, 0x51, 0x8f, 0x7c, 0x09, 0x17,
+	0x43, 0x96, 0xad, 0x58, 0x36, 0x54, 0x19, 0x8b, 0x16, 0x21, 0x8b, 0xe2, 0x22, 0xeb, 0xca, 0xd5,
+	0xa2, 0xa8, 0x6e, 0xc8, 0xde, 0xbf, 0x4d, 0xe6, 0xd4, 0x7b, 0x5e, 0x23, 0x5f, 0x55, 0x8d, 0x87,
+	0x8c, 0xc7, 0x5b, 0x7b, 0xbf, 0x73, 0x31, 0x2c, 0xf5, 0x35, 0x9c, 0xdd, 0x8a, 0xf9, 0x9c, 0x4d,
+	0xd4, 0x1d, 0xde, 0xf1, 0xe4, 0x96, 0xed, 0xb9, 0x83, 0x2f, 0xa6, 0x9f, 0x3f, 0x87, 0xf3, 0xaa,
+	0x91, 0xbf, 0x65, 0xf5, 0xc8, 0xb1, 0x92, 0xa6, 0xe5, 0x6e, 0x3e, 0xfa, 0xe3, 0x8f, 0xa6, 0x89,
+	0x9a, 0x2d, 0xc7, 0x57, 0x13, 0xb1, 0x78, 0x79, 0x7d, 0x3d, 0xe1, 0x2f, 0xf1, 0x4f, 0xd5, 0xf5,
+	0xf5, 0x4b, 0xd4, 0x1e, 0x1f, 0xe3, 0x8f, 0xaa, 0xeb, 0xff, 0x0e, 0x00, 0xa0, 0x6d, 0x3b, 0x1e,
+	0xf9, 0x12, 0x00, 0x00,
 }
@@ -162,6 +162,8 @@ type Request struct {
 	//	*Request_Viewchange
 	//	*Request_Ack
 	//	*Request_Newview
+	//	*Request_Fetch
+	//	*Request_Blocks
 	Value isRequest_Value `protobuf_oneof:"value"`
 }
 
@@ -198,6 +200,12 @@ type Request_Ack struct {
 type Request_Newview struct {
 	Newview *RequestNewView `protobuf:"bytes,8,opt,name=newview,oneof"`
 }
+type Request_Fetch struct {
+	Fetch *RequestFetch `protobuf:"bytes,9,opt,name=fetch,oneof"`
+}
+type Request_Blocks struct {
+	Blocks *RequestBlocks `protobuf:"bytes,10,opt,name=blocks,oneof"`
+}
 
 func (*Request_Client) isRequest_Value()     {}
 func (*Request_Preprepare) isRequest_Value() {}
@@ -207,6 +215,8 @@ func (*Request_Checkpoint) isRequest_Value() {}
 func (*Request_Viewchange) isRequest_Value() {}
 func (*Request_Ack) isRequest_Value()        {}
 func (*Request_Newview) isRequest_Value()    {}
+func (*Request_Fetch) isRequest_Value()      {}
+func (*Request_Blocks) isRequest_Value()     {}
 
 func (m *Request) GetValue() isRequest_Value {
 	if m != nil {
@@ -271,6 +281,20 @@ func (m *Request) GetNewview() *RequestNewView {
 	return nil
 }
 
+func (m *Request) GetFetch() *RequestFetch {
+	if x, ok := m.GetValue().(*Request_Fetch); ok {
+		return x.Fetch
+	}
+	return nil
+}
+
+func (m *Request) GetBlocks() *RequestBlocks {
+	if x, ok := m.GetValue().(*Request_Blocks); ok {
+		return x.Blocks
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Request) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Request_OneofMarshaler, _Request_OneofUnmarshaler, _Request_OneofSizer, []interface{}{
@@ -282,6 +306,8 @@ func (*Request) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error
 		(*Request_Viewchange)(nil),
 		(*Request_Ack)(nil),
 		(*Request_Newview)(nil),
+		(*Request_Fetch)(nil),
+		(*Request_Blocks)(nil),
 	}
 }
 
@@ -329,6 +355,16 @@ func _Request_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Newview); err != nil {
 			return err
 		}
+	case *Request_Fetch:
+		b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Fetch); err != nil {
+			return err
+		}
+	case *Request_Blocks:
+		b.EncodeVarint(10<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Blocks); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Request.Value has unexpected type %T", x)
@@ -403,6 +439,22 @@ func _Request_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer
 		err := b.DecodeMessage(msg)
 		m.Value = &Request_Newview{msg}
 		return true, err
+	case 9: // value.fetch
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(RequestFetch)
+		err := b.DecodeMessage(msg)
+		m.Value = &Request_Fetch{msg}
+		return true, err
+	case 10: // value.blocks
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(RequestBlocks)
+		err := b.DecodeMessage(msg)
+		m.Value = &Request_Blocks{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -452,6 +504,16 @@ func _Request_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(8<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Request_Fetch:
+		s := proto.Size(x.Fetch)
+		n += proto.SizeVarint(9<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Request_Blocks:
+		s := proto.Size(x.Blocks)
+		n += proto.SizeVarint(10<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -771,6 +833,63 @@ func (m *RequestNewView) GetReplica() uint32 {
 	return 0
 }
 
+// 落后的节点向其他节点下载 stable checkpoint 之前的区块
+type RequestFetch struct {
+	Start   uint32 `protobuf:"varint,1,opt,name=start" json:"start,omitempty"`
+	End     uint32 `protobuf:"varint,2,opt,name=end" json:"end,omitempty"`
+	Replica uint32 `protobuf:"varint,3,opt,name=replica" json:"replica,omitempty"`
+}
+
+func (m *RequestFetch) Reset()                    { *m = RequestFetch{} }
+func (m *RequestFetch) String() string            { return proto.CompactTextString(m) }
+func (*RequestFetch) ProtoMessage()               {}
+func (*RequestFetch) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{15} }
+
+func (m *RequestFetch) GetStart() uint32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *RequestFetch) GetEnd() uint32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *RequestFetch) GetReplica() uint32 {
+	if m != nil {
+		return m.Replica
+	}
+	return 0
+}
+
+type RequestBlocks struct {
+	Blocks  []*Block `protobuf:"bytes,1,rep,name=blocks" json:"blocks,omitempty"`
+	Replica uint32   `protobuf:"varint,2,opt,name=replica" json:"replica,omitempty"`
+}
+
+func (m *RequestBlocks) Reset()                    { *m = RequestBlocks{} }
+func (m *RequestBlocks) String() string            { return proto.CompactTextString(m) }
+func (*RequestBlocks) ProtoMessage()               {}
+func (*RequestBlocks) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{16} }
+
+func (m *RequestBlocks) GetBlocks() []*Block {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func (m *RequestBlocks) GetReplica() uint32 {
+	if m != nil {
+		return m.Replica
+	}
+	return 0
+}
+
 type ClientReply struct {
 	View      uint32  `protobuf:"varint,1,opt,name=view" json:"view,omitempty"`
 	Timestamp string  `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp,omitempty"`
@@ -782,7 +901,7 @@ type ClientReply struct {
 func (m *ClientReply) Reset()                    { *m = ClientReply{} }
 func (m *ClientReply) String() string            { return proto.CompactTextString(m) }
 func (*ClientReply) ProtoMessage()               {}
-func (*ClientReply) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{15} }
+func (*ClientReply) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{17} }
 
 func (m *ClientReply) GetView() uint32 {
 	if m != nil {
@@ -819,6 +938,39 @@ func (m *ClientReply) GetResult() *Result {
 	return nil
 }
 
+// 节点之间传递的消息, 由发送节点签名, 签名的内容是 request
+type PbftMessage struct {
+	Replica   uint32     `protobuf:"varint,1,opt,name=replica" json:"replica,omitempty"`
+	Request   *Request   `protobuf:"bytes,2,opt,name=request" json:"request,omitempty"`
+	Signature *Signature `protobuf:"bytes,3,opt,name=signature" json:"signature,omitempty"`
+}
+
+func (m *PbftMessage) Reset()                    { *m = PbftMessage{} }
+func (m *PbftMessage) String() string            { return proto.CompactTextString(m) }
+func (*PbftMessage) ProtoMessage()               {}
+func (*PbftMessage) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{18} }
+
+func (m *PbftMessage) GetReplica() uint32 {
+	if m != nil {
+		return m.Replica
+	}
+	return 0
+}
+
+func (m *PbftMessage) GetRequest() *Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *PbftMessage) GetSignature() *Signature {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Operation)(nil), "types.Operation")
 	proto.RegisterType((*Checkpoint)(nil), "types.Checkpoint")
@@ -835,54 +987,65 @@ func init() {
 	proto.RegisterType((*RequestViewChange)(nil), "types.RequestViewChange")
 	proto.RegisterType((*RequestAck)(nil), "types.RequestAck")
 	proto.RegisterType((*RequestNewView)(nil), "types.RequestNewView")
+	proto.RegisterType((*RequestFetch)(nil), "types.RequestFetch")
+	proto.RegisterType((*RequestBlocks)(nil), "types.RequestBlocks")
 	proto.RegisterType((*ClientReply)(nil), "types.ClientReply")
+	proto.RegisterType((*PbftMessage)(nil), "types.PbftMessage")
 }
 
 func init() { proto.RegisterFile("pbft.proto", fileDescriptor6) }
 
 var fileDescriptor6 = []byte{
-	// 676 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xdf, 0x6a, 0xdb, 0x3e,
-	0x14, 0xae, 0xe3, 0xd8, 0x6e, 0x4e, 0x9a, 0xd2, 0x8a, 0xdf, 0x6f, 0x88, 0xb2, 0x8b, 0x60, 0x56,
-	0xe8, 0x45, 0x71, 0x59, 0x7d, 0x37, 0x18, 0x6c, 0x2d, 0x1b, 0xbd, 0x5a, 0x8b, 0x06, 0x83, 0xed,
-	0xce, 0xf1, 0xb4, 0x54, 0xc4, 0xff, 0x66, 0x29, 0x0d, 0x7d, 0x93, 0xdd, 0xee, 0x19, 0xf6, 0x42,
-	0x7b, 0x94, 0x21, 0x59, 0xb1, 0xac, 0xd5, 0x2d, 0x6b, 0x06, 0x83, 0x42, 0x2d, 0x9d, 0xf3, 0xe9,
-	0x1c, 0x9d, 0xf3, 0x9d, 0x4f, 0x01, 0xa8, 0x66, 0x5f, 0x44, 0x54, 0xd5, 0xa5, 0x28, 0x91, 0x27,
-	0x6e, 0x2b, 0xca, 0x0f, 0xf6, 0x66, 0x59, 0x99, 0x2e, 0xd2, 0xeb, 0x84, 0x15, 0x8d, 0x21, 0x3c,
-	0x81, 0xd1, 0x65, 0x45, 0xeb, 0x44, 0xb0, 0xb2, 0x40, 0x21, 0x78, 0x37, 0x49, 0xb6, 0xa4, 0xd8,
-	0x99, 0x3a, 0x47, 0xe3, 0xd3, 0x9d, 0x48, 0xa1, 0xa2, 0x33, 0x09, 0x22, 0x8d, 0x29, 0x7c, 0x05,
-	0x70, 0x7e, 0x4d, 0xd3, 0x45, 0x55, 0xb2, 0x42, 0xa0, 0x03, 0xd8, 0xe6, 0xf4, 0xeb, 0x92, 0x16,
-	0x69, 0x03, 0x9a, 0x90, 0x76, 0x8d, 0x9e, 0x80, 0xff, 0x99, 0xcd, 0x29, 0x17, 0x78, 0x30, 0x75,
-	0x8e, 0x76, 0x88, 0x5e, 0x85, 0x97, 0xe0, 0xbd, 0x29, 0x44, 0x7d, 0xbb, 0x09, 0x18, 0x21, 0x18,
-	0xde, 0x30, 0xba, 0xc2, 0xae, 0xf2, 0x57, 0xdf, 0xe1, 0x5b, 0x80, 0x0f, 0x8c, 0xae, 0xce, 0xaf,
-	0x93, 0x62, 0x4e, 0xd1, 0x14, 0xc6, 0x72, 0x37, 0x55, 0xab, 0x5a, 0x1f, 0xdc, 0xdd, 0xba, 0x37,
-	0xb1, 0x97, 0x10, 0xbc, 0x5f, 0xe6, 0x79, 0xb2, 0x59, 0x6a, 0xe1, 0x31, 0xf8, 0x84, 0xf2, 0x65,
-	0x26, 0xfe, 0xa8, 0x8e, 0x3f, 0x5c, 0x08, 0x88, 0x3c, 0x92, 0x0b, 0x14, 0x81, 0x9f, 0x66, 0x8c,
-	0x16, 0x42, 0x03, 0xfe, 0xd3, 0x00, 0x6d, 0x3f, 0x57, 0xb6, 0x8b, 0x2d, 0xa2, 0xbd, 0xd0, 0x0b,
-	0x80, 0xaa, 0xa6, 0xf2, 0x2f, 0xa9, 0xa9, 0xca, 0x62, 0x7c, 0x8a, 0x6d, 0xcc, 0x55, 0x4d, 0xaf,
-	0x1a, 0xfb, 0xc5, 0x16, 0xe9, 0x78, 0xa3, 0xe7, 0x10, 0xac, 0x81, 0xae, 0x02, 0xfe, 0x7f, 0x07,
-	0xa8, 0x51, 0x6b, 0x3f, 0x95, 0x5e, 0x99, 0xe7, 0x4c, 0xe0, 0x61, 0x6f, 0x7a, 0xca, 0xa6, 0xd2,
-	0x53, 0x5f, 0x32, 0xbd, 0xb4, 0xa5, 0x08, 0xf6, 0xfa, 0xd2, 0x33, 0x14, 0x92, 0xe9, 0x19, 0x6f,
-	0x89, 0x35, 0xad, 0xc2, 0x7e, 0x1f, 0xd6, 0xf4, 0x5a, 0x62, 0x8d, 0x37, 0x3a, 0x04, 0x37, 0x49,
-	0x17, 0x38, 0x50, 0xa0, 0x7d, 0x1b, 0xf4, 0x3a, 0x5d, 0x5c, 0x6c, 0x11, 0x69, 0x97, 0x15, 0x28,
-	0xe8, 0x4a, 0xb1, 0x68, 0xbb, 0xaf, 0x02, 0xef, 0xe8, 0x4a, 0x86, 0x90, 0x15, 0xd0, 0x7e, 0x67,
-	0x81, 0x6e, 0x68, 0x38, 0x87, 0x89, 0xd5, 0x14, 0x34, 0x85, 0x41, 0x59, 0xe9, 0xb6, 0xed, 0xe9,
-	0x73, 0xda, 0x81, 0x22, 0x83, 0xb2, 0x42, 0x4f, 0x61, 0x24, 0x58, 0x4e, 0xb9, 0x48, 0xf2, 0x4a,
-	0xf5, 0x6a, 0x44, 0xcc, 0x86, 0x24, 0x93, 0x6e, 0xbd, 0xab, 0x4c, 0x7a, 0x15, 0x2e, 0x61, 0xff,
-	0x4e, 0x27, 0x5b, 0xf2, 0x3b, 0x86, 0xfc, 0x16, 0x53, 0x07, 0xf7, 0x32, 0xd5, 0xb5, 0x86, 0x08,
-	0x43, 0x50, 0xd3, 0x2a, 0x63, 0x69, 0xa2, 0x3a, 0x3a, 0x21, 0xeb, 0x65, 0x58, 0xc3, 0xae, 0xcd,
-	0x83, 0x7f, 0x10, 0xf3, 0xa3, 0xa9, 0x69, 0xc3, 0x9f, 0xc7, 0x86, 0xec, 0x1c, 0xed, 0xda, 0x47,
-	0x27, 0x6d, 0x15, 0xff, 0x4e, 0xb3, 0x1e, 0x08, 0xf1, 0xd3, 0x69, 0x63, 0x74, 0x44, 0xe8, 0xb1,
-	0x57, 0x88, 0x61, 0x6c, 0x86, 0x80, 0x63, 0x77, 0xea, 0x76, 0x28, 0x6c, 0x72, 0x27, 0x5d, 0x2f,
-	0x29, 0x33, 0x72, 0x44, 0x39, 0x1e, 0x2a, 0xf7, 0xb5, 0xcc, 0x28, 0x71, 0x25, 0x8d, 0x09, 0x1d,
-	0xc1, 0xb6, 0x1e, 0x7e, 0x8e, 0xbd, 0x1e, 0xb7, 0xd6, 0xda, 0xbd, 0xa2, 0x6f, 0x5f, 0x51, 0x00,
-	0x98, 0x29, 0xea, 0xbd, 0x5a, 0x07, 0x3b, 0xb0, 0xb0, 0xbf, 0xab, 0xb1, 0xfb, 0x90, 0x1a, 0x0f,
-	0x2d, 0x39, 0xfd, 0xee, 0xb4, 0x5c, 0xd4, 0x13, 0xd9, 0x1b, 0x3a, 0xee, 0x06, 0xe0, 0x78, 0x60,
-	0x55, 0xce, 0x74, 0xa4, 0x1b, 0x93, 0xa3, 0x63, 0x18, 0x71, 0xa5, 0xf4, 0x8c, 0xae, 0xab, 0xb7,
-	0xab, 0x21, 0xfa, 0x05, 0x20, 0xc6, 0xa1, 0x7b, 0x3b, 0xcf, 0xae, 0xcc, 0x37, 0x07, 0xc6, 0x8d,
-	0x10, 0x10, 0x5a, 0x65, 0xb7, 0xbd, 0x09, 0x6e, 0x34, 0xff, 0xf7, 0x8f, 0x0b, 0x3a, 0x04, 0xbf,
-	0x56, 0xcf, 0x8c, 0x56, 0xd6, 0x49, 0xab, 0x5e, 0x72, 0x93, 0x68, 0xe3, 0xd9, 0xb3, 0x4f, 0xe1,
-	0x9c, 0x89, 0x2c, 0x99, 0x45, 0x71, 0x1c, 0xa5, 0xc5, 0x89, 0x7a, 0xf4, 0xe3, 0xb8, 0xfd, 0xaf,
-	0x40, 0x33, 0x5f, 0xfd, 0x0a, 0x88, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x36, 0x09, 0x4e, 0x6f,
-	0x2c, 0x08, 0x00, 0x00,
+	// 816 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6a, 0x1b, 0x3f,
+	0x10, 0xf6, 0x7a, 0xbd, 0x76, 0x3c, 0x8e, 0x4d, 0xac, 0x5f, 0x7e, 0x45, 0x84, 0x96, 0x9a, 0xa5,
+	0x01, 0x43, 0x83, 0x4d, 0xe3, 0x5b, 0xa1, 0xd0, 0x26, 0x34, 0xf8, 0xd2, 0xc6, 0x28, 0x50, 0x68,
+	0x6f, 0xf2, 0x46, 0xb1, 0x17, 0xdb, 0xbb, 0xdb, 0x95, 0x1c, 0x93, 0x63, 0xdf, 0xa0, 0xc7, 0x5e,
+	0xfb, 0x66, 0x7d, 0x94, 0x22, 0xad, 0xf6, 0x8f, 0x9a, 0x75, 0x68, 0x7c, 0x28, 0xf8, 0xb0, 0xd2,
+	0xcc, 0x37, 0x33, 0x1a, 0x7d, 0xf3, 0xc9, 0x00, 0xd1, 0xf4, 0x46, 0x0c, 0xa2, 0x38, 0x14, 0x21,
+	0x72, 0xc4, 0x5d, 0xc4, 0xf8, 0xd1, 0xc1, 0x74, 0x19, 0x7a, 0x0b, 0x6f, 0x4e, 0xfd, 0x20, 0x31,
+	0x1c, 0x75, 0x45, 0x4c, 0x03, 0x4e, 0x3d, 0xe1, 0x87, 0x7a, 0xcb, 0x1d, 0x42, 0xf3, 0x32, 0x62,
+	0x31, 0x95, 0x5b, 0xc8, 0x05, 0xe7, 0x96, 0x2e, 0xd7, 0x0c, 0x5b, 0x3d, 0xab, 0xdf, 0x3a, 0xdd,
+	0x1f, 0xa8, 0x40, 0x83, 0x33, 0x19, 0x87, 0x24, 0x26, 0xf7, 0x2d, 0xc0, 0xf9, 0x9c, 0x79, 0x8b,
+	0x28, 0xf4, 0x03, 0x81, 0x8e, 0x60, 0x8f, 0xb3, 0xaf, 0x6b, 0x16, 0x78, 0x09, 0xa8, 0x4d, 0xb2,
+	0x35, 0x7a, 0x02, 0xf5, 0x6b, 0x7f, 0xc6, 0xb8, 0xc0, 0xd5, 0x9e, 0xd5, 0xdf, 0x27, 0x7a, 0xe5,
+	0x5e, 0x82, 0xf3, 0x3e, 0x10, 0xf1, 0xdd, 0x2e, 0x60, 0x84, 0xa0, 0x76, 0xeb, 0xb3, 0x0d, 0xb6,
+	0x95, 0xbf, 0xfa, 0x76, 0x2f, 0x00, 0x3e, 0xf9, 0x6c, 0x73, 0x3e, 0xa7, 0xc1, 0x8c, 0xa1, 0x1e,
+	0xb4, 0xe4, 0xae, 0xa7, 0x56, 0xb1, 0x0e, 0x5c, 0xdc, 0xda, 0x5a, 0xd8, 0x1b, 0x68, 0x5c, 0xad,
+	0x57, 0x2b, 0xba, 0x5b, 0x69, 0xee, 0x09, 0xd4, 0x09, 0xe3, 0xeb, 0xa5, 0xf8, 0xab, 0x3e, 0x7e,
+	0xaf, 0x41, 0x83, 0xc8, 0x90, 0x5c, 0xa0, 0x01, 0xd4, 0xbd, 0xa5, 0xcf, 0x02, 0xa1, 0x01, 0x87,
+	0x1a, 0xa0, 0xed, 0xe7, 0xca, 0x36, 0xae, 0x10, 0xed, 0x85, 0x5e, 0x03, 0x44, 0x31, 0x93, 0x3f,
+	0x1a, 0x33, 0x55, 0x45, 0xeb, 0x14, 0x9b, 0x98, 0x49, 0xcc, 0x26, 0x89, 0x7d, 0x5c, 0x21, 0x05,
+	0x6f, 0xf4, 0x0a, 0x1a, 0x29, 0xd0, 0x56, 0xc0, 0xff, 0xef, 0x01, 0x35, 0x2a, 0xf5, 0x53, 0xe5,
+	0x85, 0xab, 0x95, 0x2f, 0x70, 0xad, 0xb4, 0x3c, 0x65, 0x53, 0xe5, 0xa9, 0x2f, 0x59, 0x9e, 0x97,
+	0x51, 0x04, 0x3b, 0x65, 0xe5, 0xe5, 0x14, 0x92, 0xe5, 0xe5, 0xde, 0x12, 0x9b, 0x5f, 0x15, 0xae,
+	0x97, 0x61, 0xf3, 0xbb, 0x96, 0xd8, 0xdc, 0x1b, 0x1d, 0x83, 0x4d, 0xbd, 0x05, 0x6e, 0x28, 0x50,
+	0xd7, 0x04, 0xbd, 0xf3, 0x16, 0xe3, 0x0a, 0x91, 0x76, 0xd9, 0x81, 0x80, 0x6d, 0x14, 0x8b, 0xf6,
+	0xca, 0x3a, 0xf0, 0x91, 0x6d, 0x64, 0x0a, 0xd9, 0x01, 0xed, 0x87, 0x5e, 0x82, 0x73, 0xc3, 0x84,
+	0x37, 0xc7, 0x4d, 0x05, 0xf8, 0xcf, 0x04, 0x5c, 0x48, 0xd3, 0xb8, 0x42, 0x12, 0x1f, 0xd9, 0x2e,
+	0x35, 0x79, 0x1c, 0x43, 0x59, 0xbb, 0x14, 0x0b, 0xb8, 0x6c, 0x57, 0xe2, 0x75, 0xd6, 0xd0, 0x6c,
+	0x71, 0x67, 0xd0, 0x36, 0x6e, 0x1c, 0xf5, 0xa0, 0x1a, 0x46, 0x9a, 0x13, 0x07, 0x3a, 0x4a, 0x36,
+	0xad, 0xa4, 0x1a, 0x46, 0xe8, 0x29, 0x34, 0x85, 0xbf, 0x62, 0x5c, 0xd0, 0x55, 0xa4, 0x88, 0xd0,
+	0x24, 0xf9, 0x86, 0x64, 0xaa, 0xe6, 0x95, 0xad, 0x4c, 0x7a, 0xe5, 0xae, 0xa1, 0x7b, 0x8f, 0x26,
+	0xd9, 0x64, 0x59, 0xf9, 0x64, 0x19, 0x63, 0x50, 0xdd, 0x3a, 0x06, 0xb6, 0x31, 0xa1, 0x18, 0x1a,
+	0x31, 0x8b, 0x96, 0xbe, 0x47, 0x15, 0x5d, 0xda, 0x24, 0x5d, 0xba, 0x31, 0x74, 0x4c, 0x92, 0xfd,
+	0x83, 0x9c, 0x9f, 0xf3, 0x9e, 0x26, 0xe4, 0x7c, 0x6c, 0xca, 0x42, 0x68, 0xdb, 0x0c, 0x4d, 0xa1,
+	0x7b, 0x8f, 0xcd, 0x3b, 0x69, 0xda, 0xf6, 0x14, 0xbf, 0xac, 0x2c, 0x47, 0x41, 0xe1, 0x1e, 0x7b,
+	0x84, 0x11, 0xb4, 0xf2, 0x09, 0xe3, 0xd8, 0xee, 0xd9, 0x85, 0xf9, 0xc8, 0x6b, 0x27, 0x45, 0x2f,
+	0xa9, 0x61, 0x72, 0xfe, 0x39, 0xae, 0xf5, 0xec, 0x82, 0x86, 0x29, 0xe5, 0x26, 0x89, 0x09, 0xf5,
+	0x61, 0x4f, 0x2b, 0x0b, 0xc7, 0x4e, 0x89, 0x5b, 0x66, 0x2d, 0x1e, 0xb1, 0x6e, 0x1e, 0x51, 0x00,
+	0xe4, 0x23, 0x5a, 0x7a, 0xb4, 0x02, 0xb6, 0x6a, 0x60, 0xff, 0x94, 0x7a, 0xfb, 0x21, 0xa9, 0xaf,
+	0x19, 0x5a, 0xfd, 0xd3, 0x82, 0x8e, 0x39, 0xee, 0xa5, 0xa9, 0x47, 0xc5, 0x04, 0x1c, 0x57, 0x8d,
+	0xce, 0xe5, 0x37, 0x52, 0xcc, 0xc9, 0xd1, 0x09, 0x34, 0xb9, 0x7a, 0x46, 0x7c, 0x96, 0x76, 0xaf,
+	0xa3, 0x21, 0xfa, 0x79, 0x21, 0xb9, 0x43, 0xf1, 0x74, 0x8e, 0xd9, 0x99, 0x09, 0xec, 0x17, 0x05,
+	0x06, 0x1d, 0x82, 0xc3, 0x05, 0x8d, 0x85, 0xae, 0x30, 0x59, 0xa0, 0x03, 0xb0, 0x59, 0x70, 0xad,
+	0x3b, 0x23, 0x3f, 0x1f, 0xa0, 0xd3, 0x25, 0xb4, 0x0d, 0x11, 0x42, 0x2f, 0x32, 0xa9, 0xb2, 0x8c,
+	0xeb, 0x53, 0xe6, 0x54, 0xa0, 0xb6, 0x5f, 0x80, 0xfb, 0xc3, 0x82, 0x56, 0xa2, 0x55, 0x84, 0x45,
+	0xcb, 0xbb, 0xd2, 0x1e, 0xee, 0x24, 0x51, 0xdb, 0x27, 0x1a, 0x1d, 0x43, 0x3d, 0x56, 0xcf, 0xac,
+	0x7e, 0x59, 0xda, 0x99, 0xbc, 0xca, 0x4d, 0xa2, 0x8d, 0xee, 0x37, 0x0b, 0x5a, 0x93, 0xe9, 0x8d,
+	0xf8, 0xc0, 0x38, 0xa7, 0x33, 0x63, 0x8e, 0x2d, 0x33, 0x60, 0x5f, 0x5a, 0x54, 0x57, 0xf4, 0x53,
+	0xda, 0x31, 0x05, 0x9b, 0xa4, 0x66, 0x34, 0x80, 0x26, 0xf7, 0x67, 0x01, 0x15, 0xeb, 0xec, 0xf5,
+	0x4c, 0x65, 0xf9, 0x2a, 0xdd, 0x27, 0xb9, 0xcb, 0xd9, 0xf3, 0x2f, 0xcf, 0x66, 0xbe, 0x98, 0xaf,
+	0xa7, 0x03, 0x2f, 0x5c, 0x0d, 0x47, 0x23, 0x2f, 0x18, 0xaa, 0xbf, 0x63, 0xa3, 0xd1, 0x50, 0xa1,
+	0xa6, 0x75, 0xf5, 0x27, 0x6c, 0xf4, 0x7b, 0x00, 0xa1, 0x67, 0xc3, 0xc2, 0xbe, 0x09, 0x00, 0x00,
 }
@@ -126,6 +126,40 @@ type Chain33Client interface {
 	// 签名交易
 	SignRawTx(ctx context.Context, in *ReqSignRawTx, opts ...grpc.CallOption) (*ReplySignRawTx, error)
 	CreateNoBalanceTransaction(ctx context.Context, in *NoBalanceTx, opts ...grpc.CallOption) (*ReplySignRawTx, error)
+	// 查询receipt log
+	GetLogs(ctx context.Context, in *ReqGetLogs, opts ...grpc.CallOption) (*ReplyReceiptLogs, error)
+	// 获取状态数据的默克尔证明
+	GetStateProof(ctx context.Context, in *ReqStateProof, opts ...grpc.CallOption) (*StateProof, error)
+	// 获取账户的默克尔证明
+	GetAccountProof(ctx context.Context, in *ReqAccountProof, opts ...grpc.CallOption) (*AccountProof, error)
+	// 获取交易执行结果的默克尔证明
+	GetReceiptProof(ctx context.Context, in *ReqHash, opts ...grpc.CallOption) (*ReceiptProof, error)
+	// 获取消息队列每个 topic 的统计信息
+	GetQueueStats(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*QueueStats, error)
+	// 按照BIP-44路径推导钱包账户
+	DeriveAccount(ctx context.Context, in *ReqDeriveAccount, opts ...grpc.CallOption) (*WalletAccount, error)
+	// 导出账户扩展公钥
+	GetExtendedPubKey(ctx context.Context, in *ReqExtendedPubKey, opts ...grpc.CallOption) (*ReplyString, error)
+	// 扫描链上交易发现已使用的HD账户
+	DiscoverAccounts(ctx context.Context, in *ReqDiscoverAccounts, opts ...grpc.CallOption) (*WalletAccounts, error)
+	// 导入只读账户
+	ImportWatchOnly(ctx context.Context, in *ReqImportWatchOnly, opts ...grpc.CallOption) (*WalletAccount, error)
+	// 为只读账户构造离线签名的交易
+	CreateOfflineTx(ctx context.Context, in *ReqWalletSendToAddress, opts ...grpc.CallOption) (*OfflineTx, error)
+	// 导出加密的钱包备份
+	ExportWallet(ctx context.Context, in *ReqWalletExport, opts ...grpc.CallOption) (*ReplyString, error)
+	// 从钱包备份中恢复seed和账户
+	ImportWallet(ctx context.Context, in *ReqWalletImport, opts ...grpc.CallOption) (*WalletAccounts, error)
+	// 获取交易签名验证的统计信息
+	GetSignVerifyStats(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*SignVerifyStats, error)
+	// 获取节点的信誉分数和封禁状态
+	GetPeerScores(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*PeerScoreList, error)
+	// 封禁节点
+	BanPeer(ctx context.Context, in *ReqBanPeer, opts ...grpc.CallOption) (*Reply, error)
+	// 解除节点的封禁
+	UnbanPeer(ctx context.Context, in *ReqString, opts ...grpc.CallOption) (*Reply, error)
+	// 订阅新区块，交易以及receipt log
+	Subscribe(ctx context.Context, in *ReqSubscribe, opts ...grpc.CallOption) (Chain33_SubscribeClient, error)
 }
 
 type chain33Client struct {
@@ -577,6 +611,182 @@ func (c *chain33Client) CreateNoBalanceTransaction(ctx context.Context, in *NoBa
 	return out, nil
 }
 
+func (c *chain33Client) GetLogs(ctx context.Context, in *ReqGetLogs, opts ...grpc.CallOption) (*ReplyReceiptLogs, error) {
+	out := new(ReplyReceiptLogs)
+	err := grpc.Invoke(ctx, "/types.chain33/GetLogs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetStateProof(ctx context.Context, in *ReqStateProof, opts ...grpc.CallOption) (*StateProof, error) {
+	out := new(StateProof)
+	err := grpc.Invoke(ctx, "/types.chain33/GetStateProof", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetAccountProof(ctx context.Context, in *ReqAccountProof, opts ...grpc.CallOption) (*AccountProof, error) {
+	out := new(AccountProof)
+	err := grpc.Invoke(ctx, "/types.chain33/GetAccountProof", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetReceiptProof(ctx context.Context, in *ReqHash, opts ...grpc.CallOption) (*ReceiptProof, error) {
+	out := new(ReceiptProof)
+	err := grpc.Invoke(ctx, "/types.chain33/GetReceiptProof", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetQueueStats(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*QueueStats, error) {
+	out := new(QueueStats)
+	err := grpc.Invoke(ctx, "/types.chain33/GetQueueStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) DeriveAccount(ctx context.Context, in *ReqDeriveAccount, opts ...grpc.CallOption) (*WalletAccount, error) {
+	out := new(WalletAccount)
+	err := grpc.Invoke(ctx, "/types.chain33/DeriveAccount", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetExtendedPubKey(ctx context.Context, in *ReqExtendedPubKey, opts ...grpc.CallOption) (*ReplyString, error) {
+	out := new(ReplyString)
+	err := grpc.Invoke(ctx, "/types.chain33/GetExtendedPubKey", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) DiscoverAccounts(ctx context.Context, in *ReqDiscoverAccounts, opts ...grpc.CallOption) (*WalletAccounts, error) {
+	out := new(WalletAccounts)
+	err := grpc.Invoke(ctx, "/types.chain33/DiscoverAccounts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) ImportWatchOnly(ctx context.Context, in *ReqImportWatchOnly, opts ...grpc.CallOption) (*WalletAccount, error) {
+	out := new(WalletAccount)
+	err := grpc.Invoke(ctx, "/types.chain33/ImportWatchOnly", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) CreateOfflineTx(ctx context.Context, in *ReqWalletSendToAddress, opts ...grpc.CallOption) (*OfflineTx, error) {
+	out := new(OfflineTx)
+	err := grpc.Invoke(ctx, "/types.chain33/CreateOfflineTx", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) ExportWallet(ctx context.Context, in *ReqWalletExport, opts ...grpc.CallOption) (*ReplyString, error) {
+	out := new(ReplyString)
+	err := grpc.Invoke(ctx, "/types.chain33/ExportWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) ImportWallet(ctx context.Context, in *ReqWalletImport, opts ...grpc.CallOption) (*WalletAccounts, error) {
+	out := new(WalletAccounts)
+	err := grpc.Invoke(ctx, "/types.chain33/ImportWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetSignVerifyStats(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*SignVerifyStats, error) {
+	out := new(SignVerifyStats)
+	err := grpc.Invoke(ctx, "/types.chain33/GetSignVerifyStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetPeerScores(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*PeerScoreList, error) {
+	out := new(PeerScoreList)
+	err := grpc.Invoke(ctx, "/types.chain33/GetPeerScores", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) BanPeer(ctx context.Context, in *ReqBanPeer, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := grpc.Invoke(ctx, "/types.chain33/BanPeer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) UnbanPeer(ctx context.Context, in *ReqString, opts ...grpc.CallOption) (*Reply, error) {
+	out := new(Reply)
+	err := grpc.Invoke(ctx, "/types.chain33/UnbanPeer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) Subscribe(ctx context.Context, in *ReqSubscribe, opts ...grpc.CallOption) (Chain33_SubscribeClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Chain33_serviceDesc.Streams[0], c.cc, "/types.chain33/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chain33SubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Chain33_SubscribeClient interface {
+	Recv() (*PushEvent, error)
+	grpc.ClientStream
+}
+
+type chain33SubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *chain33SubscribeClient) Recv() (*PushEvent, error) {
+	m := new(PushEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for Chain33 service
 
 type Chain33Server interface {
@@ -674,6 +884,40 @@ type Chain33Server interface {
 	// 签名交易
 	SignRawTx(context.Context, *ReqSignRawTx) (*ReplySignRawTx, error)
 	CreateNoBalanceTransaction(context.Context, *NoBalanceTx) (*ReplySignRawTx, error)
+	// 查询receipt log
+	GetLogs(context.Context, *ReqGetLogs) (*ReplyReceiptLogs, error)
+	// 获取状态数据的默克尔证明
+	GetStateProof(context.Context, *ReqStateProof) (*StateProof, error)
+	// 获取账户的默克尔证明
+	GetAccountProof(context.Context, *ReqAccountProof) (*AccountProof, error)
+	// 获取交易执行结果的默克尔证明
+	GetReceiptProof(context.Context, *ReqHash) (*ReceiptProof, error)
+	// 获取消息队列每个 topic 的统计信息
+	GetQueueStats(context.Context, *ReqNil) (*QueueStats, error)
+	// 按照BIP-44路径推导钱包账户
+	DeriveAccount(context.Context, *ReqDeriveAccount) (*WalletAccount, error)
+	// 导出账户扩展公钥
+	GetExtendedPubKey(context.Context, *ReqExtendedPubKey) (*ReplyString, error)
+	// 扫描链上交易发现已使用的HD账户
+	DiscoverAccounts(context.Context, *ReqDiscoverAccounts) (*WalletAccounts, error)
+	// 导入只读账户
+	ImportWatchOnly(context.Context, *ReqImportWatchOnly) (*WalletAccount, error)
+	// 为只读账户构造离线签名的交易
+	CreateOfflineTx(context.Context, *ReqWalletSendToAddress) (*OfflineTx, error)
+	// 导出加密的钱包备份
+	ExportWallet(context.Context, *ReqWalletExport) (*ReplyString, error)
+	// 从钱包备份中恢复seed和账户
+	ImportWallet(context.Context, *ReqWalletImport) (*WalletAccounts, error)
+	// 获取交易签名验证的统计信息
+	GetSignVerifyStats(context.Context, *ReqNil) (*SignVerifyStats, error)
+	// 获取节点的信誉分数和封禁状态
+	GetPeerScores(context.Context, *ReqNil) (*PeerScoreList, error)
+	// 封禁节点
+	BanPeer(context.Context, *ReqBanPeer) (*Reply, error)
+	// 解除节点的封禁
+	UnbanPeer(context.Context, *ReqString) (*Reply, error)
+	// 订阅新区块，交易以及receipt log
+	Subscribe(*ReqSubscribe, Chain33_SubscribeServer) error
 }
 
 func RegisterChain33Server(s *grpc.Server, srv Chain33Server) {
@@ -1562,6 +1806,315 @@ func _Chain33_CreateNoBalanceTransaction_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chain33_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqGetLogs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetLogs(ctx, req.(*ReqGetLogs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetStateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqStateProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetStateProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetStateProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetStateProof(ctx, req.(*ReqStateProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetAccountProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqAccountProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetAccountProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetAccountProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetAccountProof(ctx, req.(*ReqAccountProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetReceiptProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqHash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetReceiptProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetReceiptProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetReceiptProof(ctx, req.(*ReqHash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetQueueStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqNil)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetQueueStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetQueueStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetQueueStats(ctx, req.(*ReqNil))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_DeriveAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqDeriveAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).DeriveAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/DeriveAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).DeriveAccount(ctx, req.(*ReqDeriveAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetExtendedPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqExtendedPubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetExtendedPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetExtendedPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetExtendedPubKey(ctx, req.(*ReqExtendedPubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_DiscoverAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqDiscoverAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).DiscoverAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/DiscoverAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).DiscoverAccounts(ctx, req.(*ReqDiscoverAccounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_ImportWatchOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqImportWatchOnly)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).ImportWatchOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/ImportWatchOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).ImportWatchOnly(ctx, req.(*ReqImportWatchOnly))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_CreateOfflineTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqWalletSendToAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).CreateOfflineTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/CreateOfflineTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).CreateOfflineTx(ctx, req.(*ReqWalletSendToAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_ExportWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqWalletExport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).ExportWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/ExportWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).ExportWallet(ctx, req.(*ReqWalletExport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_ImportWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqWalletImport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).ImportWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/ImportWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).ImportWallet(ctx, req.(*ReqWalletImport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetSignVerifyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqNil)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetSignVerifyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetSignVerifyStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetSignVerifyStats(ctx, req.(*ReqNil))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetPeerScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqNil)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetPeerScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetPeerScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetPeerScores(ctx, req.(*ReqNil))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_BanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqBanPeer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).BanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/BanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).BanPeer(ctx, req.(*ReqBanPeer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_UnbanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqString)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).UnbanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/UnbanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).UnbanPeer(ctx, req.(*ReqString))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReqSubscribe)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Chain33Server).Subscribe(m, &chain33SubscribeServer{stream})
+}
+
+type Chain33_SubscribeServer interface {
+	Send(*PushEvent) error
+	grpc.ServerStream
+}
+
+type chain33SubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *chain33SubscribeServer) Send(m *PushEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Chain33_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.chain33",
 	HandlerType: (*Chain33Server)(nil),
@@ -1762,76 +2315,164 @@ var _Chain33_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateNoBalanceTransaction",
 			Handler:    _Chain33_CreateNoBalanceTransaction_Handler,
 		},
+		{
+			MethodName: "GetLogs",
+			Handler:    _Chain33_GetLogs_Handler,
+		},
+		{
+			MethodName: "GetStateProof",
+			Handler:    _Chain33_GetStateProof_Handler,
+		},
+		{
+			MethodName: "GetAccountProof",
+			Handler:    _Chain33_GetAccountProof_Handler,
+		},
+		{
+			MethodName: "GetReceiptProof",
+			Handler:    _Chain33_GetReceiptProof_Handler,
+		},
+		{
+			MethodName: "GetQueueStats",
+			Handler:    _Chain33_GetQueueStats_Handler,
+		},
+		{
+			MethodName: "DeriveAccount",
+			Handler:    _Chain33_DeriveAccount_Handler,
+		},
+		{
+			MethodName: "GetExtendedPubKey",
+			Handler:    _Chain33_GetExtendedPubKey_Handler,
+		},
+		{
+			MethodName: "DiscoverAccounts",
+			Handler:    _Chain33_DiscoverAccounts_Handler,
+		},
+		{
+			MethodName: "ImportWatchOnly",
+			Handler:    _Chain33_ImportWatchOnly_Handler,
+		},
+		{
+			MethodName: "CreateOfflineTx",
+			Handler:    _Chain33_CreateOfflineTx_Handler,
+		},
+		{
+			MethodName: "ExportWallet",
+			Handler:    _Chain33_ExportWallet_Handler,
+		},
+		{
+			MethodName: "ImportWallet",
+			Handler:    _Chain33_ImportWallet_Handler,
+		},
+		{
+			MethodName: "GetSignVerifyStats",
+			Handler:    _Chain33_GetSignVerifyStats_Handler,
+		},
+		{
+			MethodName: "GetPeerScores",
+			Handler:    _Chain33_GetPeerScores_Handler,
+		},
+		{
+			MethodName: "BanPeer",
+			Handler:    _Chain33_BanPeer_Handler,
+		},
+		{
+			MethodName: "UnbanPeer",
+			Handler:    _Chain33_UnbanPeer_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Chain33_Subscribe_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc.proto",
 }
 
-func init() { proto.RegisterFile("rpc.proto", fileDescriptor7) }
-
-var fileDescriptor7 = []byte{
-	// 994 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x6d, 0x6f, 0xe2, 0x46,
-	0x10, 0xf6, 0x87, 0xf6, 0x38, 0xf6, 0x80, 0x90, 0x0d, 0x89, 0xee, 0xac, 0x56, 0x95, 0xac, 0x56,
-	0xad, 0x54, 0x1d, 0xe9, 0x41, 0x9b, 0xbe, 0x57, 0x0a, 0x49, 0x21, 0x48, 0x1c, 0xe5, 0x62, 0xae,
-	0x95, 0xfa, 0x6d, 0x31, 0x53, 0xce, 0x8a, 0x59, 0xfb, 0xbc, 0xeb, 0x60, 0xfe, 0x64, 0x7f, 0x53,
-	0xb5, 0x6b, 0xaf, 0xbd, 0x7e, 0xe1, 0x92, 0x7e, 0x82, 0x9d, 0x99, 0x67, 0x5e, 0xbc, 0xcf, 0xcc,
-	0x2c, 0x6a, 0x86, 0x81, 0xd3, 0x0f, 0x42, 0x9f, 0xfb, 0xf8, 0x63, 0xbe, 0x0f, 0x80, 0x99, 0x2d,
-	0xc7, 0xdf, 0x6e, 0x7d, 0x9a, 0x08, 0xcd, 0x63, 0x1e, 0x12, 0xca, 0x88, 0xc3, 0xdd, 0x4c, 0xd4,
-	0x5d, 0x79, 0xbe, 0x73, 0xe7, 0xbc, 0x23, 0xae, 0x92, 0xb4, 0x76, 0xc4, 0xf3, 0x80, 0xa7, 0xa7,
-	0x66, 0x30, 0x08, 0xd2, 0xbf, 0x6d, 0xe2, 0x38, 0x7e, 0x44, 0x95, 0xa6, 0x03, 0x31, 0x38, 0x11,
-	0xf7, 0xc3, 0xe4, 0x3c, 0xf8, 0xf7, 0x14, 0x35, 0xa4, 0x9f, 0xe1, 0x10, 0xbf, 0x44, 0xcd, 0x09,
-	0xf0, 0x91, 0x70, 0xcd, 0x70, 0xb7, 0x2f, 0x73, 0xe9, 0xdf, 0xc2, 0xfb, 0x44, 0x62, 0xb6, 0x32,
-	0x49, 0xe0, 0xed, 0x2d, 0x03, 0x9f, 0xa3, 0xf6, 0x04, 0xf8, 0x8c, 0x30, 0x7e, 0x03, 0x64, 0x0d,
-	0x21, 0x6e, 0xe7, 0x90, 0xb9, 0xeb, 0x99, 0xea, 0x98, 0x68, 0x2d, 0x03, 0xff, 0x84, 0x7a, 0x57,
-	0x21, 0x10, 0x0e, 0xb7, 0x64, 0xb7, 0xcc, 0x6b, 0xc2, 0x47, 0xa9, 0x61, 0xa2, 0x5c, 0xc6, 0xa6,
-	0x12, 0xbc, 0xa5, 0xcc, 0xdd, 0xd0, 0x65, 0x6c, 0x19, 0xf8, 0x1a, 0x75, 0x73, 0x6c, 0x3c, 0x09,
-	0xfd, 0x28, 0xc0, 0x9f, 0x16, 0x71, 0xb9, 0x47, 0xa9, 0xae, 0xf3, 0xf2, 0x1d, 0xc2, 0x36, 0xd0,
-	0xf5, 0x81, 0xf8, 0xb6, 0xbb, 0xa1, 0xb0, 0x5e, 0xc6, 0x95, 0x4a, 0x7f, 0x43, 0xdd, 0x37, 0x11,
-	0x84, 0x7b, 0x1d, 0xd4, 0xc9, 0x8b, 0xbd, 0x21, 0xec, 0x9d, 0xf9, 0x3c, 0x3d, 0x6b, 0x36, 0xd7,
-	0xc0, 0x89, 0xeb, 0xc9, 0xb0, 0x47, 0x22, 0xac, 0x0e, 0xc7, 0x55, 0xf3, 0x4a, 0xd8, 0x5f, 0x51,
-	0x6f, 0x02, 0x5c, 0xb3, 0x18, 0xed, 0x2f, 0xd7, 0xeb, 0x50, 0x0f, 0x2d, 0xce, 0xe6, 0x89, 0x8e,
-	0x5b, 0xc6, 0x53, 0xfa, 0x8f, 0xcf, 0x2c, 0x03, 0x4f, 0xd0, 0x59, 0x19, 0x2e, 0x32, 0x85, 0xc2,
-	0xdd, 0x26, 0x12, 0xf3, 0xc5, 0xa1, 0xec, 0x85, 0xa3, 0x57, 0x08, 0x4d, 0x80, 0xbf, 0x86, 0xed,
-	0xc2, 0xf7, 0xbd, 0xf2, 0x2d, 0xe3, 0x62, 0xf0, 0x99, 0xcb, 0xb8, 0xac, 0xf8, 0xd9, 0x04, 0xf8,
-	0x65, 0x42, 0x3d, 0x56, 0xc6, 0x9c, 0xa6, 0xc7, 0xbf, 0x24, 0x67, 0x95, 0x95, 0x64, 0x08, 0x9a,
-	0xc3, 0x2e, 0x15, 0xe0, 0x9e, 0x86, 0xca, 0xa4, 0x66, 0xaf, 0x0e, 0x6c, 0x19, 0xf8, 0x16, 0x9d,
-	0x26, 0x22, 0xad, 0x06, 0x91, 0x0d, 0xfe, 0x2c, 0x77, 0x53, 0x6b, 0x60, 0x9e, 0x15, 0x3c, 0x2e,
-	0xe3, 0xbc, 0xf2, 0x31, 0x6a, 0x4f, 0xb7, 0x81, 0x1f, 0xf2, 0x45, 0xe8, 0xde, 0xdf, 0xc1, 0x3e,
-	0xa3, 0x5c, 0xe6, 0xab, 0xa0, 0x3e, 0x98, 0xdb, 0x08, 0xb5, 0x25, 0x01, 0x7c, 0x71, 0x5f, 0xc0,
-	0x58, 0xd5, 0x4f, 0x41, 0x6d, 0x76, 0xf5, 0x8f, 0x2a, 0xae, 0xc8, 0x32, 0xf0, 0x00, 0x3d, 0xb5,
-	0x45, 0x76, 0x63, 0x00, 0x7c, 0x56, 0x85, 0xf3, 0x31, 0x40, 0x85, 0x41, 0x3f, 0xa3, 0x86, 0x2d,
-	0x5a, 0x74, 0xe5, 0xe1, 0xe7, 0x35, 0x90, 0x19, 0x59, 0x81, 0xf7, 0x81, 0xa4, 0x5b, 0xaf, 0x21,
-	0xdc, 0xc0, 0x88, 0x78, 0x84, 0x3a, 0x80, 0x3f, 0x29, 0x7b, 0xd0, 0xb5, 0x45, 0x1e, 0x24, 0xac,
-	0xb2, 0x0c, 0x7c, 0x81, 0x9a, 0x36, 0xf0, 0x05, 0x61, 0x6c, 0xb7, 0xc6, 0x2f, 0x6a, 0x52, 0x48,
-	0x54, 0x95, 0xc4, 0xbf, 0x40, 0x1f, 0xcd, 0x7c, 0xe7, 0xae, 0x4c, 0x9c, 0xb2, 0xd9, 0x4b, 0xf4,
-	0xe4, 0x2d, 0x95, 0x86, 0x27, 0x85, 0x22, 0x12, 0x61, 0xcd, 0xc4, 0x12, 0xac, 0x5c, 0x00, 0x84,
-	0xa2, 0x47, 0xca, 0xce, 0xd5, 0x18, 0x10, 0xfa, 0x8c, 0xc6, 0x9d, 0x74, 0xc4, 0xfd, 0x2f, 0xf6,
-	0x7f, 0x8f, 0x8e, 0x26, 0xc0, 0xd3, 0x1a, 0x39, 0xe1, 0x51, 0xa5, 0x03, 0x8a, 0xe9, 0x26, 0x36,
-	0x92, 0xff, 0x5d, 0x35, 0x81, 0xff, 0xb8, 0x87, 0xf0, 0xde, 0x85, 0x5d, 0x65, 0xd0, 0xa8, 0xeb,
-	0x2a, 0x58, 0x59, 0x06, 0xfe, 0x41, 0x06, 0x15, 0x0c, 0xaa, 0x83, 0x16, 0x06, 0x85, 0x6e, 0x24,
-	0xfb, 0xbb, 0xa5, 0xa2, 0x8a, 0x08, 0x7a, 0xae, 0x53, 0xca, 0x6b, 0xc9, 0xf8, 0x0a, 0x35, 0x26,
-	0x40, 0x6d, 0x80, 0x75, 0x36, 0xc9, 0xd2, 0xf3, 0x8c, 0xd0, 0x4d, 0x11, 0x22, 0xa4, 0x0a, 0xc2,
-	0x4b, 0x10, 0x79, 0x1e, 0xed, 0x17, 0xbb, 0x5a, 0xc8, 0x39, 0x7a, 0x6a, 0x93, 0x7b, 0x90, 0x18,
-	0x95, 0xbb, 0x12, 0x48, 0x50, 0xf9, 0x82, 0x07, 0x72, 0x52, 0x29, 0xc2, 0x1e, 0x6b, 0x2b, 0x2c,
-	0x65, 0xa9, 0xba, 0x63, 0x6d, 0xe6, 0x0c, 0x10, 0x92, 0xc3, 0xfd, 0x4a, 0x6c, 0xc1, 0x6c, 0xe6,
-	0xc8, 0xd3, 0xef, 0xe9, 0xae, 0xac, 0x8b, 0x23, 0x74, 0xc9, 0xed, 0x3d, 0x12, 0x73, 0x81, 0x3a,
-	0x49, 0x1c, 0x9f, 0x32, 0xa0, 0x2c, 0x62, 0x8f, 0xc4, 0xfd, 0x88, 0x8e, 0x2b, 0x0b, 0x2e, 0x2b,
-	0x4d, 0xad, 0xcc, 0x29, 0xad, 0x5b, 0x77, 0xdf, 0x48, 0xfa, 0xde, 0x40, 0xbc, 0x8c, 0x93, 0xd9,
-	0x5f, 0x21, 0x53, 0x2b, 0xdb, 0xd1, 0x71, 0xba, 0x20, 0x9f, 0x5d, 0x47, 0xdb, 0x40, 0x8d, 0x3b,
-	0x6d, 0x51, 0xd8, 0x3c, 0x74, 0xe9, 0xa6, 0x48, 0xf8, 0x44, 0x66, 0x19, 0xf8, 0x2b, 0xd4, 0xf8,
-	0x13, 0x42, 0x26, 0x32, 0x7b, 0xa0, 0x63, 0xbf, 0x44, 0x4f, 0xa6, 0xcc, 0xde, 0x53, 0xe7, 0x21,
-	0xc3, 0x73, 0xd4, 0x99, 0xb2, 0x39, 0x0f, 0xae, 0x04, 0x2d, 0x1f, 0x03, 0xe8, 0xa3, 0xc6, 0x1c,
-	0x78, 0x5d, 0x63, 0xab, 0x9c, 0xe7, 0xfe, 0x1a, 0x52, 0x13, 0xf9, 0x71, 0x44, 0xbf, 0x8c, 0x09,
-	0x27, 0xde, 0x98, 0xb8, 0x5e, 0x14, 0xc2, 0xa1, 0x08, 0x53, 0xca, 0x87, 0x03, 0xf9, 0x71, 0x7a,
-	0xe9, 0x34, 0x90, 0xbd, 0x62, 0xc3, 0xfb, 0x08, 0x04, 0xcf, 0x0e, 0xc3, 0x2e, 0xbe, 0x95, 0xaf,
-	0x87, 0x63, 0xd5, 0x5e, 0x0a, 0x52, 0xf7, 0xbc, 0x3a, 0xd5, 0xfb, 0x3a, 0x33, 0x94, 0x43, 0x3c,
-	0x1b, 0x0a, 0x1f, 0xd8, 0xe0, 0x27, 0x3a, 0x3c, 0xdf, 0x60, 0x5f, 0x23, 0x74, 0xe5, 0xf9, 0x0c,
-	0xde, 0x44, 0x10, 0xc1, 0x43, 0x9f, 0xf0, 0x17, 0x99, 0xe9, 0xa5, 0xe7, 0x09, 0x32, 0xaa, 0x2e,
-	0x2a, 0x0f, 0x11, 0x95, 0x67, 0xd1, 0x4c, 0x12, 0xb5, 0x29, 0x5e, 0x50, 0xf2, 0x81, 0x86, 0x4f,
-	0x34, 0xe6, 0x28, 0x61, 0x06, 0x4d, 0xc8, 0xa3, 0xc4, 0x96, 0x81, 0xa7, 0xc8, 0x4c, 0x98, 0x3c,
-	0xf7, 0x53, 0x7f, 0x75, 0x6f, 0xa5, 0x5c, 0x79, 0xd8, 0xd5, 0xe8, 0xf3, 0xbf, 0xad, 0x8d, 0xcb,
-	0x3d, 0xb2, 0xea, 0x0f, 0x87, 0x7d, 0x87, 0x9e, 0xa7, 0x8f, 0xdb, 0xec, 0x57, 0xc2, 0x56, 0x4f,
-	0xe4, 0xeb, 0x77, 0xf8, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x34, 0x3b, 0xdc, 0x9f, 0x7c, 0x0b,
-	0x00, 0x00,
+func init() { proto.RegisterFile("rpc.proto", fileDescriptor8) }
+
+var fileDescriptor8 = []byte{
+	// 1290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x6d, 0x6f, 0xdb, 0xb6,
+	0x13, 0xf7, 0x1f, 0xf8, 0xaf, 0xa9, 0x59, 0x3b, 0x71, 0x98, 0x87, 0xb5, 0xc2, 0x82, 0x02, 0x06,
+	0x86, 0x0d, 0xd8, 0x9a, 0xb4, 0xf1, 0xd6, 0xee, 0xa9, 0xdb, 0xea, 0x38, 0x51, 0x8d, 0xb9, 0xae,
+	0x1b, 0xb9, 0x2d, 0xb0, 0x77, 0xb2, 0x7c, 0x71, 0x84, 0xca, 0xa4, 0x2b, 0x52, 0xb6, 0xfc, 0x59,
+	0xf7, 0x65, 0x06, 0x92, 0xa2, 0x44, 0x3d, 0x38, 0xc9, 0xde, 0x89, 0x77, 0xf7, 0xbb, 0x3b, 0x92,
+	0xc7, 0xdf, 0x9d, 0x50, 0x3d, 0x5c, 0x78, 0xc7, 0x8b, 0x90, 0x72, 0x8a, 0xbf, 0xe0, 0xeb, 0x05,
+	0x30, 0xab, 0xe1, 0xd1, 0xf9, 0x9c, 0x12, 0x25, 0xb4, 0x76, 0x79, 0xe8, 0x12, 0xe6, 0x7a, 0xdc,
+	0x4f, 0x45, 0xad, 0x49, 0x40, 0xbd, 0x4f, 0xde, 0xb5, 0xeb, 0x6b, 0x49, 0x63, 0xe5, 0x06, 0x01,
+	0xf0, 0x64, 0x55, 0x5f, 0x9c, 0x2e, 0x92, 0xcf, 0xa6, 0xeb, 0x79, 0x34, 0x22, 0x5a, 0xb3, 0x0d,
+	0x31, 0x78, 0x11, 0xa7, 0x61, 0xb2, 0xbe, 0x3f, 0x9d, 0xa8, 0xaf, 0xd3, 0x7f, 0x8e, 0xd0, 0x96,
+	0xf4, 0xd8, 0xe9, 0xe0, 0x27, 0xa8, 0x6e, 0x03, 0xef, 0x8a, 0x20, 0x0c, 0xb7, 0x8e, 0x65, 0x56,
+	0xc7, 0x97, 0xf0, 0x59, 0x49, 0xac, 0x46, 0x2a, 0x59, 0x04, 0xeb, 0x76, 0x0d, 0x9f, 0xa0, 0xa6,
+	0x0d, 0x7c, 0xe0, 0x32, 0xfe, 0x1a, 0xdc, 0x29, 0x84, 0xb8, 0x99, 0x41, 0x86, 0x7e, 0x60, 0xe9,
+	0xa5, 0xd2, 0xb6, 0x6b, 0xf8, 0x17, 0xb4, 0x7f, 0x16, 0x82, 0xcb, 0xe1, 0xd2, 0x5d, 0x8d, 0xb3,
+	0xdd, 0xe1, 0x9d, 0xc4, 0x50, 0x29, 0xc7, 0xb1, 0xa5, 0x05, 0xef, 0x09, 0xf3, 0x67, 0x64, 0x1c,
+	0xb7, 0x6b, 0xb8, 0x87, 0x5a, 0x19, 0x36, 0xb6, 0x43, 0x1a, 0x2d, 0xf0, 0x51, 0x1e, 0x97, 0x79,
+	0x94, 0xea, 0x2a, 0x2f, 0x3f, 0x22, 0xec, 0x00, 0x99, 0x6e, 0x88, 0xef, 0xf8, 0x33, 0x02, 0xd3,
+	0x71, 0x5c, 0xda, 0xe9, 0xef, 0xa8, 0xf5, 0x2e, 0x82, 0x70, 0x6d, 0x82, 0xb6, 0xb3, 0xcd, 0xbe,
+	0x76, 0xd9, 0xb5, 0xf5, 0x30, 0x59, 0x1b, 0x36, 0x3d, 0xe0, 0xae, 0x1f, 0xc8, 0xb0, 0x3b, 0x22,
+	0xac, 0x09, 0xc7, 0x65, 0xf3, 0x52, 0xd8, 0x97, 0x68, 0xdf, 0x06, 0x6e, 0x58, 0x74, 0xd7, 0xaf,
+	0xa6, 0xd3, 0xd0, 0x0c, 0x2d, 0xd6, 0xd6, 0x9e, 0x89, 0x1b, 0xc7, 0x7d, 0x72, 0x45, 0x59, 0xbb,
+	0x86, 0x6d, 0x74, 0x58, 0x84, 0x8b, 0x4c, 0x21, 0x77, 0xb7, 0x4a, 0x62, 0x3d, 0xda, 0x94, 0xbd,
+	0x70, 0xf4, 0x0c, 0x21, 0x1b, 0xf8, 0x1b, 0x98, 0x8f, 0x28, 0x0d, 0x8a, 0xb7, 0x8c, 0xf3, 0xc1,
+	0x07, 0x3e, 0xe3, 0x72, 0xc7, 0x0f, 0x6c, 0xe0, 0xaf, 0x54, 0x11, 0xb2, 0x22, 0xe6, 0x20, 0x59,
+	0x7e, 0x94, 0xd5, 0xab, 0xad, 0x64, 0x85, 0xa0, 0x21, 0xac, 0x12, 0x01, 0xde, 0x37, 0x50, 0xa9,
+	0xd4, 0xda, 0xaf, 0x02, 0xb7, 0x6b, 0xf8, 0x12, 0x1d, 0x28, 0x91, 0xb1, 0x07, 0x91, 0x0d, 0x7e,
+	0x9c, 0xb9, 0xa9, 0x34, 0xb0, 0x0e, 0x73, 0x1e, 0xc7, 0x71, 0xb6, 0xf3, 0x0b, 0xd4, 0xec, 0xcf,
+	0x17, 0x34, 0xe4, 0xa3, 0xd0, 0x5f, 0x7e, 0x82, 0x35, 0x3e, 0x2a, 0xfa, 0xca, 0xa9, 0x37, 0xe6,
+	0xd6, 0x45, 0x4d, 0x59, 0x00, 0x54, 0xdc, 0x17, 0x30, 0x56, 0xf6, 0x93, 0x53, 0x5b, 0x2d, 0xf3,
+	0x50, 0xc5, 0x15, 0xb5, 0x6b, 0xf8, 0x14, 0xdd, 0x77, 0x44, 0x76, 0x17, 0x00, 0xf8, 0xb0, 0x0c,
+	0xe7, 0x17, 0x00, 0xa5, 0x0a, 0xfa, 0x15, 0x6d, 0x39, 0xe2, 0x89, 0x4e, 0x02, 0xfc, 0xb0, 0x02,
+	0x32, 0x70, 0x27, 0x10, 0xdc, 0x90, 0x74, 0xe3, 0x0d, 0x84, 0x33, 0xe8, 0xba, 0x81, 0x4b, 0x3c,
+	0xc0, 0x5f, 0x15, 0x3d, 0x98, 0x5a, 0x0b, 0x17, 0x53, 0x06, 0x71, 0x80, 0xcf, 0x51, 0xdd, 0x01,
+	0x3e, 0x72, 0x19, 0x5b, 0x4d, 0xf1, 0xa3, 0x8a, 0x14, 0x94, 0xaa, 0x94, 0xf8, 0xd7, 0xe8, 0xff,
+	0x03, 0xea, 0x7d, 0x2a, 0x16, 0x4e, 0xd1, 0xec, 0x09, 0xba, 0xf7, 0x9e, 0x48, 0xc3, 0xbd, 0xdc,
+	0x26, 0x94, 0xb0, 0x82, 0xb1, 0x44, 0x55, 0x8e, 0x00, 0x42, 0xf1, 0x46, 0x8a, 0xce, 0x35, 0x0d,
+	0x08, 0x7d, 0x5a, 0xc6, 0xdb, 0x09, 0xc5, 0xfd, 0xa7, 0xea, 0x7f, 0x81, 0x76, 0x6c, 0xe0, 0xc9,
+	0x1e, 0xb9, 0xcb, 0xa3, 0xd2, 0x0b, 0xc8, 0xa7, 0xab, 0x6c, 0x64, 0xfd, 0xb7, 0x34, 0x03, 0xbf,
+	0x5d, 0x42, 0xb8, 0xf4, 0x61, 0x55, 0x22, 0x1a, 0x7d, 0x5d, 0x39, 0xab, 0x76, 0x0d, 0xff, 0x24,
+	0x83, 0x8a, 0x0a, 0xaa, 0x82, 0xe6, 0x88, 0xc2, 0x34, 0x92, 0xef, 0xbb, 0xa1, 0xa3, 0x8a, 0x08,
+	0x66, 0xae, 0x7d, 0xc2, 0x2b, 0x8b, 0xf1, 0x19, 0xda, 0xb2, 0x81, 0x38, 0x00, 0xd3, 0x94, 0xc9,
+	0x92, 0xf5, 0xc0, 0x25, 0xb3, 0x3c, 0x44, 0x48, 0x35, 0x84, 0x17, 0x20, 0x72, 0xdd, 0x5d, 0x8f,
+	0x56, 0x95, 0x90, 0x13, 0x74, 0xdf, 0x71, 0x97, 0x20, 0x31, 0x3a, 0x77, 0x2d, 0x90, 0xa0, 0xe2,
+	0x05, 0x9f, 0x4a, 0xa6, 0xd2, 0x05, 0xbb, 0x6b, 0xb4, 0xb0, 0xa4, 0x4a, 0xf5, 0x1d, 0x1b, 0x9c,
+	0x73, 0x8a, 0x90, 0x24, 0xf7, 0x33, 0xd1, 0x05, 0x53, 0xce, 0x91, 0xab, 0xf3, 0xa4, 0x6b, 0x56,
+	0xc5, 0x11, 0x3a, 0x75, 0x7b, 0x77, 0xc4, 0x3c, 0x47, 0xdb, 0x2a, 0x0e, 0x25, 0x0c, 0x08, 0x8b,
+	0xd8, 0x1d, 0x71, 0x3f, 0xa3, 0xdd, 0x52, 0x83, 0x4b, 0xb7, 0xa6, 0x5b, 0x66, 0x9f, 0x54, 0xb5,
+	0xbb, 0xa7, 0xb2, 0x7c, 0x5f, 0x43, 0x3c, 0x8e, 0x15, 0xf7, 0x97, 0x8a, 0xa9, 0x91, 0xf6, 0xe8,
+	0x38, 0x69, 0x90, 0x0f, 0x7a, 0xd1, 0x7c, 0xa1, 0xe9, 0xce, 0x68, 0x14, 0x0e, 0x0f, 0x7d, 0x32,
+	0xcb, 0x17, 0xbc, 0x92, 0xb5, 0x6b, 0xf8, 0x5b, 0xb4, 0xf5, 0x01, 0x42, 0x26, 0x32, 0xbb, 0xe5,
+	0xc5, 0x7e, 0x83, 0xee, 0xf5, 0x99, 0xb3, 0x26, 0xde, 0x6d, 0x86, 0x27, 0x68, 0xbb, 0xcf, 0x86,
+	0x7c, 0x71, 0x26, 0xca, 0xf2, 0x2e, 0x80, 0x63, 0xb4, 0x35, 0x04, 0x5e, 0xf5, 0xb0, 0x75, 0xce,
+	0x43, 0x3a, 0x85, 0xc4, 0x44, 0x1e, 0x8e, 0x78, 0x2f, 0x17, 0x2e, 0x77, 0x83, 0x0b, 0xd7, 0x0f,
+	0xa2, 0x10, 0x36, 0x45, 0xe8, 0x13, 0xde, 0x39, 0x95, 0x87, 0xb3, 0x9f, 0xb0, 0x81, 0x7c, 0x2b,
+	0x0e, 0x7c, 0x8e, 0x80, 0x78, 0x37, 0xc1, 0x9e, 0xff, 0x20, 0xa7, 0x87, 0x5d, 0x1b, 0xf2, 0x90,
+	0xaa, 0xf1, 0xea, 0xc0, 0x7c, 0xd7, 0xa9, 0xa1, 0x24, 0xf1, 0x94, 0x14, 0x6e, 0xe8, 0xe0, 0x7b,
+	0x26, 0x3c, 0xeb, 0x60, 0xdf, 0x21, 0x74, 0x16, 0x50, 0x06, 0xef, 0x22, 0x88, 0xe0, 0xb6, 0x23,
+	0xfc, 0x4d, 0x66, 0xfa, 0x2a, 0x08, 0x44, 0x31, 0xea, 0x57, 0x54, 0x24, 0x11, 0x9d, 0x67, 0xde,
+	0x4c, 0x16, 0x6a, 0x5d, 0x4c, 0x50, 0x72, 0x40, 0xc3, 0x7b, 0x46, 0xe5, 0x68, 0xa1, 0x75, 0x60,
+	0xc6, 0x4b, 0xc5, 0xed, 0x1a, 0xee, 0x23, 0x4b, 0x55, 0xf2, 0x90, 0x26, 0xfe, 0xaa, 0x66, 0xa5,
+	0x4c, 0x79, 0x83, 0xab, 0x17, 0x92, 0x66, 0x06, 0x74, 0xc6, 0xcc, 0xf7, 0x9f, 0x88, 0xac, 0x2f,
+	0x4d, 0xd8, 0x25, 0x78, 0xe0, 0x2f, 0xa4, 0x42, 0x72, 0x6f, 0xd3, 0x56, 0x54, 0x0c, 0xa3, 0x90,
+	0xd2, 0x2b, 0x73, 0xfc, 0xc8, 0xa4, 0x96, 0x76, 0x9a, 0x89, 0xda, 0x35, 0xfc, 0xa7, 0xe2, 0x5e,
+	0x45, 0x2a, 0x0a, 0x6d, 0xb4, 0x68, 0x53, 0x9e, 0x71, 0xb0, 0x21, 0x4c, 0xd9, 0x3b, 0xc9, 0x48,
+	0x79, 0x28, 0xbe, 0xd5, 0xec, 0x48, 0x33, 0xa3, 0x76, 0x0d, 0x77, 0x64, 0xde, 0xf2, 0x7e, 0x45,
+	0x4e, 0xa5, 0x56, 0xa3, 0x13, 0xce, 0x2c, 0x64, 0xc2, 0xcd, 0x1e, 0x84, 0xfe, 0x12, 0xf4, 0xac,
+	0x95, 0x1d, 0xcc, 0xe7, 0x9c, 0x62, 0xe3, 0x74, 0x70, 0x26, 0x6b, 0xe5, 0x3c, 0xe6, 0x40, 0xa6,
+	0x30, 0x1d, 0x45, 0x93, 0xbf, 0x60, 0x6d, 0x0e, 0x19, 0x79, 0xcd, 0x06, 0xde, 0xb0, 0x51, 0xab,
+	0xe7, 0x33, 0x8f, 0x2e, 0x21, 0x4c, 0x67, 0x45, 0xcb, 0xc8, 0xa4, 0xa0, 0xdb, 0x3c, 0x38, 0xf6,
+	0xd0, 0x8e, 0x9a, 0xc4, 0x3e, 0xba, 0xdc, 0xbb, 0x7e, 0x4b, 0x82, 0xb5, 0x39, 0x6d, 0x14, 0x54,
+	0x1b, 0xf7, 0xd4, 0x43, 0x3b, 0xaa, 0x0c, 0xdf, 0x5e, 0x5d, 0x05, 0x3e, 0x81, 0x71, 0x7c, 0xd7,
+	0x41, 0x2d, 0x05, 0xc8, 0x57, 0xd4, 0x38, 0x8f, 0x55, 0x40, 0x01, 0x28, 0x0f, 0x6b, 0x4a, 0xbb,
+	0xe1, 0x48, 0xfe, 0x40, 0x0d, 0x9d, 0x6e, 0x35, 0x5a, 0x69, 0x37, 0x1f, 0xc5, 0x4b, 0x84, 0x45,
+	0x1d, 0xfb, 0x33, 0xf2, 0x01, 0x42, 0xff, 0x6a, 0x5d, 0x59, 0x14, 0x87, 0xc6, 0x2f, 0x8f, 0x61,
+	0x26, 0xdb, 0x54, 0x33, 0x99, 0x91, 0x1c, 0x8f, 0x86, 0x50, 0x42, 0xee, 0x1b, 0x53, 0x92, 0xb4,
+	0x48, 0x66, 0x9e, 0xef, 0xd1, 0x56, 0xd7, 0x25, 0x42, 0x9a, 0xef, 0xbb, 0x52, 0x54, 0x31, 0xb8,
+	0xd5, 0xdf, 0x93, 0x49, 0x62, 0x5f, 0xee, 0x32, 0xe5, 0xde, 0x59, 0x77, 0xa2, 0x09, 0xf3, 0x42,
+	0x7f, 0x02, 0x39, 0x6a, 0xd1, 0xc2, 0xf4, 0x22, 0x46, 0x11, 0xbb, 0x3e, 0x5f, 0x82, 0xb8, 0xce,
+	0xa7, 0xff, 0xeb, 0x3e, 0xfe, 0xfb, 0x68, 0xe6, 0xf3, 0xeb, 0x68, 0x72, 0xec, 0xd1, 0xf9, 0x49,
+	0xa7, 0xe3, 0x91, 0x93, 0xe4, 0x67, 0xf7, 0x44, 0x9a, 0x4f, 0xee, 0xc9, 0xbf, 0xe0, 0xce, 0xbf,
+	0x03, 0x00, 0x3d, 0xf3, 0x88, 0xb7, 0x8e, 0x0f, 0x00, 0x00,
 }
@@ -0,0 +1,217 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bytes"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+//签名验证结果缓存的交易数量
+const signCacheSize = 102400
+
+var signVerifier = NewSignVerifier(signCacheSize, runtime.NumCPU())
+
+//GetSignVerifier 全局的签名验证服务, mempool和区块执行共享验证结果
+func GetSignVerifier() *SignVerifier {
+	return signVerifier
+}
+
+//SignVerifier 交易签名验证服务
+//1. 交易列表按照cpu数量并行验证, 签名算法实现了crypto.BatchVerifier的交易批量验证
+//2. 验证通过的交易按照交易hash缓存, mempool中验证过的交易在区块执行的时候不需要重复验证
+//3. 统计验证的数量和耗时, 通过Stats查询
+//ed25519的单个验证不考虑cofactor, 批量验证会接受一些单个验证不通过的签名(带小阶点分量),
+//为了和单个验证的结果保持一致, ed25519没有实现批量验证, 只做并行验证
+type SignVerifier struct {
+	cache   *lru.Cache
+	workers int
+
+	verifyCount int64
+	batchCount  int64
+	cacheHits   int64
+	failCount   int64
+	totalTime   int64
+}
+
+//NewSignVerifier cacheSize: 缓存的交易数量, workers: 并行验证的goroutine数量
+func NewSignVerifier(cacheSize int, workers int) *SignVerifier {
+	cache, err := lru.New(cacheSize)
+	if err != nil {
+		panic(err)
+	}
+	if workers <= 0 {
+		workers = 1
+	}
+	return &SignVerifier{cache: cache, workers: workers}
+}
+
+//交易hash不包含签名, 缓存中同时记录签名的摘要, 签名不同的相同交易需要重新验证
+func signDigest(sign *Signature) []byte {
+	return common.Sha256(Encode(sign))
+}
+
+func (v *SignVerifier) isCached(hash []byte, sign *Signature) bool {
+	if sign == nil {
+		return false
+	}
+	digest, ok := v.cache.Get(string(hash))
+	if ok && bytes.Equal(digest.([]byte), signDigest(sign)) {
+		atomic.AddInt64(&v.cacheHits, 1)
+		return true
+	}
+	return false
+}
+
+func (v *SignVerifier) record(count int, batched int, ok bool, start time.Time) {
+	atomic.AddInt64(&v.verifyCount, int64(count))
+	atomic.AddInt64(&v.batchCount, int64(batched))
+	atomic.AddInt64(&v.totalTime, int64(time.Since(start)))
+	if !ok {
+		atomic.AddInt64(&v.failCount, 1)
+	}
+}
+
+//VerifyTx 验证一个交易的签名, 交易组需要用VerifyTxs验证组中的每个交易
+func (v *SignVerifier) VerifyTx(tx *Transaction) bool {
+	hash := tx.Hash()
+	if v.isCached(hash, tx.GetSignature()) {
+		return true
+	}
+	start := time.Now()
+	ok := tx.checkSign()
+	v.record(1, 0, ok, start)
+	if ok {
+		v.cache.Add(string(hash), signDigest(tx.GetSignature()))
+	}
+	return ok
+}
+
+//VerifyTxs 并行验证交易列表中所有交易的签名, batch为true时使用批量验证
+func (v *SignVerifier) VerifyTxs(txs []*Transaction, batch bool) bool {
+	var todo []*Transaction
+	var hashes [][]byte
+	for _, tx := range txs {
+		hash := tx.Hash()
+		if v.isCached(hash, tx.GetSignature()) {
+			continue
+		}
+		todo = append(todo, tx)
+		hashes = append(hashes, hash)
+	}
+	if len(todo) == 0 {
+		return true
+	}
+	start := time.Now()
+	var ok bool
+	var batched int
+	if batch {
+		ok, batched = v.checkBatch(todo)
+	} else {
+		ok = checkAll(todo, v.workers)
+	}
+	v.record(len(todo), batched, ok, start)
+	if ok {
+		for i, tx := range todo {
+			v.cache.Add(string(hashes[i]), signDigest(tx.GetSignature()))
+		}
+	}
+	return ok
+}
+
+//支持批量验证的签名类型按类型分组批量验证, 其他的交易逐个验证, 返回批量验证的交易数量
+func (v *SignVerifier) checkBatch(txs []*Transaction) (bool, int) {
+	type batch struct {
+		verifier crypto.BatchVerifier
+		pubs     []crypto.PubKey
+		msgs     [][]byte
+		sigs     []crypto.Signature
+	}
+	batches := make(map[string]*batch)
+	var others []*Transaction
+	batched := 0
+	for _, tx := range txs {
+		sign := tx.GetSignature()
+		if sign == nil {
+			return false, 0
+		}
+		name := GetSignName(string(tx.Execer), int(sign.Ty))
+		c, err := crypto.New(name)
+		if err != nil {
+			return false, 0
+		}
+		verifier, ok := c.(crypto.BatchVerifier)
+		if !ok {
+			others = append(others, tx)
+			continue
+		}
+		pub, err := c.PubKeyFromBytes(sign.Pubkey)
+		if err != nil {
+			return false, 0
+		}
+		sig, err := c.SignatureFromBytes(sign.Signature)
+		if err != nil {
+			return false, 0
+		}
+		b, ok := batches[name]
+		if !ok {
+			b = &batch{verifier: verifier}
+			batches[name] = b
+		}
+		b.pubs = append(b.pubs, pub)
+		b.msgs = append(b.msgs, tx.signData())
+		b.sigs = append(b.sigs, sig)
+		batched++
+	}
+	//每个类型的批量按照workers数量分段并行验证
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	ok := true
+	for _, b := range batches {
+		size := (len(b.pubs) + v.workers - 1) / v.workers
+		for start := 0; start < len(b.pubs); start += size {
+			end := start + size
+			if end > len(b.pubs) {
+				end = len(b.pubs)
+			}
+			wg.Add(1)
+			go func(b *batch, start, end int) {
+				defer wg.Done()
+				if !b.verifier.VerifyBatch(b.pubs[start:end], b.msgs[start:end], b.sigs[start:end]) {
+					mu.Lock()
+					ok = false
+					mu.Unlock()
+				}
+			}(b, start, end)
+		}
+	}
+	othersOk := len(others) == 0 || checkAll(others, v.workers)
+	wg.Wait()
+	return ok && othersOk, batched
+}
+
+//Stats 签名验证的统计信息
+func (v *SignVerifier) Stats() *SignVerifyStats {
+	stats := &SignVerifyStats{
+		VerifyCount: atomic.LoadInt64(&v.verifyCount),
+		BatchCount:  atomic.LoadInt64(&v.batchCount),
+		CacheHits:   atomic.LoadInt64(&v.cacheHits),
+		FailCount:   atomic.LoadInt64(&v.failCount),
+		TotalTime:   atomic.LoadInt64(&v.totalTime) / int64(time.Microsecond),
+		CacheSize:   int32(v.cache.Len()),
+		Workers:     int32(v.workers),
+	}
+	if stats.TotalTime > 0 {
+		stats.Throughput = stats.VerifyCount * int64(time.Second/time.Microsecond) / stats.TotalTime
+	}
+	return stats
+}
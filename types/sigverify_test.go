@@ -0,0 +1,103 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func genSignTxs(t *testing.T, n int) []*Transaction {
+	secp := getprivkey("CC38546E9E659D15E6B4893F0AB32A06D103931A8230B0BDE71459D2B27D6944")
+	cr, err := crypto.New(GetSignName("", SCHNORR))
+	require.NoError(t, err)
+	schnorr, err := cr.GenKey()
+	require.NoError(t, err)
+	cr, err = crypto.New(GetSignName("", ED25519))
+	require.NoError(t, err)
+	ed25519, err := cr.GenKey()
+	require.NoError(t, err)
+
+	var txs []*Transaction
+	for i := 0; i < n; i++ {
+		tx := &Transaction{Execer: []byte("coins"), Payload: []byte("sigverify"), Fee: 1000000, Nonce: int64(i)}
+		switch i % 3 {
+		case 0:
+			tx.Sign(SECP256K1, secp)
+		case 1:
+			tx.Sign(SCHNORR, schnorr)
+		default:
+			tx.Sign(ED25519, ed25519)
+		}
+		txs = append(txs, tx)
+	}
+	return txs
+}
+
+func TestSignVerifierCache(t *testing.T) {
+	v := NewSignVerifier(100, 2)
+	txs := genSignTxs(t, 3)
+	assert.True(t, v.VerifyTx(txs[0]))
+	assert.True(t, v.VerifyTx(txs[0]))
+	stats := v.Stats()
+	assert.Equal(t, int64(1), stats.VerifyCount)
+	assert.Equal(t, int64(1), stats.CacheHits)
+	assert.Equal(t, int32(1), stats.CacheSize)
+
+	//交易hash不变, 签名被修改后需要重新验证
+	sign := *txs[0].Signature
+	sign.Signature = append([]byte{}, sign.Signature...)
+	sign.Signature[len(sign.Signature)-1] ^= 1
+	tampered := *txs[0]
+	tampered.Signature = &sign
+	assert.Equal(t, txs[0].Hash(), tampered.Hash())
+	assert.False(t, v.VerifyTx(&tampered))
+	stats = v.Stats()
+	assert.Equal(t, int64(2), stats.VerifyCount)
+	assert.Equal(t, int64(1), stats.FailCount)
+
+	//验证失败的交易不会被缓存
+	assert.False(t, v.VerifyTx(&tampered))
+	assert.Equal(t, int64(1), v.Stats().CacheHits)
+	assert.False(t, v.VerifyTx(&Transaction{Execer: []byte("coins")}))
+}
+
+func TestSignVerifierVerifyTxs(t *testing.T) {
+	txs := genSignTxs(t, 30)
+	for _, batch := range []bool{false, true} {
+		v := NewSignVerifier(100, 4)
+		assert.True(t, v.VerifyTxs(txs, batch))
+		stats := v.Stats()
+		assert.Equal(t, int64(30), stats.VerifyCount)
+		if batch {
+			assert.Equal(t, int64(10), stats.BatchCount)
+		} else {
+			assert.Equal(t, int64(0), stats.BatchCount)
+		}
+		assert.Equal(t, int32(30), stats.CacheSize)
+		assert.Equal(t, int32(4), stats.Workers)
+
+		//全部命中缓存
+		assert.True(t, v.VerifyTxs(txs, batch))
+		stats = v.Stats()
+		assert.Equal(t, int64(30), stats.VerifyCount)
+		assert.Equal(t, int64(30), stats.CacheHits)
+	}
+
+	//每一种签名被修改都会导致验证失败
+	for i := 0; i < 3; i++ {
+		for _, batch := range []bool{false, true} {
+			v := NewSignVerifier(100, 4)
+			txs[i].Nonce++
+			assert.False(t, v.VerifyTxs(txs, batch))
+			txs[i].Nonce--
+			assert.Equal(t, int64(1), v.Stats().FailCount)
+			assert.Equal(t, int32(0), v.Stats().CacheSize)
+		}
+	}
+}
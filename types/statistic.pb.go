@@ -25,7 +25,7 @@ type TotalFee struct {
 func (m *TotalFee) Reset()                    { *m = TotalFee{} }
 func (m *TotalFee) String() string            { return proto.CompactTextString(m) }
 func (*TotalFee) ProtoMessage()               {}
-func (*TotalFee) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{0} }
+func (*TotalFee) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{0} }
 
 func (m *TotalFee) GetFee() int64 {
 	if m != nil {
@@ -53,7 +53,7 @@ type ReqGetTotalCoins struct {
 func (m *ReqGetTotalCoins) Reset()                    { *m = ReqGetTotalCoins{} }
 func (m *ReqGetTotalCoins) String() string            { return proto.CompactTextString(m) }
 func (*ReqGetTotalCoins) ProtoMessage()               {}
-func (*ReqGetTotalCoins) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{1} }
+func (*ReqGetTotalCoins) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{1} }
 
 func (m *ReqGetTotalCoins) GetSymbol() string {
 	if m != nil {
@@ -101,7 +101,7 @@ type ReplyGetTotalCoins struct {
 func (m *ReplyGetTotalCoins) Reset()                    { *m = ReplyGetTotalCoins{} }
 func (m *ReplyGetTotalCoins) String() string            { return proto.CompactTextString(m) }
 func (*ReplyGetTotalCoins) ProtoMessage()               {}
-func (*ReplyGetTotalCoins) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{2} }
+func (*ReplyGetTotalCoins) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{2} }
 
 func (m *ReplyGetTotalCoins) GetCount() int64 {
 	if m != nil {
@@ -142,7 +142,7 @@ type IterateRangeByStateHash struct {
 func (m *IterateRangeByStateHash) Reset()                    { *m = IterateRangeByStateHash{} }
 func (m *IterateRangeByStateHash) String() string            { return proto.CompactTextString(m) }
 func (*IterateRangeByStateHash) ProtoMessage()               {}
-func (*IterateRangeByStateHash) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{3} }
+func (*IterateRangeByStateHash) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{3} }
 
 func (m *IterateRangeByStateHash) GetStateHash() []byte {
 	if m != nil {
@@ -184,7 +184,7 @@ type TicketStatistic struct {
 func (m *TicketStatistic) Reset()                    { *m = TicketStatistic{} }
 func (m *TicketStatistic) String() string            { return proto.CompactTextString(m) }
 func (*TicketStatistic) ProtoMessage()               {}
-func (*TicketStatistic) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{4} }
+func (*TicketStatistic) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{4} }
 
 func (m *TicketStatistic) GetCurrentOpenCount() int64 {
 	if m != nil {
@@ -228,7 +228,7 @@ type TicketMinerInfo struct {
 func (m *TicketMinerInfo) Reset()                    { *m = TicketMinerInfo{} }
 func (m *TicketMinerInfo) String() string            { return proto.CompactTextString(m) }
 func (*TicketMinerInfo) ProtoMessage()               {}
-func (*TicketMinerInfo) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{5} }
+func (*TicketMinerInfo) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{5} }
 
 func (m *TicketMinerInfo) GetTicketId() string {
 	if m != nil {
@@ -301,7 +301,7 @@ type TotalAmount struct {
 func (m *TotalAmount) Reset()                    { *m = TotalAmount{} }
 func (m *TotalAmount) String() string            { return proto.CompactTextString(m) }
 func (*TotalAmount) ProtoMessage()               {}
-func (*TotalAmount) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{6} }
+func (*TotalAmount) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{6} }
 
 func (m *TotalAmount) GetTotal() int64 {
 	if m != nil {
@@ -320,39 +320,39 @@ func init() {
 	proto.RegisterType((*TotalAmount)(nil), "types.TotalAmount")
 }
 
-func init() { proto.RegisterFile("statistic.proto", fileDescriptor8) }
-
-var fileDescriptor8 = []byte{
-	// 494 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x4f, 0x6f, 0xd3, 0x4e,
-	0x10, 0x95, 0xeb, 0x3a, 0x4d, 0xe6, 0x57, 0x29, 0xd1, 0x2a, 0xfa, 0x61, 0x21, 0x84, 0x90, 0xe1,
-	0x50, 0x71, 0x08, 0x87, 0x48, 0xdc, 0xdb, 0x48, 0x94, 0x08, 0x21, 0x24, 0x27, 0xe2, 0xc0, 0x6d,
-	0xb3, 0x99, 0xb6, 0x2b, 0xec, 0xb5, 0xd9, 0x1d, 0xa3, 0xf8, 0x6b, 0xc0, 0x47, 0xe0, 0x8b, 0xa2,
-	0x1d, 0xff, 0x89, 0xd3, 0x72, 0xf2, 0xbe, 0x37, 0x9e, 0x37, 0x33, 0x6f, 0x67, 0x61, 0xea, 0x48,
-	0x92, 0x76, 0xa4, 0xd5, 0xa2, 0xb4, 0x05, 0x15, 0x22, 0xa2, 0xba, 0x44, 0x97, 0xbc, 0x87, 0xf1,
-	0xb6, 0x20, 0x99, 0x7d, 0x40, 0x14, 0x33, 0x08, 0xef, 0x10, 0xe3, 0xe0, 0x55, 0x70, 0x15, 0xa6,
-	0xfe, 0x28, 0x62, 0xb8, 0xa0, 0xc3, 0xaa, 0xa8, 0x0c, 0xc5, 0x67, 0xcc, 0x76, 0x30, 0xf9, 0x15,
-	0xc0, 0x2c, 0xc5, 0x1f, 0xb7, 0x48, 0x9c, 0xbe, 0x2a, 0xb4, 0x71, 0xe2, 0x7f, 0x18, 0xb9, 0x3a,
-	0xdf, 0x15, 0x19, 0x6b, 0x4c, 0xd2, 0x16, 0x89, 0x17, 0x30, 0xf1, 0xe5, 0xf1, 0xa3, 0x74, 0x0f,
-	0x2c, 0x74, 0x99, 0x1e, 0x09, 0xf1, 0x1c, 0xc6, 0x8e, 0xa4, 0xa5, 0x4f, 0x58, 0xc7, 0x21, 0x07,
-	0x7b, 0x2c, 0xe6, 0x10, 0x29, 0x2e, 0x7f, 0xce, 0xe5, 0x1b, 0xe0, 0xeb, 0xe0, 0x01, 0x15, 0xda,
-	0x38, 0x6a, 0xea, 0x34, 0x28, 0x31, 0x20, 0x52, 0x2c, 0xb3, 0xfa, 0xb4, 0xab, 0x5e, 0x23, 0x18,
-	0x6a, 0xcc, 0x20, 0x34, 0x55, 0xde, 0x8e, 0xe5, 0x8f, 0x5e, 0x55, 0xe6, 0xfc, 0x63, 0xc8, 0x64,
-	0x8b, 0xbc, 0x09, 0x06, 0x0f, 0xdc, 0xde, 0x39, 0xb7, 0xd7, 0xc1, 0xa4, 0x82, 0x67, 0x6b, 0x42,
-	0x2b, 0x09, 0x53, 0x69, 0xee, 0xf1, 0xa6, 0xde, 0xf4, 0x43, 0x9d, 0x8c, 0x1c, 0x3c, 0x1e, 0x79,
-	0x0e, 0x11, 0x8f, 0xd8, 0x9a, 0xd1, 0x00, 0xdf, 0x12, 0x9a, 0x7d, 0xeb, 0x81, 0x3f, 0xfe, 0x7b,
-	0xfc, 0xe4, 0x77, 0x00, 0xd3, 0xad, 0x56, 0xdf, 0x91, 0x36, 0xdd, 0xa5, 0x8a, 0xb7, 0x30, 0x53,
-	0x95, 0xb5, 0x68, 0xe8, 0x4b, 0x89, 0x66, 0x35, 0x98, 0xf7, 0x09, 0x2f, 0xae, 0x60, 0x4a, 0xde,
-	0x9e, 0xcf, 0xda, 0xa0, 0x1d, 0xde, 0xee, 0x63, 0xda, 0xab, 0x32, 0xb5, 0x92, 0x46, 0x65, 0xb8,
-	0x1a, 0x98, 0xf3, 0x84, 0x4f, 0xfe, 0x9c, 0x75, 0x5d, 0xb1, 0xc0, 0xda, 0xdc, 0x15, 0xfe, 0x6a,
-	0x89, 0xa9, 0xf5, 0xbe, 0x5d, 0x89, 0x1e, 0xf3, 0xb2, 0x90, 0xa4, 0xca, 0x71, 0xf1, 0x28, 0x6d,
-	0x91, 0x78, 0x09, 0x50, 0x5a, 0xfc, 0xb9, 0x69, 0x62, 0x21, 0xc7, 0x06, 0x8c, 0x77, 0x56, 0xbb,
-	0x5b, 0x34, 0xe8, 0xb4, 0x63, 0x5f, 0xc6, 0xe9, 0x91, 0xf0, 0xd9, 0xca, 0xa2, 0x24, 0xdc, 0xea,
-	0x1c, 0x79, 0x3d, 0xc2, 0x74, 0xc0, 0xf8, 0xec, 0xdc, 0xb7, 0xc7, 0xe1, 0x11, 0x87, 0x8f, 0x84,
-	0x8f, 0xaa, 0xac, 0x70, 0x4d, 0xf2, 0x45, 0x13, 0xed, 0x09, 0xaf, 0xcd, 0xbf, 0x7e, 0x95, 0x59,
-	0x85, 0xf1, 0xb8, 0xd1, 0x3e, 0x32, 0x22, 0x81, 0x4b, 0x46, 0xd7, 0xfb, 0xbd, 0x45, 0xe7, 0xe2,
-	0x09, 0x4f, 0x7c, 0xc2, 0x25, 0xaf, 0xe1, 0x3f, 0x5e, 0xcd, 0xeb, 0x66, 0xb7, 0xe6, 0x10, 0xb1,
-	0x91, 0xdd, 0x6e, 0x32, 0xb8, 0x79, 0xf3, 0x2d, 0xb9, 0xd7, 0x94, 0xc9, 0xdd, 0x62, 0xb9, 0x5c,
-	0x28, 0xf3, 0x4e, 0x3d, 0x48, 0x6d, 0x96, 0xcb, 0xfe, 0xcb, 0x4f, 0x77, 0x37, 0xe2, 0x87, 0xbc,
-	0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xd3, 0xb1, 0xa2, 0xd7, 0xdb, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("statistic.proto", fileDescriptor10) }
+
+var fileDescriptor10 = []byte{
+	// 492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x4f, 0x6b, 0xdb, 0x4e,
+	0x10, 0x45, 0x51, 0xe4, 0xd8, 0xf3, 0x0b, 0xd8, 0x2c, 0xe6, 0x57, 0x51, 0xfa, 0x0f, 0xf5, 0x12,
+	0x7a, 0x88, 0x0f, 0x86, 0xde, 0x13, 0x43, 0x53, 0x53, 0x4a, 0x41, 0x36, 0x3d, 0xf4, 0xb6, 0x5e,
+	0x4f, 0xe2, 0xa5, 0xd2, 0xca, 0xdd, 0x1d, 0x15, 0xeb, 0x6b, 0xb4, 0x1f, 0xa1, 0x5f, 0xb4, 0xec,
+	0xe8, 0x8f, 0xe5, 0xa4, 0xb7, 0x7d, 0x6f, 0xb4, 0x6f, 0xe6, 0xbd, 0x1d, 0xc1, 0xd8, 0x91, 0x24,
+	0xed, 0x48, 0xab, 0xeb, 0xbd, 0x2d, 0xa8, 0x10, 0x11, 0x55, 0x7b, 0x74, 0xc9, 0x7b, 0x18, 0xae,
+	0x0b, 0x92, 0xd9, 0x07, 0x44, 0x31, 0x81, 0xf0, 0x1e, 0x31, 0x0e, 0xde, 0x04, 0x57, 0x61, 0xea,
+	0x8f, 0x22, 0x86, 0x0b, 0x3a, 0x2c, 0x8a, 0xd2, 0x50, 0x7c, 0xc6, 0x6c, 0x0b, 0x93, 0x5f, 0x01,
+	0x4c, 0x52, 0xfc, 0x71, 0x87, 0xc4, 0xd7, 0x17, 0x85, 0x36, 0x4e, 0xfc, 0x0f, 0x03, 0x57, 0xe5,
+	0x9b, 0x22, 0x63, 0x8d, 0x51, 0xda, 0x20, 0xf1, 0x02, 0x46, 0xbe, 0x3d, 0x7e, 0x94, 0x6e, 0xc7,
+	0x42, 0x97, 0xe9, 0x91, 0x10, 0xcf, 0x61, 0xe8, 0x48, 0x5a, 0xfa, 0x84, 0x55, 0x1c, 0x72, 0xb1,
+	0xc3, 0x62, 0x0a, 0x91, 0xe2, 0xf6, 0xe7, 0xdc, 0xbe, 0x06, 0xbe, 0x0f, 0x1e, 0x50, 0xa1, 0x8d,
+	0xa3, 0xba, 0x4f, 0x8d, 0x12, 0x03, 0x22, 0xc5, 0x7d, 0x56, 0x9d, 0x4e, 0xd5, 0x69, 0x04, 0x7d,
+	0x8d, 0x09, 0x84, 0xa6, 0xcc, 0x1b, 0x5b, 0xfe, 0xe8, 0x55, 0x65, 0xce, 0x1f, 0x86, 0x4c, 0x36,
+	0xc8, 0x87, 0x60, 0xf0, 0xc0, 0xe3, 0x9d, 0xf3, 0x78, 0x2d, 0x4c, 0x4a, 0x78, 0xb6, 0x24, 0xb4,
+	0x92, 0x30, 0x95, 0xe6, 0x01, 0x6f, 0xab, 0x55, 0x67, 0xea, 0xc4, 0x72, 0xf0, 0xd8, 0xf2, 0x14,
+	0x22, 0xb6, 0xd8, 0x84, 0x51, 0x03, 0x3f, 0x12, 0x9a, 0x6d, 0x93, 0x81, 0x3f, 0xfe, 0xdb, 0x7e,
+	0xf2, 0x3b, 0x80, 0xf1, 0x5a, 0xab, 0xef, 0x48, 0xab, 0xf6, 0x51, 0xc5, 0x3b, 0x98, 0xa8, 0xd2,
+	0x5a, 0x34, 0xf4, 0x65, 0x8f, 0x66, 0xd1, 0xf3, 0xfb, 0x84, 0x17, 0x57, 0x30, 0x26, 0x1f, 0xcf,
+	0x67, 0x6d, 0xd0, 0xf6, 0x5f, 0xf7, 0x31, 0xed, 0x55, 0x99, 0x5a, 0x48, 0xa3, 0x32, 0x5c, 0xf4,
+	0xc2, 0x79, 0xc2, 0x27, 0x7f, 0xce, 0xda, 0xa9, 0x58, 0x60, 0x69, 0xee, 0x0b, 0xff, 0xb4, 0xc4,
+	0xd4, 0x72, 0xdb, 0xac, 0x44, 0x87, 0x79, 0x59, 0x48, 0x52, 0xe9, 0xb8, 0x79, 0x94, 0x36, 0x48,
+	0xbc, 0x02, 0xd8, 0x5b, 0xfc, 0xb9, 0xaa, 0x6b, 0x21, 0xd7, 0x7a, 0x8c, 0x4f, 0x56, 0xbb, 0x3b,
+	0x34, 0xe8, 0xb4, 0xe3, 0x5c, 0x86, 0xe9, 0x91, 0xf0, 0xb7, 0x95, 0x45, 0x49, 0xb8, 0xd6, 0x39,
+	0xf2, 0x7a, 0x84, 0x69, 0x8f, 0xf1, 0xb7, 0x73, 0x3f, 0x1e, 0x97, 0x07, 0x5c, 0x3e, 0x12, 0xbe,
+	0xaa, 0xb2, 0xc2, 0xd5, 0x97, 0x2f, 0xea, 0x6a, 0x47, 0x78, 0x6d, 0xfe, 0xf4, 0xab, 0xcc, 0x4a,
+	0x8c, 0x87, 0xb5, 0xf6, 0x91, 0x11, 0x09, 0x5c, 0x32, 0xba, 0xd9, 0x6e, 0x2d, 0x3a, 0x17, 0x8f,
+	0xd8, 0xf1, 0x09, 0x97, 0xbc, 0x85, 0xff, 0x78, 0x35, 0x6f, 0xea, 0xdd, 0x9a, 0x42, 0xc4, 0x41,
+	0xb6, 0xbb, 0xc9, 0xe0, 0xf6, 0xf5, 0xb7, 0x97, 0x0f, 0x9a, 0x76, 0xe5, 0xe6, 0x5a, 0x15, 0xf9,
+	0x6c, 0x3e, 0x57, 0x66, 0xa6, 0x76, 0x52, 0x9b, 0xf9, 0x7c, 0xc6, 0x7f, 0xed, 0x66, 0xc0, 0xff,
+	0xf0, 0xfc, 0xef, 0x00, 0x09, 0x56, 0x34, 0x63, 0xd6, 0x03, 0x00, 0x00,
 }
@@ -25,7 +25,7 @@ type AssetsGenesis struct {
 func (m *AssetsGenesis) Reset()                    { *m = AssetsGenesis{} }
 func (m *AssetsGenesis) String() string            { return proto.CompactTextString(m) }
 func (*AssetsGenesis) ProtoMessage()               {}
-func (*AssetsGenesis) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{0} }
+func (*AssetsGenesis) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{0} }
 
 func (m *AssetsGenesis) GetAmount() int64 {
 	if m != nil {
@@ -52,7 +52,7 @@ type AssetsTransferToExec struct {
 func (m *AssetsTransferToExec) Reset()                    { *m = AssetsTransferToExec{} }
 func (m *AssetsTransferToExec) String() string            { return proto.CompactTextString(m) }
 func (*AssetsTransferToExec) ProtoMessage()               {}
-func (*AssetsTransferToExec) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{1} }
+func (*AssetsTransferToExec) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{1} }
 
 func (m *AssetsTransferToExec) GetCointoken() string {
 	if m != nil {
@@ -100,7 +100,7 @@ type AssetsWithdraw struct {
 func (m *AssetsWithdraw) Reset()                    { *m = AssetsWithdraw{} }
 func (m *AssetsWithdraw) String() string            { return proto.CompactTextString(m) }
 func (*AssetsWithdraw) ProtoMessage()               {}
-func (*AssetsWithdraw) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{2} }
+func (*AssetsWithdraw) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{2} }
 
 func (m *AssetsWithdraw) GetCointoken() string {
 	if m != nil {
@@ -147,7 +147,7 @@ type AssetsTransfer struct {
 func (m *AssetsTransfer) Reset()                    { *m = AssetsTransfer{} }
 func (m *AssetsTransfer) String() string            { return proto.CompactTextString(m) }
 func (*AssetsTransfer) ProtoMessage()               {}
-func (*AssetsTransfer) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{3} }
+func (*AssetsTransfer) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{3} }
 
 func (m *AssetsTransfer) GetCointoken() string {
 	if m != nil {
@@ -185,7 +185,7 @@ type Asset struct {
 func (m *Asset) Reset()                    { *m = Asset{} }
 func (m *Asset) String() string            { return proto.CompactTextString(m) }
 func (*Asset) ProtoMessage()               {}
-func (*Asset) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{4} }
+func (*Asset) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{4} }
 
 func (m *Asset) GetExec() string {
 	if m != nil {
@@ -215,7 +215,7 @@ type CreateTx struct {
 func (m *CreateTx) Reset()                    { *m = CreateTx{} }
 func (m *CreateTx) String() string            { return proto.CompactTextString(m) }
 func (*CreateTx) ProtoMessage()               {}
-func (*CreateTx) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{5} }
+func (*CreateTx) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{5} }
 
 func (m *CreateTx) GetTo() string {
 	if m != nil {
@@ -280,7 +280,7 @@ type CreateTransactionGroup struct {
 func (m *CreateTransactionGroup) Reset()                    { *m = CreateTransactionGroup{} }
 func (m *CreateTransactionGroup) String() string            { return proto.CompactTextString(m) }
 func (*CreateTransactionGroup) ProtoMessage()               {}
-func (*CreateTransactionGroup) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{6} }
+func (*CreateTransactionGroup) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{6} }
 
 func (m *CreateTransactionGroup) GetTxs() []string {
 	if m != nil {
@@ -296,7 +296,7 @@ type UnsignTx struct {
 func (m *UnsignTx) Reset()                    { *m = UnsignTx{} }
 func (m *UnsignTx) String() string            { return proto.CompactTextString(m) }
 func (*UnsignTx) ProtoMessage()               {}
-func (*UnsignTx) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{7} }
+func (*UnsignTx) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{7} }
 
 func (m *UnsignTx) GetData() []byte {
 	if m != nil {
@@ -316,7 +316,7 @@ type NoBalanceTx struct {
 func (m *NoBalanceTx) Reset()                    { *m = NoBalanceTx{} }
 func (m *NoBalanceTx) String() string            { return proto.CompactTextString(m) }
 func (*NoBalanceTx) ProtoMessage()               {}
-func (*NoBalanceTx) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{8} }
+func (*NoBalanceTx) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{8} }
 
 func (m *NoBalanceTx) GetTxHex() string {
 	if m != nil {
@@ -356,7 +356,7 @@ type SignedTx struct {
 func (m *SignedTx) Reset()                    { *m = SignedTx{} }
 func (m *SignedTx) String() string            { return proto.CompactTextString(m) }
 func (*SignedTx) ProtoMessage()               {}
-func (*SignedTx) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{9} }
+func (*SignedTx) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{9} }
 
 func (m *SignedTx) GetUnsign() []byte {
 	if m != nil {
@@ -404,7 +404,7 @@ type Transaction struct {
 func (m *Transaction) Reset()                    { *m = Transaction{} }
 func (m *Transaction) String() string            { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()               {}
-func (*Transaction) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{10} }
+func (*Transaction) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{10} }
 
 func (m *Transaction) GetExecer() []byte {
 	if m != nil {
@@ -483,7 +483,7 @@ type Transactions struct {
 func (m *Transactions) Reset()                    { *m = Transactions{} }
 func (m *Transactions) String() string            { return proto.CompactTextString(m) }
 func (*Transactions) ProtoMessage()               {}
-func (*Transactions) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{11} }
+func (*Transactions) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{11} }
 
 func (m *Transactions) GetTxs() []*Transaction {
 	if m != nil {
@@ -500,7 +500,7 @@ type RingSignature struct {
 func (m *RingSignature) Reset()                    { *m = RingSignature{} }
 func (m *RingSignature) String() string            { return proto.CompactTextString(m) }
 func (*RingSignature) ProtoMessage()               {}
-func (*RingSignature) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{12} }
+func (*RingSignature) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{12} }
 
 func (m *RingSignature) GetItems() []*RingSignatureItem {
 	if m != nil {
@@ -518,7 +518,7 @@ type RingSignatureItem struct {
 func (m *RingSignatureItem) Reset()                    { *m = RingSignatureItem{} }
 func (m *RingSignatureItem) String() string            { return proto.CompactTextString(m) }
 func (*RingSignatureItem) ProtoMessage()               {}
-func (*RingSignatureItem) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{13} }
+func (*RingSignatureItem) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{13} }
 
 func (m *RingSignatureItem) GetPubkey() [][]byte {
 	if m != nil {
@@ -554,7 +554,7 @@ type Signature struct {
 func (m *Signature) Reset()                    { *m = Signature{} }
 func (m *Signature) String() string            { return proto.CompactTextString(m) }
 func (*Signature) ProtoMessage()               {}
-func (*Signature) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{14} }
+func (*Signature) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{14} }
 
 func (m *Signature) GetTy() int32 {
 	if m != nil {
@@ -586,7 +586,7 @@ type AddrOverview struct {
 func (m *AddrOverview) Reset()                    { *m = AddrOverview{} }
 func (m *AddrOverview) String() string            { return proto.CompactTextString(m) }
 func (*AddrOverview) ProtoMessage()               {}
-func (*AddrOverview) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{15} }
+func (*AddrOverview) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{15} }
 
 func (m *AddrOverview) GetReciver() int64 {
 	if m != nil {
@@ -622,7 +622,7 @@ type ReqAddr struct {
 func (m *ReqAddr) Reset()                    { *m = ReqAddr{} }
 func (m *ReqAddr) String() string            { return proto.CompactTextString(m) }
 func (*ReqAddr) ProtoMessage()               {}
-func (*ReqAddr) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{16} }
+func (*ReqAddr) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{16} }
 
 func (m *ReqAddr) GetAddr() string {
 	if m != nil {
@@ -675,7 +675,7 @@ type ReqPrivacy struct {
 func (m *ReqPrivacy) Reset()                    { *m = ReqPrivacy{} }
 func (m *ReqPrivacy) String() string            { return proto.CompactTextString(m) }
 func (*ReqPrivacy) ProtoMessage()               {}
-func (*ReqPrivacy) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{17} }
+func (*ReqPrivacy) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{17} }
 
 func (m *ReqPrivacy) GetCount() int32 {
 	if m != nil {
@@ -705,7 +705,7 @@ type HexTx struct {
 func (m *HexTx) Reset()                    { *m = HexTx{} }
 func (m *HexTx) String() string            { return proto.CompactTextString(m) }
 func (*HexTx) ProtoMessage()               {}
-func (*HexTx) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{18} }
+func (*HexTx) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{18} }
 
 func (m *HexTx) GetTx() string {
 	if m != nil {
@@ -724,7 +724,7 @@ type ReplyTxInfo struct {
 func (m *ReplyTxInfo) Reset()                    { *m = ReplyTxInfo{} }
 func (m *ReplyTxInfo) String() string            { return proto.CompactTextString(m) }
 func (*ReplyTxInfo) ProtoMessage()               {}
-func (*ReplyTxInfo) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{19} }
+func (*ReplyTxInfo) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{19} }
 
 func (m *ReplyTxInfo) GetHash() []byte {
 	if m != nil {
@@ -761,7 +761,7 @@ type ReqTxList struct {
 func (m *ReqTxList) Reset()                    { *m = ReqTxList{} }
 func (m *ReqTxList) String() string            { return proto.CompactTextString(m) }
 func (*ReqTxList) ProtoMessage()               {}
-func (*ReqTxList) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{20} }
+func (*ReqTxList) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{20} }
 
 func (m *ReqTxList) GetCount() int64 {
 	if m != nil {
@@ -777,7 +777,7 @@ type ReplyTxList struct {
 func (m *ReplyTxList) Reset()                    { *m = ReplyTxList{} }
 func (m *ReplyTxList) String() string            { return proto.CompactTextString(m) }
 func (*ReplyTxList) ProtoMessage()               {}
-func (*ReplyTxList) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{21} }
+func (*ReplyTxList) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{21} }
 
 func (m *ReplyTxList) GetTxs() []*Transaction {
 	if m != nil {
@@ -786,6 +786,31 @@ func (m *ReplyTxList) GetTxs() []*Transaction {
 	return nil
 }
 
+// 按压缩区块中交易的短id查找mempool中的交易, salt是区块hash
+type ReqTxsByShortIDs struct {
+	Salt     []byte   `protobuf:"bytes,1,opt,name=salt,proto3" json:"salt,omitempty"`
+	ShortIDs []uint64 `protobuf:"varint,2,rep,packed,name=shortIDs" json:"shortIDs,omitempty"`
+}
+
+func (m *ReqTxsByShortIDs) Reset()                    { *m = ReqTxsByShortIDs{} }
+func (m *ReqTxsByShortIDs) String() string            { return proto.CompactTextString(m) }
+func (*ReqTxsByShortIDs) ProtoMessage()               {}
+func (*ReqTxsByShortIDs) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{22} }
+
+func (m *ReqTxsByShortIDs) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
+func (m *ReqTxsByShortIDs) GetShortIDs() []uint64 {
+	if m != nil {
+		return m.ShortIDs
+	}
+	return nil
+}
+
 type TxHashList struct {
 	Hashes [][]byte `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
 	Count  int64    `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
@@ -795,7 +820,7 @@ type TxHashList struct {
 func (m *TxHashList) Reset()                    { *m = TxHashList{} }
 func (m *TxHashList) String() string            { return proto.CompactTextString(m) }
 func (*TxHashList) ProtoMessage()               {}
-func (*TxHashList) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{22} }
+func (*TxHashList) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{23} }
 
 func (m *TxHashList) GetHashes() [][]byte {
 	if m != nil {
@@ -825,7 +850,7 @@ type ReplyTxInfos struct {
 func (m *ReplyTxInfos) Reset()                    { *m = ReplyTxInfos{} }
 func (m *ReplyTxInfos) String() string            { return proto.CompactTextString(m) }
 func (*ReplyTxInfos) ProtoMessage()               {}
-func (*ReplyTxInfos) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{23} }
+func (*ReplyTxInfos) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{24} }
 
 func (m *ReplyTxInfos) GetTxInfos() []*ReplyTxInfo {
 	if m != nil {
@@ -842,7 +867,7 @@ type ReceiptLog struct {
 func (m *ReceiptLog) Reset()                    { *m = ReceiptLog{} }
 func (m *ReceiptLog) String() string            { return proto.CompactTextString(m) }
 func (*ReceiptLog) ProtoMessage()               {}
-func (*ReceiptLog) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{24} }
+func (*ReceiptLog) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{25} }
 
 func (m *ReceiptLog) GetTy() int32 {
 	if m != nil {
@@ -870,7 +895,7 @@ type Receipt struct {
 func (m *Receipt) Reset()                    { *m = Receipt{} }
 func (m *Receipt) String() string            { return proto.CompactTextString(m) }
 func (*Receipt) ProtoMessage()               {}
-func (*Receipt) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{25} }
+func (*Receipt) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{26} }
 
 func (m *Receipt) GetTy() int32 {
 	if m != nil {
@@ -901,7 +926,7 @@ type ReceiptData struct {
 func (m *ReceiptData) Reset()                    { *m = ReceiptData{} }
 func (m *ReceiptData) String() string            { return proto.CompactTextString(m) }
 func (*ReceiptData) ProtoMessage()               {}
-func (*ReceiptData) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{26} }
+func (*ReceiptData) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{27} }
 
 func (m *ReceiptData) GetTy() int32 {
 	if m != nil {
@@ -929,7 +954,7 @@ type TxResult struct {
 func (m *TxResult) Reset()                    { *m = TxResult{} }
 func (m *TxResult) String() string            { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()               {}
-func (*TxResult) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{27} }
+func (*TxResult) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{28} }
 
 func (m *TxResult) GetHeight() int64 {
 	if m != nil {
@@ -988,7 +1013,7 @@ type TransactionDetail struct {
 func (m *TransactionDetail) Reset()                    { *m = TransactionDetail{} }
 func (m *TransactionDetail) String() string            { return proto.CompactTextString(m) }
 func (*TransactionDetail) ProtoMessage()               {}
-func (*TransactionDetail) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{28} }
+func (*TransactionDetail) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{29} }
 
 func (m *TransactionDetail) GetTx() *Transaction {
 	if m != nil {
@@ -1060,7 +1085,7 @@ type TransactionDetails struct {
 func (m *TransactionDetails) Reset()                    { *m = TransactionDetails{} }
 func (m *TransactionDetails) String() string            { return proto.CompactTextString(m) }
 func (*TransactionDetails) ProtoMessage()               {}
-func (*TransactionDetails) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{29} }
+func (*TransactionDetails) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{30} }
 
 func (m *TransactionDetails) GetTxs() []*TransactionDetail {
 	if m != nil {
@@ -1076,7 +1101,7 @@ type ReqAddrs struct {
 func (m *ReqAddrs) Reset()                    { *m = ReqAddrs{} }
 func (m *ReqAddrs) String() string            { return proto.CompactTextString(m) }
 func (*ReqAddrs) ProtoMessage()               {}
-func (*ReqAddrs) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{30} }
+func (*ReqAddrs) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{31} }
 
 func (m *ReqAddrs) GetAddrs() []string {
 	if m != nil {
@@ -1092,7 +1117,7 @@ type ReqDecodeRawTransaction struct {
 func (m *ReqDecodeRawTransaction) Reset()                    { *m = ReqDecodeRawTransaction{} }
 func (m *ReqDecodeRawTransaction) String() string            { return proto.CompactTextString(m) }
 func (*ReqDecodeRawTransaction) ProtoMessage()               {}
-func (*ReqDecodeRawTransaction) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{31} }
+func (*ReqDecodeRawTransaction) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{32} }
 
 func (m *ReqDecodeRawTransaction) GetTxHex() string {
 	if m != nil {
@@ -1109,7 +1134,7 @@ type UserWrite struct {
 func (m *UserWrite) Reset()                    { *m = UserWrite{} }
 func (m *UserWrite) String() string            { return proto.CompactTextString(m) }
 func (*UserWrite) ProtoMessage()               {}
-func (*UserWrite) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{32} }
+func (*UserWrite) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{33} }
 
 func (m *UserWrite) GetTopic() string {
 	if m != nil {
@@ -1134,7 +1159,7 @@ type UpgradeMeta struct {
 func (m *UpgradeMeta) Reset()                    { *m = UpgradeMeta{} }
 func (m *UpgradeMeta) String() string            { return proto.CompactTextString(m) }
 func (*UpgradeMeta) ProtoMessage()               {}
-func (*UpgradeMeta) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{33} }
+func (*UpgradeMeta) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{34} }
 
 func (m *UpgradeMeta) GetIndexing() bool {
 	if m != nil {
@@ -1180,6 +1205,7 @@ func init() {
 	proto.RegisterType((*ReplyTxInfo)(nil), "types.ReplyTxInfo")
 	proto.RegisterType((*ReqTxList)(nil), "types.ReqTxList")
 	proto.RegisterType((*ReplyTxList)(nil), "types.ReplyTxList")
+	proto.RegisterType((*ReqTxsByShortIDs)(nil), "types.ReqTxsByShortIDs")
 	proto.RegisterType((*TxHashList)(nil), "types.TxHashList")
 	proto.RegisterType((*ReplyTxInfos)(nil), "types.ReplyTxInfos")
 	proto.RegisterType((*ReceiptLog)(nil), "types.ReceiptLog")
@@ -1194,89 +1220,91 @@ func init() {
 	proto.RegisterType((*UpgradeMeta)(nil), "types.UpgradeMeta")
 }
 
-func init() { proto.RegisterFile("transaction.proto", fileDescriptor9) }
-
-var fileDescriptor9 = []byte{
-	// 1284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x5f, 0x6f, 0x1b, 0x45,
-	0x10, 0x97, 0xef, 0x6c, 0xc7, 0x1e, 0xbb, 0xa5, 0x39, 0x55, 0xad, 0x55, 0x55, 0x25, 0xac, 0x82,
-	0x54, 0x55, 0x95, 0x2b, 0xc5, 0x7d, 0x03, 0x09, 0xda, 0x06, 0xb5, 0x55, 0xff, 0x00, 0x5b, 0xb7,
-	0x45, 0x80, 0x90, 0x36, 0xe7, 0x89, 0xbd, 0xc4, 0xbe, 0x75, 0xee, 0xd6, 0xe9, 0xf9, 0x0b, 0xf0,
-	0x02, 0x6f, 0x3c, 0xf3, 0x9d, 0xf8, 0x48, 0x68, 0x67, 0x77, 0xef, 0xd6, 0x71, 0x82, 0xfa, 0x80,
-	0xc4, 0x53, 0xf6, 0x37, 0x3b, 0x9e, 0xbf, 0xbf, 0x99, 0xbd, 0xc0, 0xae, 0xce, 0x45, 0x56, 0x88,
-	0x54, 0x4b, 0x95, 0x0d, 0x97, 0xb9, 0xd2, 0x2a, 0x69, 0xe9, 0xf5, 0x12, 0x8b, 0x5b, 0xfd, 0x54,
-	0x2d, 0x16, 0x5e, 0xc8, 0x5e, 0xc1, 0x95, 0x47, 0x45, 0x81, 0xba, 0x78, 0x8a, 0x19, 0x16, 0xb2,
-	0x48, 0x6e, 0x40, 0x5b, 0x2c, 0xd4, 0x2a, 0xd3, 0x83, 0x68, 0xaf, 0x71, 0x37, 0xe6, 0x0e, 0x25,
-	0xfb, 0x70, 0x25, 0x47, 0xbd, 0xca, 0xb3, 0x47, 0x93, 0x49, 0x8e, 0x45, 0x31, 0x88, 0xf7, 0x1a,
-	0x77, 0xbb, 0x7c, 0x53, 0xc8, 0xfe, 0x68, 0xc0, 0x75, 0x6b, 0x6f, 0x6c, 0xfc, 0x1f, 0x63, 0x3e,
-	0x56, 0xdf, 0x94, 0x98, 0x26, 0xb7, 0xa1, 0x9b, 0x2a, 0x99, 0x69, 0x75, 0x82, 0xd9, 0xa0, 0x41,
-	0x3f, 0xad, 0x05, 0x97, 0x3a, 0x4d, 0xa0, 0x99, 0x29, 0x8d, 0xce, 0x17, 0x9d, 0x93, 0x5b, 0xd0,
-	0xc1, 0x12, 0xd3, 0xd7, 0x62, 0x81, 0x83, 0x26, 0xc9, 0x2b, 0x9c, 0x5c, 0x85, 0x48, 0xab, 0x41,
-	0x8b, 0xa4, 0x91, 0x56, 0xec, 0xb7, 0x06, 0x5c, 0xb5, 0xe1, 0xbc, 0x97, 0x7a, 0x36, 0xc9, 0xc5,
-	0x87, 0xff, 0x29, 0x90, 0x5f, 0x7d, 0x1c, 0xbe, 0x2c, 0xff, 0x61, 0x1c, 0xd6, 0x57, 0xb3, 0xf2,
-	0x35, 0x82, 0x16, 0xf9, 0x32, 0xca, 0x26, 0x20, 0x67, 0x9d, 0xce, 0xc6, 0x70, 0xb1, 0x5e, 0x1c,
-	0xa9, 0x39, 0x19, 0xee, 0x72, 0x87, 0xd8, 0xdf, 0x0d, 0xe8, 0x3c, 0xc9, 0x51, 0x68, 0x1c, 0x97,
-	0xce, 0x62, 0xc3, 0x5b, 0xbc, 0x34, 0x9a, 0x6b, 0x10, 0x1f, 0xa3, 0x0d, 0x26, 0xe6, 0xe6, 0x58,
-	0xc5, 0xd7, 0x0c, 0xe2, 0xbb, 0x03, 0x20, 0xab, 0xfa, 0x53, 0x4d, 0x3a, 0x3c, 0x90, 0x24, 0x03,
-	0xd8, 0x91, 0xc5, 0x98, 0xea, 0xd0, 0xa6, 0x4b, 0x0f, 0x93, 0x3d, 0xe8, 0x51, 0x39, 0xde, 0xd8,
-	0x88, 0x77, 0xc8, 0x68, 0x28, 0xda, 0xe8, 0x41, 0x67, 0xb3, 0x07, 0xec, 0x1e, 0xdc, 0x70, 0x19,
-	0xd5, 0xa3, 0xf0, 0x34, 0x57, 0xab, 0xa5, 0x89, 0x5b, 0x97, 0xc5, 0xa0, 0xb1, 0x17, 0xdf, 0xed,
-	0x72, 0x73, 0x64, 0x77, 0xa0, 0xf3, 0x36, 0x2b, 0xe4, 0x34, 0x1b, 0x97, 0x26, 0x87, 0x89, 0xd0,
-	0x82, 0xf2, 0xef, 0x73, 0x3a, 0x33, 0x05, 0xbd, 0xd7, 0xea, 0xb1, 0x98, 0x8b, 0x2c, 0x35, 0x05,
-	0xba, 0x0e, 0x2d, 0x5d, 0x3e, 0xc3, 0xd2, 0xd5, 0xc8, 0x02, 0x93, 0xc8, 0x52, 0xac, 0xcd, 0x28,
-	0xb8, 0xe2, 0x7a, 0x48, 0x37, 0xb9, 0x3c, 0x3b, 0xc1, 0xb5, 0xeb, 0x9c, 0x87, 0xa6, 0xb4, 0x58,
-	0x2e, 0x65, 0xee, 0x4b, 0xe6, 0x10, 0xfb, 0x05, 0x3a, 0x6f, 0xe4, 0x34, 0xc3, 0xc9, 0xb8, 0x34,
-	0x3a, 0x2b, 0x0a, 0xce, 0x85, 0xe4, 0x90, 0x09, 0x94, 0xa4, 0x91, 0x0d, 0x94, 0x64, 0x37, 0xa0,
-	0xbd, 0x5c, 0x1d, 0x79, 0x47, 0x7d, 0xee, 0x10, 0xb5, 0x74, 0x4d, 0x3e, 0x5a, 0x3c, 0xd2, 0x6b,
-	0xf6, 0x7b, 0x04, 0xbd, 0xa0, 0x2e, 0x36, 0x0e, 0x4c, 0x31, 0xf7, 0x3e, 0x2c, 0x72, 0x39, 0xcd,
-	0x95, 0x98, 0x38, 0x37, 0x1e, 0x26, 0x43, 0xe8, 0x1a, 0x8f, 0x42, 0xaf, 0x72, 0x4b, 0x81, 0xde,
-	0xc1, 0xb5, 0x21, 0xad, 0x98, 0xe1, 0x1b, 0x2f, 0xe7, 0xb5, 0x8a, 0x27, 0x4b, 0xb3, 0x26, 0x4b,
-	0x9d, 0x7b, 0xcb, 0xd2, 0xca, 0x22, 0x53, 0xdd, 0x4c, 0x65, 0x29, 0x12, 0x1d, 0x62, 0x6e, 0x81,
-	0x23, 0xe5, 0x4e, 0x45, 0xca, 0x3b, 0x00, 0x53, 0xd3, 0xcd, 0x27, 0x44, 0xcc, 0x0e, 0x65, 0x16,
-	0x48, 0x8c, 0xf5, 0x19, 0x8a, 0x09, 0xe6, 0x83, 0xae, 0xcd, 0xc8, 0x22, 0xa2, 0x28, 0x96, 0x7a,
-	0x00, 0xb6, 0x6a, 0xe6, 0xcc, 0x1e, 0x42, 0x3f, 0x28, 0x46, 0x91, 0xec, 0xd7, 0x04, 0xe9, 0x1d,
-	0x24, 0x2e, 0xab, 0x40, 0xc3, 0x92, 0xe6, 0x2b, 0xb8, 0xc2, 0x65, 0x36, 0xad, 0xb2, 0x4d, 0x86,
-	0xd0, 0x92, 0x1a, 0x17, 0xfe, 0x87, 0x03, 0xf7, 0xc3, 0x0d, 0xa5, 0xe7, 0x1a, 0x17, 0xdc, 0xaa,
-	0xb1, 0xe7, 0xb0, 0xbb, 0x75, 0x17, 0x74, 0xd0, 0x58, 0xa9, 0x3b, 0x78, 0x3b, 0xac, 0x77, 0x44,
-	0x57, 0xb5, 0x80, 0x7d, 0x0f, 0xdd, 0x3a, 0x0e, 0xdb, 0xec, 0x86, 0x6f, 0x76, 0x60, 0x32, 0xda,
-	0x20, 0xc5, 0xed, 0xf3, 0x2d, 0xdc, 0x30, 0xf9, 0x33, 0xf4, 0x0d, 0x79, 0xbf, 0x3d, 0xc3, 0xfc,
-	0x4c, 0x22, 0xcd, 0x69, 0x8e, 0xa9, 0x3c, 0x73, 0x1c, 0x89, 0xb9, 0x87, 0xe6, 0xe6, 0xc8, 0xce,
-	0x86, 0x5b, 0x10, 0x1e, 0x9a, 0x1b, 0x5d, 0xda, 0x0e, 0xd9, 0x2d, 0xe1, 0x21, 0xfb, 0xb3, 0x01,
-	0x3b, 0x1c, 0x4f, 0x69, 0x3c, 0x12, 0x68, 0x0a, 0x33, 0x35, 0x6e, 0x51, 0x09, 0x27, 0x3b, 0x9e,
-	0x8b, 0x29, 0x19, 0x6c, 0x71, 0x3a, 0x1b, 0x62, 0xa4, 0x95, 0xad, 0x16, 0xb7, 0xc0, 0x64, 0x31,
-	0x91, 0x39, 0x52, 0x63, 0x1c, 0xc3, 0x6b, 0x81, 0xa5, 0x81, 0x9c, 0xce, 0xb4, 0x27, 0x99, 0x45,
-	0xc6, 0x96, 0xcc, 0x26, 0x58, 0x7a, 0x92, 0x11, 0x60, 0x3f, 0x00, 0x70, 0x3c, 0xfd, 0x2e, 0x97,
-	0x67, 0x22, 0x5d, 0xd7, 0xfe, 0x1a, 0x97, 0xfa, 0x8b, 0x2e, 0xf7, 0x17, 0x87, 0xfe, 0xd8, 0x4d,
-	0x68, 0x3d, 0xc3, 0xd2, 0x2d, 0xd7, 0xb2, 0x5a, 0xae, 0x25, 0x5b, 0x41, 0x8f, 0xe3, 0x72, 0xbe,
-	0x1e, 0x97, 0xcf, 0xb3, 0x63, 0x65, 0xf2, 0x9e, 0x89, 0x62, 0xe6, 0xb7, 0x8f, 0x39, 0x07, 0x36,
-	0xa3, 0x8b, 0x73, 0x88, 0x83, 0x1c, 0x92, 0x7d, 0x68, 0x0b, 0x7a, 0x6b, 0x06, 0x4d, 0xa2, 0x61,
-	0xdf, 0xd1, 0x90, 0x1e, 0x05, 0xee, 0xee, 0xd8, 0x67, 0xd0, 0xe5, 0x78, 0x3a, 0x2e, 0x5f, 0xca,
-	0x42, 0x6f, 0x26, 0x1a, 0xbb, 0x44, 0xd9, 0xa8, 0x8a, 0x8c, 0x94, 0x3e, 0x6e, 0x28, 0x38, 0xc0,
-	0xb8, 0x7c, 0x26, 0x8a, 0x19, 0xfd, 0xc6, 0x44, 0x2e, 0x8a, 0x19, 0x16, 0x9e, 0xcc, 0x16, 0xd5,
-	0x0e, 0xa3, 0xc0, 0x61, 0xb0, 0x10, 0xe2, 0xbd, 0xb8, 0x5e, 0x08, 0xec, 0x4b, 0xe8, 0x07, 0x25,
-	0x2a, 0x92, 0xfb, 0x86, 0x55, 0x74, 0x3c, 0x17, 0x4d, 0xa0, 0xc5, 0xbd, 0x0a, 0x1b, 0x9a, 0x9e,
-	0xa6, 0x28, 0x97, 0xfa, 0xa5, 0x9a, 0x6e, 0xcd, 0xc6, 0x35, 0x88, 0xe7, 0x6a, 0xea, 0x06, 0xc3,
-	0x1c, 0x99, 0x30, 0xc4, 0x24, 0xfd, 0x2d, 0xe5, 0x4f, 0x21, 0x7a, 0xf1, 0x8e, 0x86, 0xaf, 0x77,
-	0xf0, 0x89, 0xf3, 0xf9, 0x02, 0xd7, 0xef, 0xc4, 0x7c, 0x85, 0x3c, 0x7a, 0xf1, 0x2e, 0xf9, 0x1c,
-	0x9a, 0x73, 0x35, 0x2d, 0x28, 0xfe, 0xde, 0xc1, 0x6e, 0x15, 0x96, 0x77, 0xcf, 0xe9, 0x9a, 0x1d,
-	0x9a, 0xca, 0x92, 0xec, 0x50, 0x68, 0xb1, 0xe5, 0xe6, 0x23, 0xad, 0x98, 0x37, 0x7b, 0x5c, 0x72,
-	0x2c, 0x56, 0x73, 0x1d, 0x70, 0xa4, 0x71, 0x31, 0x47, 0x2c, 0x53, 0x1d, 0x47, 0x18, 0x91, 0xd0,
-	0x6e, 0xed, 0x8b, 0x5a, 0x19, 0xe9, 0x32, 0x79, 0x08, 0xbd, 0xdc, 0xba, 0x9c, 0x08, 0xf7, 0xa4,
-	0x87, 0x95, 0xae, 0xc2, 0xe7, 0xa1, 0x9a, 0x99, 0x8e, 0xa3, 0xb9, 0x4a, 0x4f, 0xb4, 0x5c, 0xf8,
-	0xbd, 0x5e, 0x0b, 0xcc, 0xd2, 0xb6, 0x1e, 0xe8, 0xc5, 0x6e, 0xd3, 0x10, 0x04, 0x12, 0xf6, 0x57,
-	0x04, 0xbb, 0x41, 0x1c, 0x87, 0xa8, 0x85, 0x9c, 0xbb, 0x68, 0x1b, 0xff, 0x1a, 0xed, 0x7d, 0xda,
-	0x4e, 0x26, 0x0c, 0xca, 0xf4, 0xe2, 0x48, 0xbd, 0x0a, 0x6d, 0xc4, 0x5c, 0xa9, 0x63, 0x5b, 0x63,
-	0xb3, 0x11, 0x09, 0x05, 0x55, 0x6c, 0x5e, 0x5c, 0xc5, 0x56, 0x38, 0x69, 0x1b, 0xb9, 0xb6, 0xcf,
-	0xe7, 0x5a, 0x7f, 0x35, 0xed, 0x6c, 0x7c, 0x35, 0xdd, 0x82, 0xce, 0x71, 0xae, 0x16, 0xb4, 0xf1,
-	0xdc, 0x37, 0x8b, 0xc7, 0xe7, 0xea, 0xd3, 0xdd, 0xaa, 0xcf, 0xd7, 0x90, 0x6c, 0x95, 0xa7, 0x48,
-	0xee, 0x85, 0x93, 0x39, 0xd8, 0x2e, 0x90, 0xd5, 0xb3, 0xf3, 0xb9, 0x07, 0x1d, 0xb7, 0x76, 0x69,
-	0x0a, 0x8d, 0x57, 0xff, 0x25, 0x64, 0x01, 0x7b, 0x00, 0x37, 0x39, 0x9e, 0x1e, 0x62, 0xaa, 0x26,
-	0xc8, 0xc5, 0x87, 0xf0, 0x2b, 0xe1, 0xc2, 0xef, 0x1e, 0xf6, 0x05, 0x74, 0xdf, 0x16, 0x98, 0xbf,
-	0xcf, 0xa5, 0xa6, 0xc7, 0x5b, 0xab, 0xa5, 0x4c, 0x2b, 0x15, 0x03, 0xcc, 0x3b, 0x90, 0xaa, 0x4c,
-	0xa3, 0x9b, 0xf8, 0x2e, 0xf7, 0x90, 0xfd, 0x04, 0xbd, 0xb7, 0xcb, 0x69, 0x2e, 0x26, 0xf8, 0x0a,
-	0xb5, 0x30, 0xc5, 0xa1, 0xda, 0xca, 0x6c, 0x4a, 0x16, 0x3a, 0xbc, 0xc2, 0xc6, 0xc8, 0x19, 0xe6,
-	0x85, 0x5f, 0xbb, 0x5d, 0xee, 0xe1, 0x65, 0x4b, 0xf7, 0xf1, 0xfe, 0x8f, 0x6c, 0x2a, 0xf5, 0x5c,
-	0x1c, 0x0d, 0x47, 0xa3, 0x61, 0x9a, 0x3d, 0x48, 0x67, 0x42, 0x66, 0xa3, 0x51, 0xf5, 0x97, 0x2a,
-	0x75, 0xd4, 0xa6, 0x7f, 0x85, 0x46, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xcb, 0x4e, 0x3b,
-	0x34, 0x0d, 0x00, 0x00,
+func init() { proto.RegisterFile("transaction.proto", fileDescriptor11) }
+
+var fileDescriptor11 = []byte{
+	// 1318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xdd, 0x6e, 0x1b, 0xb7,
+	0x12, 0xc6, 0xae, 0x24, 0x4b, 0x1a, 0x29, 0x39, 0xf6, 0x22, 0x48, 0x84, 0x20, 0x27, 0xd1, 0x21,
+	0x72, 0x80, 0x20, 0x08, 0x64, 0xc0, 0xca, 0x5d, 0x0b, 0xb4, 0x71, 0x5c, 0x24, 0x46, 0x7e, 0xda,
+	0xd2, 0x4a, 0x52, 0xb4, 0x45, 0x01, 0x7a, 0x97, 0x96, 0xd8, 0x48, 0x4b, 0x79, 0x49, 0x39, 0xab,
+	0x17, 0xe8, 0x4d, 0x7b, 0xd7, 0xeb, 0xbe, 0x53, 0x1f, 0xa9, 0xe0, 0x90, 0xdc, 0xa5, 0x22, 0xbb,
+	0xc8, 0x45, 0x81, 0xde, 0xf1, 0x9b, 0x1d, 0xcd, 0xcf, 0xc7, 0x6f, 0x66, 0x57, 0xb0, 0xa7, 0x0b,
+	0x96, 0x2b, 0x96, 0x6a, 0x21, 0xf3, 0xd1, 0xb2, 0x90, 0x5a, 0x26, 0x2d, 0xbd, 0x5e, 0x72, 0x75,
+	0xbb, 0x9f, 0xca, 0xc5, 0xc2, 0x1b, 0xc9, 0x2b, 0xb8, 0xf6, 0x44, 0x29, 0xae, 0xd5, 0x33, 0x9e,
+	0x73, 0x25, 0x54, 0x72, 0x13, 0x76, 0xd8, 0x42, 0xae, 0x72, 0x3d, 0x88, 0x87, 0xd1, 0x83, 0x06,
+	0x75, 0x28, 0xb9, 0x0f, 0xd7, 0x0a, 0xae, 0x57, 0x45, 0xfe, 0x24, 0xcb, 0x0a, 0xae, 0xd4, 0xa0,
+	0x31, 0x8c, 0x1e, 0x74, 0xe9, 0xa6, 0x91, 0xfc, 0x16, 0xc1, 0x0d, 0x1b, 0x6f, 0x62, 0xf2, 0x9f,
+	0xf1, 0x62, 0x22, 0xbf, 0x2a, 0x79, 0x9a, 0xdc, 0x81, 0x6e, 0x2a, 0x45, 0xae, 0xe5, 0x7b, 0x9e,
+	0x0f, 0x22, 0xfc, 0x69, 0x6d, 0xb8, 0x32, 0x69, 0x02, 0xcd, 0x5c, 0x6a, 0xee, 0x72, 0xe1, 0x39,
+	0xb9, 0x0d, 0x1d, 0x5e, 0xf2, 0xf4, 0x35, 0x5b, 0xf0, 0x41, 0x13, 0xed, 0x15, 0x4e, 0xae, 0x43,
+	0xac, 0xe5, 0xa0, 0x85, 0xd6, 0x58, 0x4b, 0xf2, 0x4b, 0x04, 0xd7, 0x6d, 0x39, 0xef, 0x84, 0x9e,
+	0x65, 0x05, 0xfb, 0xf0, 0x2f, 0x15, 0xf2, 0x33, 0x5c, 0xdf, 0xa4, 0xe5, 0x1f, 0xac, 0xc3, 0xe6,
+	0x6a, 0x56, 0xb9, 0xc6, 0xd0, 0xc2, 0x5c, 0xc6, 0xd9, 0x14, 0xe4, 0xa2, 0xe3, 0xd9, 0x04, 0x56,
+	0xeb, 0xc5, 0xa9, 0x9c, 0x63, 0xe0, 0x2e, 0x75, 0x88, 0xfc, 0x19, 0x41, 0xe7, 0x69, 0xc1, 0x99,
+	0xe6, 0x93, 0xd2, 0x45, 0x8c, 0x7c, 0xc4, 0x2b, 0xab, 0xd9, 0x85, 0xc6, 0x19, 0xb7, 0xc5, 0x34,
+	0xa8, 0x39, 0x56, 0xf5, 0x35, 0x83, 0xfa, 0xee, 0x02, 0x88, 0x8a, 0x7f, 0xe4, 0xa4, 0x43, 0x03,
+	0x4b, 0x32, 0x80, 0xb6, 0x50, 0x13, 0xe4, 0x61, 0x07, 0x1f, 0x7a, 0x98, 0x0c, 0xa1, 0x87, 0x74,
+	0x9c, 0xd8, 0x8a, 0xdb, 0x18, 0x34, 0x34, 0x6d, 0xdc, 0x41, 0x67, 0xf3, 0x0e, 0xc8, 0x43, 0xb8,
+	0xe9, 0x3a, 0xaa, 0x47, 0xe1, 0x59, 0x21, 0x57, 0x4b, 0x53, 0xb7, 0x2e, 0xd5, 0x20, 0x1a, 0x36,
+	0x1e, 0x74, 0xa9, 0x39, 0x92, 0xbb, 0xd0, 0x79, 0x93, 0x2b, 0x31, 0xcd, 0x27, 0xa5, 0xe9, 0x21,
+	0x63, 0x9a, 0x61, 0xff, 0x7d, 0x8a, 0x67, 0x22, 0xa1, 0xf7, 0x5a, 0x1e, 0xb2, 0x39, 0xcb, 0x53,
+	0x43, 0xd0, 0x0d, 0x68, 0xe9, 0xf2, 0x39, 0x2f, 0x1d, 0x47, 0x16, 0x98, 0x46, 0x96, 0x6c, 0x6d,
+	0x46, 0xc1, 0x91, 0xeb, 0x21, 0x3e, 0x29, 0xc4, 0xc5, 0x7b, 0xbe, 0x76, 0x37, 0xe7, 0xa1, 0xa1,
+	0x96, 0x97, 0x4b, 0x51, 0x78, 0xca, 0x1c, 0x22, 0x3f, 0x41, 0xe7, 0x44, 0x4c, 0x73, 0x9e, 0x4d,
+	0x4a, 0xe3, 0xb3, 0xc2, 0xe2, 0x5c, 0x49, 0x0e, 0x99, 0x42, 0xd1, 0x1a, 0xdb, 0x42, 0xd1, 0x76,
+	0x13, 0x76, 0x96, 0xab, 0x53, 0x9f, 0xa8, 0x4f, 0x1d, 0xc2, 0x2b, 0x5d, 0x63, 0x8e, 0x16, 0x8d,
+	0xf5, 0x9a, 0xfc, 0x1a, 0x43, 0x2f, 0xe0, 0xc5, 0xd6, 0xc1, 0x53, 0x5e, 0xf8, 0x1c, 0x16, 0xb9,
+	0x9e, 0xe6, 0x92, 0x65, 0x2e, 0x8d, 0x87, 0xc9, 0x08, 0xba, 0x26, 0x23, 0xd3, 0xab, 0xc2, 0x4a,
+	0xa0, 0x77, 0xb0, 0x3b, 0xc2, 0x15, 0x33, 0x3a, 0xf1, 0x76, 0x5a, 0xbb, 0x78, 0xb1, 0x34, 0x6b,
+	0xb1, 0xd4, 0xbd, 0xb7, 0xac, 0xac, 0x2c, 0x32, 0xec, 0xe6, 0x32, 0x4f, 0x39, 0xca, 0xa1, 0x41,
+	0x2d, 0x70, 0xa2, 0x6c, 0x57, 0xa2, 0xbc, 0x0b, 0x30, 0x35, 0xb7, 0xf9, 0x14, 0x85, 0xd9, 0xc1,
+	0xce, 0x02, 0x8b, 0x89, 0x3e, 0xe3, 0x2c, 0xe3, 0xc5, 0xa0, 0x6b, 0x3b, 0xb2, 0x08, 0x25, 0xca,
+	0x4b, 0x3d, 0x00, 0xcb, 0x9a, 0x39, 0x93, 0xc7, 0xd0, 0x0f, 0xc8, 0x50, 0xc9, 0xfd, 0x5a, 0x20,
+	0xbd, 0x83, 0xc4, 0x75, 0x15, 0x78, 0x58, 0xd1, 0x7c, 0x01, 0xd7, 0xa8, 0xc8, 0xa7, 0x55, 0xb7,
+	0xc9, 0x08, 0x5a, 0x42, 0xf3, 0x85, 0xff, 0xe1, 0xc0, 0xfd, 0x70, 0xc3, 0xe9, 0x58, 0xf3, 0x05,
+	0xb5, 0x6e, 0xe4, 0x18, 0xf6, 0xb6, 0x9e, 0x05, 0x37, 0x68, 0xa2, 0xd4, 0x37, 0x78, 0x27, 0xe4,
+	0x3b, 0xc6, 0x47, 0xb5, 0x81, 0x7c, 0x0b, 0xdd, 0xba, 0x0e, 0x7b, 0xd9, 0x91, 0xbf, 0xec, 0x20,
+	0x64, 0x3c, 0x8c, 0xae, 0x0a, 0x69, 0xf5, 0x12, 0x84, 0xfc, 0x11, 0xfa, 0x46, 0xbc, 0x5f, 0x5f,
+	0xf0, 0xe2, 0x42, 0x70, 0x9c, 0xd3, 0x82, 0xa7, 0xe2, 0xc2, 0x69, 0xa4, 0x41, 0x3d, 0x34, 0x4f,
+	0x4e, 0xed, 0x6c, 0xb8, 0x05, 0xe1, 0xa1, 0x79, 0xa2, 0x4b, 0x7b, 0x43, 0x76, 0x4b, 0x78, 0x48,
+	0x7e, 0x8f, 0xa0, 0x4d, 0xf9, 0x39, 0x8e, 0x47, 0x02, 0x4d, 0x96, 0x65, 0x36, 0x6c, 0x97, 0x36,
+	0x99, 0xb3, 0x9d, 0xcd, 0xd9, 0x14, 0x03, 0xb6, 0x28, 0x9e, 0x8d, 0x30, 0xd2, 0x2a, 0x56, 0x8b,
+	0x5a, 0x60, 0xba, 0xc8, 0x44, 0xc1, 0xf1, 0x62, 0x9c, 0xc2, 0x6b, 0x83, 0x95, 0x81, 0x98, 0xce,
+	0xb4, 0x17, 0x99, 0x45, 0x26, 0x96, 0xc8, 0x33, 0x5e, 0x7a, 0x91, 0x21, 0x20, 0xdf, 0x01, 0x50,
+	0x7e, 0xfe, 0x4d, 0x21, 0x2e, 0x58, 0xba, 0xae, 0xf3, 0x45, 0x57, 0xe6, 0x8b, 0xaf, 0xce, 0xd7,
+	0x08, 0xf3, 0x91, 0x5b, 0xd0, 0x7a, 0xce, 0x4b, 0xb7, 0x5c, 0xcb, 0x6a, 0xb9, 0x96, 0x64, 0x05,
+	0x3d, 0xca, 0x97, 0xf3, 0xf5, 0xa4, 0x3c, 0xce, 0xcf, 0xa4, 0xe9, 0x7b, 0xc6, 0xd4, 0xcc, 0x6f,
+	0x1f, 0x73, 0x0e, 0x62, 0xc6, 0x97, 0xf7, 0xd0, 0x08, 0x7a, 0x48, 0xee, 0xc3, 0x0e, 0xc3, 0x77,
+	0xcd, 0xa0, 0x89, 0x32, 0xec, 0x3b, 0x19, 0xe2, 0x4b, 0x81, 0xba, 0x67, 0xe4, 0x7f, 0xd0, 0xa5,
+	0xfc, 0x7c, 0x52, 0xbe, 0x14, 0x4a, 0x6f, 0x36, 0xda, 0x70, 0x8d, 0x92, 0x71, 0x55, 0x19, 0x3a,
+	0x7d, 0xda, 0x50, 0x1c, 0xc2, 0x2e, 0xc6, 0x55, 0x87, 0xeb, 0x93, 0x99, 0x2c, 0xf4, 0xf1, 0x91,
+	0xc2, 0x45, 0xc5, 0xe6, 0xda, 0xf7, 0x64, 0xce, 0x66, 0x73, 0x2b, 0xf7, 0x1c, 0xd5, 0xdc, 0xa4,
+	0x15, 0x26, 0x14, 0x60, 0x52, 0x3e, 0x67, 0x6a, 0x86, 0x79, 0x4d, 0xf7, 0x4c, 0xcd, 0xb8, 0xf2,
+	0x03, 0x61, 0x51, 0x5d, 0x74, 0x1c, 0x14, 0x1d, 0x2c, 0x95, 0xc6, 0xb0, 0x51, 0x2f, 0x15, 0xf2,
+	0x39, 0xf4, 0x03, 0x9a, 0x55, 0xf2, 0xc8, 0x28, 0x13, 0x8f, 0x1f, 0x75, 0x14, 0x78, 0x51, 0xef,
+	0x42, 0x46, 0x46, 0x17, 0x29, 0x17, 0x4b, 0xfd, 0x52, 0x4e, 0xb7, 0xe6, 0x6b, 0x17, 0x1a, 0x73,
+	0x39, 0x75, 0xc3, 0x65, 0x8e, 0x84, 0x41, 0xdb, 0xf9, 0x6f, 0x39, 0xdf, 0x83, 0xf8, 0xc5, 0x5b,
+	0x6c, 0xb9, 0x77, 0xf0, 0x1f, 0x97, 0xf3, 0x05, 0x5f, 0xbf, 0x65, 0xf3, 0x15, 0xa7, 0xf1, 0x8b,
+	0xb7, 0xc9, 0xff, 0xa1, 0x39, 0x97, 0x53, 0x85, 0xf5, 0xf7, 0x0e, 0xf6, 0xaa, 0xb2, 0x7c, 0x7a,
+	0x8a, 0x8f, 0xc9, 0x11, 0xf4, 0x9c, 0xed, 0x88, 0x69, 0xb6, 0x95, 0xe6, 0x13, 0xa3, 0x98, 0xf7,
+	0xfe, 0xa4, 0xa4, 0x5c, 0xad, 0xe6, 0x3a, 0xd0, 0x59, 0x74, 0xb9, 0xce, 0xac, 0xda, 0x2d, 0x48,
+	0x08, 0x0a, 0xd9, 0x6e, 0xfe, 0xcb, 0xe4, 0x10, 0xeb, 0x32, 0x79, 0x0c, 0xbd, 0xc2, 0xa6, 0xcc,
+	0x98, 0xfb, 0x2c, 0x08, 0x99, 0xae, 0xca, 0xa7, 0xa1, 0x9b, 0x99, 0xb0, 0xd3, 0xb9, 0x4c, 0xdf,
+	0x6b, 0xb1, 0xf0, 0xef, 0x86, 0xda, 0x60, 0x16, 0xbf, 0xcd, 0x80, 0x6f, 0xfd, 0x1d, 0x1c, 0xa4,
+	0xc0, 0x42, 0xfe, 0x88, 0x61, 0x2f, 0xa8, 0xe3, 0x88, 0x6b, 0x26, 0xe6, 0xae, 0xda, 0xe8, 0x6f,
+	0xab, 0x7d, 0x04, 0x6d, 0x57, 0xc6, 0x20, 0xde, 0x70, 0x0c, 0x2b, 0xf5, 0x2e, 0xb8, 0x55, 0x0b,
+	0x29, 0xcf, 0x2c, 0xc7, 0x7d, 0xea, 0x50, 0xc0, 0x62, 0xf3, 0x72, 0x16, 0x5b, 0xe1, 0xb4, 0x6e,
+	0xf4, 0xba, 0xf3, 0x71, 0xaf, 0xf5, 0x97, 0x57, 0x7b, 0xe3, 0xcb, 0xeb, 0x36, 0x74, 0xce, 0x0a,
+	0xb9, 0xc0, 0xad, 0xe9, 0xbe, 0x7b, 0x3c, 0xfe, 0x88, 0x9f, 0xee, 0x16, 0x3f, 0x5f, 0x42, 0xb2,
+	0x45, 0x8f, 0x4a, 0x1e, 0x86, 0xd3, 0x3d, 0xd8, 0x26, 0xc8, 0xfa, 0xd9, 0x19, 0x1f, 0x42, 0xc7,
+	0xad, 0x6e, 0x9c, 0x42, 0x93, 0xd5, 0x7f, 0x4d, 0x59, 0x40, 0xf6, 0xe1, 0x16, 0xe5, 0xe7, 0x47,
+	0x3c, 0x95, 0x19, 0xa7, 0xec, 0x43, 0x10, 0xe7, 0xf2, 0x6f, 0x27, 0xf2, 0x19, 0x74, 0xdf, 0x28,
+	0x5e, 0xbc, 0x2b, 0x84, 0xc6, 0x0f, 0x00, 0x2d, 0x97, 0x22, 0xad, 0x5c, 0x0c, 0x30, 0xef, 0x92,
+	0x54, 0xe6, 0x9a, 0xbb, 0x89, 0xef, 0x52, 0x0f, 0xc9, 0x0f, 0xd0, 0x7b, 0xb3, 0x9c, 0x16, 0x2c,
+	0xe3, 0xaf, 0xb8, 0x66, 0x86, 0x1c, 0xe4, 0x56, 0xe4, 0x53, 0x8c, 0xd0, 0xa1, 0x15, 0x36, 0x41,
+	0x2e, 0x78, 0xa1, 0xfc, 0xea, 0xee, 0x52, 0x0f, 0xaf, 0x5a, 0xdc, 0x87, 0xf7, 0xbe, 0xff, 0xef,
+	0x54, 0xe8, 0xd9, 0xea, 0x74, 0x94, 0xca, 0xc5, 0xfe, 0x78, 0x9c, 0xe6, 0xfb, 0xe9, 0x8c, 0x89,
+	0x7c, 0x3c, 0xde, 0x47, 0x92, 0x4e, 0x77, 0xf0, 0x9f, 0xd4, 0xf8, 0xaf, 0x01, 0x00, 0x29, 0xc7,
+	0x19, 0xe2, 0x73, 0x0d, 0x00, 0x00,
 }
@@ -6,6 +6,8 @@ package types
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"time"
@@ -117,6 +119,10 @@ func (txgroup *Transactions) Check(height int64, minfee int64) error {
 		if err != nil {
 			return err
 		}
+		err = txs[i].checkSignType(height)
+		if err != nil {
+			return err
+		}
 		name := string(txs[i].Execer)
 		if IsParaExecName(name) {
 			para[name] = true
@@ -248,11 +254,11 @@ func (tx *TransactionCache) CheckSign() bool {
 		}
 		if group == nil {
 			//非group，简单校验签名
-			if ok := tx.checkSign(); ok {
+			if ok := signVerifier.VerifyTx(tx.Transaction); ok {
 				tx.signok = 1
 			}
 		} else {
-			if ok := group.CheckSign(); ok {
+			if ok := signVerifier.VerifyTxs(group.Txs, false); ok {
 				tx.signok = 1
 			}
 		}
@@ -316,6 +322,14 @@ func (tx *Transaction) Hash() []byte {
 	return common.Sha256(data)
 }
 
+//CalcTxShortID 压缩区块中交易的短id, 使用区块hash做盐, 同一个交易在不同区块中的短id不同
+func CalcTxShortID(salt []byte, txHash []byte) uint64 {
+	h := sha256.New()
+	h.Write(salt)
+	h.Write(txHash)
+	return binary.LittleEndian.Uint64(h.Sum(nil)[:8])
+}
+
 func (tx *Transaction) Size() int {
 	return Size(tx)
 }
@@ -339,13 +353,25 @@ func (tx *Transaction) CheckSign() bool {
 
 //txgroup 的情况
 func (tx *Transaction) checkSign() bool {
-	copytx := *tx
-	copytx.Signature = nil
-	data := Encode(&copytx)
 	if tx.GetSignature() == nil {
 		return false
 	}
-	return CheckSign(data, string(tx.Execer), tx.GetSignature())
+	return CheckSign(tx.signData(), string(tx.Execer), tx.GetSignature())
+}
+
+//签名的数据是去掉签名之后的交易
+func (tx *Transaction) signData() []byte {
+	copytx := *tx
+	copytx.Signature = nil
+	return Encode(&copytx)
+}
+
+//ForkSchnorr 之前不支持schnorr签名
+func (tx *Transaction) checkSignType(height int64) error {
+	if tx.GetSignature().GetTy() == SCHNORR && !IsFork(height, "ForkSchnorr") {
+		return ErrSign
+	}
+	return nil
 }
 
 func (tx *Transaction) Check(height, minfee int64) error {
@@ -354,6 +380,9 @@ func (tx *Transaction) Check(height, minfee int64) error {
 		return err
 	}
 	if group == nil {
+		if err := tx.checkSignType(height); err != nil {
+			return err
+		}
 		return tx.check(minfee)
 	}
 	return group.Check(height, minfee)
@@ -10,6 +10,8 @@ import (
 
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	_ "github.com/33cn/chain33/system/crypto/init"
 )
@@ -125,3 +127,48 @@ func TestSignGroupTx(t *testing.T) {
 	signedtx := hex.EncodeToString(Encode(newtx))
 	t.Log(signedtx)
 }
+
+func TestCheckSignSchnorr(t *testing.T) {
+	title := GetTitle()
+	Init("chain33", nil)
+	defer Init(title, nil)
+	prev := systemFork.GetFork("chain33", "ForkSchnorr")
+	systemFork.ReplaceFork("chain33", "ForkSchnorr", 10)
+	defer systemFork.ReplaceFork("chain33", "ForkSchnorr", prev)
+
+	secp := getprivkey("CC38546E9E659D15E6B4893F0AB32A06D103931A8230B0BDE71459D2B27D6944")
+	cr, err := crypto.New(GetSignName("", SCHNORR))
+	require.NoError(t, err)
+	schnorr, err := cr.GenKey()
+	require.NoError(t, err)
+
+	block := &Block{Height: 10}
+	for i := 0; i < 10; i++ {
+		tx := &Transaction{Execer: []byte("coins"), Payload: []byte("payload"), Fee: 1000000, Nonce: int64(i)}
+		if i%3 == 0 {
+			tx.Sign(SECP256K1, secp)
+		} else {
+			tx.Sign(SCHNORR, schnorr)
+		}
+		block.Txs = append(block.Txs, tx)
+	}
+	assert.True(t, block.CheckSign())
+	//fork之前逐个验证
+	block.Height = 9
+	assert.True(t, block.CheckSign())
+
+	//fork之前不允许schnorr签名的交易
+	assert.Equal(t, ErrSign, block.Txs[1].Check(9, 0))
+	assert.Nil(t, block.Txs[1].Check(10, 0))
+	assert.Nil(t, block.Txs[0].Check(9, 0))
+
+	block.Height = 10
+	block.Txs[4].Nonce++
+	assert.False(t, block.CheckSign())
+	block.Txs[4].Nonce--
+	block.Txs[3].Nonce++
+	assert.False(t, block.CheckSign())
+	block.Txs[3].Nonce--
+	block.Txs[5].Signature.Signature[40] ^= 1
+	assert.False(t, block.CheckSign())
+}
@@ -364,6 +364,11 @@ func (r *ReceiptData) OutputReceiptDetails(execer []byte, logger log.Logger) {
 	}
 }
 
+//Hash receipt 在 receipt 默克尔树中的叶子节点
+func (r *ReceiptData) Hash() []byte {
+	return common.Sha256(Encode(r))
+}
+
 func (t *ReplyGetTotalCoins) IterateRangeByStateHash(key, value []byte) bool {
 	fmt.Println("ReplyGetTotalCoins.IterateRangeByStateHash", "key", string(key))
 	var acc Account
@@ -43,7 +43,7 @@ type WalletTxDetail struct {
 func (m *WalletTxDetail) Reset()                    { *m = WalletTxDetail{} }
 func (m *WalletTxDetail) String() string            { return proto.CompactTextString(m) }
 func (*WalletTxDetail) ProtoMessage()               {}
-func (*WalletTxDetail) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{0} }
+func (*WalletTxDetail) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{0} }
 
 func (m *WalletTxDetail) GetTx() *Transaction {
 	if m != nil {
@@ -122,7 +122,7 @@ type WalletTxDetails struct {
 func (m *WalletTxDetails) Reset()                    { *m = WalletTxDetails{} }
 func (m *WalletTxDetails) String() string            { return proto.CompactTextString(m) }
 func (*WalletTxDetails) ProtoMessage()               {}
-func (*WalletTxDetails) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{1} }
+func (*WalletTxDetails) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{1} }
 
 func (m *WalletTxDetails) GetTxDetails() []*WalletTxDetail {
 	if m != nil {
@@ -136,17 +136,21 @@ func (m *WalletTxDetails) GetTxDetails() []*WalletTxDetail {
 // 	 label :账户地址对应的标签
 // 	 addr :账户地址
 // 	 timeStamp :创建账户时的时标
+// 	 watchOnly :只读账户, 没有私钥只能查看余额和交易
+// 	 pubkey :只读账户导入时提供的公钥
 type WalletAccountStore struct {
 	Privkey   string `protobuf:"bytes,1,opt,name=privkey" json:"privkey,omitempty"`
 	Label     string `protobuf:"bytes,2,opt,name=label" json:"label,omitempty"`
 	Addr      string `protobuf:"bytes,3,opt,name=addr" json:"addr,omitempty"`
 	TimeStamp string `protobuf:"bytes,4,opt,name=timeStamp" json:"timeStamp,omitempty"`
+	WatchOnly bool   `protobuf:"varint,5,opt,name=watchOnly" json:"watchOnly,omitempty"`
+	Pubkey    string `protobuf:"bytes,6,opt,name=pubkey" json:"pubkey,omitempty"`
 }
 
 func (m *WalletAccountStore) Reset()                    { *m = WalletAccountStore{} }
 func (m *WalletAccountStore) String() string            { return proto.CompactTextString(m) }
 func (*WalletAccountStore) ProtoMessage()               {}
-func (*WalletAccountStore) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{2} }
+func (*WalletAccountStore) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{2} }
 
 func (m *WalletAccountStore) GetPrivkey() string {
 	if m != nil {
@@ -176,6 +180,20 @@ func (m *WalletAccountStore) GetTimeStamp() string {
 	return ""
 }
 
+func (m *WalletAccountStore) GetWatchOnly() bool {
+	if m != nil {
+		return m.WatchOnly
+	}
+	return false
+}
+
+func (m *WalletAccountStore) GetPubkey() string {
+	if m != nil {
+		return m.Pubkey
+	}
+	return ""
+}
+
 // 钱包模块通过一个随机值对钱包密码加密
 // 	 pwHash : 对钱包密码和一个随机值组合进行哈希计算
 // 	 randstr :对钱包密码加密的一个随机值
@@ -187,7 +205,7 @@ type WalletPwHash struct {
 func (m *WalletPwHash) Reset()                    { *m = WalletPwHash{} }
 func (m *WalletPwHash) String() string            { return proto.CompactTextString(m) }
 func (*WalletPwHash) ProtoMessage()               {}
-func (*WalletPwHash) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{3} }
+func (*WalletPwHash) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{3} }
 
 func (m *WalletPwHash) GetPwHash() []byte {
 	if m != nil {
@@ -218,7 +236,7 @@ type WalletStatus struct {
 func (m *WalletStatus) Reset()                    { *m = WalletStatus{} }
 func (m *WalletStatus) String() string            { return proto.CompactTextString(m) }
 func (*WalletStatus) ProtoMessage()               {}
-func (*WalletStatus) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{4} }
+func (*WalletStatus) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{4} }
 
 func (m *WalletStatus) GetIsWalletLock() bool {
 	if m != nil {
@@ -255,7 +273,7 @@ type WalletAccounts struct {
 func (m *WalletAccounts) Reset()                    { *m = WalletAccounts{} }
 func (m *WalletAccounts) String() string            { return proto.CompactTextString(m) }
 func (*WalletAccounts) ProtoMessage()               {}
-func (*WalletAccounts) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{5} }
+func (*WalletAccounts) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{5} }
 
 func (m *WalletAccounts) GetWallets() []*WalletAccount {
 	if m != nil {
@@ -265,14 +283,15 @@ func (m *WalletAccounts) GetWallets() []*WalletAccount {
 }
 
 type WalletAccount struct {
-	Acc   *Account `protobuf:"bytes,1,opt,name=acc" json:"acc,omitempty"`
-	Label string   `protobuf:"bytes,2,opt,name=label" json:"label,omitempty"`
+	Acc       *Account `protobuf:"bytes,1,opt,name=acc" json:"acc,omitempty"`
+	Label     string   `protobuf:"bytes,2,opt,name=label" json:"label,omitempty"`
+	WatchOnly bool     `protobuf:"varint,3,opt,name=watchOnly" json:"watchOnly,omitempty"`
 }
 
 func (m *WalletAccount) Reset()                    { *m = WalletAccount{} }
 func (m *WalletAccount) String() string            { return proto.CompactTextString(m) }
 func (*WalletAccount) ProtoMessage()               {}
-func (*WalletAccount) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{6} }
+func (*WalletAccount) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{6} }
 
 func (m *WalletAccount) GetAcc() *Account {
 	if m != nil {
@@ -288,6 +307,13 @@ func (m *WalletAccount) GetLabel() string {
 	return ""
 }
 
+func (m *WalletAccount) GetWatchOnly() bool {
+	if m != nil {
+		return m.WatchOnly
+	}
+	return false
+}
+
 // 钱包解锁
 // 	 passwd : 钱包密码
 // 	 timeout :钱包解锁时间，0，一直解锁，非0值，超时之后继续锁定
@@ -301,7 +327,7 @@ type WalletUnLock struct {
 func (m *WalletUnLock) Reset()                    { *m = WalletUnLock{} }
 func (m *WalletUnLock) String() string            { return proto.CompactTextString(m) }
 func (*WalletUnLock) ProtoMessage()               {}
-func (*WalletUnLock) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{7} }
+func (*WalletUnLock) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{7} }
 
 func (m *WalletUnLock) GetPasswd() string {
 	if m != nil {
@@ -331,7 +357,7 @@ type GenSeedLang struct {
 func (m *GenSeedLang) Reset()                    { *m = GenSeedLang{} }
 func (m *GenSeedLang) String() string            { return proto.CompactTextString(m) }
 func (*GenSeedLang) ProtoMessage()               {}
-func (*GenSeedLang) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{8} }
+func (*GenSeedLang) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{8} }
 
 func (m *GenSeedLang) GetLang() int32 {
 	if m != nil {
@@ -347,7 +373,7 @@ type GetSeedByPw struct {
 func (m *GetSeedByPw) Reset()                    { *m = GetSeedByPw{} }
 func (m *GetSeedByPw) String() string            { return proto.CompactTextString(m) }
 func (*GetSeedByPw) ProtoMessage()               {}
-func (*GetSeedByPw) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{9} }
+func (*GetSeedByPw) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{9} }
 
 func (m *GetSeedByPw) GetPasswd() string {
 	if m != nil {
@@ -367,7 +393,7 @@ type SaveSeedByPw struct {
 func (m *SaveSeedByPw) Reset()                    { *m = SaveSeedByPw{} }
 func (m *SaveSeedByPw) String() string            { return proto.CompactTextString(m) }
 func (*SaveSeedByPw) ProtoMessage()               {}
-func (*SaveSeedByPw) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{10} }
+func (*SaveSeedByPw) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{10} }
 
 func (m *SaveSeedByPw) GetSeed() string {
 	if m != nil {
@@ -390,7 +416,7 @@ type ReplySeed struct {
 func (m *ReplySeed) Reset()                    { *m = ReplySeed{} }
 func (m *ReplySeed) String() string            { return proto.CompactTextString(m) }
 func (*ReplySeed) ProtoMessage()               {}
-func (*ReplySeed) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{11} }
+func (*ReplySeed) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{11} }
 
 func (m *ReplySeed) GetSeed() string {
 	if m != nil {
@@ -407,7 +433,7 @@ type ReqWalletSetPasswd struct {
 func (m *ReqWalletSetPasswd) Reset()                    { *m = ReqWalletSetPasswd{} }
 func (m *ReqWalletSetPasswd) String() string            { return proto.CompactTextString(m) }
 func (*ReqWalletSetPasswd) ProtoMessage()               {}
-func (*ReqWalletSetPasswd) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{12} }
+func (*ReqWalletSetPasswd) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{12} }
 
 func (m *ReqWalletSetPasswd) GetOldPass() string {
 	if m != nil {
@@ -430,7 +456,7 @@ type ReqNewAccount struct {
 func (m *ReqNewAccount) Reset()                    { *m = ReqNewAccount{} }
 func (m *ReqNewAccount) String() string            { return proto.CompactTextString(m) }
 func (*ReqNewAccount) ProtoMessage()               {}
-func (*ReqNewAccount) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{13} }
+func (*ReqNewAccount) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{13} }
 
 func (m *ReqNewAccount) GetLabel() string {
 	if m != nil {
@@ -454,7 +480,7 @@ type ReqWalletTransactionList struct {
 func (m *ReqWalletTransactionList) Reset()                    { *m = ReqWalletTransactionList{} }
 func (m *ReqWalletTransactionList) String() string            { return proto.CompactTextString(m) }
 func (*ReqWalletTransactionList) ProtoMessage()               {}
-func (*ReqWalletTransactionList) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{14} }
+func (*ReqWalletTransactionList) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{14} }
 
 func (m *ReqWalletTransactionList) GetFromTx() []byte {
 	if m != nil {
@@ -486,7 +512,7 @@ type ReqWalletImportPrivkey struct {
 func (m *ReqWalletImportPrivkey) Reset()                    { *m = ReqWalletImportPrivkey{} }
 func (m *ReqWalletImportPrivkey) String() string            { return proto.CompactTextString(m) }
 func (*ReqWalletImportPrivkey) ProtoMessage()               {}
-func (*ReqWalletImportPrivkey) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{15} }
+func (*ReqWalletImportPrivkey) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{15} }
 
 func (m *ReqWalletImportPrivkey) GetPrivkey() string {
 	if m != nil {
@@ -519,7 +545,7 @@ type ReqWalletSendToAddress struct {
 func (m *ReqWalletSendToAddress) Reset()                    { *m = ReqWalletSendToAddress{} }
 func (m *ReqWalletSendToAddress) String() string            { return proto.CompactTextString(m) }
 func (*ReqWalletSendToAddress) ProtoMessage()               {}
-func (*ReqWalletSendToAddress) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{16} }
+func (*ReqWalletSendToAddress) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{16} }
 
 func (m *ReqWalletSendToAddress) GetFrom() string {
 	if m != nil {
@@ -570,7 +596,7 @@ type ReqWalletSetFee struct {
 func (m *ReqWalletSetFee) Reset()                    { *m = ReqWalletSetFee{} }
 func (m *ReqWalletSetFee) String() string            { return proto.CompactTextString(m) }
 func (*ReqWalletSetFee) ProtoMessage()               {}
-func (*ReqWalletSetFee) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{17} }
+func (*ReqWalletSetFee) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{17} }
 
 func (m *ReqWalletSetFee) GetAmount() int64 {
 	if m != nil {
@@ -587,7 +613,7 @@ type ReqWalletSetLabel struct {
 func (m *ReqWalletSetLabel) Reset()                    { *m = ReqWalletSetLabel{} }
 func (m *ReqWalletSetLabel) String() string            { return proto.CompactTextString(m) }
 func (*ReqWalletSetLabel) ProtoMessage()               {}
-func (*ReqWalletSetLabel) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{18} }
+func (*ReqWalletSetLabel) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{18} }
 
 func (m *ReqWalletSetLabel) GetAddr() string {
 	if m != nil {
@@ -610,7 +636,7 @@ type ReqWalletMergeBalance struct {
 func (m *ReqWalletMergeBalance) Reset()                    { *m = ReqWalletMergeBalance{} }
 func (m *ReqWalletMergeBalance) String() string            { return proto.CompactTextString(m) }
 func (*ReqWalletMergeBalance) ProtoMessage()               {}
-func (*ReqWalletMergeBalance) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{19} }
+func (*ReqWalletMergeBalance) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{19} }
 
 func (m *ReqWalletMergeBalance) GetTo() string {
 	if m != nil {
@@ -632,7 +658,7 @@ type ReqTokenPreCreate struct {
 func (m *ReqTokenPreCreate) Reset()                    { *m = ReqTokenPreCreate{} }
 func (m *ReqTokenPreCreate) String() string            { return proto.CompactTextString(m) }
 func (*ReqTokenPreCreate) ProtoMessage()               {}
-func (*ReqTokenPreCreate) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{20} }
+func (*ReqTokenPreCreate) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{20} }
 
 func (m *ReqTokenPreCreate) GetCreatorAddr() string {
 	if m != nil {
@@ -692,7 +718,7 @@ type ReqTokenFinishCreate struct {
 func (m *ReqTokenFinishCreate) Reset()                    { *m = ReqTokenFinishCreate{} }
 func (m *ReqTokenFinishCreate) String() string            { return proto.CompactTextString(m) }
 func (*ReqTokenFinishCreate) ProtoMessage()               {}
-func (*ReqTokenFinishCreate) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{21} }
+func (*ReqTokenFinishCreate) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{21} }
 
 func (m *ReqTokenFinishCreate) GetFinisherAddr() string {
 	if m != nil {
@@ -724,7 +750,7 @@ type ReqTokenRevokeCreate struct {
 func (m *ReqTokenRevokeCreate) Reset()                    { *m = ReqTokenRevokeCreate{} }
 func (m *ReqTokenRevokeCreate) String() string            { return proto.CompactTextString(m) }
 func (*ReqTokenRevokeCreate) ProtoMessage()               {}
-func (*ReqTokenRevokeCreate) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{22} }
+func (*ReqTokenRevokeCreate) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{22} }
 
 func (m *ReqTokenRevokeCreate) GetRevokerAddr() string {
 	if m != nil {
@@ -757,7 +783,7 @@ type ReqModifyConfig struct {
 func (m *ReqModifyConfig) Reset()                    { *m = ReqModifyConfig{} }
 func (m *ReqModifyConfig) String() string            { return proto.CompactTextString(m) }
 func (*ReqModifyConfig) ProtoMessage()               {}
-func (*ReqModifyConfig) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{23} }
+func (*ReqModifyConfig) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{23} }
 
 func (m *ReqModifyConfig) GetKey() string {
 	if m != nil {
@@ -803,7 +829,7 @@ type ReqSignRawTx struct {
 func (m *ReqSignRawTx) Reset()                    { *m = ReqSignRawTx{} }
 func (m *ReqSignRawTx) String() string            { return proto.CompactTextString(m) }
 func (*ReqSignRawTx) ProtoMessage()               {}
-func (*ReqSignRawTx) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{24} }
+func (*ReqSignRawTx) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{24} }
 
 func (m *ReqSignRawTx) GetAddr() string {
 	if m != nil {
@@ -854,7 +880,7 @@ type ReplySignRawTx struct {
 func (m *ReplySignRawTx) Reset()                    { *m = ReplySignRawTx{} }
 func (m *ReplySignRawTx) String() string            { return proto.CompactTextString(m) }
 func (*ReplySignRawTx) ProtoMessage()               {}
-func (*ReplySignRawTx) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{25} }
+func (*ReplySignRawTx) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{25} }
 
 func (m *ReplySignRawTx) GetTxHex() string {
 	if m != nil {
@@ -872,7 +898,7 @@ type ReportErrEvent struct {
 func (m *ReportErrEvent) Reset()                    { *m = ReportErrEvent{} }
 func (m *ReportErrEvent) String() string            { return proto.CompactTextString(m) }
 func (*ReportErrEvent) ProtoMessage()               {}
-func (*ReportErrEvent) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{26} }
+func (*ReportErrEvent) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{26} }
 
 func (m *ReportErrEvent) GetFrommodule() string {
 	if m != nil {
@@ -902,7 +928,7 @@ type Int32 struct {
 func (m *Int32) Reset()                    { *m = Int32{} }
 func (m *Int32) String() string            { return proto.CompactTextString(m) }
 func (*Int32) ProtoMessage()               {}
-func (*Int32) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{27} }
+func (*Int32) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{27} }
 
 func (m *Int32) GetData() int32 {
 	if m != nil {
@@ -935,7 +961,7 @@ type ReqCreateTransaction struct {
 func (m *ReqCreateTransaction) Reset()                    { *m = ReqCreateTransaction{} }
 func (m *ReqCreateTransaction) String() string            { return proto.CompactTextString(m) }
 func (*ReqCreateTransaction) ProtoMessage()               {}
-func (*ReqCreateTransaction) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{28} }
+func (*ReqCreateTransaction) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{28} }
 
 func (m *ReqCreateTransaction) GetTokenname() string {
 	if m != nil {
@@ -1007,7 +1033,7 @@ type ReqAccountList struct {
 func (m *ReqAccountList) Reset()                    { *m = ReqAccountList{} }
 func (m *ReqAccountList) String() string            { return proto.CompactTextString(m) }
 func (*ReqAccountList) ProtoMessage()               {}
-func (*ReqAccountList) Descriptor() ([]byte, []int) { return fileDescriptor10, []int{29} }
+func (*ReqAccountList) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{29} }
 
 func (m *ReqAccountList) GetWithoutBalance() bool {
 	if m != nil {
@@ -1016,6 +1042,206 @@ func (m *ReqAccountList) GetWithoutBalance() bool {
 	return false
 }
 
+// 按照BIP-44路径从钱包种子推导账户, path格式 m/44'/coin'/account'/change/index
+type ReqDeriveAccount struct {
+	Label string `protobuf:"bytes,1,opt,name=label" json:"label,omitempty"`
+	Path  string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+}
+
+func (m *ReqDeriveAccount) Reset()                    { *m = ReqDeriveAccount{} }
+func (m *ReqDeriveAccount) String() string            { return proto.CompactTextString(m) }
+func (*ReqDeriveAccount) ProtoMessage()               {}
+func (*ReqDeriveAccount) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{30} }
+
+func (m *ReqDeriveAccount) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *ReqDeriveAccount) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+// 导出 m/44'/coin'/account' 的扩展公钥
+type ReqExtendedPubKey struct {
+	Account uint32 `protobuf:"varint,1,opt,name=account" json:"account,omitempty"`
+}
+
+func (m *ReqExtendedPubKey) Reset()                    { *m = ReqExtendedPubKey{} }
+func (m *ReqExtendedPubKey) String() string            { return proto.CompactTextString(m) }
+func (*ReqExtendedPubKey) ProtoMessage()               {}
+func (*ReqExtendedPubKey) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{31} }
+
+func (m *ReqExtendedPubKey) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+// 扫描链上交易记录发现已使用的账户, 连续gapLimit个地址未使用时停止
+type ReqDiscoverAccounts struct {
+	Account  uint32 `protobuf:"varint,1,opt,name=account" json:"account,omitempty"`
+	GapLimit int32  `protobuf:"varint,2,opt,name=gapLimit" json:"gapLimit,omitempty"`
+}
+
+func (m *ReqDiscoverAccounts) Reset()                    { *m = ReqDiscoverAccounts{} }
+func (m *ReqDiscoverAccounts) String() string            { return proto.CompactTextString(m) }
+func (*ReqDiscoverAccounts) ProtoMessage()               {}
+func (*ReqDiscoverAccounts) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{32} }
+
+func (m *ReqDiscoverAccounts) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *ReqDiscoverAccounts) GetGapLimit() int32 {
+	if m != nil {
+		return m.GapLimit
+	}
+	return 0
+}
+
+// 导入只读账户, addr和pubkey至少提供一个
+type ReqImportWatchOnly struct {
+	Addr   string `protobuf:"bytes,1,opt,name=addr" json:"addr,omitempty"`
+	Pubkey string `protobuf:"bytes,2,opt,name=pubkey" json:"pubkey,omitempty"`
+	Label  string `protobuf:"bytes,3,opt,name=label" json:"label,omitempty"`
+}
+
+func (m *ReqImportWatchOnly) Reset()                    { *m = ReqImportWatchOnly{} }
+func (m *ReqImportWatchOnly) String() string            { return proto.CompactTextString(m) }
+func (*ReqImportWatchOnly) ProtoMessage()               {}
+func (*ReqImportWatchOnly) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{33} }
+
+func (m *ReqImportWatchOnly) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqImportWatchOnly) GetPubkey() string {
+	if m != nil {
+		return m.Pubkey
+	}
+	return ""
+}
+
+func (m *ReqImportWatchOnly) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+// 离线签名使用的未签名交易, 可以在隔离网络的节点上通过SignRawTx签名
+type OfflineTx struct {
+	Addr   string `protobuf:"bytes,1,opt,name=addr" json:"addr,omitempty"`
+	TxHex  string `protobuf:"bytes,2,opt,name=txHex" json:"txHex,omitempty"`
+	Expire string `protobuf:"bytes,3,opt,name=expire" json:"expire,omitempty"`
+}
+
+func (m *OfflineTx) Reset()                    { *m = OfflineTx{} }
+func (m *OfflineTx) String() string            { return proto.CompactTextString(m) }
+func (*OfflineTx) ProtoMessage()               {}
+func (*OfflineTx) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{34} }
+
+func (m *OfflineTx) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *OfflineTx) GetTxHex() string {
+	if m != nil {
+		return m.TxHex
+	}
+	return ""
+}
+
+func (m *OfflineTx) GetExpire() string {
+	if m != nil {
+		return m.Expire
+	}
+	return ""
+}
+
+// 导出钱包备份, passwd用于加密备份数据, 可以和钱包密码不同
+type ReqWalletExport struct {
+	Passwd string `protobuf:"bytes,1,opt,name=passwd" json:"passwd,omitempty"`
+}
+
+func (m *ReqWalletExport) Reset()                    { *m = ReqWalletExport{} }
+func (m *ReqWalletExport) String() string            { return proto.CompactTextString(m) }
+func (*ReqWalletExport) ProtoMessage()               {}
+func (*ReqWalletExport) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{35} }
+
+func (m *ReqWalletExport) GetPasswd() string {
+	if m != nil {
+		return m.Passwd
+	}
+	return ""
+}
+
+// 从备份中恢复seed和账户, data是ExportWallet返回的备份数据
+type ReqWalletImport struct {
+	Data   string `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Passwd string `protobuf:"bytes,2,opt,name=passwd" json:"passwd,omitempty"`
+}
+
+func (m *ReqWalletImport) Reset()                    { *m = ReqWalletImport{} }
+func (m *ReqWalletImport) String() string            { return proto.CompactTextString(m) }
+func (*ReqWalletImport) ProtoMessage()               {}
+func (*ReqWalletImport) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{36} }
+
+func (m *ReqWalletImport) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *ReqWalletImport) GetPasswd() string {
+	if m != nil {
+		return m.Passwd
+	}
+	return ""
+}
+
+// 钱包备份的内容, 加密之后保存在备份数据中, 账户的privkey是未加密的私钥
+type WalletBackup struct {
+	Seed     string                `protobuf:"bytes,1,opt,name=seed" json:"seed,omitempty"`
+	Accounts []*WalletAccountStore `protobuf:"bytes,2,rep,name=accounts" json:"accounts,omitempty"`
+}
+
+func (m *WalletBackup) Reset()                    { *m = WalletBackup{} }
+func (m *WalletBackup) String() string            { return proto.CompactTextString(m) }
+func (*WalletBackup) ProtoMessage()               {}
+func (*WalletBackup) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{37} }
+
+func (m *WalletBackup) GetSeed() string {
+	if m != nil {
+		return m.Seed
+	}
+	return ""
+}
+
+func (m *WalletBackup) GetAccounts() []*WalletAccountStore {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*WalletTxDetail)(nil), "types.WalletTxDetail")
 	proto.RegisterType((*WalletTxDetails)(nil), "types.WalletTxDetails")
@@ -1047,90 +1273,110 @@ func init() {
 	proto.RegisterType((*Int32)(nil), "types.Int32")
 	proto.RegisterType((*ReqCreateTransaction)(nil), "types.ReqCreateTransaction")
 	proto.RegisterType((*ReqAccountList)(nil), "types.ReqAccountList")
-}
-
-func init() { proto.RegisterFile("wallet.proto", fileDescriptor10) }
-
-var fileDescriptor10 = []byte{
-	// 1269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6e, 0x1b, 0x37,
-	0x13, 0xc5, 0x4a, 0x96, 0x6d, 0xd1, 0xb2, 0x93, 0x10, 0x49, 0xb0, 0xf0, 0xf7, 0x35, 0x55, 0xd8,
-	0x26, 0x75, 0x81, 0xc2, 0x05, 0xa2, 0x9b, 0xa2, 0x40, 0x81, 0x38, 0xbf, 0x0e, 0xe0, 0xa4, 0x06,
-	0xa5, 0xa2, 0x40, 0x6f, 0x0a, 0x6a, 0x97, 0x96, 0x08, 0xad, 0x96, 0x6b, 0x2e, 0xf5, 0xf7, 0x22,
-	0x45, 0x1f, 0xa0, 0x8f, 0xd0, 0x17, 0xe9, 0x7b, 0xf4, 0x21, 0x8a, 0x19, 0x92, 0xab, 0x5d, 0xc7,
-	0xbd, 0x08, 0x7a, 0xb5, 0x3c, 0xb3, 0xc3, 0x19, 0xce, 0x19, 0xf2, 0x90, 0xa4, 0xb7, 0x12, 0x59,
-	0x26, 0xed, 0x69, 0x61, 0xb4, 0xd5, 0xb4, 0x63, 0x37, 0x85, 0x2c, 0x8f, 0xef, 0x59, 0x23, 0xf2,
-	0x52, 0x24, 0x56, 0xe9, 0xdc, 0xfd, 0x39, 0xbe, 0x3b, 0xce, 0x74, 0x32, 0x4b, 0xa6, 0x42, 0x05,
-	0xcb, 0xa1, 0x48, 0x12, 0xbd, 0xc8, 0xfd, 0xd4, 0xe3, 0x23, 0xb9, 0x96, 0xc9, 0xc2, 0x6a, 0xe3,
-	0x30, 0xfb, 0xb3, 0x45, 0x8e, 0x7e, 0xc6, 0xd8, 0xa3, 0xf5, 0x2b, 0x69, 0x85, 0xca, 0x28, 0x23,
-	0x2d, 0xbb, 0x8e, 0xa3, 0x7e, 0x74, 0x72, 0xf0, 0x8c, 0x9e, 0x62, 0xaa, 0xd3, 0xd1, 0x36, 0x13,
-	0x6f, 0xd9, 0x35, 0xfd, 0x86, 0xec, 0x19, 0x99, 0x48, 0x55, 0xd8, 0xb8, 0xd5, 0x70, 0xe4, 0xce,
-	0xfa, 0x4a, 0x58, 0xc1, 0x83, 0x0b, 0x7d, 0x48, 0x76, 0xa7, 0x52, 0x4d, 0xa6, 0x36, 0x6e, 0xf7,
-	0xa3, 0x93, 0x36, 0xf7, 0x88, 0xde, 0x27, 0x1d, 0x95, 0xa7, 0x72, 0x1d, 0xef, 0xa0, 0xd9, 0x01,
-	0xfa, 0x7f, 0xd2, 0xc5, 0x2a, 0xac, 0x9a, 0xcb, 0xb8, 0x83, 0x7f, 0xb6, 0x06, 0x88, 0x25, 0xe6,
-	0x50, 0x50, 0xbc, 0xeb, 0x62, 0x39, 0x44, 0x8f, 0xc9, 0xfe, 0x95, 0xd1, 0x73, 0x91, 0xa6, 0x26,
-	0xde, 0xeb, 0x47, 0x27, 0x5d, 0x5e, 0x61, 0x98, 0x63, 0xd7, 0x53, 0x51, 0x4e, 0xe3, 0xfd, 0x7e,
-	0x74, 0xd2, 0xe3, 0x1e, 0xd1, 0x47, 0x84, 0xb8, 0x9a, 0x3e, 0x88, 0xb9, 0x8c, 0xbb, 0x38, 0xab,
-	0x66, 0xa1, 0x31, 0xd9, 0x2b, 0xc4, 0x26, 0xd3, 0x22, 0x8d, 0x09, 0x4e, 0x0c, 0x90, 0xbd, 0x21,
-	0x77, 0x9a, 0xac, 0x95, 0x74, 0x40, 0xba, 0x36, 0x80, 0x38, 0xea, 0xb7, 0x4f, 0x0e, 0x9e, 0x3d,
-	0xf0, 0xa4, 0x34, 0x5d, 0xf9, 0xd6, 0x8f, 0x2d, 0x09, 0x75, 0x3f, 0xcf, 0x5c, 0x97, 0x86, 0x56,
-	0x1b, 0x97, 0xd7, 0xa8, 0xe5, 0x4c, 0x6e, 0xb0, 0x0d, 0x5d, 0x1e, 0x20, 0x30, 0x96, 0x89, 0xb1,
-	0xcc, 0x90, 0xf5, 0x2e, 0x77, 0x80, 0x52, 0xb2, 0x83, 0x75, 0xb7, 0xd1, 0x88, 0x63, 0x60, 0x11,
-	0xf8, 0x1a, 0x5a, 0x31, 0x2f, 0x90, 0xdf, 0x2e, 0xdf, 0x1a, 0xd8, 0x73, 0xd2, 0x73, 0x79, 0x2f,
-	0x57, 0xe7, 0xc0, 0xc4, 0x43, 0xb2, 0x5b, 0xe0, 0x08, 0x13, 0xf6, 0xb8, 0x47, 0xb0, 0x12, 0x23,
-	0xf2, 0xb4, 0xb4, 0xc6, 0x67, 0x0c, 0x90, 0xfd, 0x1e, 0x85, 0x10, 0x43, 0x2b, 0xec, 0xa2, 0xa4,
-	0x8c, 0xf4, 0x54, 0xe9, 0x2c, 0x17, 0x3a, 0x99, 0x61, 0xa0, 0x7d, 0xde, 0xb0, 0x39, 0x9f, 0xb3,
-	0x85, 0xd5, 0xef, 0x55, 0xae, 0xf2, 0x09, 0xc6, 0x44, 0x9f, 0xad, 0x0d, 0x16, 0xae, 0xca, 0x73,
-	0x51, 0x0e, 0xa5, 0x4c, 0xb1, 0xa2, 0x7d, 0xbe, 0x35, 0xb8, 0x08, 0x23, 0x95, 0xcc, 0x7c, 0x96,
-	0x9d, 0x10, 0x61, 0x6b, 0x63, 0xcf, 0xc3, 0x96, 0xf6, 0xa4, 0x96, 0xf4, 0x94, 0xec, 0xb9, 0x03,
-	0x14, 0x3a, 0x73, 0xbf, 0xd1, 0x19, 0xef, 0xc7, 0x83, 0x13, 0x7b, 0x4b, 0x0e, 0x1b, 0x7f, 0x68,
-	0x9f, 0xb4, 0x45, 0x92, 0xf8, 0x43, 0x71, 0xe4, 0x27, 0x87, 0x69, 0xf0, 0xeb, 0xf6, 0xce, 0xb0,
-	0x69, 0x20, 0xe9, 0xa7, 0x1c, 0x09, 0x00, 0x9e, 0x45, 0x59, 0xae, 0x52, 0xdf, 0x58, 0x8f, 0x80,
-	0x67, 0x68, 0x8e, 0x5e, 0xb8, 0xf3, 0xd4, 0xe6, 0x01, 0xd2, 0xa7, 0xe4, 0xc8, 0xad, 0xea, 0x47,
-	0xe3, 0x4a, 0xf4, 0x9c, 0xdc, 0xb0, 0xb2, 0xc7, 0xe4, 0xe0, 0xad, 0xcc, 0x81, 0xa3, 0x0b, 0x91,
-	0x4f, 0x60, 0x4b, 0x64, 0x22, 0x9f, 0x60, 0x9a, 0x0e, 0xc7, 0x31, 0x7b, 0x02, 0x2e, 0x16, 0x5c,
-	0x5e, 0x6c, 0x2e, 0x57, 0xff, 0xb6, 0x16, 0xf6, 0x3d, 0xe9, 0x0d, 0xc5, 0x52, 0x56, 0x7e, 0x94,
-	0xec, 0x94, 0xd0, 0x0b, 0xe7, 0x85, 0xe3, 0xda, 0xdc, 0x56, 0x63, 0xee, 0xe7, 0xa4, 0xcb, 0x65,
-	0x91, 0x6d, 0xb0, 0x57, 0xb7, 0x4c, 0x64, 0xe7, 0x84, 0x72, 0x79, 0xed, 0x37, 0x8e, 0xb4, 0x97,
-	0x55, 0xf9, 0x3a, 0x4b, 0x01, 0x84, 0x0d, 0xef, 0x21, 0xfc, 0xc9, 0xe5, 0x0a, 0xff, 0xf8, 0x0d,
-	0xe8, 0x21, 0x7b, 0x42, 0x0e, 0xb9, 0xbc, 0xfe, 0x20, 0x57, 0xa1, 0x47, 0x55, 0x07, 0xa2, 0x7a,
-	0x07, 0xae, 0x48, 0x5c, 0x25, 0xac, 0xa9, 0xd8, 0x85, 0x2a, 0x51, 0x97, 0x40, 0x23, 0x46, 0xeb,
-	0xb0, 0xeb, 0x1d, 0x82, 0x48, 0x18, 0x12, 0x53, 0x76, 0xb8, 0x03, 0xb0, 0x31, 0x53, 0x65, 0x24,
-	0x4e, 0xc7, 0x26, 0x74, 0xf8, 0xd6, 0xc0, 0xce, 0xc9, 0xc3, 0x2a, 0xcf, 0xbb, 0x79, 0xa1, 0x8d,
-	0xbd, 0xf4, 0x67, 0xf6, 0x13, 0x4f, 0x33, 0xfb, 0x23, 0xaa, 0x85, 0x1a, 0xca, 0x3c, 0x1d, 0xe9,
-	0xb3, 0x34, 0x35, 0xb2, 0x2c, 0x81, 0x51, 0x58, 0x62, 0x60, 0x14, 0xc6, 0xf4, 0x88, 0xb4, 0xac,
-	0xf6, 0x11, 0x5a, 0x56, 0xd7, 0x04, 0xb2, 0xdd, 0x10, 0x48, 0x4a, 0x76, 0x72, 0x6d, 0xa5, 0xd7,
-	0x02, 0x1c, 0xc3, 0xd2, 0x54, 0x39, 0xd2, 0x33, 0x99, 0xa3, 0xd0, 0xee, 0xf3, 0x00, 0x69, 0x9f,
-	0x1c, 0x58, 0x18, 0x0c, 0x37, 0xf3, 0xb1, 0xce, 0x50, 0x6b, 0xbb, 0xbc, 0x6e, 0x62, 0x5f, 0x93,
-	0x3b, 0xf5, 0x4e, 0xbe, 0x91, 0x75, 0x6d, 0x8e, 0xea, 0xa9, 0xd9, 0x0f, 0xe4, 0x5e, 0xdd, 0xf5,
-	0xa2, 0x21, 0x5a, 0x51, 0x4d, 0xb4, 0x6e, 0x27, 0xe4, 0x2b, 0xf2, 0xa0, 0x9a, 0xfe, 0x5e, 0x9a,
-	0x89, 0x7c, 0x21, 0x32, 0x91, 0x27, 0xd2, 0x97, 0x1e, 0x85, 0xd2, 0xd9, 0x5f, 0x11, 0x26, 0xc2,
-	0x0a, 0x2e, 0x8d, 0x7c, 0x69, 0xa4, 0xb0, 0x92, 0x3e, 0x26, 0xbd, 0x04, 0x46, 0xda, 0xfc, 0x5a,
-	0x4b, 0x78, 0xe0, 0x6d, 0x40, 0x2d, 0x72, 0x03, 0x57, 0x40, 0xcb, 0x73, 0x23, 0xdc, 0x45, 0x53,
-	0xba, 0xe2, 0x9d, 0xac, 0x7a, 0x84, 0x0a, 0x94, 0x5b, 0xa3, 0xd3, 0x85, 0xdb, 0x09, 0x8e, 0xcf,
-	0x86, 0x8d, 0x7e, 0x46, 0x88, 0x5e, 0xe5, 0xd2, 0x27, 0xec, 0x38, 0xf5, 0x45, 0xcb, 0x99, 0x2f,
-	0xd3, 0x6a, 0x2b, 0x32, 0x7f, 0x85, 0x39, 0x00, 0xd6, 0xc2, 0xa8, 0x44, 0xe2, 0xf5, 0xd5, 0xe6,
-	0x0e, 0x30, 0x43, 0xee, 0x87, 0x92, 0xde, 0xa8, 0x5c, 0x95, 0x53, 0x5f, 0xd5, 0x17, 0xe4, 0xf0,
-	0x0a, 0xb1, 0x6c, 0x94, 0xd5, 0x0b, 0xc6, 0x33, 0x7f, 0xf1, 0xf9, 0x1a, 0x5a, 0x8d, 0x1a, 0x9a,
-	0xeb, 0x6b, 0xdf, 0x58, 0x1f, 0x2b, 0xb6, 0x39, 0xb9, 0x5c, 0xea, 0x59, 0x8d, 0x49, 0x83, 0xb8,
-	0xc9, 0xa4, 0xb7, 0xfd, 0x97, 0x8c, 0x12, 0x37, 0xd3, 0x7b, 0x9d, 0xaa, 0xab, 0xcd, 0x4b, 0x9d,
-	0x5f, 0xa9, 0x09, 0xbd, 0x4b, 0xda, 0xdb, 0x23, 0x03, 0x43, 0x68, 0xb7, 0x2e, 0xc2, 0x4e, 0xd7,
-	0x05, 0x10, 0xb6, 0x14, 0xd9, 0x42, 0xfa, 0x70, 0x0e, 0xc0, 0x43, 0x60, 0x0e, 0x71, 0x94, 0x34,
-	0xbe, 0x37, 0x15, 0x66, 0xbf, 0x45, 0xa4, 0xc7, 0xe5, 0xf5, 0x50, 0x4d, 0x72, 0x2e, 0x56, 0xa3,
-	0xf5, 0xad, 0x9b, 0xb0, 0x76, 0x5e, 0x5b, 0x1f, 0x9d, 0x57, 0xbb, 0x3e, 0x97, 0xeb, 0x90, 0x10,
-	0x01, 0x94, 0x2c, 0xd7, 0x85, 0x32, 0xe1, 0x68, 0x79, 0xb4, 0x7d, 0xdd, 0x74, 0x9c, 0x8a, 0xb8,
-	0xd7, 0x0d, 0xf6, 0x1e, 0x0e, 0xdc, 0x9e, 0x8f, 0x01, 0x80, 0x3d, 0x25, 0x47, 0x4e, 0x37, 0xab,
-	0x95, 0x55, 0xb9, 0xa2, 0x5a, 0x2e, 0x36, 0x46, 0x3f, 0x6d, 0xec, 0x6b, 0x63, 0x5e, 0x2f, 0x65,
-	0x6e, 0xe1, 0x0d, 0x03, 0x32, 0x30, 0xd7, 0xe9, 0x22, 0x93, 0xde, 0xb9, 0x66, 0x01, 0x3a, 0xac,
-	0xf6, 0x7f, 0x5d, 0x39, 0x15, 0x86, 0x1c, 0xd2, 0x18, 0x1d, 0xfa, 0xe1, 0x00, 0xfb, 0x1f, 0xe9,
-	0xbc, 0xcb, 0xed, 0xe0, 0x19, 0x90, 0x93, 0x0a, 0x2b, 0xc2, 0x1d, 0x02, 0x63, 0xf6, 0x77, 0x84,
-	0x7b, 0xc3, 0x6d, 0x88, 0x9a, 0x9e, 0xe2, 0x7b, 0x03, 0x4a, 0xc1, 0x73, 0x14, 0xf9, 0xf7, 0x46,
-	0x30, 0x40, 0x28, 0xb8, 0x33, 0xbd, 0xa0, 0xe2, 0xf8, 0x93, 0x84, 0x2a, 0x08, 0x5f, 0xe7, 0x23,
-	0xe1, 0xdb, 0xad, 0x84, 0xef, 0x11, 0x21, 0xc5, 0x62, 0x3c, 0x93, 0x9b, 0x42, 0x28, 0x83, 0x0f,
-	0xb6, 0x2e, 0xaf, 0x59, 0x70, 0x63, 0xa8, 0xb5, 0x13, 0xf6, 0x03, 0x5c, 0x47, 0x85, 0x6b, 0x3d,
-	0xec, 0xb9, 0xb5, 0x38, 0xc4, 0xbe, 0x03, 0xbe, 0xaf, 0xfd, 0x0d, 0x83, 0x77, 0x06, 0xdc, 0xc7,
-	0xca, 0x4e, 0xf5, 0xc2, 0x7a, 0x15, 0xf2, 0x0f, 0x9d, 0x1b, 0xd6, 0x17, 0x5f, 0xfe, 0xc2, 0x26,
-	0xca, 0x66, 0x62, 0x7c, 0x3a, 0x18, 0x9c, 0x26, 0xf9, 0xb7, 0xf8, 0x26, 0x1f, 0x0c, 0xaa, 0x2f,
-	0x3e, 0x21, 0xc6, 0xbb, 0xf8, 0x0a, 0x1f, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0x83, 0xc0, 0x0c,
-	0x7d, 0xe0, 0x0b, 0x00, 0x00,
+	proto.RegisterType((*ReqDeriveAccount)(nil), "types.ReqDeriveAccount")
+	proto.RegisterType((*ReqExtendedPubKey)(nil), "types.ReqExtendedPubKey")
+	proto.RegisterType((*ReqDiscoverAccounts)(nil), "types.ReqDiscoverAccounts")
+	proto.RegisterType((*ReqImportWatchOnly)(nil), "types.ReqImportWatchOnly")
+	proto.RegisterType((*OfflineTx)(nil), "types.OfflineTx")
+	proto.RegisterType((*ReqWalletExport)(nil), "types.ReqWalletExport")
+	proto.RegisterType((*ReqWalletImport)(nil), "types.ReqWalletImport")
+	proto.RegisterType((*WalletBackup)(nil), "types.WalletBackup")
+}
+
+func init() { proto.RegisterFile("wallet.proto", fileDescriptor12) }
+
+var fileDescriptor12 = []byte{
+	// 1466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xe1, 0x6e, 0xdb, 0xb6,
+	0x13, 0x87, 0xec, 0x38, 0x89, 0x19, 0x27, 0x6d, 0xf5, 0x6f, 0x0b, 0xff, 0xb3, 0xb5, 0x4d, 0x39,
+	0xb4, 0xcb, 0x80, 0x2d, 0x05, 0x1a, 0x0c, 0x18, 0x86, 0x15, 0x68, 0xd2, 0xa6, 0x4b, 0xd1, 0xa4,
+	0x0d, 0xe4, 0x6c, 0xc5, 0xf6, 0x65, 0xa0, 0xa5, 0x8b, 0x4d, 0x44, 0x16, 0x15, 0x8a, 0x8e, 0xed,
+	0x17, 0x19, 0xf6, 0x00, 0xfb, 0xb8, 0x8f, 0x7b, 0x91, 0xbd, 0xc7, 0x1e, 0x62, 0xb8, 0x23, 0x29,
+	0x4b, 0xa9, 0x33, 0xa0, 0xd8, 0x37, 0xfe, 0x4e, 0xc7, 0x3b, 0xde, 0xef, 0xc8, 0xbb, 0x13, 0xeb,
+	0x4c, 0x44, 0x9a, 0x82, 0xd9, 0xc9, 0xb5, 0x32, 0x2a, 0x6c, 0x99, 0x59, 0x0e, 0xc5, 0xe6, 0x2d,
+	0xa3, 0x45, 0x56, 0x88, 0xd8, 0x48, 0x95, 0xd9, 0x2f, 0x9b, 0x37, 0xfb, 0xa9, 0x8a, 0xcf, 0xe3,
+	0xa1, 0x90, 0x5e, 0xb2, 0x2e, 0xe2, 0x58, 0x8d, 0x33, 0xb7, 0x75, 0x73, 0x03, 0xa6, 0x10, 0x8f,
+	0x8d, 0xd2, 0x16, 0xf3, 0x3f, 0x1b, 0x6c, 0xe3, 0x3d, 0xd9, 0x3e, 0x9d, 0xbe, 0x04, 0x23, 0x64,
+	0x1a, 0x72, 0xd6, 0x30, 0xd3, 0x6e, 0xb0, 0x15, 0x6c, 0xaf, 0x3d, 0x0d, 0x77, 0xc8, 0xd5, 0xce,
+	0xe9, 0xdc, 0x53, 0xd4, 0x30, 0xd3, 0xf0, 0x4b, 0xb6, 0xa2, 0x21, 0x06, 0x99, 0x9b, 0x6e, 0xa3,
+	0xa6, 0x18, 0x59, 0xe9, 0x4b, 0x61, 0x44, 0xe4, 0x55, 0xc2, 0xbb, 0x6c, 0x79, 0x08, 0x72, 0x30,
+	0x34, 0xdd, 0xe6, 0x56, 0xb0, 0xdd, 0x8c, 0x1c, 0x0a, 0x6f, 0xb3, 0x96, 0xcc, 0x12, 0x98, 0x76,
+	0x97, 0x48, 0x6c, 0x41, 0xf8, 0x29, 0x6b, 0x53, 0x14, 0x46, 0x8e, 0xa0, 0xdb, 0xa2, 0x2f, 0x73,
+	0x01, 0xda, 0x12, 0x23, 0x0c, 0xa8, 0xbb, 0x6c, 0x6d, 0x59, 0x14, 0x6e, 0xb2, 0xd5, 0x33, 0xad,
+	0x46, 0x22, 0x49, 0x74, 0x77, 0x65, 0x2b, 0xd8, 0x6e, 0x47, 0x25, 0xc6, 0x3d, 0x66, 0x3a, 0x14,
+	0xc5, 0xb0, 0xbb, 0xba, 0x15, 0x6c, 0x77, 0x22, 0x87, 0xc2, 0xfb, 0x8c, 0xd9, 0x98, 0xde, 0x8a,
+	0x11, 0x74, 0xdb, 0xb4, 0xab, 0x22, 0x09, 0xbb, 0x6c, 0x25, 0x17, 0xb3, 0x54, 0x89, 0xa4, 0xcb,
+	0x68, 0xa3, 0x87, 0xfc, 0x15, 0xbb, 0x51, 0x67, 0xad, 0x08, 0x77, 0x59, 0xdb, 0x78, 0xd0, 0x0d,
+	0xb6, 0x9a, 0xdb, 0x6b, 0x4f, 0xef, 0x38, 0x52, 0xea, 0xaa, 0xd1, 0x5c, 0x8f, 0xff, 0x11, 0xb0,
+	0xd0, 0x7e, 0xdd, 0xb3, 0x69, 0xea, 0x19, 0xa5, 0xad, 0x63, 0x2d, 0x2f, 0xcf, 0x61, 0x46, 0x79,
+	0x68, 0x47, 0x1e, 0x22, 0x65, 0xa9, 0xe8, 0x43, 0x4a, 0xb4, 0xb7, 0x23, 0x0b, 0xc2, 0x90, 0x2d,
+	0x51, 0xe0, 0x4d, 0x12, 0xd2, 0x1a, 0x69, 0x44, 0xc2, 0x7a, 0x46, 0x8c, 0x72, 0x22, 0xb8, 0x1d,
+	0xcd, 0x05, 0xf8, 0x75, 0x22, 0x4c, 0x3c, 0x7c, 0x97, 0xa5, 0x33, 0x22, 0x79, 0x35, 0x9a, 0x0b,
+	0x90, 0xb0, 0x7c, 0xdc, 0x47, 0xf7, 0xcb, 0xb4, 0xd1, 0x21, 0xfe, 0x9c, 0x75, 0xec, 0x69, 0x4f,
+	0x26, 0x87, 0x48, 0x20, 0xea, 0xd1, 0x8a, 0x8e, 0xd9, 0x89, 0x1c, 0xc2, 0xf3, 0x6b, 0x91, 0x25,
+	0x85, 0xd1, 0xee, 0x9c, 0x1e, 0xf2, 0xdf, 0x02, 0x6f, 0xa2, 0x67, 0x84, 0x19, 0x17, 0x21, 0x67,
+	0x1d, 0x59, 0x58, 0xc9, 0x91, 0x8a, 0xcf, 0xc9, 0xd0, 0x6a, 0x54, 0x93, 0x59, 0x9d, 0xbd, 0xb1,
+	0x51, 0xc7, 0x32, 0x93, 0xd9, 0xa0, 0xdb, 0xf0, 0x3a, 0x73, 0x19, 0x06, 0x24, 0x8b, 0x43, 0x51,
+	0xf4, 0x00, 0x12, 0xe2, 0x61, 0x35, 0x9a, 0x0b, 0xac, 0x85, 0x53, 0x19, 0x9f, 0x3b, 0x2f, 0x4b,
+	0xde, 0xc2, 0x5c, 0xc6, 0x9f, 0xb3, 0x8d, 0x5a, 0x2a, 0x8a, 0x70, 0x87, 0xad, 0xd8, 0x77, 0xe7,
+	0x13, 0x7a, 0xbb, 0x96, 0x50, 0xa7, 0x17, 0x79, 0x25, 0x0e, 0x6c, 0xbd, 0xf6, 0x25, 0xdc, 0x62,
+	0x4d, 0x11, 0xc7, 0xee, 0x2d, 0x6d, 0xb8, 0xcd, 0x7e, 0x1b, 0x7e, 0xba, 0x26, 0x9f, 0xb5, 0xec,
+	0x34, 0xaf, 0x64, 0x87, 0x0f, 0x3d, 0x85, 0x3f, 0x64, 0x44, 0x0f, 0x66, 0x41, 0x14, 0xc5, 0x24,
+	0x71, 0x97, 0xc5, 0x21, 0xcc, 0x02, 0x26, 0x5c, 0x8d, 0xed, 0x23, 0x6d, 0x46, 0x1e, 0x86, 0x8f,
+	0xd9, 0x86, 0x3d, 0xf3, 0x3b, 0x6d, 0x09, 0x70, 0x4e, 0xae, 0x48, 0xf9, 0x43, 0xb6, 0xf6, 0x3d,
+	0x64, 0xc8, 0xe0, 0x91, 0xc8, 0x06, 0x78, 0xcd, 0x52, 0x91, 0x0d, 0xc8, 0x4d, 0x2b, 0xa2, 0x35,
+	0x7f, 0x84, 0x2a, 0x06, 0x55, 0xf6, 0x67, 0x27, 0x93, 0xeb, 0xce, 0xc2, 0xbf, 0x65, 0x9d, 0x9e,
+	0xb8, 0x84, 0x52, 0x2f, 0x64, 0x4b, 0x05, 0x80, 0xd7, 0xa2, 0x75, 0x65, 0x6f, 0xa3, 0xb6, 0xf7,
+	0x01, 0x6b, 0x47, 0x90, 0xa7, 0x33, 0xca, 0xe4, 0x82, 0x8d, 0xfc, 0x90, 0x85, 0x11, 0x5c, 0xb8,
+	0x6b, 0x05, 0xe6, 0xa4, 0x0c, 0x5f, 0xa5, 0x09, 0x02, 0xff, 0x88, 0x1c, 0xc4, 0x2f, 0x19, 0x4c,
+	0xe8, 0x8b, 0xbb, 0x9e, 0x0e, 0xf2, 0x47, 0x6c, 0x3d, 0x82, 0x8b, 0xb7, 0x30, 0xf1, 0x19, 0x2c,
+	0xf3, 0x13, 0x54, 0xf2, 0xc3, 0xcf, 0x58, 0xb7, 0x74, 0x58, 0x29, 0x8d, 0x47, 0xb2, 0xa0, 0x62,
+	0x87, 0x85, 0xe7, 0x74, 0xea, 0xdf, 0x84, 0x45, 0x68, 0x89, 0x4c, 0x92, 0xcb, 0x56, 0x64, 0x01,
+	0x66, 0x3a, 0x91, 0x1a, 0x68, 0x3b, 0x25, 0xa1, 0x15, 0xcd, 0x05, 0xfc, 0x90, 0xdd, 0x2d, 0xfd,
+	0xbc, 0x1e, 0xe5, 0x4a, 0x9b, 0x13, 0x57, 0x07, 0x3e, 0xb2, 0x42, 0xf0, 0xdf, 0x83, 0x8a, 0xa9,
+	0x1e, 0x64, 0xc9, 0xa9, 0xda, 0x4b, 0x12, 0x0d, 0x45, 0x81, 0x8c, 0xe2, 0x11, 0x3d, 0xa3, 0xb8,
+	0x0e, 0x37, 0x58, 0xc3, 0x28, 0x67, 0xa1, 0x61, 0x54, 0xa5, 0xea, 0x36, 0x6b, 0x55, 0x37, 0x64,
+	0x4b, 0x99, 0x32, 0xe0, 0xea, 0x0b, 0xad, 0xf1, 0x68, 0xb2, 0x38, 0x55, 0xe7, 0x90, 0xb9, 0xc2,
+	0xe2, 0x61, 0xb8, 0xc5, 0xd6, 0x0c, 0x2e, 0x7a, 0xb3, 0x51, 0x5f, 0xa5, 0xae, 0xb6, 0x54, 0x45,
+	0xfc, 0x0b, 0x76, 0xa3, 0x9a, 0xc9, 0x57, 0x50, 0x2d, 0xf8, 0x41, 0xd5, 0x35, 0x7f, 0xc6, 0x6e,
+	0x55, 0x55, 0x8f, 0x6a, 0x85, 0x30, 0xa8, 0x14, 0xc2, 0xc5, 0x84, 0x7c, 0xce, 0xee, 0x94, 0xdb,
+	0x8f, 0x41, 0x0f, 0x60, 0x5f, 0xa4, 0x22, 0x8b, 0xc1, 0x85, 0x1e, 0xf8, 0xd0, 0xf9, 0x5f, 0x01,
+	0x39, 0xa2, 0x08, 0x4e, 0x34, 0xbc, 0xd0, 0x20, 0x0c, 0x84, 0x0f, 0x59, 0x27, 0xc6, 0x95, 0xd2,
+	0xbf, 0x54, 0x1c, 0xae, 0x39, 0x19, 0x52, 0x4b, 0xdc, 0x60, 0x5f, 0x69, 0x38, 0x6e, 0x84, 0xed,
+	0x5e, 0x85, 0x0d, 0xde, 0x96, 0x6a, 0x87, 0xa8, 0x3e, 0x65, 0x46, 0xab, 0x64, 0x6c, 0x6f, 0x82,
+	0xe5, 0xb3, 0x26, 0x0b, 0xef, 0x31, 0xa6, 0x26, 0x19, 0x38, 0x87, 0x2d, 0xd2, 0x68, 0x93, 0x64,
+	0xcf, 0x85, 0x69, 0x94, 0x11, 0xa9, 0xeb, 0x8b, 0x16, 0xa0, 0x34, 0xd7, 0x32, 0x06, 0xea, 0x89,
+	0xcd, 0xc8, 0x02, 0xae, 0xd9, 0x6d, 0x1f, 0xd2, 0x2b, 0x99, 0xc9, 0x62, 0xe8, 0xa2, 0xfa, 0x8c,
+	0xad, 0x9f, 0x11, 0x86, 0x5a, 0x58, 0x1d, 0x2f, 0xdc, 0x73, 0xdd, 0xd4, 0xc5, 0xd0, 0xa8, 0xc5,
+	0x50, 0x3f, 0x5f, 0xf3, 0xca, 0xf9, 0x78, 0x3e, 0xf7, 0x19, 0xc1, 0xa5, 0x3a, 0xaf, 0x30, 0xa9,
+	0x09, 0xd7, 0x99, 0x74, 0xb2, 0xff, 0xe2, 0x11, 0xe8, 0x32, 0x1d, 0xab, 0x44, 0x9e, 0xcd, 0x5e,
+	0xa8, 0xec, 0x4c, 0x0e, 0xc2, 0x9b, 0xac, 0x39, 0x7f, 0x32, 0xb8, 0xc4, 0x74, 0xab, 0xdc, 0xdf,
+	0x74, 0x95, 0x23, 0x61, 0x97, 0x22, 0x1d, 0x83, 0x33, 0x67, 0x01, 0x4e, 0x17, 0x23, 0xb4, 0x23,
+	0x41, 0xbb, 0xdc, 0x94, 0x98, 0xff, 0x1a, 0xb0, 0x4e, 0x04, 0x17, 0x3d, 0x39, 0xc8, 0x22, 0x31,
+	0x39, 0x9d, 0x2e, 0xbc, 0x84, 0x95, 0xf7, 0xda, 0xf8, 0xe0, 0xbd, 0x9a, 0xe9, 0x21, 0x4c, 0xbd,
+	0x43, 0x02, 0x18, 0x32, 0x4c, 0x73, 0xa9, 0xfd, 0xd3, 0x72, 0x68, 0x3e, 0x32, 0xb5, 0x6c, 0x15,
+	0x21, 0x60, 0x73, 0x8f, 0x0f, 0x6e, 0xc5, 0xd9, 0x40, 0xc0, 0x1f, 0xb3, 0x0d, 0x5b, 0x37, 0xcb,
+	0x93, 0x95, 0xbe, 0x82, 0x8a, 0x2f, 0xde, 0x27, 0x3d, 0xa5, 0xcd, 0x81, 0xd6, 0x07, 0x97, 0x90,
+	0x19, 0x1c, 0x8c, 0xb0, 0x0c, 0x8c, 0x54, 0x32, 0x4e, 0xc1, 0x29, 0x57, 0x24, 0x48, 0x87, 0x51,
+	0xee, 0xab, 0x0d, 0xa7, 0xc4, 0xe8, 0x03, 0xb4, 0x56, 0x3e, 0x1f, 0x16, 0xf0, 0x4f, 0x58, 0xeb,
+	0x75, 0x66, 0x76, 0x9f, 0x22, 0x39, 0x89, 0x30, 0xc2, 0xf7, 0x10, 0x5c, 0xf3, 0xbf, 0x03, 0xba,
+	0x1b, 0xf6, 0x42, 0x54, 0xea, 0x29, 0xcd, 0x30, 0x18, 0x0a, 0xbd, 0xa3, 0xc0, 0xcd, 0x30, 0x5e,
+	0x80, 0xa6, 0xb0, 0xa3, 0xba, 0x82, 0x4a, 0xeb, 0x8f, 0x2a, 0x54, 0xbe, 0xf0, 0xb5, 0x3e, 0x28,
+	0x7c, 0xcb, 0x65, 0xe1, 0xbb, 0xcf, 0x98, 0x9d, 0x7d, 0x72, 0x21, 0x35, 0x4d, 0x81, 0xed, 0xa8,
+	0x22, 0xa1, 0x8b, 0x21, 0xa7, 0xb6, 0xb0, 0xaf, 0xd1, 0x39, 0x4a, 0x5c, 0xc9, 0x61, 0xc7, 0x9e,
+	0xc5, 0x22, 0xfe, 0x0d, 0xf2, 0x7d, 0xe1, 0x3a, 0x0c, 0xf5, 0x0c, 0xec, 0xc7, 0xd2, 0x0c, 0xd5,
+	0xd8, 0xb8, 0x2a, 0xe4, 0xc6, 0xa0, 0x2b, 0x52, 0xfe, 0x1d, 0xbb, 0x19, 0xc1, 0xc5, 0x4b, 0xd0,
+	0xf2, 0x12, 0xfe, 0xb5, 0x43, 0x61, 0x6c, 0xb9, 0x30, 0x43, 0x5f, 0x7c, 0x70, 0xcd, 0xbf, 0xa2,
+	0x42, 0x76, 0x30, 0x35, 0x90, 0x25, 0x90, 0x9c, 0x8c, 0xfb, 0x6f, 0x6c, 0x23, 0x71, 0x7f, 0x08,
+	0x64, 0x60, 0x3d, 0xf2, 0x90, 0xbf, 0x61, 0xff, 0x43, 0x67, 0xb2, 0x88, 0xd5, 0x25, 0xe8, 0x72,
+	0x28, 0xba, 0x76, 0x03, 0x72, 0x31, 0x10, 0xf9, 0x91, 0x1c, 0x49, 0xdf, 0xe4, 0x4a, 0xcc, 0x7f,
+	0xa4, 0x16, 0x6d, 0x7b, 0xd8, 0xfb, 0x72, 0xce, 0x5c, 0xf4, 0x52, 0xe6, 0xb3, 0x67, 0xa3, 0x3a,
+	0x7b, 0xce, 0xe3, 0x6c, 0x56, 0xcb, 0xf8, 0x31, 0x6b, 0xbf, 0x3b, 0x3b, 0x4b, 0x65, 0x06, 0xd7,
+	0x3c, 0xbc, 0xf2, 0xca, 0x37, 0x16, 0x3f, 0xaf, 0x66, 0xf5, 0x79, 0xd5, 0xfa, 0xcf, 0xc1, 0x14,
+	0x0f, 0x7b, 0xed, 0x44, 0xf3, 0xac, 0xa2, 0x6a, 0xe3, 0xaa, 0xdd, 0xed, 0xb6, 0xbd, 0xdb, 0xd7,
+	0x0e, 0x35, 0x3f, 0xf9, 0x21, 0x6e, 0x5f, 0xc4, 0xe7, 0xe3, 0x7c, 0xe1, 0x40, 0xf4, 0x35, 0x5b,
+	0x75, 0xdc, 0xe2, 0xa0, 0x82, 0x03, 0xe8, 0xff, 0x17, 0x0d, 0xa0, 0xf4, 0xcf, 0x10, 0x95, 0xaa,
+	0xfb, 0x0f, 0x7e, 0xbe, 0x37, 0x90, 0x66, 0x38, 0xee, 0xef, 0xc4, 0x6a, 0xf4, 0x64, 0x77, 0x37,
+	0xce, 0x9e, 0xd0, 0x1f, 0xe1, 0xee, 0xee, 0x13, 0xda, 0xdd, 0x5f, 0xa6, 0x7f, 0xbf, 0xdd, 0x7f,
+	0x06, 0x00, 0x29, 0x1a, 0x7a, 0xbe, 0x56, 0x0e, 0x00, 0x00,
 }
@@ -39,6 +39,7 @@ import (
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/rpc"
 	"github.com/33cn/chain33/store"
+	drivers "github.com/33cn/chain33/system/store"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/wallet"
 	"golang.org/x/net/trace"
@@ -52,6 +53,8 @@ var (
 	datadir    = flag.String("datadir", "", "data dir of chain33, include logs and datas")
 	versionCmd = flag.Bool("v", false, "version")
 	fixtime    = flag.Bool("fixtime", false, "fix time")
+	snapshot   = flag.String("snapshot", "", "import state snapshot before start, only for empty datadir")
+	module     = flag.String("module", "", "only run this module(execs or wallet), connect to the queue of main process")
 )
 
 func RunChain33(name string) {
@@ -133,21 +136,34 @@ func RunChain33(name string) {
 	//开始区块链模块加载
 	//channel, rabitmq 等
 	log.Info(cfg.Title + " " + version.GetVersion())
+	if *module != "" {
+		runRemoteModule(cfg, sub, *module)
+		return
+	}
 	log.Info("loading queue")
-	q := queue.New("channel")
+	q := queue.NewWithConfig("channel", cfg.Queue)
+
+	if cfg.BlockChain.LightMode {
+		runLight(cfg, q)
+		return
+	}
 
 	log.Info("loading mempool module")
 	mem := mempool.New(cfg.MemPool)
 	mem.SetQueueClient(q.Client())
 
 	log.Info("loading execs module")
-	exec := executor.New(cfg.Exec, sub.Exec)
+	exec := newModule(cfg, "execs", func() queue.Module { return executor.New(cfg.Exec, sub.Exec) })
 	exec.SetQueueClient(q.Client())
 
 	log.Info("loading store module")
 	s := store.New(cfg.Store, sub.Store)
 	s.SetQueueClient(q.Client())
 
+	if *snapshot != "" {
+		importSnapshot(cfg.BlockChain, s, *snapshot)
+	}
+
 	log.Info("loading blockchain module")
 	chain := blockchain.New(cfg.BlockChain)
 	chain.SetQueueClient(q.Client())
@@ -169,7 +185,7 @@ func RunChain33(name string) {
 	rpcapi.SetQueueClient(q.Client())
 
 	log.Info("loading wallet module")
-	walletm := wallet.New(cfg.Wallet, sub.Wallet)
+	walletm := newModule(cfg, "wallet", func() queue.Module { return wallet.New(cfg.Wallet, sub.Wallet) })
 	walletm.SetQueueClient(q.Client())
 	defer func() {
 		//close all module,clean some resource
@@ -198,6 +214,94 @@ func RunChain33(name string) {
 	q.Start()
 }
 
+//在其他进程中运行的模块, 本进程不加载, 连接到队列以后由其他进程处理消息
+type remoteModule struct{}
+
+func (m *remoteModule) SetQueueClient(client queue.Client) {}
+func (m *remoteModule) Close()                             {}
+
+func newModule(cfg *types.Config, name string, create func() queue.Module) queue.Module {
+	if cfg.Queue != nil {
+		for _, remote := range cfg.Queue.RemoteModules {
+			if remote == name {
+				log.Info("module run in other process", "module", name)
+				return &remoteModule{}
+			}
+		}
+	}
+	return create()
+}
+
+//只运行一个模块, 通过主进程的 queue.listenAddr 连接到主进程的队列
+func runRemoteModule(cfg *types.Config, sub *types.ConfigSubModule, name string) {
+	if cfg.Queue == nil || cfg.Queue.ListenAddr == "" {
+		panic("remote module need queue listenAddr config")
+	}
+	var m queue.Module
+	switch name {
+	case "execs":
+		m = executor.New(cfg.Exec, sub.Exec)
+	case "wallet":
+		m = wallet.New(cfg.Wallet, sub.Wallet)
+	default:
+		panic("remote module not support: " + name)
+	}
+	log.Info("loading remote queue", "addr", cfg.Queue.ListenAddr)
+	q := queue.NewRemote("remote", cfg.Queue.ListenAddr)
+	log.Info("loading remote module", "module", name)
+	m.SetQueueClient(q.Client())
+	defer func() {
+		log.Info("begin close remote module", "module", name)
+		m.Close()
+		log.Info("begin close queue module")
+		q.Close()
+	}()
+	q.Start()
+}
+
+//轻节点模式: 只同步和校验区块头, 区块和状态数据在需要的时候从其他节点获取并校验
+//不加载执行器, 共识和钱包模块, rpc 只提供只读的查询接口
+func runLight(cfg *types.Config, q queue.Queue) {
+	if !cfg.P2P.Enable {
+		panic("light mode need p2p module")
+	}
+	log.Info("loading light mempool module")
+	mem := mempool.NewLight()
+	mem.SetQueueClient(q.Client())
+
+	log.Info("loading light store module")
+	s := store.NewLight()
+	s.SetQueueClient(q.Client())
+
+	log.Info("loading light blockchain module")
+	chain := blockchain.NewLight(cfg.BlockChain)
+	chain.SetQueueClient(q.Client())
+
+	log.Info("loading p2p module")
+	//轻节点不给其他节点提供区块下载
+	cfg.P2P.ServerStart = false
+	network := p2p.New(cfg.P2P)
+	network.SetQueueClient(q.Client())
+
+	rpcapi := rpc.New(cfg.Rpc)
+	rpcapi.SetQueueClient(q.Client())
+	defer func() {
+		log.Info("begin close light blockchain module")
+		chain.Close()
+		log.Info("begin close light mempool module")
+		mem.Close()
+		log.Info("begin close P2P module")
+		network.Close()
+		log.Info("begin close light store module")
+		s.Close()
+		log.Info("begin close rpc module")
+		rpcapi.Close()
+		log.Info("begin close queue module")
+		q.Close()
+	}()
+	q.Start()
+}
+
 func resetDatadir(cfg *types.Config, datadir string) {
 	// Check in case of paths like "/something/~/something/"
 	if datadir[:2] == "~/" {
@@ -213,6 +317,29 @@ func resetDatadir(cfg *types.Config, datadir string) {
 	cfg.Store.DbPath = filepath.Join(datadir, cfg.Store.DbPath)
 }
 
+//导入状态快照, 数据库中已经有区块的时候忽略快照
+func importSnapshot(cfg *types.BlockChain, s queue.Module, path string) {
+	st, ok := s.(drivers.SnapshotStore)
+	if !ok {
+		panic("store driver not support snapshot")
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+	log.Info("begin import snapshot", "file", path)
+	meta, err := blockchain.ImportSnapshot(cfg, st, f)
+	if err == types.ErrSnapshotDBNotEmpty {
+		log.Info("blockchain db is not empty, skip import snapshot")
+		return
+	}
+	if err != nil {
+		panic(err)
+	}
+	log.Info("end import snapshot", "height", meta.Height, "hash", common.ToHex(meta.Hash))
+}
+
 // 开启trace
 
 func startTrace() {
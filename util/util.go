@@ -260,6 +260,14 @@ func ExecBlock(client queue.Client, prevStateRoot []byte, block *types.Block, er
 		block.Txs = newtx
 		block.TxHash = merkle.CalcMerkleRoot(block.Txs)
 	}
+	//check ReceiptHash
+	if types.IsFork(block.Height, "ForkReceiptHash") {
+		receiptHash := merkle.CalcReceiptMerkleRoot(rdata)
+		if errReturn && !bytes.Equal(receiptHash, block.ReceiptHash) {
+			return nil, nil, types.ErrCheckReceiptHash
+		}
+		block.ReceiptHash = receiptHash
+	}
 
 	var detail types.BlockDetail
 	//if kvset == nil {
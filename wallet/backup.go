@@ -0,0 +1,249 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	wcom "github.com/33cn/chain33/wallet/common"
+)
+
+const (
+	backupVersion = 1
+	backupKDF     = "scrypt"
+	backupCipher  = "aes-256-gcm"
+)
+
+//钱包备份的格式, 和钱包数据库使用相同的加密方式: scrypt推导密钥, aes gcm加密
+type walletBackupJSON struct {
+	Version    int             `json:"version"`
+	KDF        string          `json:"kdf"`
+	KDFParams  *wcom.KDFParams `json:"kdfparams"`
+	Cipher     string          `json:"cipher"`
+	CipherText string          `json:"ciphertext"`
+}
+
+//input:
+//type ReqWalletExport struct {
+//	Passwd string
+//output:
+//type ReplyString struct {
+//	Data string
+//导出钱包的seed和所有账户, 使用passwd加密
+func (wallet *Wallet) ProcWalletExport(req *types.ReqWalletExport) (*types.ReplyString, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	if req == nil || len(req.GetPasswd()) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	ok, err := wallet.CheckWalletStatus()
+	if !ok {
+		return nil, err
+	}
+	seed, err := wallet.getSeed(wallet.Password)
+	if err != nil {
+		return nil, err
+	}
+	backup := &types.WalletBackup{Seed: seed}
+	accStores, err := wallet.walletStore.GetAccountByPrefix("Account")
+	if err != nil && err != types.ErrAccountNotExist {
+		return nil, err
+	}
+	for _, accStore := range accStores {
+		acc := &types.WalletAccountStore{
+			Label:     accStore.GetLabel(),
+			Addr:      accStore.GetAddr(),
+			WatchOnly: accStore.GetWatchOnly(),
+			Pubkey:    accStore.GetPubkey(),
+		}
+		if !acc.WatchOnly {
+			priv, err := wallet.getPrivKey(accStore)
+			if err != nil {
+				walletlog.Error("ProcWalletExport", "addr", acc.Addr, "getPrivKey err", err)
+				return nil, err
+			}
+			if len(priv.Bytes()) != 0 {
+				acc.Privkey = common.ToHex(priv.Bytes())
+			} else {
+				//私钥在远程签名进程中, 备份中只保存公钥
+				acc.WatchOnly = true
+				acc.Pubkey = common.ToHex(priv.PubKey().Bytes())
+			}
+		}
+		backup.Accounts = append(backup.Accounts, acc)
+	}
+
+	params, err := wcom.NewKDFParams()
+	if err != nil {
+		return nil, err
+	}
+	key, err := wcom.DeriveKey([]byte(req.GetPasswd()), params)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := wcom.GCMEncrypt(key, types.Encode(backup))
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(&walletBackupJSON{
+		Version:    backupVersion,
+		KDF:        backupKDF,
+		KDFParams:  params,
+		Cipher:     backupCipher,
+		CipherText: hex.EncodeToString(ciphertext),
+	})
+	if err != nil {
+		return nil, types.ErrMarshal
+	}
+	return &types.ReplyString{Data: string(data)}, nil
+}
+
+func decryptBackup(data string, passwd string) (*types.WalletBackup, error) {
+	var backupJSON walletBackupJSON
+	if err := json.Unmarshal([]byte(data), &backupJSON); err != nil {
+		walletlog.Error("decryptBackup", "Unmarshal err", err)
+		return nil, types.ErrUnmarshal
+	}
+	if backupJSON.Version != backupVersion || backupJSON.KDF != backupKDF || backupJSON.Cipher != backupCipher {
+		return nil, types.ErrNotSupport
+	}
+	ciphertext, err := hex.DecodeString(backupJSON.CipherText)
+	if err != nil {
+		return nil, types.ErrFromHex
+	}
+	key, err := wcom.DeriveKey([]byte(passwd), backupJSON.KDFParams)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := wcom.GCMDecrypt(key, ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	var backup types.WalletBackup
+	if err := types.Decode(plaintext, &backup); err != nil {
+		return nil, err
+	}
+	return &backup, nil
+}
+
+//input:
+//type ReqWalletImport struct {
+//	Data   string
+//	Passwd string
+//output:
+//type WalletAccounts struct {
+//	Wallets []*WalletAccount
+//从备份中恢复钱包, 钱包还没有seed时使用备份中的seed并把passwd设置成钱包密码,
+//已经存在的地址会被跳过, 返回导入的账户
+func (wallet *Wallet) ProcWalletImport(req *types.ReqWalletImport) (*types.WalletAccounts, error) {
+	if req == nil || len(req.GetData()) == 0 || len(req.GetPasswd()) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	backup, err := decryptBackup(req.GetData(), req.GetPasswd())
+	if err != nil {
+		return nil, err
+	}
+	has, _ := wallet.walletStore.HasSeed()
+	if !has {
+		if len(backup.GetSeed()) == 0 {
+			return nil, types.ErrSaveSeedFirst
+		}
+		ok, err := wallet.saveSeed(req.GetPasswd(), backup.GetSeed())
+		if !ok {
+			return nil, err
+		}
+	} else {
+		ok, err := wallet.CheckWalletStatus()
+		if !ok {
+			return nil, err
+		}
+	}
+
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	var addrs []string
+	var labels []string
+	var watchOnly []bool
+	for _, acc := range backup.GetAccounts() {
+		if wallet.AddrInWallet(acc.GetAddr()) {
+			continue
+		}
+		label := acc.GetLabel()
+		if account, _ := wallet.walletStore.GetAccountByLabel(label); account != nil || len(label) == 0 {
+			label = acc.GetAddr()
+		}
+		accStore := &types.WalletAccountStore{
+			Label:     label,
+			Addr:      acc.GetAddr(),
+			WatchOnly: acc.GetWatchOnly(),
+			Pubkey:    acc.GetPubkey(),
+		}
+		if !accStore.WatchOnly {
+			accStore.Privkey, err = wallet.saveBackupKey(acc)
+			if err != nil {
+				walletlog.Error("ProcWalletImport", "addr", acc.GetAddr(), "saveBackupKey err", err)
+				return nil, err
+			}
+		}
+		err = wallet.walletStore.SetWalletAccount(false, accStore.Addr, accStore)
+		if err != nil {
+			walletlog.Error("ProcWalletImport", "SetWalletAccount err", err)
+			return nil, err
+		}
+		addrs = append(addrs, accStore.Addr)
+		labels = append(labels, label)
+		watchOnly = append(watchOnly, accStore.WatchOnly)
+	}
+
+	reply := &types.WalletAccounts{}
+	if len(addrs) == 0 {
+		return reply, nil
+	}
+	accounts, err := accountdb.LoadAccounts(wallet.api, addrs)
+	if err != nil {
+		walletlog.Error("ProcWalletImport", "LoadAccounts err", err)
+		return nil, err
+	}
+	for i, acc := range accounts {
+		if len(acc.Addr) == 0 {
+			acc.Addr = addrs[i]
+		}
+		for _, policy := range wcom.PolicyContainer {
+			policy.OnImportPrivateKey(acc)
+		}
+		reply.Wallets = append(reply.Wallets, &types.WalletAccount{Acc: acc, Label: labels[i], WatchOnly: watchOnly[i]})
+		//恢复之前的交易记录
+		wallet.wg.Add(1)
+		go wallet.rescanAddrTxs(addrs[i])
+	}
+	return reply, nil
+}
+
+//校验备份中的私钥和地址是否匹配, 然后使用钱包当前的密钥保存
+func (wallet *Wallet) saveBackupKey(acc *types.WalletAccountStore) (string, error) {
+	privkey, err := common.FromHex(acc.GetPrivkey())
+	if err != nil || len(privkey) == 0 {
+		return "", types.ErrFromHex
+	}
+	cr, err := crypto.New(types.GetSignName("", SignType))
+	if err != nil {
+		return "", err
+	}
+	priv, err := cr.PrivKeyFromBytes(privkey)
+	if err != nil {
+		return "", err
+	}
+	if address.PubKeyToAddress(priv.PubKey().Bytes()).String() != acc.GetAddr() {
+		return "", types.ErrPrivkey
+	}
+	return wallet.signer.SaveKey(wallet.passKey, acc.GetAddr(), privkey)
+}
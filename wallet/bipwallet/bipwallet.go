@@ -6,6 +6,8 @@ package bipwallet
 
 import (
 	"errors"
+	"strconv"
+	"strings"
 
 	bip32 "github.com/33cn/chain33/wallet/bipwallet/go-bip32"
 	bip39 "github.com/33cn/chain33/wallet/bipwallet/go-bip39"
@@ -139,6 +141,95 @@ func (w *HDWallet) NewKeyPair(index uint32) (priv, pub []byte, err error) {
 	return key.Key, key.PublicKey().Key, err
 }
 
+// 按照BIP-44路径生成秘钥对, 路径格式为 m/44'/coin'/account'/change/index
+func (w *HDWallet) NewKeyPairByPath(path string) (priv, pub []byte, err error) {
+	indexes, err := ParsePath(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := DeriveKey(w.MasterKey, indexes)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key.Key, key.PublicKey().Key, nil
+}
+
+// 导出指定account的扩展公钥(m/44'/coin'/account'), 可用于只读钱包推导地址
+func (w *HDWallet) AccountXPub(account uint32) (string, error) {
+	if account >= bip32.FirstHardenedChild {
+		return "", ErrInvalidPath
+	}
+	key, err := DeriveKey(w.MasterKey, []uint32{bip44.Purpose, w.CoinType, account + bip32.FirstHardenedChild})
+	if err != nil {
+		return "", err
+	}
+	return key.PublicKey().String(), nil
+}
+
+// 通过account扩展公钥推导 change/index 对应的公钥
+func PubFromXPub(xpub string, change, index uint32) ([]byte, error) {
+	key, err := bip32.B58Deserialize(xpub)
+	if err != nil {
+		return nil, err
+	}
+	if key.IsPrivate {
+		key = key.PublicKey()
+	}
+	key, err = DeriveKey(key, []uint32{change, index})
+	if err != nil {
+		return nil, err
+	}
+	return key.Key, nil
+}
+
+// 生成BIP-44标准路径字符串
+func Bip44Path(coinType, account, change, index uint32) string {
+	return "m/44'/" + strconv.FormatUint(uint64(coinType-bip32.FirstHardenedChild), 10) + "'/" +
+		strconv.FormatUint(uint64(account), 10) + "'/" +
+		strconv.FormatUint(uint64(change), 10) + "/" + strconv.FormatUint(uint64(index), 10)
+}
+
+// ErrInvalidPath 路径格式错误
+var ErrInvalidPath = errors.New("ErrInvalidPath")
+
+// 解析 m/44'/13107'/0'/0/1 格式的路径, ' 或者 h 后缀表示硬化索引
+func ParsePath(path string) ([]uint32, error) {
+	path = strings.TrimSpace(path)
+	items := strings.Split(path, "/")
+	if len(items) < 2 || items[0] != "m" {
+		return nil, ErrInvalidPath
+	}
+	indexes := make([]uint32, 0, len(items)-1)
+	for _, item := range items[1:] {
+		hardened := false
+		if strings.HasSuffix(item, "'") || strings.HasSuffix(item, "h") {
+			hardened = true
+			item = item[:len(item)-1]
+		}
+		index, err := strconv.ParseUint(item, 10, 32)
+		if err != nil || uint32(index) >= bip32.FirstHardenedChild {
+			return nil, ErrInvalidPath
+		}
+		if hardened {
+			index += uint64(bip32.FirstHardenedChild)
+		}
+		indexes = append(indexes, uint32(index))
+	}
+	return indexes, nil
+}
+
+// 从key开始逐级推导子秘钥
+func DeriveKey(key *bip32.Key, indexes []uint32) (*bip32.Key, error) {
+	var err error
+	for _, index := range indexes {
+		key, err = key.NewChildKey(index)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
 func (w *HDWallet) NewAddress(index uint32) (string, error) {
 	if cointype, ok := CoinName[w.CoinType]; ok {
 		_, pub, err := w.NewKeyPair(index)
@@ -175,6 +175,51 @@ func (key *Key) String() string {
 	return string(base58Encode(key.Serialize()))
 }
 
+// Decode a base58 encoded extended key, the reverse of String
+func B58Deserialize(data string) (*Key, error) {
+	b, err := BitcoinBase58Encoding.DecodeStringN(data, 82)
+	if err != nil {
+		return nil, err
+	}
+	return Deserialize(b)
+}
+
+// Deserialize a 82 byte (78 bytes key data and 4 bytes checksum) slice to an Key
+func Deserialize(data []byte) (*Key, error) {
+	if len(data) != 82 {
+		return nil, errors.New("Serialized keys should by exactly 82 bytes")
+	}
+	if !bytes.Equal(checksum(data[:78]), data[78:]) {
+		return nil, errors.New("Checksum doesn't match")
+	}
+
+	key := &Key{
+		Version:     data[0:4],
+		Depth:       data[4],
+		FingerPrint: data[5:9],
+		ChildNumber: data[9:13],
+		ChainCode:   data[13:45],
+	}
+	if bytes.Equal(key.Version, PrivateWalletVersion) {
+		if data[45] != 0x0 {
+			return nil, errors.New("Invalid private key prefix")
+		}
+		key.IsPrivate = true
+		key.Key = data[46:78]
+		if err := validatePrivateKey(key.Key); err != nil {
+			return nil, err
+		}
+	} else if bytes.Equal(key.Version, PublicWalletVersion) {
+		key.Key = data[45:78]
+		if err := validateChildPublicKey(key.Key); err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, errors.New("Unknown extended key version")
+	}
+	return key, nil
+}
+
 // Cryptographically secure seed
 func NewSeed() ([]byte, error) {
 	// Well that easy, just make go read 256 random bytes into a slice
@@ -98,6 +98,39 @@ func TestBip32TestVectors(t *testing.T) {
 	testVectorKeyPairs(t, vector2)
 }
 
+func TestDeserialize(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	privKey, err := bip32.NewMasterKey(seed)
+	assert.NoError(t, err)
+	child, err := privKey.NewChildKey(bip32.FirstHardenedChild)
+	assert.NoError(t, err)
+
+	key, err := bip32.B58Deserialize(child.String())
+	assert.NoError(t, err)
+	assert.Equal(t, child.String(), key.String())
+	assert.True(t, key.IsPrivate)
+
+	pubKey, err := bip32.B58Deserialize(child.PublicKey().String())
+	assert.NoError(t, err)
+	assert.False(t, pubKey.IsPrivate)
+	assert.Equal(t, child.PublicKey().String(), pubKey.String())
+
+	//公钥推导的非硬化子公钥和私钥推导的一致
+	privChild, err := key.NewChildKey(1)
+	assert.NoError(t, err)
+	pubChild, err := pubKey.NewChildKey(1)
+	assert.NoError(t, err)
+	assert.Equal(t, privChild.PublicKey().String(), pubChild.String())
+
+	_, err = pubKey.NewChildKey(bip32.FirstHardenedChild)
+	assert.NotNil(t, err)
+
+	data := key.Serialize()
+	data[len(data)-1] ^= 0xff
+	_, err = bip32.Deserialize(data)
+	assert.NotNil(t, err)
+}
+
 func testVectorKeyPairs(t *testing.T, vector testMasterKey) {
 	// Decode master seed into hex
 	seed, _ := hex.DecodeString(vector.seed)
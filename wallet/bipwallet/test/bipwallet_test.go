@@ -67,3 +67,46 @@ func TestBipwallet(t *testing.T) {
 	fmt.Println("PrivToPub:", hex.EncodeToString(pub))
 
 }
+
+func TestBipwalletPath(t *testing.T) {
+	wallet, err := bipwallet.NewWalletFromMnemonic(bipwallet.TypeBty,
+		"wish address cram damp very indicate regret sound figure scheme review scout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := bipwallet.Bip44Path(bipwallet.TypeBty, 0, 0, 3)
+	if path != "m/44'/13107'/0'/0/3" {
+		t.Fatal("Bip44Path", path)
+	}
+	//默认的索引推导和路径推导结果一致
+	priv, pub, err := wallet.NewKeyPair(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	priv2, pub2, err := wallet.NewKeyPairByPath("m/44h/13107h/0h/0/3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(priv) != hex.EncodeToString(priv2) || hex.EncodeToString(pub) != hex.EncodeToString(pub2) {
+		t.Fatal("NewKeyPairByPath not match NewKeyPair")
+	}
+
+	//扩展公钥推导出相同的公钥
+	xpub, err := wallet.AccountXPub(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub3, err := bipwallet.PubFromXPub(xpub, 0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(pub) != hex.EncodeToString(pub3) {
+		t.Fatal("PubFromXPub not match")
+	}
+
+	for _, bad := range []string{"", "m", "44'/0", "m/x", "m/2147483648", "m/0''"} {
+		if _, err := bipwallet.ParsePath(bad); err == nil {
+			t.Fatal("ParsePath should fail", bad)
+		}
+	}
+}
@@ -7,6 +7,11 @@ package common
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/rand"
+	"io"
+
+	"github.com/33cn/chain33/types"
+	"golang.org/x/crypto/scrypt"
 )
 
 //使用钱包的password对私钥进行aes cbc加密,返回加密后的privkey
@@ -44,3 +49,77 @@ func CBCDecrypterPrivkey(password []byte, privkey []byte) []byte {
 	decrypter.CryptBlocks(decryptered, privkey)
 	return decryptered
 }
+
+//KDFParams 通过钱包密码推导加密密钥的scrypt参数, 每个钱包使用随机的salt
+type KDFParams struct {
+	Salt []byte `json:"salt"`
+	N    int    `json:"n"`
+	R    int    `json:"r"`
+	P    int    `json:"p"`
+}
+
+var (
+	//推导一次密钥大约需要100毫秒, 只在解锁和修改密码时计算
+	DefaultScryptN = 1 << 15
+	DefaultScryptR = 8
+	DefaultScryptP = 1
+)
+
+const (
+	kdfSaltLen = 32
+	kdfKeyLen  = 32
+)
+
+//NewKDFParams 生成新的随机salt
+func NewKDFParams() (*KDFParams, error) {
+	salt := make([]byte, kdfSaltLen)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+	return &KDFParams{Salt: salt, N: DefaultScryptN, R: DefaultScryptR, P: DefaultScryptP}, nil
+}
+
+//DeriveKey 使用scrypt从密码推导出32字节的aes密钥
+func DeriveKey(password []byte, params *KDFParams) ([]byte, error) {
+	if params == nil || len(params.Salt) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	return scrypt.Key(password, params.Salt, params.N, params.R, params.P, kdfKeyLen)
+}
+
+//GCMEncrypt 使用aes gcm加密, 随机的nonce放在密文前面
+func GCMEncrypt(key []byte, plaintext []byte) ([]byte, error) {
+	aesgcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aesgcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aesgcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+//GCMDecrypt 解密GCMEncrypt的结果, 密钥错误或者密文被修改时返回ErrInputPassword
+func GCMDecrypt(key []byte, data []byte) ([]byte, error) {
+	aesgcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < aesgcm.NonceSize() {
+		return nil, types.ErrInputPassword
+	}
+	plaintext, err := aesgcm.Open(nil, data[:aesgcm.NonceSize()], data[aesgcm.NonceSize():], nil)
+	if err != nil {
+		return nil, types.ErrInputPassword
+	}
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
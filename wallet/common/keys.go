@@ -15,6 +15,7 @@ const (
 	keyEncryptionCompFlag = "EncryptionFlag" // 中间有一段时间运行了一个错误的密码版本，导致有部分用户信息发生错误，需要兼容下
 	keyPasswordHash       = "PasswordHash"
 	keyWalletSeed         = "walletseed"
+	keyKDFParams          = "WalletKDF"
 )
 
 //用于所有Account账户的输出list，需要安装时间排序
@@ -53,3 +54,7 @@ func CalcPasswordHash() []byte {
 func CalcWalletSeed() []byte {
 	return []byte(keyWalletSeed)
 }
+
+func CalcKDFParams() []byte {
+	return []byte(keyKDFParams)
+}
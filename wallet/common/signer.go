@@ -0,0 +1,55 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package common
+
+import (
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+)
+
+// DefaultSigner 私钥使用钱包密码加密后保存在钱包数据库中
+const DefaultSigner = "db"
+
+// Signer 私钥的存储和签名接口, 钱包中需要用到私钥的地方都通过它获取
+type Signer interface {
+	// SaveKey 保存新建或者导入的私钥, 返回值记录在钱包账户的Privkey字段中
+	SaveKey(password string, addr string, privkey []byte) (string, error)
+	// GetKey 获取账户对应的私钥用于签名, 私钥不在本进程中时返回的私钥只能用于签名, Bytes()为空
+	GetKey(password string, acc *types.WalletAccountStore) (crypto.PrivKey, error)
+	// ChangePassword 钱包修改密码时调用, 返回新的Privkey字段
+	ChangePassword(oldPass string, newPass string, acc *types.WalletAccountStore) (string, error)
+}
+
+// PendingSigner 私钥保存在钱包数据库以外的Signer, ChangePassword只准备好新密码加密的私钥,
+// 钱包数据库写入成功以后调用CommitPassword生效, 出错的时候调用AbortPassword放弃, 原来的私钥不变
+type PendingSigner interface {
+	CommitPassword(addrs []string) error
+	AbortPassword(addrs []string)
+}
+
+// SignerCreator 创建Signer, signType是钱包使用的签名类型, sub是[wallet.sub.<name>]中的配置
+type SignerCreator func(signType int, sub []byte) (Signer, error)
+
+var signerCreators = make(map[string]SignerCreator)
+
+// RegisterSigner 注册Signer的实现
+func RegisterSigner(name string, creator SignerCreator) {
+	if _, existed := signerCreators[name]; existed {
+		panic("RegisterSigner dup")
+	}
+	signerCreators[name] = creator
+}
+
+// NewSigner 根据名字创建Signer, 名字为空时使用默认的db
+func NewSigner(name string, signType int, sub []byte) (Signer, error) {
+	if name == "" {
+		name = DefaultSigner
+	}
+	creator, ok := signerCreators[name]
+	if !ok {
+		return nil, types.ErrNotSupport
+	}
+	return creator(signType, sub)
+}
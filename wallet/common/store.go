@@ -302,6 +302,17 @@ func (store *Store) SetWalletVersion(ver int64) error {
 	return nil
 }
 
+//在批量操作中升级数据库的版本号, 和版本对应的数据修改一起写入
+func (store *Store) SetWalletVersionInBatch(ver int64, batch db.Batch) error {
+	data, err := json.Marshal(ver)
+	if err != nil {
+		storelog.Error("SetWalletVersionInBatch marshal version", "err", err)
+		return types.ErrMarshal
+	}
+	batch.Set(version.WalletVerKey, data)
+	return nil
+}
+
 // 获取wallet数据库的版本号
 func (store *Store) GetWalletVersion() int64 {
 	var ver int64
@@ -317,6 +328,32 @@ func (store *Store) GetWalletVersion() int64 {
 	return ver
 }
 
+//保存钱包密码推导密钥的参数
+func (store *Store) SetKDFParams(params *KDFParams, batch db.Batch) error {
+	data, err := json.Marshal(params)
+	if err != nil {
+		storelog.Error("SetKDFParams marshal params", "err", err)
+		return types.ErrMarshal
+	}
+	batch.Set(CalcKDFParams(), data)
+	return nil
+}
+
+//获取钱包密码推导密钥的参数, 旧版本的钱包没有这个参数
+func (store *Store) GetKDFParams() (*KDFParams, error) {
+	data, err := store.Get(CalcKDFParams())
+	if data == nil || err != nil {
+		return nil, types.ErrNotFound
+	}
+	var params KDFParams
+	err = json.Unmarshal(data, &params)
+	if err != nil {
+		storelog.Error("GetKDFParams unmarshal", "err", err)
+		return nil, types.ErrUnmarshal
+	}
+	return &params, nil
+}
+
 //判断钱包是否已经保存seed
 func (store *Store) HasSeed() (bool, error) {
 	seed, err := store.Get(CalcWalletSeed())
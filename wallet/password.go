@@ -0,0 +1,171 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/hex"
+
+	"github.com/33cn/chain33/types"
+	wcom "github.com/33cn/chain33/wallet/common"
+)
+
+//钱包数据库版本
+//0: seed和私钥直接使用密码作为aes的密钥加密
+//1: 使用scrypt从密码和钱包的随机salt推导出密钥, seed和私钥使用aes gcm加密
+const walletVersionKDF int64 = 1
+
+//把钱包密码转换成加密私钥使用的密钥, 旧版本的钱包直接使用密码
+func (wallet *Wallet) passwordSecret(password string) (string, error) {
+	if wallet.walletStore.GetWalletVersion() < walletVersionKDF {
+		return password, nil
+	}
+	params, err := wallet.walletStore.GetKDFParams()
+	if err != nil {
+		walletlog.Error("passwordSecret", "GetKDFParams err", err)
+		return "", err
+	}
+	key, err := wcom.DeriveKey([]byte(password), params)
+	if err != nil {
+		walletlog.Error("passwordSecret", "DeriveKey err", err)
+		return "", err
+	}
+	return hex.EncodeToString(key), nil
+}
+
+//校验钱包密码, 同时返回密码对应的密钥
+func (wallet *Wallet) verifyPassword(password string) (string, bool) {
+	secret, err := wallet.passwordSecret(password)
+	if err != nil {
+		return "", false
+	}
+	return secret, wallet.walletStore.VerifyPasswordHash(secret)
+}
+
+//使用新的密码和新的salt重新加密seed和所有私钥, 旧版本的钱包同时升级到walletVersionKDF
+//调用者需要先校验oldPass并且持有wallet.mtx
+func (wallet *Wallet) changePassword(oldPass string, newPass string) error {
+	oldSecret, err := wallet.passwordSecret(oldPass)
+	if err != nil {
+		return err
+	}
+	params, err := wcom.NewKDFParams()
+	if err != nil {
+		walletlog.Error("changePassword", "NewKDFParams err", err)
+		return err
+	}
+	key, err := wcom.DeriveKey([]byte(newPass), params)
+	if err != nil {
+		walletlog.Error("changePassword", "DeriveKey err", err)
+		return err
+	}
+	newSecret := hex.EncodeToString(key)
+
+	//使用old密码解密seed然后用新的密钥重新加密seed
+	seed, err := GetSeed(wallet.walletStore.GetDB(), oldPass)
+	if err != nil {
+		walletlog.Error("changePassword", "GetSeed err", err)
+		return err
+	}
+	encryptedSeed, err := wcom.GCMEncrypt(key, []byte(seed))
+	if err != nil {
+		walletlog.Error("changePassword", "GCMEncrypt seed err", err)
+		return err
+	}
+
+	//密码hash, 推导参数, seed和版本号在同一个batch中写入
+	newBatch := wallet.walletStore.NewBatch(true)
+	err = wallet.walletStore.SetPasswordHash(newSecret, newBatch)
+	if err != nil {
+		walletlog.Error("changePassword", "SetPasswordHash err", err)
+		return err
+	}
+	//设置钱包加密标志位
+	err = wallet.walletStore.SetEncryptionFlag(newBatch)
+	if err != nil {
+		walletlog.Error("changePassword", "SetEncryptionFlag err", err)
+		return err
+	}
+	err = wallet.walletStore.SetKDFParams(params, newBatch)
+	if err != nil {
+		return err
+	}
+	err = wallet.walletStore.SetWalletVersionInBatch(walletVersionKDF, newBatch)
+	if err != nil {
+		return err
+	}
+	newBatch.Set(WalletSeed, encryptedSeed)
+
+	//私钥保存在钱包数据库以外的时候, batch写入成功以后才替换原来的私钥, 否则全部放弃
+	var changed []string
+	committed := false
+	pending, isPending := wallet.signer.(wcom.PendingSigner)
+	if isPending {
+		defer func() {
+			if !committed {
+				pending.AbortPassword(changed)
+			}
+		}()
+	}
+
+	//对所有存储的私钥重新使用新的密钥加密,通过Account前缀查找获取钱包中的所有账户信息
+	//任何一个私钥重新加密失败都放弃整个batch, 否则这个私钥在新的密码下无法解密
+	WalletAccStores, err := wallet.walletStore.GetAccountByPrefix("Account")
+	if err != nil && err != types.ErrAccountNotExist {
+		walletlog.Error("changePassword", "GetAccountByPrefix:err", err)
+		return err
+	}
+	for _, AccStore := range WalletAccStores {
+		//只读账户没有私钥
+		if AccStore.GetWatchOnly() {
+			continue
+		}
+		changed = append(changed, AccStore.Addr)
+		AccStore.Privkey, err = wallet.signer.ChangePassword(oldSecret, newSecret, AccStore)
+		if err != nil {
+			walletlog.Error("changePassword", "addr", AccStore.Addr, "ChangePassword err", err)
+			return err
+		}
+		err = wallet.walletStore.SetWalletAccountInBatch(true, AccStore.Addr, AccStore, newBatch)
+		if err != nil {
+			walletlog.Error("changePassword", "addr", AccStore.Addr, "SetWalletAccount err", err)
+			return err
+		}
+	}
+
+	err = newBatch.Write()
+	if err != nil {
+		walletlog.Error("changePassword", "Write err", err)
+		return err
+	}
+	committed = true
+	if isPending {
+		//新的密码已经生效, 没有改名的私钥在下次读取的时候完成改名
+		err = pending.CommitPassword(changed)
+		if err != nil {
+			walletlog.Error("changePassword", "CommitPassword err", err)
+		}
+	}
+	wallet.Password = newPass
+	wallet.passKey = newSecret
+	wallet.EncryptFlag = 1
+	return nil
+}
+
+//旧版本的钱包在解锁成功之后升级到KDF加密
+func (wallet *Wallet) upgradeWalletEncryption(password string) error {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	if wallet.walletStore.GetWalletVersion() >= walletVersionKDF {
+		return nil
+	}
+	walletlog.Info("upgradeWalletEncryption", "from", wallet.walletStore.GetWalletVersion(), "to", walletVersionKDF)
+	err := wallet.changePassword(password, password)
+	if err != nil {
+		walletlog.Error("upgradeWalletEncryption", "err", err)
+		return err
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/33cn/chain33/common"
 	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
+	wcom "github.com/33cn/chain33/wallet/common"
 )
 
 var (
@@ -87,9 +88,9 @@ func SaveSeed(db dbm.DB, seed string, password string) (bool, error) {
 		return false, types.ErrInvalidParam
 	}
 
-	Encrypted, err := AesgcmEncrypter([]byte(password), []byte(seed))
+	Encrypted, err := encryptSeed(db, password, []byte(seed))
 	if err != nil {
-		seedlog.Error("SaveSeed", "AesgcmEncrypter err", err)
+		seedlog.Error("SaveSeed", "encryptSeed err", err)
 		return false, err
 	}
 	err = db.SetSync(WalletSeed, Encrypted)
@@ -104,9 +105,9 @@ func SaveSeedInBatch(db dbm.DB, seed string, password string, batch dbm.Batch) (
 		return false, types.ErrInvalidParam
 	}
 
-	Encrypted, err := AesgcmEncrypter([]byte(password), []byte(seed))
+	Encrypted, err := encryptSeed(db, password, []byte(seed))
 	if err != nil {
-		seedlog.Error("SaveSeed", "AesgcmEncrypter err", err)
+		seedlog.Error("SaveSeed", "encryptSeed err", err)
 		return false, err
 	}
 	batch.Set(WalletSeed, Encrypted)
@@ -126,13 +127,48 @@ func GetSeed(db dbm.DB, password string) (string, error) {
 	if len(Encryptedseed) == 0 {
 		return "", types.ErrSeedNotExist
 	}
-	seed, err := AesgcmDecrypter([]byte(password), Encryptedseed)
+	seed, err := decryptSeed(db, password, Encryptedseed)
 	if err != nil {
 		return "", err
 	}
 	return string(seed), nil
 }
 
+//获取seed的加密密钥, 旧版本的钱包返回nil, 直接使用密码加密
+func seedKey(db dbm.DB, password string) ([]byte, error) {
+	store := wcom.NewStore(db)
+	if store.GetWalletVersion() < walletVersionKDF {
+		return nil, nil
+	}
+	params, err := store.GetKDFParams()
+	if err != nil {
+		return nil, err
+	}
+	return wcom.DeriveKey([]byte(password), params)
+}
+
+func encryptSeed(db dbm.DB, password string, seed []byte) ([]byte, error) {
+	key, err := seedKey(db, password)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return AesgcmEncrypter([]byte(password), seed)
+	}
+	return wcom.GCMEncrypt(key, seed)
+}
+
+func decryptSeed(db dbm.DB, password string, encrypted []byte) ([]byte, error) {
+	key, err := seedKey(db, password)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return AesgcmDecrypter([]byte(password), encrypted)
+	}
+	return wcom.GCMDecrypt(key, encrypted)
+}
+
 //通过seed生成子私钥十六进制字符串
 func GetPrivkeyBySeed(db dbm.DB, seed string) (string, error) {
 	var backupindex uint32
@@ -153,14 +189,9 @@ func GetPrivkeyBySeed(db dbm.DB, seed string) (string, error) {
 	//secp256k1
 	if SignType == 1 {
 
-		wallet, err := bipwallet.NewWalletFromMnemonic(bipwallet.TypeBty, seed)
+		wallet, err := newHDWallet(seed)
 		if err != nil {
-			seedlog.Error("GetPrivkeyBySeed NewWalletFromMnemonic", "err", err)
-			wallet, err = bipwallet.NewWalletFromSeed(bipwallet.TypeBty, []byte(seed))
-			if err != nil {
-				seedlog.Error("GetPrivkeyBySeed NewWalletFromSeed", "err", err)
-				return "", types.ErrNewWalletFromSeed
-			}
+			return "", err
 		}
 
 		//通过索引生成Key pair
@@ -215,6 +246,37 @@ func GetPrivkeyBySeed(db dbm.DB, seed string) (string, error) {
 	return Hexsubprivkey, nil
 }
 
+//通过助记词形式的seed生成HD钱包, 只支持secp256k1
+func newHDWallet(seed string) (*bipwallet.HDWallet, error) {
+	wallet, err := bipwallet.NewWalletFromMnemonic(bipwallet.TypeBty, seed)
+	if err != nil {
+		seedlog.Error("newHDWallet NewWalletFromMnemonic", "err", err)
+		wallet, err = bipwallet.NewWalletFromSeed(bipwallet.TypeBty, []byte(seed))
+		if err != nil {
+			seedlog.Error("newHDWallet NewWalletFromSeed", "err", err)
+			return nil, types.ErrNewWalletFromSeed
+		}
+	}
+	return wallet, nil
+}
+
+//备份的索引小于index时更新, 保证 ProcCreateNewAccount 从index之后开始生成
+func updateBackupKeyIndex(db dbm.DB, index uint32) {
+	value, err := db.Get([]byte(BACKUPKEYINDEX))
+	if err == nil && value != nil {
+		var backupindex uint32
+		if err = json.Unmarshal(value, &backupindex); err == nil && backupindex >= index {
+			return
+		}
+	}
+	value, err = json.Marshal(index)
+	if err != nil {
+		seedlog.Error("updateBackupKeyIndex", "Marshal err", err)
+		return
+	}
+	db.SetSync([]byte(BACKUPKEYINDEX), value)
+}
+
 //使用钱包的password对seed进行aesgcm加密,返回加密后的seed
 func AesgcmEncrypter(password []byte, seed []byte) ([]byte, error) {
 	key := make([]byte, 32)
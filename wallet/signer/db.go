@@ -0,0 +1,94 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package signer 钱包私钥存储和签名的几种实现:
+// db: 私钥使用钱包密码加密后保存在钱包数据库中
+// keystore: 私钥使用scrypt加密后保存在keystore目录下, 每个私钥一个json文件
+// remote: 私钥保存在独立的签名进程中, 钱包通过本地的gRPC(unix socket)请求签名
+package signer
+
+import (
+	"crypto/sha256"
+	"strings"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+	wcom "github.com/33cn/chain33/wallet/common"
+)
+
+var slog = log.New("module", "wallet.signer")
+
+func init() {
+	wcom.RegisterSigner(wcom.DefaultSigner, newDBSigner)
+	wcom.RegisterSigner(keystoreSignerName, newKeystoreSigner)
+	wcom.RegisterSigner(remoteSignerName, newRemoteSigner)
+}
+
+//aes gcm加密的私钥的前缀, 没有前缀的是旧版本的钱包使用aes cbc加密的私钥
+const gcmPrefix = "gcm:"
+
+//私钥加密后保存在钱包账户的Privkey字段中, password是钱包通过KDF推导出的密钥
+type dbSigner struct {
+	signType int
+}
+
+func newDBSigner(signType int, sub []byte) (wcom.Signer, error) {
+	return &dbSigner{signType: signType}, nil
+}
+
+func (s *dbSigner) SaveKey(password string, addr string, privkey []byte) (string, error) {
+	//使用钱包的password对私钥加密 aes gcm
+	key := sha256.Sum256([]byte(password))
+	encrypted, err := wcom.GCMEncrypt(key[:], privkey)
+	if err != nil {
+		return "", err
+	}
+	return gcmPrefix + common.ToHex(encrypted), nil
+}
+
+func (s *dbSigner) GetKey(password string, acc *types.WalletAccountStore) (crypto.PrivKey, error) {
+	privkey, err := s.decrypt(password, acc)
+	if err != nil {
+		return nil, err
+	}
+	return privKeyFromBytes(s.signType, privkey)
+}
+
+func (s *dbSigner) ChangePassword(oldPass string, newPass string, acc *types.WalletAccountStore) (string, error) {
+	privkey, err := s.decrypt(oldPass, acc)
+	if err != nil {
+		return "", err
+	}
+	return s.SaveKey(newPass, acc.GetAddr(), privkey)
+}
+
+func (s *dbSigner) decrypt(password string, acc *types.WalletAccountStore) ([]byte, error) {
+	privkey := acc.GetPrivkey()
+	isGCM := strings.HasPrefix(privkey, gcmPrefix)
+	prikeybyte, err := common.FromHex(strings.TrimPrefix(privkey, gcmPrefix))
+	if err != nil || len(prikeybyte) == 0 {
+		slog.Error("dbSigner decrypt", "addr", acc.GetAddr(), "FromHex err", err)
+		return nil, types.ErrFromHex
+	}
+	if !isGCM {
+		return wcom.CBCDecrypterPrivkey([]byte(password), prikeybyte), nil
+	}
+	key := sha256.Sum256([]byte(password))
+	return wcom.GCMDecrypt(key[:], prikeybyte)
+}
+
+func privKeyFromBytes(signType int, privkey []byte) (crypto.PrivKey, error) {
+	cr, err := crypto.New(types.GetSignName("", signType))
+	if err != nil {
+		return nil, err
+	}
+	priv, err := cr.PrivKeyFromBytes(privkey)
+	if err != nil {
+		slog.Error("privKeyFromBytes", "err", err)
+		return nil, err
+	}
+	return priv, nil
+}
@@ -0,0 +1,327 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package signer
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	wcom "github.com/33cn/chain33/wallet/common"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	keystoreSignerName = "keystore"
+	keystoreVersion    = 1
+	keystoreCipher     = "aes-128-ctr"
+	keystoreKDF        = "scrypt"
+	keystoreDefaultDir = "keystore"
+	//修改密码时新的私钥先写到这个后缀的文件中
+	keystorePending = ".pending"
+	//scrypt的默认参数, 解密一个私钥大约需要几百毫秒
+	defaultScryptN = 1 << 18
+	defaultScryptR = 8
+	defaultScryptP = 1
+	scryptDKLen    = 32
+)
+
+type keystoreConfig struct {
+	Dir     string `json:"dir"`
+	ScryptN int    `json:"scryptN"`
+	ScryptP int    `json:"scryptP"`
+}
+
+type keystoreJSON struct {
+	Address string     `json:"address"`
+	Crypto  cryptoJSON `json:"crypto"`
+	Version int        `json:"version"`
+}
+
+type cryptoJSON struct {
+	Cipher       string           `json:"cipher"`
+	CipherText   string           `json:"ciphertext"`
+	CipherParams cipherParamsJSON `json:"cipherparams"`
+	KDF          string           `json:"kdf"`
+	KDFParams    scryptParamsJSON `json:"kdfparams"`
+	MAC          string           `json:"mac"`
+}
+
+type cipherParamsJSON struct {
+	IV string `json:"iv"`
+}
+
+type scryptParamsJSON struct {
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+}
+
+//私钥使用钱包密码通过scrypt推导出的密钥加密, 每个地址保存一个json文件
+type keystoreSigner struct {
+	signType int
+	dir      string
+	scryptN  int
+	scryptP  int
+}
+
+func newKeystoreSigner(signType int, sub []byte) (wcom.Signer, error) {
+	var cfg keystoreConfig
+	if sub != nil {
+		types.MustDecode(sub, &cfg)
+	}
+	return NewKeystore(signType, cfg.Dir, cfg.ScryptN, cfg.ScryptP)
+}
+
+// NewKeystore 创建keystore目录, scryptN和scryptP为0时使用默认值
+func NewKeystore(signType int, dir string, scryptN, scryptP int) (wcom.Signer, error) {
+	if dir == "" {
+		dir = keystoreDefaultDir
+	}
+	if scryptN == 0 {
+		scryptN = defaultScryptN
+	}
+	if scryptP == 0 {
+		scryptP = defaultScryptP
+	}
+	if scryptN <= 1 || scryptN&(scryptN-1) != 0 {
+		return nil, types.ErrInvalidParam
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		slog.Error("NewKeystore", "dir", dir, "err", err)
+		return nil, err
+	}
+	return &keystoreSigner{signType: signType, dir: dir, scryptN: scryptN, scryptP: scryptP}, nil
+}
+
+func (s *keystoreSigner) keyFile(addr string) (string, error) {
+	//地址作为文件名, 需要先校验避免访问到keystore目录以外的文件
+	if err := address.CheckAddress(addr); err != nil {
+		return "", types.ErrInvalidAddress
+	}
+	return filepath.Join(s.dir, addr+".json"), nil
+}
+
+//私钥已经保存在keystore文件中, 钱包账户中不再记录私钥
+func (s *keystoreSigner) SaveKey(password string, addr string, privkey []byte) (string, error) {
+	file, err := s.keyFile(addr)
+	if err != nil {
+		return "", err
+	}
+	return "", s.writeKey(file, password, addr, privkey)
+}
+
+func (s *keystoreSigner) writeKey(file string, password string, addr string, privkey []byte) error {
+	key, err := s.encryptKey(password, addr, privkey)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(key, "", "    ")
+	if err != nil {
+		return err
+	}
+	//先写临时文件再改名, 避免写到一半时覆盖掉原来的私钥
+	tmp := file + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		slog.Error("keystore writeKey", "file", tmp, "err", err)
+		return err
+	}
+	if err := os.Rename(tmp, file); err != nil {
+		slog.Error("keystore writeKey", "file", file, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (s *keystoreSigner) GetKey(password string, acc *types.WalletAccountStore) (crypto.PrivKey, error) {
+	privkey, err := s.readKey(password, acc.GetAddr())
+	if err != nil {
+		return nil, err
+	}
+	return privKeyFromBytes(s.signType, privkey)
+}
+
+//新密码加密的私钥先写到pending文件, 原来的文件不变, 钱包数据库写入成功以后才改名
+func (s *keystoreSigner) ChangePassword(oldPass string, newPass string, acc *types.WalletAccountStore) (string, error) {
+	privkey, err := s.readKey(oldPass, acc.GetAddr())
+	if err != nil {
+		return "", err
+	}
+	file, err := s.keyFile(acc.GetAddr())
+	if err != nil {
+		return "", err
+	}
+	return "", s.writeKey(file+keystorePending, newPass, acc.GetAddr(), privkey)
+}
+
+func (s *keystoreSigner) CommitPassword(addrs []string) error {
+	var errs error
+	for _, addr := range addrs {
+		file, err := s.keyFile(addr)
+		if err != nil {
+			continue
+		}
+		if err := os.Rename(file+keystorePending, file); err != nil {
+			slog.Error("keystore CommitPassword", "file", file, "err", err)
+			errs = err
+		}
+	}
+	return errs
+}
+
+func (s *keystoreSigner) AbortPassword(addrs []string) {
+	for _, addr := range addrs {
+		file, err := s.keyFile(addr)
+		if err != nil {
+			continue
+		}
+		if err := os.Remove(file + keystorePending); err != nil && !os.IsNotExist(err) {
+			slog.Error("keystore AbortPassword", "file", file, "err", err)
+		}
+	}
+}
+
+//钱包数据库已经写入新的密码, 但是pending文件还没有改名的时候, 使用pending文件并且完成改名
+func (s *keystoreSigner) readKey(password string, addr string) ([]byte, error) {
+	file, err := s.keyFile(addr)
+	if err != nil {
+		return nil, err
+	}
+	privkey, err := readKeyFile(file, password, addr)
+	if err != types.ErrInputPassword {
+		return privkey, err
+	}
+	pending := file + keystorePending
+	if _, statErr := os.Stat(pending); statErr != nil {
+		return nil, err
+	}
+	privkey, pendingErr := readKeyFile(pending, password, addr)
+	if pendingErr != nil {
+		return nil, err
+	}
+	if err := os.Rename(pending, file); err != nil {
+		slog.Error("keystore readKey", "file", pending, "err", err)
+	}
+	return privkey, nil
+}
+
+func readKeyFile(file string, password string, addr string) ([]byte, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		slog.Error("keystore readKey", "file", file, "err", err)
+		return nil, types.ErrAccountNotExist
+	}
+	var key keystoreJSON
+	if err := json.Unmarshal(data, &key); err != nil {
+		slog.Error("keystore readKey", "file", file, "err", err)
+		return nil, err
+	}
+	if key.Address != addr {
+		return nil, types.ErrAccountNotExist
+	}
+	return decryptKey(password, &key)
+}
+
+func (s *keystoreSigner) encryptKey(password string, addr string, privkey []byte) (*keystoreJSON, error) {
+	salt := make([]byte, 32)
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return nil, err
+	}
+	dk, err := scrypt.Key([]byte(password), salt, s.scryptN, defaultScryptR, s.scryptP, scryptDKLen)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := aesCTRXOR(dk[:16], privkey, iv)
+	if err != nil {
+		return nil, err
+	}
+	return &keystoreJSON{
+		Address: addr,
+		Version: keystoreVersion,
+		Crypto: cryptoJSON{
+			Cipher:       keystoreCipher,
+			CipherText:   hex.EncodeToString(ciphertext),
+			CipherParams: cipherParamsJSON{IV: hex.EncodeToString(iv)},
+			KDF:          keystoreKDF,
+			KDFParams: scryptParamsJSON{
+				N:     s.scryptN,
+				R:     defaultScryptR,
+				P:     s.scryptP,
+				DKLen: scryptDKLen,
+				Salt:  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(keystoreMAC(dk, ciphertext)),
+		},
+	}, nil
+}
+
+func decryptKey(password string, key *keystoreJSON) ([]byte, error) {
+	if key.Version != keystoreVersion || key.Crypto.Cipher != keystoreCipher || key.Crypto.KDF != keystoreKDF {
+		return nil, types.ErrNotSupport
+	}
+	params := key.Crypto.KDFParams
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, types.ErrFromHex
+	}
+	iv, err := hex.DecodeString(key.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, types.ErrFromHex
+	}
+	ciphertext, err := hex.DecodeString(key.Crypto.CipherText)
+	if err != nil {
+		return nil, types.ErrFromHex
+	}
+	mac, err := hex.DecodeString(key.Crypto.MAC)
+	if err != nil {
+		return nil, types.ErrFromHex
+	}
+	if params.DKLen != scryptDKLen {
+		return nil, types.ErrNotSupport
+	}
+	dk, err := scrypt.Key([]byte(password), salt, params.N, params.R, params.P, params.DKLen)
+	if err != nil {
+		return nil, err
+	}
+	//mac不一致说明密码错误或者文件被修改
+	if !bytes.Equal(keystoreMAC(dk, ciphertext), mac) {
+		return nil, types.ErrInputPassword
+	}
+	return aesCTRXOR(dk[:16], ciphertext, iv)
+}
+
+func keystoreMAC(dk []byte, ciphertext []byte) []byte {
+	h := sha256.New()
+	h.Write(dk[16:32])
+	h.Write(ciphertext)
+	return h.Sum(nil)
+}
+
+func aesCTRXOR(key, in, iv []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(in))
+	cipher.NewCTR(block, iv).XORKeyStream(out, in)
+	return out, nil
+}
@@ -0,0 +1,176 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package signer
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	wcom "github.com/33cn/chain33/wallet/common"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+const (
+	remoteSignerName     = "remote"
+	remoteDefaultAddr    = "unix://signer.sock"
+	remoteDefaultTimeout = 10
+	unixPrefix           = "unix://"
+)
+
+type remoteConfig struct {
+	//unix://path 或者 127.0.0.1:port
+	Addr string `json:"addr"`
+	//单次请求的超时时间, 单位秒
+	Timeout int64 `json:"timeout"`
+}
+
+//私钥保存在独立的签名进程中, 钱包只保存账户地址, 签名时通过gRPC请求签名进程
+type remoteSigner struct {
+	signType int
+	timeout  time.Duration
+	conn     *grpc.ClientConn
+	client   types.SignerClient
+}
+
+func newRemoteSigner(signType int, sub []byte) (wcom.Signer, error) {
+	var cfg remoteConfig
+	if sub != nil {
+		types.MustDecode(sub, &cfg)
+	}
+	return NewRemote(signType, cfg.Addr, time.Duration(cfg.Timeout)*time.Second)
+}
+
+// NewRemote 连接远程签名服务, addr以unix://开头时使用unix socket
+func NewRemote(signType int, addr string, timeout time.Duration) (wcom.Signer, error) {
+	if addr == "" {
+		addr = remoteDefaultAddr
+	}
+	if timeout == 0 {
+		timeout = remoteDefaultTimeout * time.Second
+	}
+	//grpc.Dial不会阻塞等待连接建立, 签名服务可以晚于钱包启动
+	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithDialer(dialRemote))
+	if err != nil {
+		slog.Error("NewRemote", "addr", addr, "err", err)
+		return nil, err
+	}
+	return &remoteSigner{
+		signType: signType,
+		timeout:  timeout,
+		conn:     conn,
+		client:   types.NewSignerClient(conn),
+	}, nil
+}
+
+func dialRemote(addr string, timeout time.Duration) (net.Conn, error) {
+	if strings.HasPrefix(addr, unixPrefix) {
+		return net.DialTimeout("unix", strings.TrimPrefix(addr, unixPrefix), timeout)
+	}
+	return net.DialTimeout("tcp", addr, timeout)
+}
+
+//私钥发送给签名进程保存, 钱包账户中不记录私钥
+func (s *remoteSigner) SaveKey(password string, addr string, privkey []byte) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	reply, err := s.client.ImportKey(ctx, &types.ReqSignerImportKey{Addr: addr, Privkey: privkey})
+	if err != nil {
+		slog.Error("remote SaveKey", "addr", addr, "err", err)
+		return "", err
+	}
+	if !reply.IsOk {
+		return "", errors.New(string(reply.GetMsg()))
+	}
+	return "", nil
+}
+
+//签名进程中的私钥不会导出, 返回的私钥只能用于签名
+func (s *remoteSigner) GetKey(password string, acc *types.WalletAccountStore) (crypto.PrivKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	reply, err := s.client.GetPubKey(ctx, &types.ReqSignerPubKey{Addr: acc.GetAddr()})
+	if err != nil {
+		slog.Error("remote GetKey", "addr", acc.GetAddr(), "err", err)
+		return nil, err
+	}
+	cr, err := crypto.New(types.GetSignName("", s.signType))
+	if err != nil {
+		return nil, err
+	}
+	pub, err := cr.PubKeyFromBytes(reply.GetPubkey())
+	if err != nil {
+		return nil, err
+	}
+	return &remotePrivKey{signer: s, cr: cr, addr: acc.GetAddr(), pub: pub}, nil
+}
+
+//私钥由签名进程自己保护, 和钱包密码无关
+func (s *remoteSigner) ChangePassword(oldPass string, newPass string, acc *types.WalletAccountStore) (string, error) {
+	return acc.GetPrivkey(), nil
+}
+
+type remotePrivKey struct {
+	signer *remoteSigner
+	cr     crypto.Crypto
+	addr   string
+	pub    crypto.PubKey
+}
+
+func (k *remotePrivKey) Bytes() []byte {
+	return nil
+}
+
+//crypto.PrivKey的Sign不能返回错误, 请求失败时返回空的签名, 交易在校验签名时会被拒绝
+func (k *remotePrivKey) Sign(msg []byte) crypto.Signature {
+	ctx, cancel := context.WithTimeout(context.Background(), k.signer.timeout)
+	defer cancel()
+	reply, err := k.signer.client.Sign(ctx, &types.ReqSignerSign{Addr: k.addr, Msg: msg})
+	if err != nil {
+		slog.Error("remote Sign", "addr", k.addr, "err", err)
+		return remoteSignature(nil)
+	}
+	sig, err := k.cr.SignatureFromBytes(reply.GetSignature())
+	if err != nil {
+		slog.Error("remote Sign", "addr", k.addr, "SignatureFromBytes err", err)
+		return remoteSignature(nil)
+	}
+	return sig
+}
+
+func (k *remotePrivKey) PubKey() crypto.PubKey {
+	return k.pub
+}
+
+func (k *remotePrivKey) Equals(other crypto.PrivKey) bool {
+	if otherKey, ok := other.(*remotePrivKey); ok {
+		return k.addr == otherKey.addr && k.pub.Equals(otherKey.pub)
+	}
+	return false
+}
+
+type remoteSignature []byte
+
+func (sig remoteSignature) Bytes() []byte {
+	return sig
+}
+
+func (sig remoteSignature) IsZero() bool {
+	return len(sig) == 0
+}
+
+func (sig remoteSignature) String() string {
+	return common.ToHex(sig)
+}
+
+func (sig remoteSignature) Equals(other crypto.Signature) bool {
+	return bytes.Equal(sig.Bytes(), other.Bytes())
+}
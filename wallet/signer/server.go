@@ -0,0 +1,132 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package signer
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	wcom "github.com/33cn/chain33/wallet/common"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+var (
+	errListenAddr = errors.New("ErrSignerListenAddr")
+	errSignData   = errors.New("ErrSignDataNotTx")
+)
+
+// RemoteServer 远程签名服务端, 运行在独立的进程中, 私钥保存在它自己的Signer(一般是keystore)里
+type RemoteServer struct {
+	store    wcom.Signer
+	password string
+	signType int
+	server   *grpc.Server
+}
+
+// NewRemoteServer password用于加密签名进程中保存的私钥
+func NewRemoteServer(store wcom.Signer, password string, signType int) *RemoteServer {
+	s := &RemoteServer{store: store, password: password, signType: signType}
+	s.server = grpc.NewServer()
+	types.RegisterSignerServer(s.server, s)
+	return s
+}
+
+// Listen 监听地址和钱包配置中的remote.addr一致, unix socket文件只允许当前用户访问
+// 签名服务没有认证, tcp只允许监听本机地址
+func Listen(addr string) (net.Listener, error) {
+	if strings.HasPrefix(addr, unixPrefix) {
+		path := strings.TrimPrefix(addr, unixPrefix)
+		os.Remove(path)
+		lis, err := net.Listen("unix", path)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.Chmod(path, 0600); err != nil {
+			lis.Close()
+			return nil, err
+		}
+		return lis, nil
+	}
+	if err := checkListenAddr(addr); err != nil {
+		return nil, err
+	}
+	return net.Listen("tcp", addr)
+}
+
+func checkListenAddr(addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	if host == "localhost" {
+		return nil
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return errListenAddr
+	}
+	return nil
+}
+
+// Serve 阻塞直到Stop或者监听出错
+func (s *RemoteServer) Serve(lis net.Listener) error {
+	return s.server.Serve(lis)
+}
+
+// Stop 停止服务
+func (s *RemoteServer) Stop() {
+	s.server.Stop()
+}
+
+// ImportKey 校验私钥和地址是否匹配后保存
+func (s *RemoteServer) ImportKey(ctx context.Context, in *types.ReqSignerImportKey) (*types.Reply, error) {
+	priv, err := privKeyFromBytes(s.signType, in.GetPrivkey())
+	if err != nil {
+		return nil, err
+	}
+	if address.PubKeyToAddress(priv.PubKey().Bytes()).String() != in.GetAddr() {
+		return nil, types.ErrPrivkey
+	}
+	if _, err := s.store.SaveKey(s.password, in.GetAddr(), in.GetPrivkey()); err != nil {
+		return nil, err
+	}
+	return &types.Reply{IsOk: true}, nil
+}
+
+// GetPubKey 获取地址对应的公钥
+func (s *RemoteServer) GetPubKey(ctx context.Context, in *types.ReqSignerPubKey) (*types.ReplySignerPubKey, error) {
+	priv, err := s.store.GetKey(s.password, &types.WalletAccountStore{Addr: in.GetAddr()})
+	if err != nil {
+		return nil, err
+	}
+	return &types.ReplySignerPubKey{Pubkey: priv.PubKey().Bytes()}, nil
+}
+
+// Sign 使用地址对应的私钥签名, 只签名去掉签名之后的交易, 私钥不能用来签名其他的数据
+func (s *RemoteServer) Sign(ctx context.Context, in *types.ReqSignerSign) (*types.ReplySignerSign, error) {
+	if !isTxSignData(in.GetMsg()) {
+		return nil, errSignData
+	}
+	priv, err := s.store.GetKey(s.password, &types.WalletAccountStore{Addr: in.GetAddr()})
+	if err != nil {
+		return nil, err
+	}
+	return &types.ReplySignerSign{Signature: priv.Sign(in.GetMsg()).Bytes()}, nil
+}
+
+//签名的数据必须是没有签名的交易, 并且是交易的标准编码
+func isTxSignData(msg []byte) bool {
+	var tx types.Transaction
+	if types.Decode(msg, &tx) != nil || tx.Signature != nil || len(tx.Execer) == 0 {
+		return false
+	}
+	return bytes.Equal(types.Encode(&tx), msg)
+}
@@ -0,0 +1,205 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package signer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	wcom "github.com/33cn/chain33/wallet/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/33cn/chain33/system/crypto/init"
+)
+
+//测试时降低scrypt的计算量
+const testScryptN = 1 << 10
+
+func genKey(t *testing.T) (crypto.PrivKey, string) {
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	require.NoError(t, err)
+	priv, err := cr.GenKey()
+	require.NoError(t, err)
+	return priv, address.PubKeyToAddress(priv.PubKey().Bytes()).String()
+}
+
+func testSigner(t *testing.T, s wcom.Signer) {
+	priv, addr := genKey(t)
+	stored, err := s.SaveKey("pass1", addr, priv.Bytes())
+	require.NoError(t, err)
+	acc := &types.WalletAccountStore{Addr: addr, Privkey: stored}
+
+	key, err := s.GetKey("pass1", acc)
+	require.NoError(t, err)
+	assert.True(t, key.PubKey().Equals(priv.PubKey()))
+	//远程签名服务只签名交易
+	msg := types.Encode(&types.Transaction{Execer: []byte("none"), Payload: []byte("hello signer"), Nonce: 1})
+	assert.True(t, priv.PubKey().VerifyBytes(msg, key.Sign(msg)))
+
+	acc.Privkey, err = s.ChangePassword("pass1", "pass2", acc)
+	require.NoError(t, err)
+	key, err = s.GetKey("pass2", acc)
+	require.NoError(t, err)
+	assert.True(t, priv.PubKey().VerifyBytes(msg, key.Sign(msg)))
+}
+
+func TestNewSigner(t *testing.T) {
+	s, err := wcom.NewSigner("", types.SECP256K1, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &dbSigner{}, s)
+	_, err = wcom.NewSigner("hsm", types.SECP256K1, nil)
+	assert.Equal(t, types.ErrNotSupport, err)
+}
+
+func TestDBSigner(t *testing.T) {
+	s, err := newDBSigner(types.SECP256K1, nil)
+	require.NoError(t, err)
+	testSigner(t, s)
+}
+
+//旧版本的私钥使用aes cbc加密, 新版本的私钥带gcm前缀, 不能根据密文长度判断
+func TestDBSignerFormat(t *testing.T) {
+	s, err := newDBSigner(types.SECP256K1, nil)
+	require.NoError(t, err)
+	priv, addr := genKey(t)
+	legacy := &types.WalletAccountStore{Addr: addr, Privkey: common.ToHex(wcom.CBCEncrypterPrivkey([]byte("pass"), priv.Bytes()))}
+	key, err := s.GetKey("pass", legacy)
+	require.NoError(t, err)
+	assert.Equal(t, priv.Bytes(), key.Bytes())
+	stored, err := s.ChangePassword("pass", "pass2", legacy)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(stored, gcmPrefix))
+	key, err = s.GetKey("pass2", &types.WalletAccountStore{Addr: addr, Privkey: stored})
+	require.NoError(t, err)
+	assert.Equal(t, priv.Bytes(), key.Bytes())
+	_, err = s.GetKey("pass", &types.WalletAccountStore{Addr: addr, Privkey: stored})
+	assert.Equal(t, types.ErrInputPassword, err)
+}
+
+func TestKeystoreSigner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := NewKeystore(types.SECP256K1, dir, testScryptN, 0)
+	require.NoError(t, err)
+	testSigner(t, s)
+
+	priv, addr := genKey(t)
+	stored, err := s.SaveKey("pass", addr, priv.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, "", stored)
+	info, err := os.Stat(filepath.Join(dir, addr+".json"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	_, err = s.GetKey("wrong", &types.WalletAccountStore{Addr: addr})
+	assert.Equal(t, types.ErrInputPassword, err)
+	_, err = s.GetKey("pass", &types.WalletAccountStore{Addr: "../" + addr})
+	assert.Equal(t, types.ErrInvalidAddress, err)
+	_, notExist := genKey(t)
+	_, err = s.GetKey("pass", &types.WalletAccountStore{Addr: notExist})
+	assert.Equal(t, types.ErrAccountNotExist, err)
+
+	//修改密码以后提交之前原来的私钥不变, 放弃以后删除新的私钥
+	acc := &types.WalletAccountStore{Addr: addr}
+	_, err = s.ChangePassword("pass", "pass2", acc)
+	require.NoError(t, err)
+	_, err = s.GetKey("pass", acc)
+	require.NoError(t, err)
+	pending := s.(wcom.PendingSigner)
+	pending.AbortPassword([]string{addr})
+	_, err = os.Stat(filepath.Join(dir, addr+".json"+keystorePending))
+	assert.True(t, os.IsNotExist(err))
+	_, err = s.GetKey("pass2", acc)
+	assert.Equal(t, types.ErrInputPassword, err)
+	_, err = s.ChangePassword("pass", "pass2", acc)
+	require.NoError(t, err)
+	require.NoError(t, pending.CommitPassword([]string{addr}))
+	_, err = s.GetKey("pass", acc)
+	assert.Equal(t, types.ErrInputPassword, err)
+	key, err := s.GetKey("pass2", acc)
+	require.NoError(t, err)
+	assert.Equal(t, priv.Bytes(), key.Bytes())
+	//钱包数据库写入以后没有来得及改名, 读取的时候完成改名
+	_, err = s.ChangePassword("pass2", "pass3", acc)
+	require.NoError(t, err)
+	key, err = s.GetKey("pass3", acc)
+	require.NoError(t, err)
+	assert.Equal(t, priv.Bytes(), key.Bytes())
+	_, err = os.Stat(filepath.Join(dir, addr+".json"+keystorePending))
+	assert.True(t, os.IsNotExist(err))
+	_, err = s.GetKey("pass2", acc)
+	assert.Equal(t, types.ErrInputPassword, err)
+
+	_, err = NewKeystore(types.SECP256K1, dir, 1000, 0)
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "remote")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := NewKeystore(types.SECP256K1, filepath.Join(dir, "keystore"), testScryptN, 0)
+	require.NoError(t, err)
+	addr := "unix://" + filepath.Join(dir, "signer.sock")
+	lis, err := Listen(addr)
+	require.NoError(t, err)
+	server := NewRemoteServer(store, "server-pass", types.SECP256K1)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	s, err := NewRemote(types.SECP256K1, addr, time.Second)
+	require.NoError(t, err)
+	testSigner(t, s)
+
+	//私钥和地址不匹配时拒绝导入
+	priv, _ := genKey(t)
+	_, other := genKey(t)
+	_, err = s.SaveKey("", other, priv.Bytes())
+	assert.NotNil(t, err)
+
+	//远程的私钥不能导出, 签名结果可以用于交易
+	priv, from := genKey(t)
+	_, err = s.SaveKey("", from, priv.Bytes())
+	require.NoError(t, err)
+	key, err := s.GetKey("", &types.WalletAccountStore{Addr: from})
+	require.NoError(t, err)
+	assert.Nil(t, key.Bytes())
+	tx := &types.Transaction{Execer: []byte("coins"), Payload: []byte("payload"), Fee: types.Coin, Nonce: 1}
+	tx.Sign(types.SECP256K1, key)
+	assert.True(t, tx.CheckSign())
+	assert.Equal(t, from, tx.From())
+
+	//不是交易的数据不签名
+	assert.True(t, key.Sign([]byte("hello signer")).IsZero())
+	signed := types.Encode(tx)
+	assert.True(t, key.Sign(signed).IsZero())
+
+	//签名服务停止之后获取私钥失败
+	server.Stop()
+	_, err = s.GetKey("", &types.WalletAccountStore{Addr: from})
+	assert.NotNil(t, err)
+}
+
+func TestListenAddr(t *testing.T) {
+	lis, err := Listen("127.0.0.1:0")
+	require.NoError(t, err)
+	lis.Close()
+	_, err = Listen("0.0.0.0:0")
+	assert.Equal(t, errListenAddr, err)
+	_, err = Listen(":0")
+	assert.Equal(t, errListenAddr, err)
+}
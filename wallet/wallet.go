@@ -14,7 +14,6 @@ import (
 
 	"github.com/33cn/chain33/account"
 	"github.com/33cn/chain33/client"
-	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
 	dbm "github.com/33cn/chain33/common/db"
@@ -23,6 +22,7 @@ import (
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/types"
 	wcom "github.com/33cn/chain33/wallet/common"
+	_ "github.com/33cn/chain33/wallet/signer" //register signer package
 )
 
 var (
@@ -30,7 +30,7 @@ var (
 	maxTxNumPerBlock  int64 = types.MaxTxsPerBlock
 	MaxTxHashsPerTime int64 = 100
 	walletlog               = log.New("module", "wallet")
-	// 1；secp256k1，2：ed25519，3：sm2
+	// 1；secp256k1，2：ed25519，3：sm2，6：schnorr
 	SignType                = 1
 	accountdb   *account.DB = nil
 	accTokenMap             = make(map[string]*account.DB)
@@ -60,6 +60,8 @@ type Wallet struct {
 	isWalletLocked     int32
 	fatalFailureFlag   int32
 	Password           string
+	//加密私钥使用的密钥, 由Password和钱包的KDF参数推导得到
+	passKey            string
 	FeeAmount          int64
 	EncryptFlag        int64
 	wg                 *sync.WaitGroup
@@ -69,6 +71,7 @@ type Wallet struct {
 	done               chan struct{}
 	rescanwg           *sync.WaitGroup
 	lastHeader         *types.Header
+	signer             wcom.Signer
 }
 
 func SetLogLevel(level string) {
@@ -92,6 +95,11 @@ func New(cfg *types.Wallet, sub map[string][]byte) *Wallet {
 		signType = types.SECP256K1
 	}
 	SignType = signType
+	//私钥的保存方式, 对应的配置在[wallet.sub.<signer>]中
+	signer, err := wcom.NewSigner(cfg.Signer, signType, sub[cfg.Signer])
+	if err != nil {
+		panic("wallet signer " + cfg.Signer + " init err:" + err.Error())
+	}
 
 	wallet := &Wallet{
 		walletStore:      walletStore,
@@ -103,6 +111,7 @@ func New(cfg *types.Wallet, sub map[string][]byte) *Wallet {
 		done:             make(chan struct{}),
 		cfg:              cfg,
 		rescanwg:         &sync.WaitGroup{},
+		signer:           signer,
 	}
 	wallet.random = rand.New(rand.NewSource(types.Now().UnixNano()))
 	wcom.QueryData.SetThis("wallet", reflect.ValueOf(wallet))
@@ -262,24 +271,18 @@ func (wallet *Wallet) getPrivKeyByAddr(addr string) (crypto.PrivKey, error) {
 		walletlog.Error("ProcSendToAddress", "GetAccountByAddr err:", err)
 		return nil, err
 	}
+	return wallet.getPrivKey(Accountstor)
+}
 
-	//通过password解密存储的私钥
-	prikeybyte, err := common.FromHex(Accountstor.GetPrivkey())
-	if err != nil || len(prikeybyte) == 0 {
-		walletlog.Error("ProcSendToAddress", "FromHex err", err)
-		return nil, err
-	}
-
-	privkey := wcom.CBCDecrypterPrivkey([]byte(wallet.Password), prikeybyte)
-	//通过privkey生成一个pubkey然后换算成对应的addr
-	cr, err := crypto.New(types.GetSignName("", SignType))
-	if err != nil {
-		walletlog.Error("ProcSendToAddress", "err", err)
-		return nil, err
+//通过signer获取账户的私钥, 私钥可能保存在钱包数据库, keystore目录或者远程签名进程中
+func (wallet *Wallet) getPrivKey(Accountstor *types.WalletAccountStore) (crypto.PrivKey, error) {
+	//只读账户没有私钥
+	if Accountstor.GetWatchOnly() {
+		return nil, types.ErrWatchOnlyAccount
 	}
-	priv, err := cr.PrivKeyFromBytes(privkey)
+	priv, err := wallet.signer.GetKey(wallet.passKey, Accountstor)
 	if err != nil {
-		walletlog.Error("ProcSendToAddress", "PrivKeyFromBytes err", err)
+		walletlog.Error("getPrivKey", "addr", Accountstor.GetAddr(), "GetKey err", err)
 		return nil, err
 	}
 	return priv, nil
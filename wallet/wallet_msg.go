@@ -58,6 +58,22 @@ func (wallet *Wallet) On_WalletImportPrivkey(req *types.ReqWalletImportPrivkey)
 	return reply, err
 }
 
+func (wallet *Wallet) On_WalletImportWatchOnly(req *types.ReqImportWatchOnly) (types.Message, error) {
+	reply, err := wallet.ProcImportWatchOnly(req)
+	if err != nil {
+		walletlog.Error("onWalletImportWatchOnly", "err", err.Error())
+	}
+	return reply, err
+}
+
+func (wallet *Wallet) On_WalletCreateOfflineTx(req *types.ReqWalletSendToAddress) (types.Message, error) {
+	reply, err := wallet.ProcCreateOfflineTx(req)
+	if err != nil {
+		walletlog.Error("onWalletCreateOfflineTx", "err", err.Error())
+	}
+	return reply, err
+}
+
 func (wallet *Wallet) On_WalletSendToAddress(req *types.ReqWalletSendToAddress) (types.Message, error) {
 	reply, err := wallet.ProcSendToAddress(req)
 	if err != nil {
@@ -165,6 +181,46 @@ func (wallet *Wallet) On_GetSeed(req *types.GetSeedByPw) (types.Message, error)
 	return reply, err
 }
 
+func (wallet *Wallet) On_DeriveAccount(req *types.ReqDeriveAccount) (types.Message, error) {
+	reply, err := wallet.ProcDeriveAccount(req)
+	if err != nil {
+		walletlog.Error("onDeriveAccount", "err", err.Error())
+	}
+	return reply, err
+}
+
+func (wallet *Wallet) On_GetExtendedPubKey(req *types.ReqExtendedPubKey) (types.Message, error) {
+	reply, err := wallet.ProcGetExtendedPubKey(req)
+	if err != nil {
+		walletlog.Error("onGetExtendedPubKey", "err", err.Error())
+	}
+	return reply, err
+}
+
+func (wallet *Wallet) On_DiscoverAccounts(req *types.ReqDiscoverAccounts) (types.Message, error) {
+	reply, err := wallet.ProcDiscoverAccounts(req)
+	if err != nil {
+		walletlog.Error("onDiscoverAccounts", "err", err.Error())
+	}
+	return reply, err
+}
+
+func (wallet *Wallet) On_WalletExport(req *types.ReqWalletExport) (types.Message, error) {
+	reply, err := wallet.ProcWalletExport(req)
+	if err != nil {
+		walletlog.Error("onWalletExport", "err", err.Error())
+	}
+	return reply, err
+}
+
+func (wallet *Wallet) On_WalletImport(req *types.ReqWalletImport) (types.Message, error) {
+	reply, err := wallet.ProcWalletImport(req)
+	if err != nil {
+		walletlog.Error("onWalletImport", "err", err.Error())
+	}
+	return reply, err
+}
+
 func (wallet *Wallet) On_SaveSeed(req *types.SaveSeedByPw) (types.Message, error) {
 	reply := &types.Reply{
 		IsOk: true,
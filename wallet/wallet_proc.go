@@ -5,6 +5,7 @@
 package wallet
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"strings"
@@ -205,6 +206,7 @@ func (wallet *Wallet) ProcGetAccountList(req *types.ReqAccountList) (*types.Wall
 		}
 		WalletAccount.Acc = Account
 		WalletAccount.Label = WalletAccStores[index].GetLabel()
+		WalletAccount.WatchOnly = WalletAccStores[index].GetWatchOnly()
 		WalletAccounts.Wallets[index] = &WalletAccount
 	}
 	return &WalletAccounts, nil
@@ -222,6 +224,7 @@ func makeAccountWithoutBalance(accountStores []*types.WalletAccountStore) (*type
 		}
 		WalletAccount.Acc = &types.Account{Addr: account.Addr}
 		WalletAccount.Label = account.GetLabel()
+		WalletAccount.WatchOnly = account.GetWatchOnly()
 		WalletAccounts.Wallets[index] = &WalletAccount
 	}
 	return &WalletAccounts, nil
@@ -321,9 +324,12 @@ func (wallet *Wallet) ProcCreateNewAccount(Label *types.ReqNewAccount) (*types.W
 	walletAccount.Acc = &Account
 	walletAccount.Label = Label.GetLabel()
 
-	//使用钱包的password对私钥加密 aes cbc
-	Encrypted := wcom.CBCEncrypterPrivkey([]byte(wallet.Password), privkeybyte)
-	WalletAccStore.Privkey = common.ToHex(Encrypted)
+	//通过signer保存私钥, 默认使用钱包的password对私钥加密 aes cbc
+	WalletAccStore.Privkey, err = wallet.signer.SaveKey(wallet.passKey, addr, privkeybyte)
+	if err != nil {
+		walletlog.Error("ProcCreateNewAccount", "SaveKey err", err)
+		return nil, err
+	}
 	WalletAccStore.Label = Label.GetLabel()
 	WalletAccStore.Addr = addr
 
@@ -444,24 +450,31 @@ func (wallet *Wallet) ProcImportPrivKey(PrivKey *types.ReqWalletImportPrivkey) (
 		return nil, types.ErrPrivkeyToPub
 	}
 
-	//对私钥加密
-	Encryptered := wcom.CBCEncrypterPrivkey([]byte(wallet.Password), privkeybyte)
-	Encrypteredstr := common.ToHex(Encryptered)
 	//校验PrivKey对应的addr是否已经存在钱包中
 	Account, err = wallet.walletStore.GetAccountByAddr(addr)
+	if Account != nil && Account.GetWatchOnly() {
+		//只读账户导入私钥之后升级成普通账户, 沿用原来的label
+		return wallet.upgradeWatchOnly(Account, privkeybyte)
+	}
 	if Account != nil {
-		if Account.Privkey == Encrypteredstr {
+		stored, err := wallet.signer.GetKey(wallet.passKey, Account)
+		if err == nil && bytes.Equal(stored.PubKey().Bytes(), pub) {
 			walletlog.Error("ProcImportPrivKey Privkey is exist in wallet!")
 			return nil, types.ErrPrivkeyExist
 		} else {
-			walletlog.Error("ProcImportPrivKey!", "Account.Privkey", Account.Privkey, "input Privkey", PrivKey.Privkey)
+			walletlog.Error("ProcImportPrivKey!", "addr", addr, "GetKey err", err)
 			return nil, types.ErrPrivkey
 		}
 	}
 
 	var walletaccount types.WalletAccount
 	var WalletAccStore types.WalletAccountStore
-	WalletAccStore.Privkey = Encrypteredstr //存储加密后的私钥
+	//存储加密后的私钥
+	WalletAccStore.Privkey, err = wallet.signer.SaveKey(wallet.passKey, addr, privkeybyte)
+	if err != nil {
+		walletlog.Error("ProcImportPrivKey", "SaveKey err", err)
+		return nil, err
+	}
 	WalletAccStore.Label = PrivKey.GetLabel()
 	WalletAccStore.Addr = addr
 	//存储Addr:label+privkey+addr到数据库
@@ -492,81 +505,279 @@ func (wallet *Wallet) ProcImportPrivKey(PrivKey *types.ReqWalletImportPrivkey) (
 	return &walletaccount, nil
 }
 
+//HD钱包账户发现时默认的连续未使用地址数
+const (
+	defaultGapLimit = 20
+	maxGapLimit     = 1000
+)
+
+//通过seed生成HD钱包, 目前只有secp256k1支持BIP-44推导
+func (wallet *Wallet) getHDWallet() (*bipwallet.HDWallet, error) {
+	if SignType != 1 {
+		return nil, types.ErrNotSupport
+	}
+	seed, err := wallet.getSeed(wallet.Password)
+	if err != nil {
+		walletlog.Error("getHDWallet", "getSeed err", err)
+		return nil, err
+	}
+	return newHDWallet(seed)
+}
+
+//把HD钱包推导出的私钥加密后保存到钱包中
+func (wallet *Wallet) saveHDAccount(label string, privkeybyte []byte) (*types.WalletAccount, error) {
+	pub, err := bipwallet.PrivkeyToPub(bipwallet.TypeBty, privkeybyte)
+	if err != nil {
+		walletlog.Error("saveHDAccount PrivkeyToPub", "err", err)
+		return nil, types.ErrPrivkeyToPub
+	}
+	addr, err := bipwallet.PubToAddress(bipwallet.TypeBty, pub)
+	if err != nil {
+		walletlog.Error("saveHDAccount PubToAddress", "err", err)
+		return nil, types.ErrPrivkeyToPub
+	}
+	account, _ := wallet.walletStore.GetAccountByAddr(addr)
+	if account != nil {
+		return nil, types.ErrPrivkeyExist
+	}
+
+	var WalletAccStore types.WalletAccountStore
+	WalletAccStore.Privkey, err = wallet.signer.SaveKey(wallet.passKey, addr, privkeybyte)
+	if err != nil {
+		walletlog.Error("saveHDAccount", "SaveKey err", err)
+		return nil, err
+	}
+	WalletAccStore.Label = label
+	WalletAccStore.Addr = addr
+	err = wallet.walletStore.SetWalletAccount(false, addr, &WalletAccStore)
+	if err != nil {
+		walletlog.Error("saveHDAccount", "SetWalletAccount err", err)
+		return nil, err
+	}
+
+	accounts, err := accountdb.LoadAccounts(wallet.api, []string{addr})
+	if err != nil {
+		walletlog.Error("saveHDAccount", "LoadAccounts err", err)
+		return nil, err
+	}
+	if len(accounts[0].Addr) == 0 {
+		accounts[0].Addr = addr
+	}
+	for _, policy := range wcom.PolicyContainer {
+		policy.OnImportPrivateKey(accounts[0])
+	}
+	return &types.WalletAccount{Acc: accounts[0], Label: label}, nil
+}
+
 //input:
-//type ReqWalletSendToAddress struct {
-//	From   string
-//	To     string
-//	Amount int64
-//	Note   string
+//type ReqDeriveAccount struct {
+//	Label string
+//	Path  string
 //output:
-//type ReplyHash struct {
-//	Hashe []byte
-//发送一笔交易给对方地址，返回交易hash
-func (wallet *Wallet) ProcSendToAddress(SendToAddress *types.ReqWalletSendToAddress) (*types.ReplyHash, error) {
+//type WalletAccount struct {
+//	Acc   *Account
+//	Label string
+//按照BIP-44路径推导私钥并导入钱包, label为空时使用路径作为label
+func (wallet *Wallet) ProcDeriveAccount(req *types.ReqDeriveAccount) (*types.WalletAccount, error) {
 	wallet.mtx.Lock()
 	defer wallet.mtx.Unlock()
 
-	if SendToAddress == nil {
-		walletlog.Error("ProcSendToAddress input para is nil")
+	ok, err := wallet.CheckWalletStatus()
+	if !ok {
+		return nil, err
+	}
+	if req == nil || len(req.GetPath()) == 0 {
+		walletlog.Error("ProcDeriveAccount input parameter is nil!")
 		return nil, types.ErrInvalidParam
 	}
-	if len(SendToAddress.From) == 0 || len(SendToAddress.To) == 0 {
-		walletlog.Error("ProcSendToAddress input para From or To is nil!")
+	label := req.GetLabel()
+	if len(label) == 0 {
+		label = req.GetPath()
+	}
+	account, _ := wallet.walletStore.GetAccountByLabel(label)
+	if account != nil {
+		walletlog.Error("ProcDeriveAccount Label is exist in wallet!")
+		return nil, types.ErrLabelHasUsed
+	}
+
+	hdwallet, err := wallet.getHDWallet()
+	if err != nil {
+		return nil, err
+	}
+	priv, _, err := hdwallet.NewKeyPairByPath(req.GetPath())
+	if err != nil {
+		walletlog.Error("ProcDeriveAccount", "NewKeyPairByPath err", err)
+		return nil, err
+	}
+	return wallet.saveHDAccount(label, priv)
+}
+
+//导出 m/44'/coin'/account' 的扩展公钥
+func (wallet *Wallet) ProcGetExtendedPubKey(req *types.ReqExtendedPubKey) (*types.ReplyString, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	if req == nil {
 		return nil, types.ErrInvalidParam
 	}
+	hdwallet, err := wallet.getHDWallet()
+	if err != nil {
+		return nil, err
+	}
+	xpub, err := hdwallet.AccountXPub(req.GetAccount())
+	if err != nil {
+		walletlog.Error("ProcGetExtendedPubKey", "AccountXPub err", err)
+		return nil, err
+	}
+	return &types.ReplyString{Data: xpub}, nil
+}
 
-	ok, err := wallet.IsTransfer(SendToAddress.GetTo())
-	if !ok {
+//按照BIP-44的gap limit规则扫描 m/44'/coin'/account'/0/index,
+//地址有交易记录就认为已使用, 连续gapLimit个地址未使用时停止,
+//已使用但不在钱包中的地址导入钱包, 返回新导入的账户
+func (wallet *Wallet) ProcDiscoverAccounts(req *types.ReqDiscoverAccounts) (*types.WalletAccounts, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	if req == nil || req.GetGapLimit() < 0 || req.GetGapLimit() > maxGapLimit {
+		return nil, types.ErrInvalidParam
+	}
+	gapLimit := req.GetGapLimit()
+	if gapLimit == 0 {
+		gapLimit = defaultGapLimit
+	}
+	hdwallet, err := wallet.getHDWallet()
+	if err != nil {
 		return nil, err
 	}
 
-	//获取from账户的余额从account模块，校验余额是否充足
-	addrs := make([]string, 1)
-	addrs[0] = SendToAddress.GetFrom()
-	var accounts []*types.Account
-	var tokenAccounts []*types.Account
-	accounts, err = accountdb.LoadAccounts(wallet.api, addrs)
+	var reply types.WalletAccounts
+	var gap int32
+	lastUsed := int64(-1)
+	for index := uint32(0); gap < gapLimit; index++ {
+		path := bipwallet.Bip44Path(hdwallet.CoinType, req.GetAccount(), 0, index)
+		priv, pub, err := hdwallet.NewKeyPairByPath(path)
+		if err != nil {
+			walletlog.Error("ProcDiscoverAccounts", "NewKeyPairByPath err", err)
+			return nil, err
+		}
+		addr, err := bipwallet.PubToAddress(hdwallet.CoinType, pub)
+		if err != nil {
+			return nil, types.ErrPrivkeyToPub
+		}
+		overview, err := wallet.api.GetAddrOverview(&types.ReqAddr{Addr: addr})
+		if err != nil {
+			walletlog.Error("ProcDiscoverAccounts", "GetAddrOverview err", err)
+			return nil, err
+		}
+		if overview.GetTxCount() == 0 {
+			gap++
+			continue
+		}
+		gap = 0
+		lastUsed = int64(index)
+		account, _ := wallet.walletStore.GetAccountByAddr(addr)
+		if account != nil {
+			continue
+		}
+		//路径作为label, 如果已被占用就不设置label
+		label := path
+		if acc, _ := wallet.walletStore.GetAccountByLabel(label); acc != nil {
+			label = addr
+		}
+		walletAccount, err := wallet.saveHDAccount(label, priv)
+		if err != nil {
+			return nil, err
+		}
+		reply.Wallets = append(reply.Wallets, walletAccount)
+	}
+	//默认账户下新建地址时跳过已经发现的索引
+	if req.GetAccount() == 0 && lastUsed >= 0 {
+		updateBackupKeyIndex(wallet.walletStore.GetDB(), uint32(lastUsed))
+	}
+	return &reply, nil
+}
+
+//获取from账户的余额从account模块，校验余额是否充足
+func (wallet *Wallet) checkSendBalance(SendToAddress *types.ReqWalletSendToAddress) error {
+	addrs := []string{SendToAddress.GetFrom()}
+	accounts, err := accountdb.LoadAccounts(wallet.api, addrs)
 	if err != nil || len(accounts) == 0 {
 		walletlog.Error("ProcSendToAddress", "LoadAccounts err", err)
-		return nil, err
+		return err
 	}
 	Balance := accounts[0].Balance
 	amount := SendToAddress.GetAmount()
 	if !SendToAddress.IsToken {
 		if Balance < amount+wallet.FeeAmount {
-			return nil, types.ErrInsufficientBalance
+			return types.ErrInsufficientBalance
 		}
 	} else {
 		//如果是token转账，一方面需要保证coin的余额满足fee，另一方面则需要保证token的余额满足转账操作
 		if Balance < wallet.FeeAmount {
-			return nil, types.ErrInsufficientBalance
+			return types.ErrInsufficientBalance
 		}
 
 		if nil == accTokenMap[SendToAddress.TokenSymbol] {
 			tokenAccDB, err := account.NewAccountDB("token", SendToAddress.TokenSymbol, nil)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			accTokenMap[SendToAddress.TokenSymbol] = tokenAccDB
 		}
 		tokenAccDB := accTokenMap[SendToAddress.TokenSymbol]
-		tokenAccounts, err = tokenAccDB.LoadAccounts(wallet.api, addrs)
+		tokenAccounts, err := tokenAccDB.LoadAccounts(wallet.api, addrs)
 		if err != nil || len(tokenAccounts) == 0 {
 			walletlog.Error("ProcSendToAddress", "Load Token Accounts err", err)
-			return nil, err
+			return err
 		}
 		tokenBalance := tokenAccounts[0].Balance
 		if tokenBalance < amount {
-			return nil, types.ErrInsufficientTokenBal
+			return types.ErrInsufficientTokenBal
 		}
 	}
+	return nil
+}
+
+//input:
+//type ReqWalletSendToAddress struct {
+//	From   string
+//	To     string
+//	Amount int64
+//	Note   string
+//output:
+//type ReplyHash struct {
+//	Hashe []byte
+//发送一笔交易给对方地址，返回交易hash
+func (wallet *Wallet) ProcSendToAddress(SendToAddress *types.ReqWalletSendToAddress) (*types.ReplyHash, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	if SendToAddress == nil {
+		walletlog.Error("ProcSendToAddress input para is nil")
+		return nil, types.ErrInvalidParam
+	}
+	if len(SendToAddress.From) == 0 || len(SendToAddress.To) == 0 {
+		walletlog.Error("ProcSendToAddress input para From or To is nil!")
+		return nil, types.ErrInvalidParam
+	}
+
+	ok, err := wallet.IsTransfer(SendToAddress.GetTo())
+	if !ok {
+		return nil, err
+	}
+
+	err = wallet.checkSendBalance(SendToAddress)
+	if err != nil {
+		return nil, err
+	}
 	addrto := SendToAddress.GetTo()
 	note := SendToAddress.GetNote()
-	priv, err := wallet.getPrivKeyByAddr(addrs[0])
+	priv, err := wallet.getPrivKeyByAddr(SendToAddress.GetFrom())
 	if err != nil {
 		return nil, err
 	}
-	return wallet.sendToAddress(priv, addrto, amount, note, SendToAddress.IsToken, SendToAddress.TokenSymbol)
+	return wallet.sendToAddress(priv, addrto, SendToAddress.GetAmount(), note, SendToAddress.IsToken, SendToAddress.TokenSymbol)
 }
 
 //type ReqWalletSetFee struct {
@@ -682,31 +893,19 @@ func (wallet *Wallet) ProcMergeBalance(MergeBalance *types.ReqWalletMergeBalance
 	if len(WalletAccStores) != len(accounts) {
 		walletlog.Error("ProcMergeBalance", "AccStores", len(WalletAccStores), "accounts", len(accounts))
 	}
-	//通过privkey生成一个pubkey然后换算成对应的addr
-	cr, err := crypto.New(types.GetSignName("", SignType))
-	if err != nil {
-		walletlog.Error("ProcMergeBalance", "err", err)
-		return nil, err
-	}
-
 	addrto := MergeBalance.GetTo()
 	note := "MergeBalance"
 
 	var ReplyHashes types.ReplyHashes
 
 	for index, Account := range accounts {
-		Privkey := WalletAccStores[index].Privkey
-		//解密存储的私钥
-		prikeybyte, err := common.FromHex(Privkey)
-		if err != nil || len(prikeybyte) == 0 {
-			walletlog.Error("ProcMergeBalance", "FromHex err", err, "index", index)
+		if WalletAccStores[index].GetWatchOnly() {
 			continue
 		}
-
-		privkey := wcom.CBCDecrypterPrivkey([]byte(wallet.Password), prikeybyte)
-		priv, err := cr.PrivKeyFromBytes(privkey)
+		//获取存储的私钥
+		priv, err := wallet.getPrivKey(WalletAccStores[index])
 		if err != nil {
-			walletlog.Error("ProcMergeBalance", "PrivKeyFromBytes err", err, "index", index)
+			walletlog.Error("ProcMergeBalance", "getPrivKey err", err, "index", index)
 			continue
 		}
 		//过滤掉to地址
@@ -771,7 +970,7 @@ func (wallet *Wallet) ProcWalletSetPasswd(Passwd *types.ReqWalletSetPasswd) erro
 
 	// 钱包已经加密需要验证oldpass的正确性
 	if len(wallet.Password) == 0 && wallet.EncryptFlag == 1 {
-		isok := wallet.walletStore.VerifyPasswordHash(Passwd.OldPass)
+		_, isok := wallet.verifyPassword(Passwd.OldPass)
 		if !isok {
 			walletlog.Error("ProcWalletSetPasswd Verify Oldpasswd fail!")
 			return types.ErrVerifyOldpasswdFail
@@ -783,59 +982,8 @@ func (wallet *Wallet) ProcWalletSetPasswd(Passwd *types.ReqWalletSetPasswd) erro
 		return types.ErrVerifyOldpasswdFail
 	}
 
-	//使用新的密码生成passwdhash用于下次密码的验证
-	newBatch := wallet.walletStore.NewBatch(true)
-	err = wallet.walletStore.SetPasswordHash(Passwd.NewPass, newBatch)
-	if err != nil {
-		walletlog.Error("ProcWalletSetPasswd", "SetPasswordHash err", err)
-		return err
-	}
-	//设置钱包加密标志位
-	err = wallet.walletStore.SetEncryptionFlag(newBatch)
-	if err != nil {
-		walletlog.Error("ProcWalletSetPasswd", "SetEncryptionFlag err", err)
-		return err
-	}
-	//使用old密码解密seed然后用新的钱包密码重新加密seed
-	seed, err := wallet.getSeed(Passwd.OldPass)
-	if err != nil {
-		walletlog.Error("ProcWalletSetPasswd", "getSeed err", err)
-		return err
-	}
-	ok, err := SaveSeedInBatch(wallet.walletStore.GetDB(), seed, Passwd.NewPass, newBatch)
-	if !ok {
-		walletlog.Error("ProcWalletSetPasswd", "SaveSeed err", err)
-		return err
-	}
-
-	//对所有存储的私钥重新使用新的密码加密,通过Account前缀查找获取钱包中的所有账户信息
-	WalletAccStores, err := wallet.walletStore.GetAccountByPrefix("Account")
-	if err != nil || len(WalletAccStores) == 0 {
-		walletlog.Error("ProcWalletSetPasswd", "GetAccountByPrefix:err", err)
-	}
-
-	for _, AccStore := range WalletAccStores {
-		//使用old Password解密存储的私钥
-		storekey, err := common.FromHex(AccStore.GetPrivkey())
-		if err != nil || len(storekey) == 0 {
-			walletlog.Info("ProcWalletSetPasswd", "addr", AccStore.Addr, "FromHex err", err)
-			continue
-		}
-		Decrypter := wcom.CBCDecrypterPrivkey([]byte(Passwd.OldPass), storekey)
-
-		//使用新的密码重新加密私钥
-		Encrypter := wcom.CBCEncrypterPrivkey([]byte(Passwd.NewPass), Decrypter)
-		AccStore.Privkey = common.ToHex(Encrypter)
-		err = wallet.walletStore.SetWalletAccountInBatch(true, AccStore.Addr, AccStore, newBatch)
-		if err != nil {
-			walletlog.Info("ProcWalletSetPasswd", "addr", AccStore.Addr, "SetWalletAccount err", err)
-		}
-	}
-
-	newBatch.Write()
-	wallet.Password = Passwd.NewPass
-	wallet.EncryptFlag = 1
-	return nil
+	//使用新的密码重新加密seed和私钥
+	return wallet.changePassword(Passwd.OldPass, Passwd.NewPass)
 }
 
 //锁定钱包
@@ -866,18 +1014,28 @@ func (wallet *Wallet) ProcWalletUnLock(WalletUnLock *types.WalletUnLock) error {
 	}
 	// 钱包已经加密需要验证passwd的正确性
 	if len(wallet.Password) == 0 && wallet.EncryptFlag == 1 {
-		isok := wallet.walletStore.VerifyPasswordHash(WalletUnLock.Passwd)
+		secret, isok := wallet.verifyPassword(WalletUnLock.Passwd)
 		if !isok {
 			walletlog.Error("ProcWalletUnLock Verify Oldpasswd fail!")
 			return types.ErrVerifyOldpasswdFail
 		}
+		wallet.passKey = secret
 	}
 	//内存中已经记录password时的校验
 	if len(wallet.Password) != 0 && WalletUnLock.Passwd != wallet.Password {
 		return types.ErrInputPassword
 	}
 	//本钱包没有设置密码加密过,只需要解锁不需要记录解锁密码
+	if len(wallet.Password) == 0 && wallet.EncryptFlag != 1 {
+		wallet.passKey = WalletUnLock.Passwd
+	}
 	wallet.Password = WalletUnLock.Passwd
+	//密码校验通过之后把旧版本的钱包升级到KDF加密
+	if wallet.EncryptFlag == 1 {
+		if err := wallet.upgradeWalletEncryption(WalletUnLock.Passwd); err != nil {
+			return err
+		}
+	}
 	//只解锁挖矿转账
 	if !WalletUnLock.WalletOrTicket {
 		//wallet.isTicketLocked = false
@@ -1222,6 +1380,10 @@ func (wallet *Wallet) ProcDumpPrivkey(addr string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	//远程签名进程中的私钥不能导出
+	if len(priv.Bytes()) == 0 {
+		return "", types.ErrNotSupport
+	}
 	return common.ToHex(priv.Bytes()), nil
 	//return strings.ToUpper(common.ToHex(priv.Bytes())), nil
 }
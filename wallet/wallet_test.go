@@ -6,7 +6,11 @@ package wallet
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	//	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -15,6 +19,7 @@ import (
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
 
 	// "github.com/33cn/chain33/common/log"
 
@@ -22,6 +27,9 @@ import (
 	"github.com/33cn/chain33/store"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/wallet/bipwallet"
+	wcom "github.com/33cn/chain33/wallet/common"
+	"github.com/33cn/chain33/wallet/signer"
 
 	_ "github.com/33cn/chain33/system"
 )
@@ -29,6 +37,8 @@ import (
 func init() {
 	queue.DisableLog()
 	SetLogLevel("err")
+	//测试时降低scrypt的计算量
+	wcom.DefaultScryptN = 1 << 10
 }
 
 func initEnv() (*Wallet, queue.Module, queue.Queue) {
@@ -51,6 +61,8 @@ var (
 	ToAddr1     string
 	ToAddr2     string
 	AddrPrivKey string
+	//有交易记录的地址, 用于HD钱包账户发现
+	UsedAddrs = make(map[string]bool)
 )
 
 func blockchainModProc(q queue.Queue) {
@@ -94,6 +106,13 @@ func blockchainModProc(q queue.Queue) {
 					txDetails.Txs[index] = &txDetail
 				}
 				msg.Reply(client.NewMessage("rpc", types.EventTransactionDetails, &txDetails))
+			} else if msg.Ty == types.EventGetAddrOverview {
+				addr := (msg.Data).(*types.ReqAddr)
+				overview := &types.AddrOverview{}
+				if UsedAddrs[addr.Addr] {
+					overview.TxCount = 1
+				}
+				msg.Reply(client.NewMessage("rpc", types.EventReplyAddrOverview, overview))
 			} else if msg.Ty == types.EventGetBlockHeight {
 				msg.Reply(client.NewMessage("", types.EventReplyBlockHeight, &types.ReplyBlockHeight{Height: 1}))
 			} else if msg.Ty == types.EventIsSync {
@@ -160,6 +179,11 @@ func TestWallet(t *testing.T) {
 	mempoolModProc(q)
 
 	testSeed(t, wallet)
+	testHDWallet(t, wallet)
+	testWatchOnly(t, wallet)
+	testKeystoreSigner(t, wallet)
+	testWalletPassword(t, wallet)
+	testWalletBackup(t, wallet)
 	return
 	testProcCreateNewAccount(t, wallet)
 
@@ -261,6 +285,419 @@ func testSeed(t *testing.T, wallet *Wallet) {
 	println("--------------------------")
 }
 
+func sendWalletMsg(wallet *Wallet, ty int64, data types.Message) (queue.Message, error) {
+	msg := wallet.client.NewMessage("wallet", ty, data)
+	wallet.client.Send(msg, true)
+	return wallet.client.Wait(msg)
+}
+
+func testHDWallet(t *testing.T, wallet *Wallet) {
+	println("TestHDWallet begin")
+	seed, err := GetSeed(wallet.walletStore.GetDB(), "password")
+	require.NoError(t, err)
+	hdwallet, err := newHDWallet(seed)
+	require.NoError(t, err)
+	hdAddr := func(index uint32) string {
+		_, pub, err := hdwallet.NewKeyPairByPath(bipwallet.Bip44Path(bipwallet.TypeBty, 0, 0, index))
+		require.NoError(t, err)
+		addr, err := bipwallet.PubToAddress(bipwallet.TypeBty, pub)
+		require.NoError(t, err)
+		return addr
+	}
+
+	//按路径推导账户
+	resp, err := sendWalletMsg(wallet, types.EventDeriveAccount, &types.ReqDeriveAccount{Label: "hd5", Path: "m/44'/13107'/0'/0/5"})
+	require.NoError(t, err)
+	acc := resp.GetData().(*types.WalletAccount)
+	assert.Equal(t, "hd5", acc.Label)
+	assert.Equal(t, hdAddr(5), acc.Acc.Addr)
+	_, err = sendWalletMsg(wallet, types.EventDeriveAccount, &types.ReqDeriveAccount{Label: "hd5-2", Path: "m/44'/13107'/0'/0/5"})
+	assert.Equal(t, types.ErrPrivkeyExist.Error(), err.Error())
+	_, err = sendWalletMsg(wallet, types.EventDeriveAccount, &types.ReqDeriveAccount{Label: "hdbad", Path: "44/0"})
+	assert.Equal(t, bipwallet.ErrInvalidPath.Error(), err.Error())
+
+	//扩展公钥推导出的地址和私钥推导一致
+	resp, err = sendWalletMsg(wallet, types.EventGetExtendedPubKey, &types.ReqExtendedPubKey{Account: 0})
+	require.NoError(t, err)
+	xpub := resp.GetData().(*types.ReplyString).Data
+	pub, err := bipwallet.PubFromXPub(xpub, 0, 5)
+	require.NoError(t, err)
+	addr, err := bipwallet.PubToAddress(bipwallet.TypeBty, pub)
+	require.NoError(t, err)
+	assert.Equal(t, hdAddr(5), addr)
+
+	//index 2和8有交易记录, gapLimit为5时扫描到7就停止
+	UsedAddrs[hdAddr(2)] = true
+	UsedAddrs[hdAddr(8)] = true
+	resp, err = sendWalletMsg(wallet, types.EventDiscoverAccounts, &types.ReqDiscoverAccounts{GapLimit: 5})
+	require.NoError(t, err)
+	accs := resp.GetData().(*types.WalletAccounts)
+	require.Equal(t, 1, len(accs.Wallets))
+	assert.Equal(t, hdAddr(2), accs.Wallets[0].Acc.Addr)
+	assert.Equal(t, "m/44'/13107'/0'/0/2", accs.Wallets[0].Label)
+
+	resp, err = sendWalletMsg(wallet, types.EventDiscoverAccounts, &types.ReqDiscoverAccounts{GapLimit: 10})
+	require.NoError(t, err)
+	accs = resp.GetData().(*types.WalletAccounts)
+	require.Equal(t, 1, len(accs.Wallets))
+	assert.Equal(t, hdAddr(8), accs.Wallets[0].Acc.Addr)
+
+	_, err = sendWalletMsg(wallet, types.EventDiscoverAccounts, &types.ReqDiscoverAccounts{GapLimit: -1})
+	assert.Equal(t, types.ErrInvalidParam.Error(), err.Error())
+
+	//新建账户从已发现的索引之后开始
+	resp, err = sendWalletMsg(wallet, types.EventNewAccount, &types.ReqNewAccount{Label: "hdnew"})
+	require.NoError(t, err)
+	assert.Equal(t, hdAddr(9), resp.GetData().(*types.WalletAccount).Acc.Addr)
+	println("TestHDWallet end")
+	println("--------------------------")
+}
+
+func testWatchOnly(t *testing.T, wallet *Wallet) {
+	println("TestWatchOnly begin")
+	cr, err := crypto.New(types.GetSignName("", SignType))
+	require.NoError(t, err)
+	priv, err := cr.GenKey()
+	require.NoError(t, err)
+	pubkey := common.ToHex(priv.PubKey().Bytes())
+	addr := address.PubKeyToAddress(priv.PubKey().Bytes()).String()
+	toPriv, err := cr.GenKey()
+	require.NoError(t, err)
+	toAddr := address.PubKeyToAddress(toPriv.PubKey().Bytes()).String()
+
+	_, err = sendWalletMsg(wallet, types.EventWalletImportWatchOnly, &types.ReqImportWatchOnly{Addr: toAddr, Pubkey: pubkey, Label: "watch"})
+	assert.Equal(t, types.ErrInvalidParam.Error(), err.Error())
+	resp, err := sendWalletMsg(wallet, types.EventWalletImportWatchOnly, &types.ReqImportWatchOnly{Pubkey: pubkey, Label: "watch"})
+	require.NoError(t, err)
+	acc := resp.GetData().(*types.WalletAccount)
+	assert.True(t, acc.WatchOnly)
+	assert.Equal(t, addr, acc.Acc.Addr)
+	_, err = sendWalletMsg(wallet, types.EventWalletImportWatchOnly, &types.ReqImportWatchOnly{Addr: addr, Label: "watch2"})
+	assert.Equal(t, types.ErrAccountAddrExist.Error(), err.Error())
+
+	resp, err = sendWalletMsg(wallet, types.EventWalletGetAccountList, &types.ReqAccountList{WithoutBalance: true})
+	require.NoError(t, err)
+	for _, acc := range resp.GetData().(*types.WalletAccounts).Wallets {
+		assert.Equal(t, acc.Acc.Addr == addr, acc.WatchOnly)
+	}
+
+	//导入时从blockchain获取历史交易
+	var txs *types.WalletTxDetails
+	for i := 0; i < 100; i++ {
+		resp, err = sendWalletMsg(wallet, types.EventWalletTransactionList, &types.ReqWalletTransactionList{Count: 10, Direction: 1})
+		if err == nil {
+			txs = resp.GetData().(*types.WalletTxDetails)
+			break
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	require.NotNil(t, txs)
+	assert.NotEqual(t, 0, len(txs.TxDetails))
+
+	//钱包拒绝为只读账户签名
+	_, err = sendWalletMsg(wallet, types.EventDumpPrivkey, &types.ReqString{Data: addr})
+	assert.Equal(t, types.ErrWatchOnlyAccount.Error(), err.Error())
+	_, err = sendWalletMsg(wallet, types.EventWalletSendToAddress, &types.ReqWalletSendToAddress{From: addr, To: toAddr, Amount: 1})
+	assert.NotNil(t, err)
+
+	//构造离线交易, 使用私钥离线签名
+	_, err = sendWalletMsg(wallet, types.EventWalletCreateOfflineTx, &types.ReqWalletSendToAddress{From: addr, To: toAddr, Amount: types.Coin})
+	assert.Equal(t, types.ErrInsufficientBalance.Error(), err.Error())
+	SaveAccountTomavl(wallet.client, nil, []*types.Account{{Addr: addr, Balance: 10 * types.Coin}})
+	resp, err = sendWalletMsg(wallet, types.EventWalletCreateOfflineTx, &types.ReqWalletSendToAddress{From: addr, To: toAddr, Amount: types.Coin})
+	require.NoError(t, err)
+	offline := resp.GetData().(*types.OfflineTx)
+	assert.Equal(t, addr, offline.Addr)
+	_, err = sendWalletMsg(wallet, types.EventSignRawTx, &types.ReqSignRawTx{Addr: offline.Addr, TxHex: offline.TxHex, Expire: offline.Expire})
+	assert.Equal(t, types.ErrWatchOnlyAccount.Error(), err.Error())
+	resp, err = sendWalletMsg(wallet, types.EventSignRawTx, &types.ReqSignRawTx{Privkey: common.ToHex(priv.Bytes()), TxHex: offline.TxHex, Expire: offline.Expire})
+	require.NoError(t, err)
+	txbytes, err := common.FromHex(resp.GetData().(*types.ReplySignRawTx).TxHex)
+	require.NoError(t, err)
+	var tx types.Transaction
+	require.NoError(t, types.Decode(txbytes, &tx))
+	assert.True(t, tx.CheckSign())
+	assert.Equal(t, addr, tx.From())
+
+	//导入私钥之后升级成普通账户
+	resp, err = sendWalletMsg(wallet, types.EventWalletImportPrivkey, &types.ReqWalletImportPrivkey{Privkey: common.ToHex(priv.Bytes()), Label: "watch-upgrade"})
+	require.NoError(t, err)
+	acc = resp.GetData().(*types.WalletAccount)
+	assert.False(t, acc.WatchOnly)
+	assert.Equal(t, "watch", acc.Label)
+	resp, err = sendWalletMsg(wallet, types.EventDumpPrivkey, &types.ReqString{Data: addr})
+	require.NoError(t, err)
+	assert.Equal(t, common.ToHex(priv.Bytes()), resp.GetData().(*types.ReplyString).Data)
+	println("TestWatchOnly end")
+	println("--------------------------")
+}
+
+func testKeystoreSigner(t *testing.T, wallet *Wallet) {
+	println("TestKeystoreSigner begin")
+	dir, err := ioutil.TempDir("", "keystore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	keystore, err := signer.NewKeystore(SignType, dir, 1<<10, 0)
+	require.NoError(t, err)
+	dbsigner := wallet.signer
+	wallet.signer = keystore
+	defer func() { wallet.signer = dbsigner }()
+
+	cr, err := crypto.New(types.GetSignName("", SignType))
+	require.NoError(t, err)
+	priv, err := cr.GenKey()
+	require.NoError(t, err)
+	privkey := common.ToHex(priv.Bytes())
+	resp, err := sendWalletMsg(wallet, types.EventWalletImportPrivkey, &types.ReqWalletImportPrivkey{Privkey: privkey, Label: "keystore"})
+	require.NoError(t, err)
+	addr := resp.GetData().(*types.WalletAccount).Acc.Addr
+	_, err = os.Stat(filepath.Join(dir, addr+".json"))
+	require.NoError(t, err)
+	//私钥保存在keystore中, 钱包数据库中不再记录
+	accStore, err := wallet.walletStore.GetAccountByAddr(addr)
+	require.NoError(t, err)
+	assert.Equal(t, "", accStore.Privkey)
+
+	_, err = sendWalletMsg(wallet, types.EventWalletImportPrivkey, &types.ReqWalletImportPrivkey{Privkey: privkey, Label: "keystore2"})
+	assert.Equal(t, types.ErrPrivkeyExist.Error(), err.Error())
+	resp, err = sendWalletMsg(wallet, types.EventDumpPrivkey, &types.ReqString{Data: addr})
+	require.NoError(t, err)
+	assert.Equal(t, privkey, resp.GetData().(*types.ReplyString).Data)
+
+	//恢复db signer之后, 私钥重新保存到钱包数据库中
+	accStore.Privkey, err = dbsigner.SaveKey(wallet.passKey, addr, priv.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, wallet.walletStore.SetWalletAccount(true, addr, accStore))
+	println("TestKeystoreSigner end")
+	println("--------------------------")
+}
+
+//使用独立的内存数据库构造一个钱包, 和被测钱包共用signer和api
+func newTestWallet(wallet *Wallet) *Wallet {
+	return &Wallet{
+		walletStore:    NewStore(dbm.NewDB("wallet", "memdb", "", 16)),
+		isWalletLocked: 1,
+		wg:             &sync.WaitGroup{},
+		done:           make(chan struct{}),
+		cfg:            wallet.cfg,
+		rescanwg:       &sync.WaitGroup{},
+		signer:         wallet.signer,
+		api:            wallet.api,
+		client:         wallet.client,
+	}
+}
+
+func testWalletPassword(t *testing.T, wallet *Wallet) {
+	println("TestWalletPassword begin")
+	//saveSeed之后钱包已经是KDF加密的版本
+	assert.Equal(t, walletVersionKDF, wallet.walletStore.GetWalletVersion())
+	params, err := wallet.walletStore.GetKDFParams()
+	require.NoError(t, err)
+	assert.Equal(t, 32, len(params.Salt))
+
+	resp, err := sendWalletMsg(wallet, types.EventNewAccount, &types.ReqNewAccount{Label: "password-test"})
+	require.NoError(t, err)
+	addr := resp.GetData().(*types.WalletAccount).Acc.Addr
+	priv, err := wallet.getPrivKeyByAddr(addr)
+	require.NoError(t, err)
+
+	resp, err = sendWalletMsg(wallet, types.EventWalletSetPasswd, &types.ReqWalletSetPasswd{OldPass: "wrong", NewPass: "password2"})
+	require.NoError(t, err)
+	assert.False(t, resp.GetData().(*types.Reply).IsOk)
+	resp, err = sendWalletMsg(wallet, types.EventWalletSetPasswd, &types.ReqWalletSetPasswd{OldPass: "password", NewPass: "password2"})
+	require.NoError(t, err)
+	assert.True(t, resp.GetData().(*types.Reply).IsOk)
+	//每次修改密码都会使用新的salt
+	newParams, err := wallet.walletStore.GetKDFParams()
+	require.NoError(t, err)
+	assert.NotEqual(t, params.Salt, newParams.Salt)
+	_, ok := wallet.verifyPassword("password")
+	assert.False(t, ok)
+	_, ok = wallet.verifyPassword("password2")
+	assert.True(t, ok)
+	priv2, err := wallet.getPrivKeyByAddr(addr)
+	require.NoError(t, err)
+	assert.Equal(t, priv.Bytes(), priv2.Bytes())
+	_, err = GetSeed(wallet.walletStore.GetDB(), "password")
+	assert.NotNil(t, err)
+
+	resp, err = sendWalletMsg(wallet, types.EventWalletSetPasswd, &types.ReqWalletSetPasswd{OldPass: "password2", NewPass: "password"})
+	require.NoError(t, err)
+	assert.True(t, resp.GetData().(*types.Reply).IsOk)
+	seed, err := GetSeed(wallet.walletStore.GetDB(), "password")
+	require.NoError(t, err)
+
+	//旧版本的钱包: seed和私钥直接使用密码加密, 解锁之后升级到KDF加密
+	legacy := newTestWallet(wallet)
+	password := "legacy"
+	ok, err = SaveSeed(legacy.walletStore.GetDB(), seed, password)
+	require.True(t, ok, err)
+	batch := legacy.walletStore.NewBatch(true)
+	require.NoError(t, legacy.walletStore.SetPasswordHash(password, batch))
+	require.NoError(t, legacy.walletStore.SetEncryptionFlag(batch))
+	batch.Write()
+	legacy.EncryptFlag = 1
+	accStore := &types.WalletAccountStore{
+		Privkey: common.ToHex(wcom.CBCEncrypterPrivkey([]byte(password), priv.Bytes())),
+		Label:   "legacy",
+		Addr:    addr,
+	}
+	require.NoError(t, legacy.walletStore.SetWalletAccount(false, addr, accStore))
+
+	err = legacy.ProcWalletUnLock(&types.WalletUnLock{Passwd: "wrong"})
+	assert.Equal(t, types.ErrVerifyOldpasswdFail, err)
+	assert.Equal(t, int64(0), legacy.walletStore.GetWalletVersion())
+	err = legacy.ProcWalletUnLock(&types.WalletUnLock{Passwd: password})
+	require.NoError(t, err)
+	assert.Equal(t, walletVersionKDF, legacy.walletStore.GetWalletVersion())
+	//私钥已经使用新的密钥重新加密
+	accStore, err = legacy.walletStore.GetAccountByAddr(addr)
+	require.NoError(t, err)
+	assert.NotEqual(t, common.ToHex(wcom.CBCEncrypterPrivkey([]byte(password), priv.Bytes())), accStore.Privkey)
+	priv2, err = legacy.getPrivKeyByAddr(addr)
+	require.NoError(t, err)
+	assert.Equal(t, priv.Bytes(), priv2.Bytes())
+	seedstr, err := GetSeed(legacy.walletStore.GetDB(), password)
+	require.NoError(t, err)
+	assert.Equal(t, seed, seedstr)
+
+	//升级之后重新加载钱包, 使用相同的密码可以解锁
+	legacy.Password = ""
+	legacy.passKey = ""
+	require.NoError(t, legacy.ProcWalletUnLock(&types.WalletUnLock{Passwd: password}))
+	priv2, err = legacy.getPrivKeyByAddr(addr)
+	require.NoError(t, err)
+	assert.Equal(t, priv.Bytes(), priv2.Bytes())
+
+	//有一个私钥不能重新加密的时候整个修改失败, 旧的密码仍然可以使用
+	broken := "1BrokenAccountAddr"
+	require.NoError(t, legacy.walletStore.SetWalletAccount(false, broken, &types.WalletAccountStore{Privkey: "gcm:0x0102", Label: "broken", Addr: broken}))
+	legacy.mtx.Lock()
+	err = legacy.changePassword(password, "legacy2")
+	legacy.mtx.Unlock()
+	assert.NotNil(t, err)
+	_, ok = legacy.verifyPassword(password)
+	assert.True(t, ok)
+	priv2, err = legacy.getPrivKeyByAddr(addr)
+	require.NoError(t, err)
+	assert.Equal(t, priv.Bytes(), priv2.Bytes())
+
+	//私钥保存在keystore中, 修改密码中途失败的时候原来的私钥文件不变
+	dir, err := ioutil.TempDir("", "keystore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	keystore, err := signer.NewKeystore(SignType, dir, 1<<10, 0)
+	require.NoError(t, err)
+	ks := newTestWallet(wallet)
+	ks.signer = keystore
+	password = "keystore"
+	ok, err = SaveSeed(ks.walletStore.GetDB(), seed, password)
+	require.True(t, ok, err)
+	batch = ks.walletStore.NewBatch(true)
+	require.NoError(t, ks.walletStore.SetPasswordHash(password, batch))
+	require.NoError(t, ks.walletStore.SetEncryptionFlag(batch))
+	batch.Write()
+	ks.EncryptFlag = 1
+	cr, err := crypto.New(types.GetSignName("", SignType))
+	require.NoError(t, err)
+	privs := make(map[string]crypto.PrivKey)
+	for i := 0; i < 3; i++ {
+		key, err := cr.GenKey()
+		require.NoError(t, err)
+		keyAddr := address.PubKeyToAddress(key.PubKey().Bytes()).String()
+		_, err = keystore.SaveKey(password, keyAddr, key.Bytes())
+		require.NoError(t, err)
+		require.NoError(t, ks.walletStore.SetWalletAccount(false, keyAddr, &types.WalletAccountStore{Label: fmt.Sprint("keystore", i), Addr: keyAddr}))
+		privs[keyAddr] = key
+	}
+	accs, err := ks.walletStore.GetAccountByPrefix("Account")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(accs))
+	//最后一个私钥文件损坏, 前面的私钥已经用新的密码重新加密
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, accs[2].Addr+".json"), []byte("broken"), 0600))
+	ks.mtx.Lock()
+	err = ks.changePassword(password, "keystore2")
+	ks.mtx.Unlock()
+	assert.NotNil(t, err)
+	_, ok = ks.verifyPassword(password)
+	assert.True(t, ok)
+	pending, err := filepath.Glob(filepath.Join(dir, "*.pending"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(pending))
+	for _, acc := range accs[:2] {
+		key, err := keystore.GetKey(password, acc)
+		require.NoError(t, err)
+		assert.Equal(t, privs[acc.Addr].Bytes(), key.Bytes())
+	}
+	//修复以后修改成功, 所有私钥都使用新的密码
+	_, err = keystore.SaveKey(password, accs[2].Addr, privs[accs[2].Addr].Bytes())
+	require.NoError(t, err)
+	ks.mtx.Lock()
+	err = ks.changePassword(password, "keystore2")
+	ks.mtx.Unlock()
+	require.NoError(t, err)
+	pending, err = filepath.Glob(filepath.Join(dir, "*.pending"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(pending))
+	for _, acc := range accs {
+		key, err := ks.getPrivKeyByAddr(acc.Addr)
+		require.NoError(t, err)
+		assert.Equal(t, privs[acc.Addr].Bytes(), key.Bytes())
+		_, err = keystore.GetKey(password, acc)
+		assert.Equal(t, types.ErrInputPassword, err)
+	}
+	println("TestWalletPassword end")
+	println("--------------------------")
+}
+
+func testWalletBackup(t *testing.T, wallet *Wallet) {
+	println("TestWalletBackup begin")
+	_, err := sendWalletMsg(wallet, types.EventWalletExport, &types.ReqWalletExport{})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	resp, err := sendWalletMsg(wallet, types.EventWalletExport, &types.ReqWalletExport{Passwd: "backup"})
+	require.NoError(t, err)
+	data := resp.GetData().(*types.ReplyString).Data
+	seed, err := GetSeed(wallet.walletStore.GetDB(), "password")
+	require.NoError(t, err)
+	//备份中不包含明文的seed
+	assert.NotContains(t, data, seed)
+
+	_, err = sendWalletMsg(wallet, types.EventWalletImport, &types.ReqWalletImport{Data: data, Passwd: "wrong"})
+	assert.Equal(t, types.ErrInputPassword, err)
+	//已经存在的账户会被跳过
+	resp, err = sendWalletMsg(wallet, types.EventWalletImport, &types.ReqWalletImport{Data: data, Passwd: "backup"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(resp.GetData().(*types.WalletAccounts).Wallets))
+
+	//恢复到一个新的钱包中, 备份的密码作为新钱包的密码
+	accStores, err := wallet.walletStore.GetAccountByPrefix("Account")
+	require.NoError(t, err)
+	restored := newTestWallet(wallet)
+	reply, err := restored.ProcWalletImport(&types.ReqWalletImport{Data: data, Passwd: "backup"})
+	require.NoError(t, err)
+	restored.wg.Wait()
+	assert.Equal(t, len(accStores), len(reply.Wallets))
+	seedstr, err := GetSeed(restored.walletStore.GetDB(), "backup")
+	require.NoError(t, err)
+	assert.Equal(t, seed, seedstr)
+	require.NoError(t, restored.ProcWalletUnLock(&types.WalletUnLock{Passwd: "backup"}))
+	for _, accStore := range accStores {
+		acc, err := restored.walletStore.GetAccountByAddr(accStore.Addr)
+		require.NoError(t, err)
+		assert.Equal(t, accStore.WatchOnly, acc.WatchOnly)
+		if accStore.WatchOnly {
+			continue
+		}
+		priv, err := wallet.getPrivKeyByAddr(accStore.Addr)
+		require.NoError(t, err)
+		priv2, err := restored.getPrivKeyByAddr(accStore.Addr)
+		require.NoError(t, err)
+		assert.Equal(t, priv.Bytes(), priv2.Bytes())
+	}
+	println("TestWalletBackup end")
+	println("--------------------------")
+}
+
 func testProcCreateNewAccount(t *testing.T, wallet *Wallet) {
 	println("TestProcCreateNewAccount begin")
 	total := 10
@@ -0,0 +1,208 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/hex"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	wcom "github.com/33cn/chain33/wallet/common"
+)
+
+//只读账户导入时一次从blockchain获取的交易hash数
+const rescanTxsPerTime = 100
+
+//离线交易默认的过期时间, 在离线节点签名时开始计算
+const defaultOfflineExpire = "300s"
+
+//input:
+//type ReqImportWatchOnly struct {
+//	Addr   string
+//	Pubkey string
+//	Label  string
+//output:
+//type WalletAccount struct {
+//	Acc       *Account
+//	Label     string
+//	WatchOnly bool
+//导入只读账户, 只读账户可以查看余额和交易记录, 但是钱包不会为它签名
+func (wallet *Wallet) ProcImportWatchOnly(req *types.ReqImportWatchOnly) (*types.WalletAccount, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	if req == nil || len(req.GetLabel()) == 0 || (len(req.GetAddr()) == 0 && len(req.GetPubkey()) == 0) {
+		walletlog.Error("ProcImportWatchOnly input parameter is nil!")
+		return nil, types.ErrInvalidParam
+	}
+	account, _ := wallet.walletStore.GetAccountByLabel(req.GetLabel())
+	if account != nil {
+		walletlog.Error("ProcImportWatchOnly Label is exist in wallet!")
+		return nil, types.ErrLabelHasUsed
+	}
+
+	addr := req.GetAddr()
+	if len(req.GetPubkey()) != 0 {
+		pubkeyAddr, err := pubkeyToAddr(req.GetPubkey())
+		if err != nil {
+			return nil, err
+		}
+		if len(addr) != 0 && addr != pubkeyAddr {
+			walletlog.Error("ProcImportWatchOnly pubkey not match addr", "addr", addr, "pubkeyAddr", pubkeyAddr)
+			return nil, types.ErrInvalidParam
+		}
+		addr = pubkeyAddr
+	}
+	if err := address.CheckAddress(addr); err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+	account, _ = wallet.walletStore.GetAccountByAddr(addr)
+	if account != nil {
+		walletlog.Error("ProcImportWatchOnly addr is exist in wallet!", "addr", addr)
+		return nil, types.ErrAccountAddrExist
+	}
+
+	accStore := &types.WalletAccountStore{
+		Label:     req.GetLabel(),
+		Addr:      addr,
+		WatchOnly: true,
+		Pubkey:    req.GetPubkey(),
+	}
+	err := wallet.walletStore.SetWalletAccount(false, addr, accStore)
+	if err != nil {
+		walletlog.Error("ProcImportWatchOnly", "SetWalletAccount err", err)
+		return nil, err
+	}
+
+	accounts, err := accountdb.LoadAccounts(wallet.api, []string{addr})
+	if err != nil {
+		walletlog.Error("ProcImportWatchOnly", "LoadAccounts err", err)
+		return nil, err
+	}
+	if len(accounts[0].Addr) == 0 {
+		accounts[0].Addr = addr
+	}
+	for _, policy := range wcom.PolicyContainer {
+		policy.OnImportPrivateKey(accounts[0])
+	}
+
+	//新区块中的交易通过AddrInWallet记录, 之前的交易需要从blockchain重新获取
+	wallet.wg.Add(1)
+	go wallet.rescanAddrTxs(addr)
+	return &types.WalletAccount{Acc: accounts[0], Label: req.GetLabel(), WatchOnly: true}, nil
+}
+
+//只读账户导入私钥后升级成普通账户
+func (wallet *Wallet) upgradeWatchOnly(account *types.WalletAccountStore, privkey []byte) (*types.WalletAccount, error) {
+	var err error
+	account.Privkey, err = wallet.signer.SaveKey(wallet.passKey, account.Addr, privkey)
+	if err != nil {
+		walletlog.Error("upgradeWatchOnly", "SaveKey err", err)
+		return nil, err
+	}
+	account.WatchOnly = false
+	err = wallet.walletStore.SetWalletAccount(true, account.Addr, account)
+	if err != nil {
+		walletlog.Error("upgradeWatchOnly", "SetWalletAccount err", err)
+		return nil, err
+	}
+	accounts, err := accountdb.LoadAccounts(wallet.api, []string{account.Addr})
+	if err != nil {
+		walletlog.Error("upgradeWatchOnly", "LoadAccounts err", err)
+		return nil, err
+	}
+	if len(accounts[0].Addr) == 0 {
+		accounts[0].Addr = account.Addr
+	}
+	return &types.WalletAccount{Acc: accounts[0], Label: account.Label}, nil
+}
+
+func pubkeyToAddr(pubkey string) (string, error) {
+	pub, err := common.FromHex(pubkey)
+	if err != nil || len(pub) == 0 {
+		return "", types.ErrFromHex
+	}
+	cr, err := crypto.New(types.GetSignName("", SignType))
+	if err != nil {
+		return "", err
+	}
+	if _, err = cr.PubKeyFromBytes(pub); err != nil {
+		walletlog.Error("pubkeyToAddr", "PubKeyFromBytes err", err)
+		return "", types.ErrPubKeyLen
+	}
+	return address.PubKeyToAddress(pub).String(), nil
+}
+
+//分页获取地址参与的所有交易并保存到钱包数据库中
+func (wallet *Wallet) rescanAddrTxs(addr string) {
+	defer wallet.wg.Done()
+	req := &types.ReqAddr{Addr: addr, Count: rescanTxsPerTime, Direction: 0, Height: -1}
+	for {
+		if wallet.IsClose() {
+			return
+		}
+		txinfos, err := wallet.api.GetTransactionByAddr(req)
+		if err != nil || txinfos == nil || len(txinfos.TxInfos) == 0 {
+			//地址没有交易记录时也会返回错误
+			walletlog.Debug("rescanAddrTxs", "addr", addr, "err", err)
+			return
+		}
+		var hashes types.ReqHashes
+		for _, txinfo := range txinfos.TxInfos {
+			hashes.Hashes = append(hashes.Hashes, txinfo.GetHash())
+		}
+		wallet.GetTxDetailByHashs(&hashes)
+		if len(txinfos.TxInfos) < rescanTxsPerTime {
+			return
+		}
+		last := txinfos.TxInfos[len(txinfos.TxInfos)-1]
+		req.Height = last.GetHeight()
+		req.Index = last.GetIndex()
+	}
+}
+
+//input:
+//type ReqWalletSendToAddress struct {
+//	From   string
+//	To     string
+//	Amount int64
+//	Note   string
+//output:
+//type OfflineTx struct {
+//	Addr   string
+//	TxHex  string
+//	Expire string
+//为钱包中的账户(一般是只读账户)构造未签名的转账交易, 导出后在离线节点上签名
+func (wallet *Wallet) ProcCreateOfflineTx(req *types.ReqWalletSendToAddress) (*types.OfflineTx, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	if req == nil || len(req.GetFrom()) == 0 || len(req.GetTo()) == 0 {
+		walletlog.Error("ProcCreateOfflineTx input para From or To is nil!")
+		return nil, types.ErrInvalidParam
+	}
+	if !wallet.AddrInWallet(req.GetFrom()) {
+		return nil, types.ErrAddrNotExist
+	}
+	//不需要签名, 所以钱包锁定时也可以构造交易
+	if err := address.CheckAddress(req.GetTo()); err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+	err := wallet.checkSendBalance(req)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := wallet.createSendToAddress(req.GetTo(), req.GetAmount(), req.GetNote(), req.GetIsToken(), req.GetTokenSymbol())
+	if err != nil {
+		return nil, err
+	}
+	return &types.OfflineTx{
+		Addr:   req.GetFrom(),
+		TxHex:  hex.EncodeToString(types.Encode(tx)),
+		Expire: defaultOfflineExpire,
+	}, nil
+}